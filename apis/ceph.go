@@ -20,6 +20,8 @@ package apis
 import (
 	"k8s.io/apimachinery/pkg/runtime"
 
+	nsv1alpha1 "github.com/linode/provider-ceph/apis/namespaced/provider-ceph/v1alpha1"
+	apisnsv1alpha1 "github.com/linode/provider-ceph/apis/namespaced/v1alpha1"
 	providercephv1alpha1 "github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
 	v1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
 )
@@ -29,6 +31,8 @@ func init() {
 	AddToSchemes = append(AddToSchemes,
 		providercephv1alpha1.SchemeBuilder.AddToScheme,
 		v1alpha1.SchemeBuilder.AddToScheme,
+		nsv1alpha1.SchemeBuilder.AddToScheme,
+		apisnsv1alpha1.SchemeBuilder.AddToScheme,
 	)
 }
 
@@ -0,0 +1,107 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+
+	providercephv1alpha1 "github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+)
+
+// A BucketSpec defines the desired state of a namespaced Bucket.
+type BucketSpec struct {
+	// +optional
+	// Providers is a list of ProviderConfig names representing
+	// S3 backends on which the bucket is to be created. Each name refers
+	// to a ProviderConfig in the namespace of the Bucket or, if there is no
+	// such ProviderConfig, to a ClusterProviderConfig.
+	Providers   []string                              `json:"providers,omitempty"`
+	ForProvider providercephv1alpha1.BucketParameters `json:"forProvider"`
+	// +optional
+	// Placement chooses the S3 backends on which the bucket is to be
+	// created when Providers is not specified. Without Providers and
+	// Placement, the bucket is created on all S3 backends available to
+	// the namespace of the Bucket.
+	Placement *providercephv1alpha1.PlacementPolicy `json:"placement,omitempty"`
+	// +optional
+	// ReplicaSync synchronises the objects of the bucket from a primary
+	// backend to the other backends of the bucket. Objects are not
+	// synchronised when omitted.
+	ReplicaSync *providercephv1alpha1.ReplicaSync `json:"replicaSync,omitempty"`
+	// Disabled allows the user to create a Bucket CR without creating
+	// buckets on any S3 backends. If an existing bucket CR is updated
+	// with Disabled=true, then provider-ceph attempts to remove any
+	// existing buckets from the existing S3 backends and the Bucket
+	// CR's status is updated accordingly.
+	// This flag overrides 'Providers'.
+	Disabled bool `json:"disabled,omitempty"`
+	// LifecycleConfigurationDisabled causes provider-ceph to
+	// attempt deletion and/or avoid create/updates of the
+	// lifecycle config for the bucket on all of the bucket's
+	// backends. The Bucket CR's status is updated accordingly.
+	LifecycleConfigurationDisabled bool `json:"lifecycleConfigurationDisabled,omitempty"`
+	// +optional
+	// AutoPause allows the user to disable further reconciliation
+	// of the bucket after successfully created or updated.
+	// If `crossplane.io/paused` label is `true`, disables reconciliation of object.
+	// If `crossplane.io/paused` label is missing or empty, triggers auto pause function.
+	// Any other value disables auto pause function on bucket.
+	AutoPause                bool `json:"autoPause,omitempty"`
+	xpv2.ManagedResourceSpec `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Bucket is a namespaced S3 bucket on one or more Ceph backends.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,ceph}
+type Bucket struct {
+	Spec              BucketSpec                        `json:"spec"`
+	Status            providercephv1alpha1.BucketStatus `json:"status,omitempty"`
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BucketList contains a list of Bucket
+type BucketList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Bucket `json:"items"`
+}
+
+// Bucket type metadata.
+var (
+	BucketKind             = reflect.TypeOf(Bucket{}).Name()
+	BucketGroupKind        = schema.GroupKind{Group: Group, Kind: BucketKind}.String()
+	BucketKindAPIVersion   = BucketKind + "." + SchemeGroupVersion.String()
+	BucketGroupVersionKind = SchemeGroupVersion.WithKind(BucketKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Bucket{}, &BucketList{})
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+)
+
+// +kubebuilder:object:root=true
+
+// A ProviderConfig configures a Ceph provider for the namespaced managed
+// resources in its namespace. The Secret referenced by the credentials is
+// always read from the namespace of the ProviderConfig.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="SECRET-NAME",type="string",JSONPath=".spec.credentials.secretRef.name",priority=1
+// +kubebuilder:printcolumn:name="MODE",type="string",JSONPath=".spec.mode"
+// +kubebuilder:printcolumn:name="BACKFILL",type="string",JSONPath=".status.backfill.progress",priority=1
+// +kubebuilder:printcolumn:name="DRAIN",type="string",JSONPath=".status.drain.progress",priority=1
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,provider,ceph}
+type ProviderConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   apisv1alpha1.ProviderConfigSpec   `json:"spec"`
+	Status apisv1alpha1.ProviderConfigStatus `json:"status,omitempty"`
+}
+
+// GetSpec returns the spec of the ProviderConfig.
+func (p *ProviderConfig) GetSpec() *apisv1alpha1.ProviderConfigSpec {
+	return &p.Spec
+}
+
+// GetStatus returns the status of the ProviderConfig.
+func (p *ProviderConfig) GetStatus() *apisv1alpha1.ProviderConfigStatus {
+	return &p.Status
+}
+
+// +kubebuilder:object:root=true
+
+// ProviderConfigList contains a list of ProviderConfig.
+type ProviderConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProviderConfig `json:"items"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ClusterProviderConfig configures a Ceph provider for the namespaced
+// managed resources in all namespaces.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="SECRET-NAME",type="string",JSONPath=".spec.credentials.secretRef.name",priority=1
+// +kubebuilder:printcolumn:name="MODE",type="string",JSONPath=".spec.mode"
+// +kubebuilder:printcolumn:name="BACKFILL",type="string",JSONPath=".status.backfill.progress",priority=1
+// +kubebuilder:printcolumn:name="DRAIN",type="string",JSONPath=".status.drain.progress",priority=1
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,provider,ceph}
+type ClusterProviderConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   apisv1alpha1.ProviderConfigSpec   `json:"spec"`
+	Status apisv1alpha1.ProviderConfigStatus `json:"status,omitempty"`
+}
+
+// GetSpec returns the spec of the ClusterProviderConfig.
+func (p *ClusterProviderConfig) GetSpec() *apisv1alpha1.ProviderConfigSpec {
+	return &p.Spec
+}
+
+// GetStatus returns the status of the ClusterProviderConfig.
+func (p *ClusterProviderConfig) GetStatus() *apisv1alpha1.ProviderConfigStatus {
+	return &p.Status
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterProviderConfigList contains a list of ClusterProviderConfig.
+type ClusterProviderConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterProviderConfig `json:"items"`
+}
+
+// ProviderConfig type metadata.
+var (
+	ProviderConfigKind             = reflect.TypeOf(ProviderConfig{}).Name()
+	ProviderConfigGroupKind        = schema.GroupKind{Group: Group, Kind: ProviderConfigKind}.String()
+	ProviderConfigKindAPIVersion   = ProviderConfigKind + "." + SchemeGroupVersion.String()
+	ProviderConfigGroupVersionKind = SchemeGroupVersion.WithKind(ProviderConfigKind)
+)
+
+// ClusterProviderConfig type metadata.
+var (
+	ClusterProviderConfigKind             = reflect.TypeOf(ClusterProviderConfig{}).Name()
+	ClusterProviderConfigGroupKind        = schema.GroupKind{Group: Group, Kind: ClusterProviderConfigKind}.String()
+	ClusterProviderConfigKindAPIVersion   = ClusterProviderConfigKind + "." + SchemeGroupVersion.String()
+	ClusterProviderConfigGroupVersionKind = SchemeGroupVersion.WithKind(ClusterProviderConfigKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ProviderConfig{}, &ProviderConfigList{})
+	SchemeBuilder.Register(&ClusterProviderConfig{}, &ClusterProviderConfigList{})
+}
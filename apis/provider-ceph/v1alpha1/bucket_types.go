@@ -26,6 +26,7 @@ import (
 )
 
 // BucketParameters are the configurable fields of a Bucket.
+// +kubebuilder:validation:XValidation:rule="!has(self.policy) || !has(self.bucketPolicy)",message="policy and bucketPolicy are mutually exclusive"
 type BucketParameters struct {
 	// The canned ACL to apply to the bucket.
 	// +kubebuilder:validation:Enum=private;public-read;public-read-write;authenticated-read
@@ -71,6 +72,11 @@ type BucketParameters struct {
 	// over every object in the bucket. The bucket only accepts PUT requests that
 	// don't specify an ACL or bucket owner full control ACLs, such as the bucket-owner-full-control
 	// canned ACL or an equivalent form of this ACL expressed in the XML format.
+	//
+	// The object ownership is enforced on all backends by means of the ownership
+	// controls of the bucket, which are removed from all backends when omitted.
+	// +kubebuilder:validation:Enum=BucketOwnerPreferred;ObjectWriter;BucketOwnerEnforced
+	// +optional
 	ObjectOwnership *string `json:"objectOwnership,omitempty"`
 
 	// Specifies the Region where the bucket will be created.
@@ -93,15 +99,95 @@ type BucketParameters struct {
 	// +optional
 	ObjectLockConfiguration *ObjectLockConfiguration `json:"objectLockConfiguration,omitempty"`
 
+	// Quota describes the desired quota of the bucket. The quota is disabled
+	// on all backends when omitted.
+	// +optional
+	Quota *BucketQuota `json:"quota,omitempty"`
+
+	// CORSConfiguration describes the cross-origin resource sharing rules of
+	// the bucket. The CORS configuration is removed from all backends when omitted.
+	// +optional
+	CORSConfiguration *CORSConfiguration `json:"corsConfiguration,omitempty"`
+
+	// Tags is the set of tags of the bucket. Tags are merged with the labels of
+	// the Bucket whose keys start with the tag label prefix of the provider, the
+	// tags taking precedence. The tags are removed from all backends when there
+	// are none.
+	// +kubebuilder:validation:MaxItems=50
+	// +listType=map
+	// +listMapKey=key
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+
+	// ServerSideEncryptionConfiguration describes the default server-side
+	// encryption of the objects in the bucket. The default encryption is
+	// removed from all backends when omitted.
+	// +optional
+	ServerSideEncryptionConfiguration *ServerSideEncryptionConfiguration `json:"serverSideEncryptionConfiguration,omitempty"`
+
+	// NotificationConfiguration describes the notifications which are published
+	// to topics when events occur in the bucket. The notifications are removed
+	// from all backends when omitted.
+	// +optional
+	NotificationConfiguration *NotificationConfiguration `json:"notificationConfiguration,omitempty"`
+
+	// ReplicationConfiguration describes the replication of the objects of the
+	// bucket to other buckets. Versioning must be Enabled on the bucket. The
+	// replication configuration is removed from all backends when omitted.
+	// +optional
+	ReplicationConfiguration *ReplicationConfiguration `json:"replicationConfiguration,omitempty"`
+
+	// WebsiteConfiguration describes the static website hosting of the bucket.
+	// The URL of the website is published when the ProviderConfig of the
+	// backend specifies a website endpoint. The website configuration is
+	// removed from all backends when omitted.
+	// +optional
+	WebsiteConfiguration *WebsiteConfiguration `json:"websiteConfiguration,omitempty"`
+
+	// PublicAccessBlockConfiguration describes how public access to the bucket
+	// is blocked. When omitted, the provider may block all public access by
+	// default, which the bucket opts out of by specifying a configuration which
+	// does not block it. Otherwise the configuration is removed from all
+	// backends when omitted.
+	// +optional
+	PublicAccessBlockConfiguration *PublicAccessBlockConfiguration `json:"publicAccessBlockConfiguration,omitempty"`
+
+	// LoggingConfiguration describes the server access logging of the bucket.
+	// Logging is disabled on all backends when omitted.
+	// +optional
+	LoggingConfiguration *LoggingConfiguration `json:"loggingConfiguration,omitempty"`
+
 	// AssumeRoleTags may be used to add custom values to an AssumeRole request.
 	// +optional
 	AssumeRoleTags []Tag `json:"assumeRoleTags,omitempty"`
 
+	// AssumeRoleArn is the ARN of the role assumed for S3 operations on the
+	// bucket. It takes precedence over the role of the ProviderConfig of each
+	// backend and over the role set by the --assume-role-arn flag. It must be
+	// the role of the ProviderConfig of each backend or one of its
+	// allowedRoleArns.
+	// +optional
+	AssumeRoleArn *string `json:"assumeRoleArn,omitempty"`
+
+	// AssumeRolePolicy is a JSON IAM policy passed as an inline session policy
+	// with each AssumeRole request for the bucket. It limits the permissions of
+	// the assumed role, but cannot grant permissions the role does not have.
+	// It is ignored unless a role is assumed.
+	// +optional
+	AssumeRolePolicy *string `json:"assumeRolePolicy,omitempty"`
+
 	// Policy is a JSON string of BucketPolicy.
 	// If it is set, Provider-Ceph calls PutBucketPolicy API after creating the bucket.
-	// Before adding it, you should validate the JSON string.
+	// The JSON string is validated by the webhook. It cannot be combined with
+	// BucketPolicy.
 	// +optional
 	Policy string `json:"policy,omitempty"`
+
+	// BucketPolicy is a structured bucket policy, which is an alternative to
+	// the JSON string of Policy. The policy is removed from all backends when
+	// both are omitted.
+	// +optional
+	BucketPolicy *BucketPolicy `json:"bucketPolicy,omitempty"`
 }
 
 // BackendInfo contains relevant information about an S3 backend for
@@ -124,6 +210,53 @@ type BackendInfo struct {
 	// configuration on the S3 backend. Use a pointer to allow nil value when
 	// there is no object lock configuration.
 	ObjectLockConfigurationCondition *xpv1.Condition `json:"objectLockConfigurationCondition,omitempty"`
+	// +optional
+	// QuotaCondition is the condition of the bucket quota on the S3 backend.
+	// Use a pointer to allow nil value when there is no quota.
+	QuotaCondition *xpv1.Condition `json:"quotaCondition,omitempty"`
+	// +optional
+	// CORSConfigurationCondition is the condition of the CORS configuration
+	// on the S3 backend. Use a pointer to allow nil value when there is no
+	// CORS configuration.
+	CORSConfigurationCondition *xpv1.Condition `json:"corsConfigurationCondition,omitempty"`
+	// +optional
+	// TaggingCondition is the condition of the bucket tags on the S3 backend.
+	// Use a pointer to allow nil value when there are no tags.
+	TaggingCondition *xpv1.Condition `json:"taggingCondition,omitempty"`
+	// +optional
+	// ServerSideEncryptionConfigurationCondition is the condition of the
+	// server-side encryption configuration on the S3 backend. Use a pointer
+	// to allow nil value when there is no server-side encryption configuration.
+	ServerSideEncryptionConfigurationCondition *xpv1.Condition `json:"serverSideEncryptionConfigurationCondition,omitempty"`
+	// +optional
+	// NotificationConfigurationCondition is the condition of the notification
+	// configuration on the S3 backend. Use a pointer to allow nil value when
+	// there is no notification configuration.
+	NotificationConfigurationCondition *xpv1.Condition `json:"notificationConfigurationCondition,omitempty"`
+	// +optional
+	// ReplicationConfigurationCondition is the condition of the replication
+	// configuration on the S3 backend. Use a pointer to allow nil value when
+	// there is no replication configuration.
+	ReplicationConfigurationCondition *xpv1.Condition `json:"replicationConfigurationCondition,omitempty"`
+	// +optional
+	// WebsiteConfigurationCondition is the condition of the website
+	// configuration on the S3 backend. Use a pointer to allow nil value when
+	// there is no website configuration.
+	WebsiteConfigurationCondition *xpv1.Condition `json:"websiteConfigurationCondition,omitempty"`
+	// +optional
+	// OwnershipControlsCondition is the condition of the ownership controls
+	// on the S3 backend. Use a pointer to allow nil value when there are no
+	// ownership controls.
+	OwnershipControlsCondition *xpv1.Condition `json:"ownershipControlsCondition,omitempty"`
+	// +optional
+	// PublicAccessBlockCondition is the condition of the public access block
+	// configuration on the S3 backend. Use a pointer to allow nil value when
+	// there is no public access block configuration.
+	PublicAccessBlockCondition *xpv1.Condition `json:"publicAccessBlockCondition,omitempty"`
+	// +optional
+	// LoggingConfigurationCondition is the condition of the logging configuration
+	// on the S3 backend. Use a pointer to allow nil value when logging is disabled.
+	LoggingConfigurationCondition *xpv1.Condition `json:"loggingConfigurationCondition,omitempty"`
 }
 
 // Backends is a map of the names of the S3 backends to BackendInfo.
@@ -131,13 +264,52 @@ type Backends map[string]*BackendInfo
 
 const (
 	ValidationRequiredLabel = "provider-ceph.crossplane.io/validation-required"
+	// BackfillAnnotation is set to the name of the backend onto which a Bucket
+	// is backfilled, which triggers the reconciliation of the Bucket.
+	BackfillAnnotation = "provider-ceph.crossplane.io/backfill"
+	// DrainAnnotation is set to the name of the backend from which a Bucket is
+	// being drained, which triggers the reconciliation of the Bucket.
+	DrainAnnotation = "provider-ceph.crossplane.io/drain"
 )
 
+// BackendUsage contains the usage statistics of a bucket on a single S3 backend.
+type BackendUsage struct {
+	// ObjectCount is the number of objects in the bucket.
+	ObjectCount int64 `json:"objectCount"`
+	// BytesUsed is the total size of the objects in the bucket in bytes.
+	BytesUsed int64 `json:"bytesUsed"`
+	// LastMeasured is the time at which the usage was measured.
+	LastMeasured metav1.Time `json:"lastMeasured"`
+}
+
+// BackendUsages is a map of the names of the S3 backends to BackendUsage.
+type BackendUsages map[string]*BackendUsage
+
 // BucketObservation are the observable fields of a Bucket.
 type BucketObservation struct {
 	Backends          Backends `json:"backends,omitempty"`
 	ConfigurableField string   `json:"configurableField"`
-	ObservableField   string   `json:"observableField,omitempty"`
+	// +optional
+	// Usage is the usage of the bucket on each S3 backend. It is measured
+	// periodically, independently of the reconciliation of the Bucket.
+	Usage BackendUsages `json:"usage,omitempty"`
+	// +optional
+	// PlacedBackends is the list of backends chosen by the placement
+	// policy of the bucket.
+	PlacedBackends []string `json:"placedBackends,omitempty"`
+	// +optional
+	// FailoverBackends maps each backend holding a failover replica of the
+	// bucket to the unhealthy backend which the replica stands in for.
+	FailoverBackends map[string]string `json:"failoverBackends,omitempty"`
+	// +optional
+	// ReplicaSync is the state of the synchronisation of the objects of the
+	// bucket to each backend other than the primary backend.
+	ReplicaSync ReplicaSyncStatuses `json:"replicaSync,omitempty"`
+	// +optional
+	// WebsiteURL is the URL of the static website of the bucket on the
+	// first backend on which the bucket is available. It is only set when
+	// the ProviderConfig of the backend specifies a website endpoint.
+	WebsiteURL string `json:"websiteURL,omitempty"`
 }
 
 // A BucketSpec defines the desired state of a Bucket.
@@ -147,6 +319,16 @@ type BucketSpec struct {
 	// S3 backends on which the bucket is to be created.
 	Providers   []string         `json:"providers,omitempty"`
 	ForProvider BucketParameters `json:"forProvider"`
+	// +optional
+	// Placement chooses the S3 backends on which the bucket is to be
+	// created when Providers is not specified. Without Providers and
+	// Placement, the bucket is created on all S3 backends.
+	Placement *PlacementPolicy `json:"placement,omitempty"`
+	// +optional
+	// ReplicaSync synchronises the objects of the bucket from a primary
+	// backend to the other backends of the bucket. Objects are not
+	// synchronised when omitted.
+	ReplicaSync *ReplicaSync `json:"replicaSync,omitempty"`
 	// Disabled allows the user to create a Bucket CR without creating
 	// buckets on any S3 backends. If an existing bucket CR is updated
 	// with Disabled=true, then provider-ceph attempts to remove any
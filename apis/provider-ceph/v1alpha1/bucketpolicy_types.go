@@ -0,0 +1,79 @@
+package v1alpha1
+
+// BucketPolicy is a structured bucket policy, which is an alternative to the
+// JSON string of Policy. It is rendered to the JSON policy language, eg the
+// conditions of a statement are rendered to a map of condition operators.
+type BucketPolicy struct {
+	// Version is the version of the policy language.
+	// +kubebuilder:validation:Enum="2012-10-17";"2008-10-17"
+	// +kubebuilder:default="2012-10-17"
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// ID is an optional identifier of the policy.
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// Statements are the statements of the policy.
+	// +kubebuilder:validation:MinItems=1
+	Statements []BucketPolicyStatement `json:"statements"`
+}
+
+// BucketPolicyStatement is a single statement of a bucket policy.
+type BucketPolicyStatement struct {
+	// SID is an optional identifier of the statement.
+	// +optional
+	SID *string `json:"sid,omitempty"`
+
+	// Effect specifies whether the statement allows or denies access.
+	// +kubebuilder:validation:Enum=Allow;Deny
+	Effect string `json:"effect"`
+
+	// Principal is the principal to which the statement applies.
+	Principal BucketPolicyPrincipal `json:"principal"`
+
+	// Actions are the actions to which the statement applies, eg "s3:GetObject".
+	// +kubebuilder:validation:MinItems=1
+	Actions []string `json:"actions"`
+
+	// Resources are the ARNs of the resources to which the statement applies,
+	// eg "arn:aws:s3:::bucket/*".
+	// +kubebuilder:validation:MinItems=1
+	Resources []string `json:"resources"`
+
+	// Conditions restrict when the statement applies.
+	// +optional
+	Conditions []BucketPolicyCondition `json:"conditions,omitempty"`
+}
+
+// BucketPolicyPrincipal is the principal of a bucket policy statement. Either
+// all principals or a list of AWS principals must be specified.
+// +kubebuilder:validation:XValidation:rule="has(self.allPrincipals) && self.allPrincipals ? !has(self.aws) : has(self.aws)",message="exactly one of allPrincipals or aws must be specified"
+type BucketPolicyPrincipal struct {
+	// AllPrincipals specifies whether the statement applies to everyone,
+	// which is rendered as the "*" principal.
+	// +optional
+	AllPrincipals *bool `json:"allPrincipals,omitempty"`
+
+	// AWS are the ARNs of the users or accounts to which the statement applies,
+	// eg "arn:aws:iam:::user/alice".
+	// +kubebuilder:validation:MinItems=1
+	// +optional
+	AWS []string `json:"aws,omitempty"`
+}
+
+// BucketPolicyCondition is a condition of a bucket policy statement, eg the
+// operator "IpAddress" with the key "aws:SourceIp" and the values of CIDRs.
+type BucketPolicyCondition struct {
+	// Operator is the condition operator, eg "StringEquals".
+	// +kubebuilder:validation:MinLength=1
+	Operator string `json:"operator"`
+
+	// Key is the condition key, eg "aws:SourceIp".
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+
+	// Values are the values against which the key is compared.
+	// +kubebuilder:validation:MinItems=1
+	Values []string `json:"values"`
+}
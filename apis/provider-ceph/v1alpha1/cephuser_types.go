@@ -0,0 +1,156 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// CephUserParameters are the configurable fields of a CephUser.
+// The RGW user ID is taken from the name of the CephUser CR, prefixed
+// with the tenant if one is specified.
+type CephUserParameters struct {
+	// Tenant is the RGW tenant the user belongs to. If set, the user ID
+	// on the backend is "<tenant>$<name>".
+	// +optional
+	Tenant string `json:"tenant,omitempty"`
+
+	// DisplayName is the display name of the user.
+	DisplayName string `json:"displayName"`
+
+	// Email is the email address associated with the user.
+	// +optional
+	Email *string `json:"email,omitempty"`
+
+	// MaxBuckets specifies the maximum number of buckets the user can own.
+	// If unset, the backend default is used.
+	// +optional
+	MaxBuckets *int32 `json:"maxBuckets,omitempty"`
+
+	// Suspended specifies whether the user should be suspended.
+	// +optional
+	Suspended *bool `json:"suspended,omitempty"`
+
+	// KeyRotationPeriod specifies how often a new S3 access key is created
+	// for the user. If unset, the access key is never rotated.
+	// +optional
+	KeyRotationPeriod *metav1.Duration `json:"keyRotationPeriod,omitempty"`
+
+	// KeyExpiryGracePeriod specifies how long a superseded access key remains
+	// valid after rotation, before it is revoked on all S3 backends. This gives
+	// consumers of the connection secret time to pick up the new key.
+	// +optional
+	// +kubebuilder:default="1h"
+	KeyExpiryGracePeriod *metav1.Duration `json:"keyExpiryGracePeriod,omitempty"`
+}
+
+// UserBackendInfo contains relevant information about an S3 backend for
+// a single user.
+type UserBackendInfo struct {
+	// UserCondition is the condition of the user on the S3 backend.
+	UserCondition xpv1.Condition `json:"userCondition,omitempty"`
+}
+
+// UserBackends is a map of the names of the S3 backends to UserBackendInfo.
+type UserBackends map[string]*UserBackendInfo
+
+// AccessKey is an S3 access key of a CephUser. The secret key is never
+// stored in the status, it is only published in the connection secret.
+type AccessKey struct {
+	// AccessKeyID is the ID of the access key.
+	AccessKeyID string `json:"accessKeyID"`
+
+	// CreatedAt is the time at which the access key was created.
+	CreatedAt metav1.Time `json:"createdAt"`
+
+	// ExpiresAt is set once the access key has been superseded by a newer
+	// access key. The access key is revoked on all S3 backends after this time.
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+}
+
+// CephUserObservation are the observable fields of a CephUser.
+type CephUserObservation struct {
+	// UID is the user ID of the user on the S3 backends.
+	UID      string       `json:"uid,omitempty"`
+	Backends UserBackends `json:"backends,omitempty"`
+
+	// AccessKeys are the S3 access keys of the user, ordered by creation time.
+	// The last access key without an expiry time is the active access key,
+	// which is published in the connection secret.
+	// +optional
+	AccessKeys []AccessKey `json:"accessKeys,omitempty"`
+}
+
+// A CephUserSpec defines the desired state of a CephUser.
+type CephUserSpec struct {
+	// +optional
+	// Providers is a list of ProviderConfig names representing
+	// S3 backends on which the user is to be created. If unset,
+	// the user is created on all S3 backends.
+	Providers         []string           `json:"providers,omitempty"`
+	ForProvider       CephUserParameters `json:"forProvider"`
+	xpv1.ResourceSpec `json:",inline"`
+}
+
+// A CephUserStatus represents the observed state of a CephUser.
+type CephUserStatus struct {
+	AtProvider          CephUserObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CephUser is an RGW user managed through the RGW Admin Ops API.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="UID",type="string",JSONPath=".status.atProvider.uid"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,ceph}
+type CephUser struct {
+	Spec              CephUserSpec   `json:"spec"`
+	Status            CephUserStatus `json:"status,omitempty"`
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CephUserList contains a list of CephUser
+type CephUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CephUser `json:"items"`
+}
+
+// CephUser type metadata.
+var (
+	CephUserKind             = reflect.TypeOf(CephUser{}).Name()
+	CephUserGroupKind        = schema.GroupKind{Group: Group, Kind: CephUserKind}.String()
+	CephUserKindAPIVersion   = CephUserKind + "." + SchemeGroupVersion.String()
+	CephUserGroupVersionKind = SchemeGroupVersion.WithKind(CephUserKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&CephUser{}, &CephUserList{})
+}
@@ -10,6 +10,19 @@ const (
 	ReasonHealthCheckDisabled v1.ConditionReason = "HealthCheckDisabled"
 	ReasonHealthCheckSuccess  v1.ConditionReason = "HealthCheckSuccess"
 	ReasonHealthCheckFail     v1.ConditionReason = "HealthCheckFail"
+
+	ReasonShadowed v1.ConditionReason = "Shadowed"
+)
+
+const (
+	// TypeCredentials indicates whether the credentials of a ProviderConfig
+	// could be loaded.
+	TypeCredentials v1.ConditionType = "Credentials"
+
+	ReasonCredentialsLoaded            v1.ConditionReason = "CredentialsLoaded"
+	ReasonCredentialsError             v1.ConditionReason = "CredentialsError"
+	ReasonUnsupportedCredentialsSource v1.ConditionReason = "UnsupportedCredentialsSource"
+	ReasonCredentialsRejected          v1.ConditionReason = "CredentialsRejected"
 )
 
 // HealthCheckDisabled returns a condition that indicates that the health
@@ -44,3 +57,63 @@ func HealthCheckFail() v1.Condition {
 		Reason:             ReasonHealthCheckFail,
 	}
 }
+
+// Shadowed returns a condition that indicates that the ClusterProviderConfig
+// is not ready because a cluster scoped ProviderConfig has the same name.
+func Shadowed() v1.Condition {
+	return v1.Condition{
+		Type:               v1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonShadowed,
+		Message:            "a ProviderConfig.ceph.crossplane.io with the same name is used instead",
+	}
+}
+
+// CredentialsLoaded returns a condition that indicates that the credentials
+// of the resource were loaded.
+func CredentialsLoaded() v1.Condition {
+	return v1.Condition{
+		Type:               TypeCredentials,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonCredentialsLoaded,
+	}
+}
+
+// CredentialsError returns a condition that indicates that the credentials
+// of the resource could not be loaded.
+func CredentialsError(err error) v1.Condition {
+	return v1.Condition{
+		Type:               TypeCredentials,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonCredentialsError,
+		Message:            err.Error(),
+	}
+}
+
+// UnsupportedCredentialsSource returns a condition that indicates that the
+// credentials source of the resource is not supported.
+func UnsupportedCredentialsSource(err error) v1.Condition {
+	return v1.Condition{
+		Type:               TypeCredentials,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUnsupportedCredentialsSource,
+		Message:            err.Error(),
+	}
+}
+
+// CredentialsRejected returns a condition that indicates that new credentials
+// of the resource were rejected by the backend, which keeps using the previous
+// credentials.
+func CredentialsRejected(err error) v1.Condition {
+	return v1.Condition{
+		Type:               TypeCredentials,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonCredentialsRejected,
+		Message:            err.Error(),
+	}
+}
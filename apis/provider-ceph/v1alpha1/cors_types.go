@@ -0,0 +1,47 @@
+package v1alpha1
+
+// CORSConfiguration describes the cross-origin access configuration for objects
+// in an S3 bucket.
+type CORSConfiguration struct {
+	// CORSRules is a set of origins and methods (cross-origin access that you want
+	// to allow). You can add up to 100 rules to the configuration.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=100
+	CORSRules []CORSRule `json:"corsRules"`
+}
+
+// CORSRule specifies a cross-origin access rule for an S3 bucket.
+type CORSRule struct {
+	// AllowedHeaders specifies which headers are allowed in a preflight OPTIONS
+	// request through the Access-Control-Request-Headers header.
+	// +optional
+	AllowedHeaders []string `json:"allowedHeaders,omitempty"`
+
+	// AllowedMethods is an HTTP method that you allow the origin to execute.
+	// Valid values are GET, PUT, HEAD, POST, and DELETE.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:Enum=GET;PUT;HEAD;POST;DELETE
+	AllowedMethods []string `json:"allowedMethods"`
+
+	// AllowedOrigins is one or more origins you want customers to be able to
+	// access the bucket from.
+	// +kubebuilder:validation:MinItems=1
+	AllowedOrigins []string `json:"allowedOrigins"`
+
+	// ExposeHeaders is one or more headers in the response that you want customers
+	// to be able to access from their applications (for example, from a JavaScript
+	// XMLHttpRequest object).
+	// +optional
+	ExposeHeaders []string `json:"exposeHeaders,omitempty"`
+
+	// ID is a unique identifier for the rule. The value cannot be longer than 255 characters.
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// MaxAgeSeconds is the time in seconds that your browser is to cache the
+	// preflight response for the specified resource.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxAgeSeconds *int32 `json:"maxAgeSeconds,omitempty"`
+}
@@ -0,0 +1,36 @@
+package v1alpha1
+
+// Enum values for LogFormat.
+const (
+	LogFormatSimplePrefix      = "SimplePrefix"
+	LogFormatPartitionedPrefix = "PartitionedPrefix"
+)
+
+// LoggingConfiguration describes the server access logging of an S3 bucket.
+// The target bucket must be a Bucket managed by provider-ceph on the same
+// backends as the logged bucket.
+// +kubebuilder:validation:XValidation:rule="!has(self.partitionDateSource) || (has(self.logFormat) && self.logFormat == 'PartitionedPrefix')",message="partitionDateSource requires the PartitionedPrefix logFormat"
+type LoggingConfiguration struct {
+	// TargetBucket is the name of the bucket to which the logs are delivered.
+	// +kubebuilder:validation:MinLength=1
+	TargetBucket string `json:"targetBucket"`
+
+	// TargetPrefix is prepended to the keys of the log objects, eg "logs/".
+	// +optional
+	TargetPrefix *string `json:"targetPrefix,omitempty"`
+
+	// LogFormat is the format of the keys of the log objects. SimplePrefix
+	// keys are of the form [TargetPrefix][YYYY]-[MM]-[DD]-[hh]-[mm]-[ss]-[UniqueString],
+	// while PartitionedPrefix keys are partitioned by the source bucket and date,
+	// eg [TargetPrefix][SourceAccountId]/[SourceRegion]/[SourceBucket]/[YYYY]/[MM]/[DD]/[UniqueString].
+	// Defaults to SimplePrefix.
+	// +kubebuilder:validation:Enum=SimplePrefix;PartitionedPrefix
+	// +optional
+	LogFormat *string `json:"logFormat,omitempty"`
+
+	// PartitionDateSource is the date by which the keys of the PartitionedPrefix
+	// log format are partitioned. Defaults to DeliveryTime.
+	// +kubebuilder:validation:Enum=EventTime;DeliveryTime
+	// +optional
+	PartitionDateSource *string `json:"partitionDateSource,omitempty"`
+}
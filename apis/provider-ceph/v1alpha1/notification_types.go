@@ -0,0 +1,46 @@
+package v1alpha1
+
+// NotificationConfiguration describes the notifications which are published
+// to topics when events occur in an S3 bucket.
+type NotificationConfiguration struct {
+	// TopicConfigurations are the notifications which are published to topics.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=map
+	// +listMapKey=id
+	TopicConfigurations []TopicConfiguration `json:"topicConfigurations"`
+}
+
+// TopicConfiguration specifies the events of a bucket for which notifications
+// are published to a topic.
+type TopicConfiguration struct {
+	// ID is a unique identifier for the notification.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	ID string `json:"id"`
+
+	// Topic is the name of the Topic to which notifications are published.
+	// The topic must exist on all backends of the bucket.
+	// +kubebuilder:validation:MinLength=1
+	Topic string `json:"topic"`
+
+	// Events are the bucket events for which notifications are published,
+	// eg "s3:ObjectCreated:*" or "s3:ObjectRemoved:Delete".
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:Pattern=`^s3:`
+	Events []string `json:"events"`
+
+	// Filter restricts notifications to objects with matching keys.
+	// +optional
+	Filter *NotificationFilter `json:"filter,omitempty"`
+}
+
+// NotificationFilter restricts notifications by object key.
+type NotificationFilter struct {
+	// Prefix is the prefix which object keys must start with.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+
+	// Suffix is the suffix which object keys must end with.
+	// +optional
+	Suffix *string `json:"suffix,omitempty"`
+}
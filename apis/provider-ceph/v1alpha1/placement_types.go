@@ -0,0 +1,46 @@
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// PlacementStrategy is the strategy used to choose the backends of a bucket.
+// +kubebuilder:validation:Enum=SpreadByLabel;LeastUsed;RoundRobin
+type PlacementStrategy string
+
+const (
+	// PlacementStrategySpreadByLabel spreads the replicas of a bucket across
+	// the values of a ProviderConfig label, eg one replica per zone.
+	PlacementStrategySpreadByLabel PlacementStrategy = "SpreadByLabel"
+	// PlacementStrategyLeastUsed places a bucket on the backends which hold
+	// the fewest buckets.
+	PlacementStrategyLeastUsed PlacementStrategy = "LeastUsed"
+	// PlacementStrategyRoundRobin rotates the backends on which consecutive
+	// buckets are placed.
+	PlacementStrategyRoundRobin PlacementStrategy = "RoundRobin"
+)
+
+// PlacementPolicy describes how the backends of a bucket are chosen when
+// no Providers are specified. The chosen backends are recorded in the status
+// of the Bucket and are kept across reconciles. Backends are added to the
+// placement while it has fewer than Replicas backends, but are never removed
+// from it when Replicas is lowered.
+// +kubebuilder:validation:XValidation:rule="self.strategy != 'SpreadByLabel' || has(self.spreadLabelKey)",message="spreadLabelKey is required by the SpreadByLabel strategy"
+type PlacementPolicy struct {
+	// Replicas is the number of backends on which the bucket is placed.
+	// +kubebuilder:validation:Minimum=1
+	Replicas uint `json:"replicas"`
+
+	// Selector selects the ProviderConfigs, by label, on which the bucket
+	// may be placed. All backends are eligible when omitted.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// Strategy is the strategy used to choose among the eligible backends.
+	// +kubebuilder:default=RoundRobin
+	// +optional
+	Strategy PlacementStrategy `json:"strategy,omitempty"`
+
+	// SpreadLabelKey is the ProviderConfig label, eg "topology.kubernetes.io/zone",
+	// whose values the replicas are spread across by the SpreadByLabel strategy.
+	// +optional
+	SpreadLabelKey string `json:"spreadLabelKey,omitempty"`
+}
@@ -0,0 +1,30 @@
+package v1alpha1
+
+// PublicAccessBlockConfiguration describes how public access to an S3 bucket
+// and its objects is blocked. Fields which are omitted are false.
+type PublicAccessBlockConfiguration struct {
+	// BlockPublicAcls specifies whether requests which set a public ACL on
+	// the bucket or its objects are rejected.
+	// +optional
+	BlockPublicAcls *bool `json:"blockPublicAcls,omitempty"`
+
+	// IgnorePublicAcls specifies whether public ACLs of the bucket and its
+	// objects are ignored.
+	// +optional
+	IgnorePublicAcls *bool `json:"ignorePublicAcls,omitempty"`
+
+	// BlockPublicPolicy specifies whether requests which set a public bucket
+	// policy are rejected.
+	// +optional
+	BlockPublicPolicy *bool `json:"blockPublicPolicy,omitempty"`
+
+	// RestrictPublicBuckets specifies whether access to the bucket is
+	// restricted to the bucket owner when the bucket has a public policy.
+	// +optional
+	RestrictPublicBuckets *bool `json:"restrictPublicBuckets,omitempty"`
+}
+
+// BlocksPublicAcls returns true if the configuration blocks public ACLs.
+func (c *PublicAccessBlockConfiguration) BlocksPublicAcls() bool {
+	return c != nil && c.BlockPublicAcls != nil && *c.BlockPublicAcls
+}
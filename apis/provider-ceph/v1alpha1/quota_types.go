@@ -0,0 +1,22 @@
+package v1alpha1
+
+import "k8s.io/apimachinery/pkg/api/resource"
+
+// BucketQuota describes the quota of a bucket. Quotas are not part of the
+// S3 API and are applied through the RGW Admin Ops API instead.
+type BucketQuota struct {
+	// Enabled specifies whether the quota is enforced. Defaults to true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// MaxObjects is the maximum number of objects the bucket may contain.
+	// The number of objects is unlimited when omitted.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxObjects *int64 `json:"maxObjects,omitempty"`
+
+	// MaxSize is the maximum total size of the objects in the bucket,
+	// eg "10Gi". The size is unlimited when omitted.
+	// +optional
+	MaxSize *resource.Quantity `json:"maxSize,omitempty"`
+}
@@ -0,0 +1,66 @@
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ReplicaSync describes the synchronisation of the objects of a bucket from
+// its replica on a primary backend to its replicas on the other backends.
+// Objects are synchronised in passes, each of which copies the objects
+// modified on the primary backend since the previous pass started. Objects
+// which are deleted from the primary backend without leaving a delete marker,
+// ie from a bucket without versioning, are not deleted from the other backends.
+type ReplicaSync struct {
+	// Primary is the name of the ProviderConfig of the backend whose replica
+	// of the bucket is the source of the synchronisation.
+	// +kubebuilder:validation:MinLength=1
+	Primary string `json:"primary"`
+
+	// Versions specifies whether noncurrent object versions are synchronised
+	// in addition to the current version of each object. The versions of an
+	// object are copied oldest first, so that the current version on the
+	// primary backend is also the current version on the other backends.
+	// +optional
+	Versions bool `json:"versions,omitempty"`
+
+	// BandwidthLimit is the maximum rate, in bytes per second, at which the
+	// objects of the bucket are copied, eg "10Mi". Unlimited when omitted.
+	// +optional
+	BandwidthLimit *resource.Quantity `json:"bandwidthLimit,omitempty"`
+}
+
+// ReplicaSyncStatus is the state of the synchronisation of a bucket to a
+// single backend.
+type ReplicaSyncStatus struct {
+	// +optional
+	// SyncedUntil is the time at which the last completed pass started.
+	// Objects modified on the primary backend before this time have been
+	// synchronised to the backend.
+	SyncedUntil *metav1.Time `json:"syncedUntil,omitempty"`
+	// +optional
+	// PassStarted is the time at which the pass in progress started.
+	PassStarted *metav1.Time `json:"passStarted,omitempty"`
+	// +optional
+	// KeyMarker is the checkpoint of the pass in progress. The pass resumes
+	// with the objects listed after this key.
+	KeyMarker string `json:"keyMarker,omitempty"`
+	// +optional
+	// VersionIDMarker is the checkpoint of the pass in progress within the
+	// versions of the object at KeyMarker.
+	VersionIDMarker string `json:"versionIdMarker,omitempty"`
+	// +optional
+	// ObjectsCopied is the number of object versions copied or deleted by
+	// the pass in progress or, if none is in progress, by the last pass.
+	ObjectsCopied int64 `json:"objectsCopied,omitempty"`
+	// +optional
+	// Lag is how far the backend was behind the primary backend when the
+	// status was last updated, ie the time elapsed since SyncedUntil.
+	Lag *metav1.Duration `json:"lag,omitempty"`
+	// +optional
+	// Message describes why the last attempt to synchronise the backend failed.
+	Message string `json:"message,omitempty"`
+}
+
+// ReplicaSyncStatuses is a map of the names of the S3 backends to ReplicaSyncStatus.
+type ReplicaSyncStatuses map[string]*ReplicaSyncStatus
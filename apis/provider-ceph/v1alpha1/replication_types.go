@@ -0,0 +1,99 @@
+package v1alpha1
+
+// ReplicationConfiguration describes the replication of the objects in an S3
+// bucket to other buckets. Replication requires versioning to be Enabled on
+// the bucket.
+type ReplicationConfiguration struct {
+	// Role is the ARN of the role which the backend assumes when replicating
+	// objects. It is not required by RGW.
+	// +optional
+	Role *string `json:"role,omitempty"`
+
+	// Rules is a list of replication rules.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=1000
+	// +listType=map
+	// +listMapKey=id
+	Rules []ReplicationRule `json:"rules"`
+}
+
+// ReplicationRule specifies which objects of the bucket are replicated and
+// where they are replicated to.
+type ReplicationRule struct {
+	// ID is the unique identifier of the rule.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	ID string `json:"id"`
+
+	// Priority determines which rule takes precedence when several rules
+	// apply to an object. The rule with the highest priority wins.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Priority *int32 `json:"priority,omitempty"`
+
+	// Status specifies whether the rule is applied.
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	Status string `json:"status"`
+
+	// Filter identifies the objects to which the rule applies. The rule
+	// applies to all objects of the bucket when omitted.
+	// +optional
+	Filter *ReplicationRuleFilter `json:"filter,omitempty"`
+
+	// Destination is the bucket to which the objects are replicated.
+	Destination ReplicationDestination `json:"destination"`
+
+	// DeleteMarkerReplication specifies whether delete markers are replicated.
+	// Delete markers are not replicated when omitted.
+	// +optional
+	DeleteMarkerReplication *DeleteMarkerReplication `json:"deleteMarkerReplication,omitempty"`
+}
+
+// ReplicationRuleFilter identifies the objects to which a replication rule
+// applies. At most one of Prefix, Tag, or And may be specified.
+// +kubebuilder:validation:XValidation:rule="(has(self.prefix) ? 1 : 0) + (has(self.tag) ? 1 : 0) + (has(self.and) ? 1 : 0) <= 1",message="at most one of prefix, tag or and may be specified"
+type ReplicationRuleFilter struct {
+	// Prefix identifying one or more objects to which the rule applies.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+
+	// This tag must exist in the object's tag set in order for the rule to apply.
+	// +optional
+	Tag *Tag `json:"tag,omitempty"`
+
+	// And applies the rule to the objects which match all of its predicates.
+	// +optional
+	And *ReplicationRuleAndOperator `json:"and,omitempty"`
+}
+
+// ReplicationRuleAndOperator is used in a Replication Rule Filter to apply a
+// logical AND to two or more predicates.
+type ReplicationRuleAndOperator struct {
+	// Prefix identifying one or more objects to which the rule applies.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+
+	// All of these tags must exist in the object's tag set in order for the rule
+	// to apply.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// ReplicationDestination is the bucket to which objects are replicated.
+type ReplicationDestination struct {
+	// Bucket is the name of the destination bucket.
+	// +kubebuilder:validation:MinLength=1
+	Bucket string `json:"bucket"`
+
+	// StorageClass is the storage class of the replicated objects. The
+	// storage class of the source objects is kept when omitted.
+	// +optional
+	StorageClass *string `json:"storageClass,omitempty"`
+}
+
+// DeleteMarkerReplication specifies whether delete markers are replicated.
+type DeleteMarkerReplication struct {
+	// Status specifies whether delete markers are replicated.
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	Status string `json:"status"`
+}
@@ -0,0 +1,54 @@
+package v1alpha1
+
+// ServerSideEncryptionConfiguration describes the default server-side encryption
+// of the objects in an S3 bucket.
+type ServerSideEncryptionConfiguration struct {
+	// Rules is a list of server-side encryption rules.
+	// +kubebuilder:validation:MinItems=1
+	Rules []ServerSideEncryptionRule `json:"rules"`
+}
+
+// ServerSideEncryptionRule specifies the default server-side encryption
+// configuration of an S3 bucket.
+type ServerSideEncryptionRule struct {
+	// ApplyServerSideEncryptionByDefault specifies the default server-side
+	// encryption to apply to new objects in the bucket. If a PUT Object request
+	// doesn't specify any server-side encryption, this default encryption will
+	// be applied.
+	ApplyServerSideEncryptionByDefault ServerSideEncryptionByDefault `json:"applyServerSideEncryptionByDefault"`
+
+	// BucketKeyEnabled specifies whether Amazon S3 should use an S3 Bucket Key
+	// with server-side encryption using KMS (SSE-KMS) for new objects in the bucket.
+	// +optional
+	BucketKeyEnabled *bool `json:"bucketKeyEnabled,omitempty"`
+}
+
+// ServerSideEncryptionByDefault describes the default server-side encryption to
+// apply to new objects in the bucket.
+// +kubebuilder:validation:XValidation:rule="!has(self.kmsMasterKeyId) || self.sseAlgorithm == 'aws:kms'",message="kmsMasterKeyId requires the aws:kms sseAlgorithm"
+type ServerSideEncryptionByDefault struct {
+	// SSEAlgorithm is the server-side encryption algorithm to use for the default
+	// encryption, ie AES256 for SSE-S3 or aws:kms for SSE-KMS.
+	// +kubebuilder:validation:Enum=AES256;"aws:kms"
+	SSEAlgorithm string `json:"sseAlgorithm"`
+
+	// KMSMasterKeyID is the ID of the KMS key to use for the default encryption.
+	// It may only be specified with the aws:kms algorithm, and only on backends
+	// which support KMS.
+	// +optional
+	KMSMasterKeyID *string `json:"kmsMasterKeyId,omitempty"`
+}
+
+// HasKMSMasterKeyID returns true if a KMS key ID is specified by any rule.
+func (c *ServerSideEncryptionConfiguration) HasKMSMasterKeyID() bool {
+	if c == nil {
+		return false
+	}
+	for _, rule := range c.Rules {
+		if rule.ApplyServerSideEncryptionByDefault.KMSMasterKeyID != nil {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,108 @@
+package v1alpha1
+
+// WebsiteConfiguration describes the static website hosting of an S3 bucket.
+// The website either serves the objects of the bucket, which requires an
+// IndexDocument, or redirects all requests to another host.
+// +kubebuilder:validation:XValidation:rule="has(self.redirectAllRequestsTo) ? !has(self.indexDocument) && !has(self.errorDocument) && !has(self.routingRules) : has(self.indexDocument)",message="either indexDocument or redirectAllRequestsTo must be specified, redirectAllRequestsTo cannot be combined with other fields"
+type WebsiteConfiguration struct {
+	// IndexDocument is the document which is returned for requests to a
+	// directory of the website.
+	// +optional
+	IndexDocument *IndexDocument `json:"indexDocument,omitempty"`
+
+	// ErrorDocument is the document which is returned when an error occurs.
+	// +optional
+	ErrorDocument *ErrorDocument `json:"errorDocument,omitempty"`
+
+	// RedirectAllRequestsTo redirects all requests to the website to another host.
+	// +optional
+	RedirectAllRequestsTo *RedirectAllRequestsTo `json:"redirectAllRequestsTo,omitempty"`
+
+	// RoutingRules redirect requests which match their condition.
+	// +kubebuilder:validation:MaxItems=50
+	// +optional
+	RoutingRules []RoutingRule `json:"routingRules,omitempty"`
+}
+
+// IndexDocument is the document which is returned for requests to a directory
+// of a website.
+type IndexDocument struct {
+	// Suffix is appended to requests for a directory, eg for the suffix
+	// "index.html" a request for "images/" returns "images/index.html".
+	// +kubebuilder:validation:MinLength=1
+	Suffix string `json:"suffix"`
+}
+
+// ErrorDocument is the document which is returned when an error occurs.
+type ErrorDocument struct {
+	// Key is the key of the object which is returned when an error occurs.
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+}
+
+// RedirectAllRequestsTo redirects all requests to a website to another host.
+type RedirectAllRequestsTo struct {
+	// HostName is the host to which requests are redirected.
+	// +kubebuilder:validation:MinLength=1
+	HostName string `json:"hostName"`
+
+	// Protocol is the protocol of the redirect. The protocol of the original
+	// request is used when omitted.
+	// +kubebuilder:validation:Enum=http;https
+	// +optional
+	Protocol *string `json:"protocol,omitempty"`
+}
+
+// RoutingRule redirects the requests to a website which match its condition.
+type RoutingRule struct {
+	// Condition is the condition which requests must match to be redirected.
+	// All requests are redirected when omitted.
+	// +optional
+	Condition *RoutingRuleCondition `json:"condition,omitempty"`
+
+	// Redirect specifies where matching requests are redirected to.
+	Redirect RoutingRuleRedirect `json:"redirect"`
+}
+
+// RoutingRuleCondition is the condition which requests must match to be
+// redirected by a routing rule.
+type RoutingRuleCondition struct {
+	// HTTPErrorCodeReturnedEquals matches requests which result in the given
+	// HTTP error code, eg "404".
+	// +optional
+	HTTPErrorCodeReturnedEquals *string `json:"httpErrorCodeReturnedEquals,omitempty"`
+
+	// KeyPrefixEquals matches requests for objects whose key starts with the prefix.
+	// +optional
+	KeyPrefixEquals *string `json:"keyPrefixEquals,omitempty"`
+}
+
+// RoutingRuleRedirect specifies where the requests matching a routing rule are
+// redirected to. At most one of ReplaceKeyPrefixWith and ReplaceKeyWith may be
+// specified.
+// +kubebuilder:validation:XValidation:rule="!(has(self.replaceKeyPrefixWith) && has(self.replaceKeyWith))",message="replaceKeyPrefixWith and replaceKeyWith cannot both be specified"
+type RoutingRuleRedirect struct {
+	// HostName is the host to which requests are redirected. The host of the
+	// original request is used when omitted.
+	// +optional
+	HostName *string `json:"hostName,omitempty"`
+
+	// HTTPRedirectCode is the HTTP status code of the redirect, eg "301".
+	// +optional
+	HTTPRedirectCode *string `json:"httpRedirectCode,omitempty"`
+
+	// Protocol is the protocol of the redirect. The protocol of the original
+	// request is used when omitted.
+	// +kubebuilder:validation:Enum=http;https
+	// +optional
+	Protocol *string `json:"protocol,omitempty"`
+
+	// ReplaceKeyPrefixWith replaces the prefix matched by KeyPrefixEquals in
+	// the key of the redirect.
+	// +optional
+	ReplaceKeyPrefixWith *string `json:"replaceKeyPrefixWith,omitempty"`
+
+	// ReplaceKeyWith replaces the key of the redirect.
+	// +optional
+	ReplaceKeyWith *string `json:"replaceKeyWith,omitempty"`
+}
@@ -22,9 +22,35 @@ package v1alpha1
 
 import (
 	"github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AMQPEndpoint) DeepCopyInto(out *AMQPEndpoint) {
+	*out = *in
+	if in.AckLevel != nil {
+		in, out := &in.AckLevel, &out.AckLevel
+		*out = new(string)
+		**out = **in
+	}
+	if in.VerifySSL != nil {
+		in, out := &in.VerifySSL, &out.VerifySSL
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AMQPEndpoint.
+func (in *AMQPEndpoint) DeepCopy() *AMQPEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(AMQPEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AbortIncompleteMultipartUpload) DeepCopyInto(out *AbortIncompleteMultipartUpload) {
 	*out = *in
@@ -72,6 +98,26 @@ func (in *AccessControlPolicy) DeepCopy() *AccessControlPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessKey) DeepCopyInto(out *AccessKey) {
+	*out = *in
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessKey.
+func (in *AccessKey) DeepCopy() *AccessKey {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackendInfo) DeepCopyInto(out *BackendInfo) {
 	*out = *in
@@ -91,6 +137,56 @@ func (in *BackendInfo) DeepCopyInto(out *BackendInfo) {
 		*out = new(v1.Condition)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.QuotaCondition != nil {
+		in, out := &in.QuotaCondition, &out.QuotaCondition
+		*out = new(v1.Condition)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CORSConfigurationCondition != nil {
+		in, out := &in.CORSConfigurationCondition, &out.CORSConfigurationCondition
+		*out = new(v1.Condition)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TaggingCondition != nil {
+		in, out := &in.TaggingCondition, &out.TaggingCondition
+		*out = new(v1.Condition)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServerSideEncryptionConfigurationCondition != nil {
+		in, out := &in.ServerSideEncryptionConfigurationCondition, &out.ServerSideEncryptionConfigurationCondition
+		*out = new(v1.Condition)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NotificationConfigurationCondition != nil {
+		in, out := &in.NotificationConfigurationCondition, &out.NotificationConfigurationCondition
+		*out = new(v1.Condition)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReplicationConfigurationCondition != nil {
+		in, out := &in.ReplicationConfigurationCondition, &out.ReplicationConfigurationCondition
+		*out = new(v1.Condition)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WebsiteConfigurationCondition != nil {
+		in, out := &in.WebsiteConfigurationCondition, &out.WebsiteConfigurationCondition
+		*out = new(v1.Condition)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OwnershipControlsCondition != nil {
+		in, out := &in.OwnershipControlsCondition, &out.OwnershipControlsCondition
+		*out = new(v1.Condition)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PublicAccessBlockCondition != nil {
+		in, out := &in.PublicAccessBlockCondition, &out.PublicAccessBlockCondition
+		*out = new(v1.Condition)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LoggingConfigurationCondition != nil {
+		in, out := &in.LoggingConfigurationCondition, &out.LoggingConfigurationCondition
+		*out = new(v1.Condition)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendInfo.
@@ -103,6 +199,52 @@ func (in *BackendInfo) DeepCopy() *BackendInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendUsage) DeepCopyInto(out *BackendUsage) {
+	*out = *in
+	in.LastMeasured.DeepCopyInto(&out.LastMeasured)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendUsage.
+func (in *BackendUsage) DeepCopy() *BackendUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in BackendUsages) DeepCopyInto(out *BackendUsages) {
+	{
+		in := &in
+		*out = make(BackendUsages, len(*in))
+		for key, val := range *in {
+			var outVal *BackendUsage
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(BackendUsage)
+				(*in).DeepCopyInto(*out)
+			}
+			(*out)[key] = outVal
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendUsages.
+func (in BackendUsages) DeepCopy() BackendUsages {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendUsages)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in Backends) DeepCopyInto(out *Backends) {
 	{
@@ -233,6 +375,50 @@ func (in *BucketObservation) DeepCopyInto(out *BucketObservation) {
 			(*out)[key] = outVal
 		}
 	}
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = make(BackendUsages, len(*in))
+		for key, val := range *in {
+			var outVal *BackendUsage
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(BackendUsage)
+				(*in).DeepCopyInto(*out)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.PlacedBackends != nil {
+		in, out := &in.PlacedBackends, &out.PlacedBackends
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FailoverBackends != nil {
+		in, out := &in.FailoverBackends, &out.FailoverBackends
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ReplicaSync != nil {
+		in, out := &in.ReplicaSync, &out.ReplicaSync
+		*out = make(ReplicaSyncStatuses, len(*in))
+		for key, val := range *in {
+			var outVal *ReplicaSyncStatus
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(ReplicaSyncStatus)
+				(*in).DeepCopyInto(*out)
+			}
+			(*out)[key] = outVal
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketObservation.
@@ -308,11 +494,71 @@ func (in *BucketParameters) DeepCopyInto(out *BucketParameters) {
 		*out = new(ObjectLockConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Quota != nil {
+		in, out := &in.Quota, &out.Quota
+		*out = new(BucketQuota)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CORSConfiguration != nil {
+		in, out := &in.CORSConfiguration, &out.CORSConfiguration
+		*out = new(CORSConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServerSideEncryptionConfiguration != nil {
+		in, out := &in.ServerSideEncryptionConfiguration, &out.ServerSideEncryptionConfiguration
+		*out = new(ServerSideEncryptionConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NotificationConfiguration != nil {
+		in, out := &in.NotificationConfiguration, &out.NotificationConfiguration
+		*out = new(NotificationConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReplicationConfiguration != nil {
+		in, out := &in.ReplicationConfiguration, &out.ReplicationConfiguration
+		*out = new(ReplicationConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WebsiteConfiguration != nil {
+		in, out := &in.WebsiteConfiguration, &out.WebsiteConfiguration
+		*out = new(WebsiteConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PublicAccessBlockConfiguration != nil {
+		in, out := &in.PublicAccessBlockConfiguration, &out.PublicAccessBlockConfiguration
+		*out = new(PublicAccessBlockConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LoggingConfiguration != nil {
+		in, out := &in.LoggingConfiguration, &out.LoggingConfiguration
+		*out = new(LoggingConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AssumeRoleTags != nil {
 		in, out := &in.AssumeRoleTags, &out.AssumeRoleTags
 		*out = make([]Tag, len(*in))
 		copy(*out, *in)
 	}
+	if in.AssumeRoleArn != nil {
+		in, out := &in.AssumeRoleArn, &out.AssumeRoleArn
+		*out = new(string)
+		**out = **in
+	}
+	if in.AssumeRolePolicy != nil {
+		in, out := &in.AssumeRolePolicy, &out.AssumeRolePolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketPolicy != nil {
+		in, out := &in.BucketPolicy, &out.BucketPolicy
+		*out = new(BucketPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketParameters.
@@ -326,444 +572,1751 @@ func (in *BucketParameters) DeepCopy() *BucketParameters {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BucketSpec) DeepCopyInto(out *BucketSpec) {
+func (in *BucketPolicy) DeepCopyInto(out *BucketPolicy) {
 	*out = *in
-	if in.Providers != nil {
-		in, out := &in.Providers, &out.Providers
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Statements != nil {
+		in, out := &in.Statements, &out.Statements
+		*out = make([]BucketPolicyStatement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	in.ForProvider.DeepCopyInto(&out.ForProvider)
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketSpec.
-func (in *BucketSpec) DeepCopy() *BucketSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketPolicy.
+func (in *BucketPolicy) DeepCopy() *BucketPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(BucketSpec)
+	out := new(BucketPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BucketStatus) DeepCopyInto(out *BucketStatus) {
+func (in *BucketPolicyCondition) DeepCopyInto(out *BucketPolicyCondition) {
 	*out = *in
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketStatus.
-func (in *BucketStatus) DeepCopy() *BucketStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketPolicyCondition.
+func (in *BucketPolicyCondition) DeepCopy() *BucketPolicyCondition {
 	if in == nil {
 		return nil
 	}
-	out := new(BucketStatus)
+	out := new(BucketPolicyCondition)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DefaultRetention) DeepCopyInto(out *DefaultRetention) {
+func (in *BucketPolicyPrincipal) DeepCopyInto(out *BucketPolicyPrincipal) {
 	*out = *in
-	if in.Days != nil {
-		in, out := &in.Days, &out.Days
-		*out = new(int32)
+	if in.AllPrincipals != nil {
+		in, out := &in.AllPrincipals, &out.AllPrincipals
+		*out = new(bool)
 		**out = **in
 	}
-	if in.Years != nil {
-		in, out := &in.Years, &out.Years
-		*out = new(int32)
-		**out = **in
+	if in.AWS != nil {
+		in, out := &in.AWS, &out.AWS
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultRetention.
-func (in *DefaultRetention) DeepCopy() *DefaultRetention {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketPolicyPrincipal.
+func (in *BucketPolicyPrincipal) DeepCopy() *BucketPolicyPrincipal {
 	if in == nil {
 		return nil
 	}
-	out := new(DefaultRetention)
+	out := new(BucketPolicyPrincipal)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Grant) DeepCopyInto(out *Grant) {
+func (in *BucketPolicyStatement) DeepCopyInto(out *BucketPolicyStatement) {
 	*out = *in
-	if in.Grantee != nil {
-		in, out := &in.Grantee, &out.Grantee
-		*out = new(Grantee)
-		(*in).DeepCopyInto(*out)
+	if in.SID != nil {
+		in, out := &in.SID, &out.SID
+		*out = new(string)
+		**out = **in
+	}
+	in.Principal.DeepCopyInto(&out.Principal)
+	if in.Actions != nil {
+		in, out := &in.Actions, &out.Actions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]BucketPolicyCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Grant.
-func (in *Grant) DeepCopy() *Grant {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketPolicyStatement.
+func (in *BucketPolicyStatement) DeepCopy() *BucketPolicyStatement {
 	if in == nil {
 		return nil
 	}
-	out := new(Grant)
+	out := new(BucketPolicyStatement)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Grantee) DeepCopyInto(out *Grantee) {
+func (in *BucketQuota) DeepCopyInto(out *BucketQuota) {
 	*out = *in
-	if in.DisplayName != nil {
-		in, out := &in.DisplayName, &out.DisplayName
-		*out = new(string)
-		**out = **in
-	}
-	if in.EmailAddress != nil {
-		in, out := &in.EmailAddress, &out.EmailAddress
-		*out = new(string)
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
 		**out = **in
 	}
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(string)
+	if in.MaxObjects != nil {
+		in, out := &in.MaxObjects, &out.MaxObjects
+		*out = new(int64)
 		**out = **in
 	}
-	if in.URI != nil {
-		in, out := &in.URI, &out.URI
-		*out = new(string)
-		**out = **in
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		x := (*in).DeepCopy()
+		*out = &x
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Grantee.
-func (in *Grantee) DeepCopy() *Grantee {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketQuota.
+func (in *BucketQuota) DeepCopy() *BucketQuota {
 	if in == nil {
 		return nil
 	}
-	out := new(Grantee)
+	out := new(BucketQuota)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LifecycleExpiration) DeepCopyInto(out *LifecycleExpiration) {
+func (in *BucketSpec) DeepCopyInto(out *BucketSpec) {
 	*out = *in
-	if in.Date != nil {
-		in, out := &in.Date, &out.Date
-		*out = (*in).DeepCopy()
+	if in.Providers != nil {
+		in, out := &in.Providers, &out.Providers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.Days != nil {
-		in, out := &in.Days, &out.Days
-		*out = new(int32)
-		**out = **in
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.Placement != nil {
+		in, out := &in.Placement, &out.Placement
+		*out = new(PlacementPolicy)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.ExpiredObjectDeleteMarker != nil {
-		in, out := &in.ExpiredObjectDeleteMarker, &out.ExpiredObjectDeleteMarker
-		*out = new(bool)
-		**out = **in
+	if in.ReplicaSync != nil {
+		in, out := &in.ReplicaSync, &out.ReplicaSync
+		*out = new(ReplicaSync)
+		(*in).DeepCopyInto(*out)
 	}
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecycleExpiration.
-func (in *LifecycleExpiration) DeepCopy() *LifecycleExpiration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketSpec.
+func (in *BucketSpec) DeepCopy() *BucketSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketStatus) DeepCopyInto(out *BucketStatus) {
+	*out = *in
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketStatus.
+func (in *BucketStatus) DeepCopy() *BucketStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CORSConfiguration) DeepCopyInto(out *CORSConfiguration) {
+	*out = *in
+	if in.CORSRules != nil {
+		in, out := &in.CORSRules, &out.CORSRules
+		*out = make([]CORSRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CORSConfiguration.
+func (in *CORSConfiguration) DeepCopy() *CORSConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(CORSConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CORSRule) DeepCopyInto(out *CORSRule) {
+	*out = *in
+	if in.AllowedHeaders != nil {
+		in, out := &in.AllowedHeaders, &out.AllowedHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedMethods != nil {
+		in, out := &in.AllowedMethods, &out.AllowedMethods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedOrigins != nil {
+		in, out := &in.AllowedOrigins, &out.AllowedOrigins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExposeHeaders != nil {
+		in, out := &in.ExposeHeaders, &out.ExposeHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxAgeSeconds != nil {
+		in, out := &in.MaxAgeSeconds, &out.MaxAgeSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CORSRule.
+func (in *CORSRule) DeepCopy() *CORSRule {
+	if in == nil {
+		return nil
+	}
+	out := new(CORSRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephUser) DeepCopyInto(out *CephUser) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephUser.
+func (in *CephUser) DeepCopy() *CephUser {
+	if in == nil {
+		return nil
+	}
+	out := new(CephUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CephUser) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephUserList) DeepCopyInto(out *CephUserList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CephUser, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephUserList.
+func (in *CephUserList) DeepCopy() *CephUserList {
+	if in == nil {
+		return nil
+	}
+	out := new(CephUserList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CephUserList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephUserObservation) DeepCopyInto(out *CephUserObservation) {
+	*out = *in
+	if in.Backends != nil {
+		in, out := &in.Backends, &out.Backends
+		*out = make(UserBackends, len(*in))
+		for key, val := range *in {
+			var outVal *UserBackendInfo
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(UserBackendInfo)
+				(*in).DeepCopyInto(*out)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.AccessKeys != nil {
+		in, out := &in.AccessKeys, &out.AccessKeys
+		*out = make([]AccessKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephUserObservation.
+func (in *CephUserObservation) DeepCopy() *CephUserObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CephUserObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephUserParameters) DeepCopyInto(out *CephUserParameters) {
+	*out = *in
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxBuckets != nil {
+		in, out := &in.MaxBuckets, &out.MaxBuckets
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Suspended != nil {
+		in, out := &in.Suspended, &out.Suspended
+		*out = new(bool)
+		**out = **in
+	}
+	if in.KeyRotationPeriod != nil {
+		in, out := &in.KeyRotationPeriod, &out.KeyRotationPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.KeyExpiryGracePeriod != nil {
+		in, out := &in.KeyExpiryGracePeriod, &out.KeyExpiryGracePeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephUserParameters.
+func (in *CephUserParameters) DeepCopy() *CephUserParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CephUserParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephUserSpec) DeepCopyInto(out *CephUserSpec) {
+	*out = *in
+	if in.Providers != nil {
+		in, out := &in.Providers, &out.Providers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephUserSpec.
+func (in *CephUserSpec) DeepCopy() *CephUserSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CephUserSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephUserStatus) DeepCopyInto(out *CephUserStatus) {
+	*out = *in
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephUserStatus.
+func (in *CephUserStatus) DeepCopy() *CephUserStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CephUserStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultRetention) DeepCopyInto(out *DefaultRetention) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Years != nil {
+		in, out := &in.Years, &out.Years
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultRetention.
+func (in *DefaultRetention) DeepCopy() *DefaultRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeleteMarkerReplication) DeepCopyInto(out *DeleteMarkerReplication) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeleteMarkerReplication.
+func (in *DeleteMarkerReplication) DeepCopy() *DeleteMarkerReplication {
+	if in == nil {
+		return nil
+	}
+	out := new(DeleteMarkerReplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorDocument) DeepCopyInto(out *ErrorDocument) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorDocument.
+func (in *ErrorDocument) DeepCopy() *ErrorDocument {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorDocument)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Grant) DeepCopyInto(out *Grant) {
+	*out = *in
+	if in.Grantee != nil {
+		in, out := &in.Grantee, &out.Grantee
+		*out = new(Grantee)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Grant.
+func (in *Grant) DeepCopy() *Grant {
+	if in == nil {
+		return nil
+	}
+	out := new(Grant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Grantee) DeepCopyInto(out *Grantee) {
+	*out = *in
+	if in.DisplayName != nil {
+		in, out := &in.DisplayName, &out.DisplayName
+		*out = new(string)
+		**out = **in
+	}
+	if in.EmailAddress != nil {
+		in, out := &in.EmailAddress, &out.EmailAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.URI != nil {
+		in, out := &in.URI, &out.URI
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Grantee.
+func (in *Grantee) DeepCopy() *Grantee {
+	if in == nil {
+		return nil
+	}
+	out := new(Grantee)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPEndpoint) DeepCopyInto(out *HTTPEndpoint) {
+	*out = *in
+	if in.VerifySSL != nil {
+		in, out := &in.VerifySSL, &out.VerifySSL
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CloudEvents != nil {
+		in, out := &in.CloudEvents, &out.CloudEvents
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPEndpoint.
+func (in *HTTPEndpoint) DeepCopy() *HTTPEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IndexDocument) DeepCopyInto(out *IndexDocument) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IndexDocument.
+func (in *IndexDocument) DeepCopy() *IndexDocument {
+	if in == nil {
+		return nil
+	}
+	out := new(IndexDocument)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaEndpoint) DeepCopyInto(out *KafkaEndpoint) {
+	*out = *in
+	if in.AckLevel != nil {
+		in, out := &in.AckLevel, &out.AckLevel
+		*out = new(string)
+		**out = **in
+	}
+	if in.UseSSL != nil {
+		in, out := &in.UseSSL, &out.UseSSL
+		*out = new(bool)
+		**out = **in
+	}
+	if in.VerifySSL != nil {
+		in, out := &in.VerifySSL, &out.VerifySSL
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaEndpoint.
+func (in *KafkaEndpoint) DeepCopy() *KafkaEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifecycleExpiration) DeepCopyInto(out *LifecycleExpiration) {
+	*out = *in
+	if in.Date != nil {
+		in, out := &in.Date, &out.Date
+		*out = (*in).DeepCopy()
+	}
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ExpiredObjectDeleteMarker != nil {
+		in, out := &in.ExpiredObjectDeleteMarker, &out.ExpiredObjectDeleteMarker
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecycleExpiration.
+func (in *LifecycleExpiration) DeepCopy() *LifecycleExpiration {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecycleExpiration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifecycleRule) DeepCopyInto(out *LifecycleRule) {
+	*out = *in
+	if in.AbortIncompleteMultipartUpload != nil {
+		in, out := &in.AbortIncompleteMultipartUpload, &out.AbortIncompleteMultipartUpload
+		*out = new(AbortIncompleteMultipartUpload)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Expiration != nil {
+		in, out := &in.Expiration, &out.Expiration
+		*out = new(LifecycleExpiration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(LifecycleRuleFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.NoncurrentVersionExpiration != nil {
+		in, out := &in.NoncurrentVersionExpiration, &out.NoncurrentVersionExpiration
+		*out = new(NoncurrentVersionExpiration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NoncurrentVersionTransitions != nil {
+		in, out := &in.NoncurrentVersionTransitions, &out.NoncurrentVersionTransitions
+		*out = make([]NoncurrentVersionTransition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Transitions != nil {
+		in, out := &in.Transitions, &out.Transitions
+		*out = make([]Transition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecycleRule.
+func (in *LifecycleRule) DeepCopy() *LifecycleRule {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecycleRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifecycleRuleAndOperator) DeepCopyInto(out *LifecycleRuleAndOperator) {
+	*out = *in
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+	if in.ObjectSizeGreaterThan != nil {
+		in, out := &in.ObjectSizeGreaterThan, &out.ObjectSizeGreaterThan
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ObjectSizeLessThan != nil {
+		in, out := &in.ObjectSizeLessThan, &out.ObjectSizeLessThan
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecycleRuleAndOperator.
+func (in *LifecycleRuleAndOperator) DeepCopy() *LifecycleRuleAndOperator {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecycleRuleAndOperator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifecycleRuleFilter) DeepCopyInto(out *LifecycleRuleFilter) {
+	*out = *in
+	if in.And != nil {
+		in, out := &in.And, &out.And
+		*out = new(LifecycleRuleAndOperator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tag != nil {
+		in, out := &in.Tag, &out.Tag
+		*out = new(Tag)
+		**out = **in
+	}
+	if in.ObjectSizeGreaterThan != nil {
+		in, out := &in.ObjectSizeGreaterThan, &out.ObjectSizeGreaterThan
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ObjectSizeLessThan != nil {
+		in, out := &in.ObjectSizeLessThan, &out.ObjectSizeLessThan
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecycleRuleFilter.
+func (in *LifecycleRuleFilter) DeepCopy() *LifecycleRuleFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecycleRuleFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingConfiguration) DeepCopyInto(out *LoggingConfiguration) {
+	*out = *in
+	if in.TargetPrefix != nil {
+		in, out := &in.TargetPrefix, &out.TargetPrefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.LogFormat != nil {
+		in, out := &in.LogFormat, &out.LogFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.PartitionDateSource != nil {
+		in, out := &in.PartitionDateSource, &out.PartitionDateSource
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingConfiguration.
+func (in *LoggingConfiguration) DeepCopy() *LoggingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NoncurrentVersionExpiration) DeepCopyInto(out *NoncurrentVersionExpiration) {
+	*out = *in
+	if in.NoncurrentDays != nil {
+		in, out := &in.NoncurrentDays, &out.NoncurrentDays
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NewerNoncurrentVersions != nil {
+		in, out := &in.NewerNoncurrentVersions, &out.NewerNoncurrentVersions
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NoncurrentVersionExpiration.
+func (in *NoncurrentVersionExpiration) DeepCopy() *NoncurrentVersionExpiration {
+	if in == nil {
+		return nil
+	}
+	out := new(NoncurrentVersionExpiration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NoncurrentVersionTransition) DeepCopyInto(out *NoncurrentVersionTransition) {
+	*out = *in
+	if in.NoncurrentDays != nil {
+		in, out := &in.NoncurrentDays, &out.NoncurrentDays
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NewerNoncurrentVersions != nil {
+		in, out := &in.NewerNoncurrentVersions, &out.NewerNoncurrentVersions
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NoncurrentVersionTransition.
+func (in *NoncurrentVersionTransition) DeepCopy() *NoncurrentVersionTransition {
+	if in == nil {
+		return nil
+	}
+	out := new(NoncurrentVersionTransition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationConfiguration) DeepCopyInto(out *NotificationConfiguration) {
+	*out = *in
+	if in.TopicConfigurations != nil {
+		in, out := &in.TopicConfigurations, &out.TopicConfigurations
+		*out = make([]TopicConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationConfiguration.
+func (in *NotificationConfiguration) DeepCopy() *NotificationConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationFilter) DeepCopyInto(out *NotificationFilter) {
+	*out = *in
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Suffix != nil {
+		in, out := &in.Suffix, &out.Suffix
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationFilter.
+func (in *NotificationFilter) DeepCopy() *NotificationFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectLockConfiguration) DeepCopyInto(out *ObjectLockConfiguration) {
+	*out = *in
+	if in.ObjectLockEnabled != nil {
+		in, out := &in.ObjectLockEnabled, &out.ObjectLockEnabled
+		*out = new(ObjectLockEnabled)
+		**out = **in
+	}
+	if in.Rule != nil {
+		in, out := &in.Rule, &out.Rule
+		*out = new(ObjectLockRule)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectLockConfiguration.
+func (in *ObjectLockConfiguration) DeepCopy() *ObjectLockConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectLockConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectLockRule) DeepCopyInto(out *ObjectLockRule) {
+	*out = *in
+	if in.DefaultRetention != nil {
+		in, out := &in.DefaultRetention, &out.DefaultRetention
+		*out = new(DefaultRetention)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectLockRule.
+func (in *ObjectLockRule) DeepCopy() *ObjectLockRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectLockRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Owner) DeepCopyInto(out *Owner) {
+	*out = *in
+	if in.DisplayName != nil {
+		in, out := &in.DisplayName, &out.DisplayName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Owner.
+func (in *Owner) DeepCopy() *Owner {
+	if in == nil {
+		return nil
+	}
+	out := new(Owner)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementPolicy) DeepCopyInto(out *PlacementPolicy) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementPolicy.
+func (in *PlacementPolicy) DeepCopy() *PlacementPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicAccessBlockConfiguration) DeepCopyInto(out *PublicAccessBlockConfiguration) {
+	*out = *in
+	if in.BlockPublicAcls != nil {
+		in, out := &in.BlockPublicAcls, &out.BlockPublicAcls
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IgnorePublicAcls != nil {
+		in, out := &in.IgnorePublicAcls, &out.IgnorePublicAcls
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BlockPublicPolicy != nil {
+		in, out := &in.BlockPublicPolicy, &out.BlockPublicPolicy
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RestrictPublicBuckets != nil {
+		in, out := &in.RestrictPublicBuckets, &out.RestrictPublicBuckets
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicAccessBlockConfiguration.
+func (in *PublicAccessBlockConfiguration) DeepCopy() *PublicAccessBlockConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicAccessBlockConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedirectAllRequestsTo) DeepCopyInto(out *RedirectAllRequestsTo) {
+	*out = *in
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedirectAllRequestsTo.
+func (in *RedirectAllRequestsTo) DeepCopy() *RedirectAllRequestsTo {
+	if in == nil {
+		return nil
+	}
+	out := new(RedirectAllRequestsTo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicaSync) DeepCopyInto(out *ReplicaSync) {
+	*out = *in
+	if in.BandwidthLimit != nil {
+		in, out := &in.BandwidthLimit, &out.BandwidthLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicaSync.
+func (in *ReplicaSync) DeepCopy() *ReplicaSync {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicaSync)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicaSyncStatus) DeepCopyInto(out *ReplicaSyncStatus) {
+	*out = *in
+	if in.SyncedUntil != nil {
+		in, out := &in.SyncedUntil, &out.SyncedUntil
+		*out = (*in).DeepCopy()
+	}
+	if in.PassStarted != nil {
+		in, out := &in.PassStarted, &out.PassStarted
+		*out = (*in).DeepCopy()
+	}
+	if in.Lag != nil {
+		in, out := &in.Lag, &out.Lag
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicaSyncStatus.
+func (in *ReplicaSyncStatus) DeepCopy() *ReplicaSyncStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicaSyncStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in ReplicaSyncStatuses) DeepCopyInto(out *ReplicaSyncStatuses) {
+	{
+		in := &in
+		*out = make(ReplicaSyncStatuses, len(*in))
+		for key, val := range *in {
+			var outVal *ReplicaSyncStatus
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(ReplicaSyncStatus)
+				(*in).DeepCopyInto(*out)
+			}
+			(*out)[key] = outVal
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicaSyncStatuses.
+func (in ReplicaSyncStatuses) DeepCopy() ReplicaSyncStatuses {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicaSyncStatuses)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationConfiguration) DeepCopyInto(out *ReplicationConfiguration) {
+	*out = *in
+	if in.Role != nil {
+		in, out := &in.Role, &out.Role
+		*out = new(string)
+		**out = **in
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]ReplicationRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationConfiguration.
+func (in *ReplicationConfiguration) DeepCopy() *ReplicationConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationDestination) DeepCopyInto(out *ReplicationDestination) {
+	*out = *in
+	if in.StorageClass != nil {
+		in, out := &in.StorageClass, &out.StorageClass
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationDestination.
+func (in *ReplicationDestination) DeepCopy() *ReplicationDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationRule) DeepCopyInto(out *ReplicationRule) {
+	*out = *in
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(ReplicationRuleFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Destination.DeepCopyInto(&out.Destination)
+	if in.DeleteMarkerReplication != nil {
+		in, out := &in.DeleteMarkerReplication, &out.DeleteMarkerReplication
+		*out = new(DeleteMarkerReplication)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationRule.
+func (in *ReplicationRule) DeepCopy() *ReplicationRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationRuleAndOperator) DeepCopyInto(out *ReplicationRuleAndOperator) {
+	*out = *in
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationRuleAndOperator.
+func (in *ReplicationRuleAndOperator) DeepCopy() *ReplicationRuleAndOperator {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationRuleAndOperator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationRuleFilter) DeepCopyInto(out *ReplicationRuleFilter) {
+	*out = *in
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tag != nil {
+		in, out := &in.Tag, &out.Tag
+		*out = new(Tag)
+		**out = **in
+	}
+	if in.And != nil {
+		in, out := &in.And, &out.And
+		*out = new(ReplicationRuleAndOperator)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationRuleFilter.
+func (in *ReplicationRuleFilter) DeepCopy() *ReplicationRuleFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationRuleFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoutingRule) DeepCopyInto(out *RoutingRule) {
+	*out = *in
+	if in.Condition != nil {
+		in, out := &in.Condition, &out.Condition
+		*out = new(RoutingRuleCondition)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Redirect.DeepCopyInto(&out.Redirect)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingRule.
+func (in *RoutingRule) DeepCopy() *RoutingRule {
+	if in == nil {
+		return nil
+	}
+	out := new(RoutingRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoutingRuleCondition) DeepCopyInto(out *RoutingRuleCondition) {
+	*out = *in
+	if in.HTTPErrorCodeReturnedEquals != nil {
+		in, out := &in.HTTPErrorCodeReturnedEquals, &out.HTTPErrorCodeReturnedEquals
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyPrefixEquals != nil {
+		in, out := &in.KeyPrefixEquals, &out.KeyPrefixEquals
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingRuleCondition.
+func (in *RoutingRuleCondition) DeepCopy() *RoutingRuleCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(RoutingRuleCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoutingRuleRedirect) DeepCopyInto(out *RoutingRuleRedirect) {
+	*out = *in
+	if in.HostName != nil {
+		in, out := &in.HostName, &out.HostName
+		*out = new(string)
+		**out = **in
+	}
+	if in.HTTPRedirectCode != nil {
+		in, out := &in.HTTPRedirectCode, &out.HTTPRedirectCode
+		*out = new(string)
+		**out = **in
+	}
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReplaceKeyPrefixWith != nil {
+		in, out := &in.ReplaceKeyPrefixWith, &out.ReplaceKeyPrefixWith
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReplaceKeyWith != nil {
+		in, out := &in.ReplaceKeyWith, &out.ReplaceKeyWith
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingRuleRedirect.
+func (in *RoutingRuleRedirect) DeepCopy() *RoutingRuleRedirect {
+	if in == nil {
+		return nil
+	}
+	out := new(RoutingRuleRedirect)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerSideEncryptionByDefault) DeepCopyInto(out *ServerSideEncryptionByDefault) {
+	*out = *in
+	if in.KMSMasterKeyID != nil {
+		in, out := &in.KMSMasterKeyID, &out.KMSMasterKeyID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerSideEncryptionByDefault.
+func (in *ServerSideEncryptionByDefault) DeepCopy() *ServerSideEncryptionByDefault {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerSideEncryptionByDefault)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerSideEncryptionConfiguration) DeepCopyInto(out *ServerSideEncryptionConfiguration) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]ServerSideEncryptionRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerSideEncryptionConfiguration.
+func (in *ServerSideEncryptionConfiguration) DeepCopy() *ServerSideEncryptionConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerSideEncryptionConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerSideEncryptionRule) DeepCopyInto(out *ServerSideEncryptionRule) {
+	*out = *in
+	in.ApplyServerSideEncryptionByDefault.DeepCopyInto(&out.ApplyServerSideEncryptionByDefault)
+	if in.BucketKeyEnabled != nil {
+		in, out := &in.BucketKeyEnabled, &out.BucketKeyEnabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerSideEncryptionRule.
+func (in *ServerSideEncryptionRule) DeepCopy() *ServerSideEncryptionRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerSideEncryptionRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tag) DeepCopyInto(out *Tag) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
+func (in *Tag) DeepCopy() *Tag {
+	if in == nil {
+		return nil
+	}
+	out := new(Tag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Topic) DeepCopyInto(out *Topic) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Topic.
+func (in *Topic) DeepCopy() *Topic {
+	if in == nil {
+		return nil
+	}
+	out := new(Topic)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Topic) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopicBackendInfo) DeepCopyInto(out *TopicBackendInfo) {
+	*out = *in
+	in.TopicCondition.DeepCopyInto(&out.TopicCondition)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopicBackendInfo.
+func (in *TopicBackendInfo) DeepCopy() *TopicBackendInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(TopicBackendInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in TopicBackends) DeepCopyInto(out *TopicBackends) {
+	{
+		in := &in
+		*out = make(TopicBackends, len(*in))
+		for key, val := range *in {
+			var outVal *TopicBackendInfo
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(TopicBackendInfo)
+				(*in).DeepCopyInto(*out)
+			}
+			(*out)[key] = outVal
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopicBackends.
+func (in TopicBackends) DeepCopy() TopicBackends {
 	if in == nil {
 		return nil
 	}
-	out := new(LifecycleExpiration)
+	out := new(TopicBackends)
 	in.DeepCopyInto(out)
-	return out
+	return *out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LifecycleRule) DeepCopyInto(out *LifecycleRule) {
+func (in *TopicConfiguration) DeepCopyInto(out *TopicConfiguration) {
 	*out = *in
-	if in.AbortIncompleteMultipartUpload != nil {
-		in, out := &in.AbortIncompleteMultipartUpload, &out.AbortIncompleteMultipartUpload
-		*out = new(AbortIncompleteMultipartUpload)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Expiration != nil {
-		in, out := &in.Expiration, &out.Expiration
-		*out = new(LifecycleExpiration)
-		(*in).DeepCopyInto(*out)
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 	if in.Filter != nil {
 		in, out := &in.Filter, &out.Filter
-		*out = new(LifecycleRuleFilter)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(string)
-		**out = **in
-	}
-	if in.NoncurrentVersionExpiration != nil {
-		in, out := &in.NoncurrentVersionExpiration, &out.NoncurrentVersionExpiration
-		*out = new(NoncurrentVersionExpiration)
+		*out = new(NotificationFilter)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.NoncurrentVersionTransitions != nil {
-		in, out := &in.NoncurrentVersionTransitions, &out.NoncurrentVersionTransitions
-		*out = make([]NoncurrentVersionTransition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Transitions != nil {
-		in, out := &in.Transitions, &out.Transitions
-		*out = make([]Transition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Prefix != nil {
-		in, out := &in.Prefix, &out.Prefix
-		*out = new(string)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecycleRule.
-func (in *LifecycleRule) DeepCopy() *LifecycleRule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopicConfiguration.
+func (in *TopicConfiguration) DeepCopy() *TopicConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(LifecycleRule)
+	out := new(TopicConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LifecycleRuleAndOperator) DeepCopyInto(out *LifecycleRuleAndOperator) {
+func (in *TopicEndpoint) DeepCopyInto(out *TopicEndpoint) {
 	*out = *in
-	if in.Prefix != nil {
-		in, out := &in.Prefix, &out.Prefix
-		*out = new(string)
-		**out = **in
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]Tag, len(*in))
-		copy(*out, *in)
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPEndpoint)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.ObjectSizeGreaterThan != nil {
-		in, out := &in.ObjectSizeGreaterThan, &out.ObjectSizeGreaterThan
-		*out = new(int64)
-		**out = **in
+	if in.AMQP != nil {
+		in, out := &in.AMQP, &out.AMQP
+		*out = new(AMQPEndpoint)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.ObjectSizeLessThan != nil {
-		in, out := &in.ObjectSizeLessThan, &out.ObjectSizeLessThan
-		*out = new(int64)
-		**out = **in
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(KafkaEndpoint)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecycleRuleAndOperator.
-func (in *LifecycleRuleAndOperator) DeepCopy() *LifecycleRuleAndOperator {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopicEndpoint.
+func (in *TopicEndpoint) DeepCopy() *TopicEndpoint {
 	if in == nil {
 		return nil
 	}
-	out := new(LifecycleRuleAndOperator)
+	out := new(TopicEndpoint)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LifecycleRuleFilter) DeepCopyInto(out *LifecycleRuleFilter) {
+func (in *TopicList) DeepCopyInto(out *TopicList) {
 	*out = *in
-	if in.And != nil {
-		in, out := &in.And, &out.And
-		*out = new(LifecycleRuleAndOperator)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Prefix != nil {
-		in, out := &in.Prefix, &out.Prefix
-		*out = new(string)
-		**out = **in
-	}
-	if in.Tag != nil {
-		in, out := &in.Tag, &out.Tag
-		*out = new(Tag)
-		**out = **in
-	}
-	if in.ObjectSizeGreaterThan != nil {
-		in, out := &in.ObjectSizeGreaterThan, &out.ObjectSizeGreaterThan
-		*out = new(int64)
-		**out = **in
-	}
-	if in.ObjectSizeLessThan != nil {
-		in, out := &in.ObjectSizeLessThan, &out.ObjectSizeLessThan
-		*out = new(int64)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Topic, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecycleRuleFilter.
-func (in *LifecycleRuleFilter) DeepCopy() *LifecycleRuleFilter {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopicList.
+func (in *TopicList) DeepCopy() *TopicList {
 	if in == nil {
 		return nil
 	}
-	out := new(LifecycleRuleFilter)
+	out := new(TopicList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TopicList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NoncurrentVersionExpiration) DeepCopyInto(out *NoncurrentVersionExpiration) {
+func (in *TopicObservation) DeepCopyInto(out *TopicObservation) {
 	*out = *in
-	if in.NoncurrentDays != nil {
-		in, out := &in.NoncurrentDays, &out.NoncurrentDays
-		*out = new(int32)
-		**out = **in
-	}
-	if in.NewerNoncurrentVersions != nil {
-		in, out := &in.NewerNoncurrentVersions, &out.NewerNoncurrentVersions
-		*out = new(int32)
-		**out = **in
+	if in.Backends != nil {
+		in, out := &in.Backends, &out.Backends
+		*out = make(TopicBackends, len(*in))
+		for key, val := range *in {
+			var outVal *TopicBackendInfo
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(TopicBackendInfo)
+				(*in).DeepCopyInto(*out)
+			}
+			(*out)[key] = outVal
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NoncurrentVersionExpiration.
-func (in *NoncurrentVersionExpiration) DeepCopy() *NoncurrentVersionExpiration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopicObservation.
+func (in *TopicObservation) DeepCopy() *TopicObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(NoncurrentVersionExpiration)
+	out := new(TopicObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NoncurrentVersionTransition) DeepCopyInto(out *NoncurrentVersionTransition) {
+func (in *TopicParameters) DeepCopyInto(out *TopicParameters) {
 	*out = *in
-	if in.NoncurrentDays != nil {
-		in, out := &in.NoncurrentDays, &out.NoncurrentDays
-		*out = new(int32)
+	in.Endpoint.DeepCopyInto(&out.Endpoint)
+	if in.OpaqueData != nil {
+		in, out := &in.OpaqueData, &out.OpaqueData
+		*out = new(string)
 		**out = **in
 	}
-	if in.NewerNoncurrentVersions != nil {
-		in, out := &in.NewerNoncurrentVersions, &out.NewerNoncurrentVersions
-		*out = new(int32)
+	if in.Persistent != nil {
+		in, out := &in.Persistent, &out.Persistent
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NoncurrentVersionTransition.
-func (in *NoncurrentVersionTransition) DeepCopy() *NoncurrentVersionTransition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopicParameters.
+func (in *TopicParameters) DeepCopy() *TopicParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(NoncurrentVersionTransition)
+	out := new(TopicParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectLockConfiguration) DeepCopyInto(out *ObjectLockConfiguration) {
+func (in *TopicSpec) DeepCopyInto(out *TopicSpec) {
 	*out = *in
-	if in.ObjectLockEnabled != nil {
-		in, out := &in.ObjectLockEnabled, &out.ObjectLockEnabled
-		*out = new(ObjectLockEnabled)
-		**out = **in
-	}
-	if in.Rule != nil {
-		in, out := &in.Rule, &out.Rule
-		*out = new(ObjectLockRule)
-		(*in).DeepCopyInto(*out)
+	if in.Providers != nil {
+		in, out := &in.Providers, &out.Providers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectLockConfiguration.
-func (in *ObjectLockConfiguration) DeepCopy() *ObjectLockConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopicSpec.
+func (in *TopicSpec) DeepCopy() *TopicSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectLockConfiguration)
+	out := new(TopicSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectLockRule) DeepCopyInto(out *ObjectLockRule) {
+func (in *TopicStatus) DeepCopyInto(out *TopicStatus) {
 	*out = *in
-	if in.DefaultRetention != nil {
-		in, out := &in.DefaultRetention, &out.DefaultRetention
-		*out = new(DefaultRetention)
-		(*in).DeepCopyInto(*out)
-	}
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectLockRule.
-func (in *ObjectLockRule) DeepCopy() *ObjectLockRule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopicStatus.
+func (in *TopicStatus) DeepCopy() *TopicStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectLockRule)
+	out := new(TopicStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Owner) DeepCopyInto(out *Owner) {
+func (in *Transition) DeepCopyInto(out *Transition) {
 	*out = *in
-	if in.DisplayName != nil {
-		in, out := &in.DisplayName, &out.DisplayName
-		*out = new(string)
-		**out = **in
+	if in.Date != nil {
+		in, out := &in.Date, &out.Date
+		*out = (*in).DeepCopy()
 	}
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(string)
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = new(int32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Owner.
-func (in *Owner) DeepCopy() *Owner {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Transition.
+func (in *Transition) DeepCopy() *Transition {
 	if in == nil {
 		return nil
 	}
-	out := new(Owner)
+	out := new(Transition)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Tag) DeepCopyInto(out *Tag) {
+func (in *UserBackendInfo) DeepCopyInto(out *UserBackendInfo) {
 	*out = *in
+	in.UserCondition.DeepCopyInto(&out.UserCondition)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
-func (in *Tag) DeepCopy() *Tag {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserBackendInfo.
+func (in *UserBackendInfo) DeepCopy() *UserBackendInfo {
 	if in == nil {
 		return nil
 	}
-	out := new(Tag)
+	out := new(UserBackendInfo)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Transition) DeepCopyInto(out *Transition) {
-	*out = *in
-	if in.Date != nil {
-		in, out := &in.Date, &out.Date
-		*out = (*in).DeepCopy()
-	}
-	if in.Days != nil {
-		in, out := &in.Days, &out.Days
-		*out = new(int32)
-		**out = **in
+func (in UserBackends) DeepCopyInto(out *UserBackends) {
+	{
+		in := &in
+		*out = make(UserBackends, len(*in))
+		for key, val := range *in {
+			var outVal *UserBackendInfo
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(UserBackendInfo)
+				(*in).DeepCopyInto(*out)
+			}
+			(*out)[key] = outVal
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Transition.
-func (in *Transition) DeepCopy() *Transition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserBackends.
+func (in UserBackends) DeepCopy() UserBackends {
 	if in == nil {
 		return nil
 	}
-	out := new(Transition)
+	out := new(UserBackends)
 	in.DeepCopyInto(out)
-	return out
+	return *out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -790,3 +2343,40 @@ func (in *VersioningConfiguration) DeepCopy() *VersioningConfiguration {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebsiteConfiguration) DeepCopyInto(out *WebsiteConfiguration) {
+	*out = *in
+	if in.IndexDocument != nil {
+		in, out := &in.IndexDocument, &out.IndexDocument
+		*out = new(IndexDocument)
+		**out = **in
+	}
+	if in.ErrorDocument != nil {
+		in, out := &in.ErrorDocument, &out.ErrorDocument
+		*out = new(ErrorDocument)
+		**out = **in
+	}
+	if in.RedirectAllRequestsTo != nil {
+		in, out := &in.RedirectAllRequestsTo, &out.RedirectAllRequestsTo
+		*out = new(RedirectAllRequestsTo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RoutingRules != nil {
+		in, out := &in.RoutingRules, &out.RoutingRules
+		*out = make([]RoutingRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebsiteConfiguration.
+func (in *WebsiteConfiguration) DeepCopy() *WebsiteConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(WebsiteConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
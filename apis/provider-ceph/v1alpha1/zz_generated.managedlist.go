@@ -27,3 +27,21 @@ func (l *BucketList) GetItems() []resource.Managed {
 	}
 	return items
 }
+
+// GetItems of this CephUserList.
+func (l *CephUserList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this TopicList.
+func (l *TopicList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
@@ -18,9 +18,11 @@ package v1alpha1
 
 import (
 	"reflect"
+	"slices"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 )
@@ -39,6 +41,19 @@ type ProviderConfigSpec struct {
 	// +optional
 	STSAddress *string `json:"stsAddress,omitempty"`
 
+	// AssumeRole configures the role assumed with STS for S3 operations on
+	// this backend. It takes precedence over the role set by the
+	// --assume-role-arn flag, and may itself be overridden by a Bucket.
+	// +optional
+	AssumeRole *AssumeRoleConfig `json:"assumeRole,omitempty"`
+
+	// WebsiteEndpoint is the host of the static website endpoint of the
+	// backend, eg "s3-website.example.com". Bucket websites are served from
+	// the virtual host of the bucket on this endpoint, using the scheme set
+	// by UseHTTPS. Website URLs are not published when unset.
+	// +optional
+	WebsiteEndpoint *string `json:"websiteEndpoint,omitempty"`
+
 	// HostBucket url specified in s3cfg.
 	HostBucket string `json:"hostBucket,omitempty"`
 
@@ -50,14 +65,162 @@ type ProviderConfigSpec struct {
 	// +kubebuilder:validation:Minimum:=2
 	// +kubebuilder:default:=30
 	HealthCheckIntervalSeconds int32 `json:"healthCheckIntervalSeconds,omitempty"`
+
+	// Mode of the backend. No new buckets are placed on a Cordoned backend,
+	// unless it is specified as one of the providers of a Bucket, but the
+	// buckets already on the backend are kept. A Draining backend is cordoned
+	// and its buckets are also moved to other backends, after which the backend
+	// is disabled by label on each Bucket.
+	// +kubebuilder:validation:Enum=Active;Cordoned;Draining
+	// +kubebuilder:default:=Active
+	// +optional
+	Mode BackendMode `json:"mode,omitempty"`
+
+	// Capabilities are the optional features supported by the backend.
+	// +optional
+	Capabilities BackendCapabilities `json:"capabilities,omitempty"`
+}
+
+// AssumeRoleConfig configures the AssumeRole requests made to the STS
+// service of the backend of a ProviderConfig.
+type AssumeRoleConfig struct {
+	// RoleARN is the ARN of the role assumed for S3 operations on the backend.
+	// The role set by the --assume-role-arn flag is used when unset.
+	// +optional
+	RoleARN *string `json:"roleArn,omitempty"`
+
+	// DurationSeconds is the duration of the role sessions. The default
+	// duration of the STS service is used when unset.
+	// +kubebuilder:validation:Minimum:=900
+	// +kubebuilder:validation:Maximum:=43200
+	// +optional
+	DurationSeconds *int32 `json:"durationSeconds,omitempty"`
+
+	// ExternalID is passed to the STS service with each AssumeRole request,
+	// for roles whose trust policy requires one.
+	// +optional
+	ExternalID *string `json:"externalId,omitempty"`
+
+	// AllowedRoleARNs are the ARNs of the roles which Buckets may assume on
+	// the backend instead of RoleARN. Buckets may only override the role of
+	// the backend with one of these roles. DurationSeconds and ExternalID are
+	// not passed when assuming them.
+	// +optional
+	AllowedRoleARNs []string `json:"allowedRoleArns,omitempty"`
 }
 
+// AllowsRoleARN returns true if Buckets may assume the role on the backend,
+// which is the case for RoleARN and the AllowedRoleARNs.
+func (c *AssumeRoleConfig) AllowsRoleARN(roleARN string) bool {
+	if c == nil {
+		return false
+	}
+
+	return c.RoleARN != nil && roleARN == *c.RoleARN || slices.Contains(c.AllowedRoleARNs, roleARN)
+}
+
+// BackendCapabilities are the optional features supported by the backend of a
+// ProviderConfig. Buckets which require a feature are rejected on backends
+// which do not support it.
+type BackendCapabilities struct {
+	// KMS is true if the backend is integrated with a key management service,
+	// which is required for server-side encryption with a KMS key ID.
+	// +optional
+	KMS bool `json:"kms,omitempty"`
+}
+
+// BackendMode is the mode of the backend of a ProviderConfig.
+type BackendMode string
+
+const (
+	// BackendModeActive backends are used for new and existing buckets.
+	BackendModeActive BackendMode = "Active"
+	// BackendModeCordoned backends are only used for existing buckets.
+	BackendModeCordoned BackendMode = "Cordoned"
+	// BackendModeDraining backends are cordoned and their buckets are moved
+	// to other backends.
+	BackendModeDraining BackendMode = "Draining"
+)
+
+// IsCordoned returns true if no new buckets may be placed on the backend.
+func (m BackendMode) IsCordoned() bool {
+	return m == BackendModeCordoned || m == BackendModeDraining
+}
+
+// CredentialsSourceWebIdentity credentials are temporary credentials obtained
+// by exchanging a projected ServiceAccount token with STS
+// AssumeRoleWithWebIdentity.
+const CredentialsSourceWebIdentity xpv1.CredentialsSource = "WebIdentity"
+
 // ProviderCredentials required to authenticate.
 type ProviderCredentials struct {
 	xpv1.CommonCredentialSelectors `json:",inline"`
 	// Source of the provider credentials.
-	// +kubebuilder:validation:Enum=None;Secret;InjectedIdentity;Environment;Filesystem
+	// +kubebuilder:validation:Enum=None;Secret;InjectedIdentity;Environment;Filesystem;WebIdentity
 	Source xpv1.CredentialsSource `json:"source"`
+
+	// Format of the Secret, Environment and Filesystem credentials. Keys
+	// credentials are an access key and a secret key stored under separate
+	// keys of the Secret, in separate environment variables, or in separate
+	// files of the directory at fs.path. S3cfg credentials are an s3cmd
+	// configuration file stored under secretRef.key of the Secret, in the
+	// environment variable env.name, or in the file at fs.path.
+	// +kubebuilder:validation:Enum=Keys;S3cfg
+	// +kubebuilder:default:=Keys
+	// +optional
+	Format CredentialsFormat `json:"format,omitempty"`
+
+	// Keys are the names of the access key and the secret key of Keys
+	// credentials.
+	// +optional
+	Keys *CredentialKeys `json:"keys,omitempty"`
+
+	// WebIdentity configures the WebIdentity credentials source. It is
+	// required when the source is WebIdentity.
+	// +optional
+	WebIdentity *WebIdentityCredentials `json:"webIdentity,omitempty"`
+}
+
+// CredentialsFormat is the format of the credentials of a ProviderConfig.
+type CredentialsFormat string
+
+const (
+	// CredentialsFormatKeys credentials are an access key and a secret key
+	// stored separately.
+	CredentialsFormatKeys CredentialsFormat = "Keys"
+	// CredentialsFormatS3cfg credentials are an s3cmd configuration file.
+	CredentialsFormatS3cfg CredentialsFormat = "S3cfg"
+)
+
+// CredentialKeys are the names of the keys of the Secret, of the environment
+// variables or of the files holding the access key and the secret key.
+type CredentialKeys struct {
+	// AccessKey is the name of the access key. It defaults to access_key
+	// for the Secret and Filesystem sources, and to AWS_ACCESS_KEY_ID for
+	// the Environment source.
+	// +optional
+	AccessKey string `json:"accessKey,omitempty"`
+
+	// SecretKey is the name of the secret key. It defaults to secret_key
+	// for the Secret and Filesystem sources, and to AWS_SECRET_ACCESS_KEY
+	// for the Environment source.
+	// +optional
+	SecretKey string `json:"secretKey,omitempty"`
+}
+
+// WebIdentityCredentials configures how a projected ServiceAccount token is
+// exchanged for temporary credentials with the STS service of the backend.
+type WebIdentityCredentials struct {
+	// RoleARN is the ARN of the role assumed with the token.
+	// +kubebuilder:validation:MinLength=1
+	RoleARN string `json:"roleArn"`
+
+	// TokenPath is the absolute path of the projected ServiceAccount token.
+	// The token is read again each time the credentials are refreshed, so
+	// that the token rotated by the kubelet is used.
+	// +kubebuilder:default:="/var/run/secrets/provider-ceph/serviceaccount/token"
+	// +optional
+	TokenPath string `json:"tokenPath,omitempty"`
 }
 
 type HealthStatus string
@@ -76,16 +239,84 @@ type ProviderConfigStatus struct {
 	Health HealthStatus `json:"health,omitempty"`
 	// Deprecated: Use ProviderConfigStatus.ConditionedStatus instead.
 	// This field will be removed in a future release.
-	Reason                    string `json:"reason,omitempty"`
+	Reason string `json:"reason,omitempty"`
+	// +optional
+	// Backfill is the progress of the backfill of existing buckets onto
+	// the backend of the ProviderConfig after it was added.
+	Backfill *BackfillStatus `json:"backfill,omitempty"`
+	// +optional
+	// Credentials identifies the credentials in use by the backend of the
+	// ProviderConfig.
+	Credentials *CredentialsStatus `json:"credentials,omitempty"`
+	// +optional
+	// Drain is the progress of the drain of the backend of the ProviderConfig
+	// while it is in the Draining mode.
+	Drain                     *DrainStatus `json:"drain,omitempty"`
 	xpv1.ProviderConfigStatus `json:",inline"`
 }
 
+// CredentialsStatus identifies the credentials in use by the backend of a
+// ProviderConfig.
+type CredentialsStatus struct {
+	// Generation is incremented each time the backend starts using new
+	// credentials, such as when the referenced Secret is rotated.
+	Generation int64 `json:"generation"`
+	// Version identifies the credentials in use. It changes whenever the
+	// ProviderConfig is recreated, its spec changes or the credentials change.
+	Version string `json:"version,omitempty"`
+}
+
+// BackfillStatus is the progress of the backfill of existing buckets onto the
+// backend of a ProviderConfig. Buckets are backfilled by triggering their
+// reconciliation, which creates them on the backend.
+type BackfillStatus struct {
+	// Total is the number of buckets which should be placed on the backend.
+	Total int `json:"total"`
+	// Backfilled is the number of buckets which exist on the backend or
+	// whose reconciliation has been triggered.
+	Backfilled int `json:"backfilled"`
+	// Progress summarises the backfill, eg "3 of 10 buckets backfilled".
+	Progress string `json:"progress,omitempty"`
+	// +optional
+	// StartTime is the time at which the backfill started.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// +optional
+	// CompletionTime is the time at which all buckets had been backfilled.
+	// The backend is not backfilled again once the backfill is complete.
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// DrainStatus is the progress of the drain of the backend of a ProviderConfig.
+// A bucket is drained once it is available on enough other backends and the
+// backend has been disabled by label on the Bucket.
+type DrainStatus struct {
+	// Total is the number of buckets which were placed on the backend.
+	Total int `json:"total"`
+	// Drained is the number of buckets on which the backend has been disabled.
+	Drained int `json:"drained"`
+	// Blocked is the number of buckets which cannot be moved to other backends,
+	// because the backend is specified as one of their providers.
+	Blocked int `json:"blocked,omitempty"`
+	// Progress summarises the drain, eg "3 of 10 buckets drained".
+	Progress string `json:"progress,omitempty"`
+	// +optional
+	// StartTime is the time at which the drain started.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// +optional
+	// CompletionTime is the time at which all buckets had been drained, except
+	// those which are blocked and remain on the backend.
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
 // +kubebuilder:object:root=true
 
 // A ProviderConfig configures a Ceph provider.
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:printcolumn:name="SECRET-NAME",type="string",JSONPath=".spec.credentials.secretRef.name",priority=1
+// +kubebuilder:printcolumn:name="MODE",type="string",JSONPath=".spec.mode"
+// +kubebuilder:printcolumn:name="BACKFILL",type="string",JSONPath=".status.backfill.progress",priority=1
+// +kubebuilder:printcolumn:name="DRAIN",type="string",JSONPath=".status.drain.progress",priority=1
 // +kubebuilder:resource:scope=Cluster
 type ProviderConfig struct {
 	Status            ProviderConfigStatus `json:"status,omitempty"`
@@ -95,6 +326,29 @@ type ProviderConfig struct {
 	Spec ProviderConfigSpec `json:"spec"`
 }
 
+// GetSpec returns the spec of the ProviderConfig.
+func (p *ProviderConfig) GetSpec() *ProviderConfigSpec {
+	return &p.Spec
+}
+
+// GetStatus returns the status of the ProviderConfig.
+func (p *ProviderConfig) GetStatus() *ProviderConfigStatus {
+	return &p.Status
+}
+
+// A ProviderConfigObject is a ProviderConfig of any kind, ie a (legacy)
+// ProviderConfig, a namespaced ProviderConfig or a ClusterProviderConfig.
+// All kinds share the same spec and status and each one registers an
+// S3 backend.
+// +kubebuilder:object:generate=false
+type ProviderConfigObject interface {
+	client.Object
+	GetSpec() *ProviderConfigSpec
+	GetStatus() *ProviderConfigStatus
+	GetCondition(ct xpv1.ConditionType) xpv1.Condition
+	SetConditions(c ...xpv1.Condition)
+}
+
 // +kubebuilder:object:root=true
 
 // ProviderConfigList contains a list of ProviderConfig.
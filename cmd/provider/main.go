@@ -33,6 +33,7 @@ import (
 	"go.uber.org/zap/zapcore"
 	authv1 "k8s.io/api/authorization/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/selection"
@@ -61,14 +62,22 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
 
 	"github.com/linode/provider-ceph/apis"
+	nsv1alpha1 "github.com/linode/provider-ceph/apis/namespaced/provider-ceph/v1alpha1"
+	apisnsv1alpha1 "github.com/linode/provider-ceph/apis/namespaced/v1alpha1"
 	providercephv1alpha1 "github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
 	"github.com/linode/provider-ceph/apis/v1alpha1"
 	"github.com/linode/provider-ceph/internal/backendstore"
 	"github.com/linode/provider-ceph/internal/controller/bucket"
+	"github.com/linode/provider-ceph/internal/controller/bucketusage"
+	"github.com/linode/provider-ceph/internal/controller/cephuser"
 	"github.com/linode/provider-ceph/internal/controller/providerconfig"
 	"github.com/linode/provider-ceph/internal/controller/providerconfig/backendmonitor"
+	"github.com/linode/provider-ceph/internal/controller/providerconfig/backfill"
+	"github.com/linode/provider-ceph/internal/controller/providerconfig/drain"
 	"github.com/linode/provider-ceph/internal/controller/providerconfig/healthcheck"
+	"github.com/linode/provider-ceph/internal/controller/replicasync"
 	"github.com/linode/provider-ceph/internal/controller/s3clienthandler"
+	"github.com/linode/provider-ceph/internal/controller/topic"
 
 	"github.com/linode/provider-ceph/internal/features"
 )
@@ -195,6 +204,8 @@ func createBucketConnector(
 	autoPauseBucket *bool,
 	minReplicas *uint,
 	recreateMissingBucket *bool,
+	failoverPeriod time.Duration,
+	failoverRecoveryPolicy bucket.FailoverRecoveryPolicy,
 	reconcileTimeout *time.Duration,
 	creationGracePeriod *time.Duration,
 	pollInterval *time.Duration,
@@ -203,11 +214,25 @@ func createBucketConnector(
 	disableLifecycleConfigReconcile *bool,
 	disableVersioningConfigReconcile *bool,
 	disableObjectLockConfigReconcile *bool,
+	disableQuotaReconcile *bool,
+	disableCORSConfigReconcile *bool,
+	disableTaggingReconcile *bool,
+	tagLabelPrefix *string,
+	disableSSEConfigReconcile *bool,
+	disableNotificationConfigReconcile *bool,
+	disableReplicationConfigReconcile *bool,
+	disableWebsiteConfigReconcile *bool,
+	disableOwnershipControlsReconcile *bool,
+	disablePublicAccessBlockReconcile *bool,
+	disableLoggingConfigReconcile *bool,
+	defaultPublicAccessBlock *bool,
 ) *bucket.Connector {
 	return bucket.NewConnector(
 		bucket.WithAutoPause(autoPauseBucket),
 		bucket.WithMinimumReplicas(minReplicas),
 		bucket.WithRecreateMissingBucket(recreateMissingBucket),
+		bucket.WithFailoverPeriod(failoverPeriod),
+		bucket.WithFailoverRecoveryPolicy(failoverRecoveryPolicy),
 		bucket.WithBackendStore(backendStore),
 		bucket.WithKubeClient(mgr.GetClient()),
 		bucket.WithKubeReader(mgr.GetAPIReader()),
@@ -220,40 +245,103 @@ func createBucketConnector(
 				backendStore,
 				s3ClientHandler,
 				bucket.SubresourceClientConfig{
-					LifecycleConfigurationClientDisabled:  *disableLifecycleConfigReconcile,
-					ACLClientDisabled:                     *disableACLReconcile,
-					PolicyClientDisabled:                  *disablePolicyReconcile,
-					VersioningConfigurationClientDisabled: *disableVersioningConfigReconcile,
-					ObjectLockConfigurationClientDisabled: *disableObjectLockConfigReconcile},
+					LifecycleConfigurationClientDisabled:            *disableLifecycleConfigReconcile,
+					ACLClientDisabled:                               *disableACLReconcile,
+					PolicyClientDisabled:                            *disablePolicyReconcile,
+					VersioningConfigurationClientDisabled:           *disableVersioningConfigReconcile,
+					ObjectLockConfigurationClientDisabled:           *disableObjectLockConfigReconcile,
+					QuotaClientDisabled:                             *disableQuotaReconcile,
+					CORSConfigurationClientDisabled:                 *disableCORSConfigReconcile,
+					TaggingClientDisabled:                           *disableTaggingReconcile,
+					TagLabelPrefix:                                  *tagLabelPrefix,
+					ServerSideEncryptionConfigurationClientDisabled: *disableSSEConfigReconcile,
+					NotificationConfigurationClientDisabled:         *disableNotificationConfigReconcile,
+					ReplicationConfigurationClientDisabled:          *disableReplicationConfigReconcile,
+					WebsiteConfigurationClientDisabled:              *disableWebsiteConfigReconcile,
+					OwnershipControlsClientDisabled:                 *disableOwnershipControlsReconcile,
+					PublicAccessBlockClientDisabled:                 *disablePublicAccessBlockReconcile,
+					LoggingConfigurationClientDisabled:              *disableLoggingConfigReconcile,
+					DefaultPublicAccessBlock:                        *defaultPublicAccessBlock},
 				log)),
 		bucket.WithS3ClientHandler(s3ClientHandler),
 		bucket.WithUsage(resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &v1alpha1.ProviderConfigUsage{})),
+		bucket.WithNamespacedUsage(resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisnsv1alpha1.ProviderConfigUsage{})),
 		bucket.WithNewServiceFn(bucket.NewNoOpService))
 }
 
-// setupControllers sets up bucket controllers with or without safe-start gating.
-func setupControllers(mgr manager.Manager, o controller.Options, connector *bucket.Connector, canSafeStart bool, log logr.Logger) {
+// createCephUserConnector creates a cephuser connector with all required options.
+func createCephUserConnector(
+	mgr manager.Manager,
+	backendStore *backendstore.BackendStore,
+	s3ClientHandler *s3clienthandler.Handler,
+	log logr.Logger,
+	reconcileTimeout *time.Duration,
+	creationGracePeriod *time.Duration,
+	pollInterval *time.Duration,
+) *cephuser.Connector {
+	return cephuser.NewConnector(
+		cephuser.WithBackendStore(backendStore),
+		cephuser.WithKubeClient(mgr.GetClient()),
+		cephuser.WithOperationTimeout(*reconcileTimeout),
+		cephuser.WithCreationGracePeriod(*creationGracePeriod),
+		cephuser.WithPollInterval(*pollInterval),
+		cephuser.WithLog(log),
+		cephuser.WithS3ClientHandler(s3ClientHandler),
+		cephuser.WithUsage(resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &v1alpha1.ProviderConfigUsage{})))
+}
+
+// createTopicConnector creates a topic connector with all required options.
+func createTopicConnector(
+	mgr manager.Manager,
+	backendStore *backendstore.BackendStore,
+	s3ClientHandler *s3clienthandler.Handler,
+	log logr.Logger,
+	reconcileTimeout *time.Duration,
+	creationGracePeriod *time.Duration,
+	pollInterval *time.Duration,
+) *topic.Connector {
+	return topic.NewConnector(
+		topic.WithBackendStore(backendStore),
+		topic.WithOperationTimeout(*reconcileTimeout),
+		topic.WithCreationGracePeriod(*creationGracePeriod),
+		topic.WithPollInterval(*pollInterval),
+		topic.WithLog(log),
+		topic.WithS3ClientHandler(s3ClientHandler),
+		topic.WithUsage(resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &v1alpha1.ProviderConfigUsage{})))
+}
+
+// setupControllers sets up bucket, cephuser and topic controllers with or without safe-start gating.
+func setupControllers(mgr manager.Manager, o controller.Options, connector *bucket.Connector, cephUserConnector *cephuser.Connector, topicConnector *topic.Connector, canSafeStart bool, log logr.Logger) {
 	if canSafeStart {
 		// Setup the CRD gate controller first
 		kingpin.FatalIfError(customresourcesgate.Setup(mgr, o), "Cannot setup CRD gate")
 		// Setup controllers with gated versions
 		kingpin.FatalIfError(bucket.SetupGated(mgr, o, connector), "Cannot setup gated Bucket controller")
+		kingpin.FatalIfError(cephuser.SetupGated(mgr, o, cephUserConnector), "Cannot setup gated CephUser controller")
+		kingpin.FatalIfError(topic.SetupGated(mgr, o, topicConnector), "Cannot setup gated Topic controller")
 	} else {
 		log.Info("Provider is missing RBAC permissions for watching CRDs, controller SafeStart capability will be disabled")
 		// Setup controllers directly without gating
 		kingpin.FatalIfError(bucket.Setup(mgr, o, connector), "Cannot setup Bucket controller")
+		kingpin.FatalIfError(bucket.SetupNamespaced(mgr, o, connector), "Cannot setup namespaced Bucket controller")
+		kingpin.FatalIfError(cephuser.Setup(mgr, o, cephUserConnector), "Cannot setup CephUser controller")
+		kingpin.FatalIfError(topic.Setup(mgr, o, topicConnector), "Cannot setup Topic controller")
 	}
 }
 
-// setupBucketWebhook sets up the bucket validating webhook.
-func setupBucketWebhook(mgr manager.Manager, backendStore *backendstore.BackendStore) {
+// setupBucketWebhook sets up the bucket validating webhooks of both scopes.
+func setupBucketWebhook(mgr manager.Manager, backendStore *backendstore.BackendStore, defaultPublicAccessBlock bool) {
 	kingpin.FatalIfError(ctrl.NewWebhookManagedBy(mgr).
 		For(&providercephv1alpha1.Bucket{}).
-		WithValidator(bucket.NewBucketValidator(backendStore)).
+		WithValidator(bucket.NewBucketValidator(backendStore, mgr.GetAPIReader(), defaultPublicAccessBlock)).
 		Complete(), "Cannot setup bucket validating webhook")
+	kingpin.FatalIfError(ctrl.NewWebhookManagedBy(mgr).
+		For(&nsv1alpha1.Bucket{}).
+		WithValidator(bucket.NewBucketValidator(backendStore, mgr.GetAPIReader(), defaultPublicAccessBlock)).
+		Complete(), "Cannot setup namespaced bucket validating webhook")
 }
 
-// setupProviderConfigControllers sets up the provider config, backend monitor, and health check controllers.
+// setupProviderConfigControllers sets up the provider config, backend monitor, health check, drain, and backfill controllers.
 func setupProviderConfigControllers(
 	mgr manager.Manager,
 	o controller.Options,
@@ -263,7 +351,23 @@ func setupProviderConfigControllers(
 	s3Timeout time.Duration,
 	backendMonitorInterval time.Duration,
 	autoPauseBucket *bool,
+	failoverPeriod time.Duration,
+	backfillRate float64,
 ) {
+	// The backfill controller is not set up if the rate is zero.
+	var bf *backfill.Controller
+	if backfillRate > 0 {
+		bf = backfill.NewController(
+			backfill.WithAutoPause(autoPauseBucket),
+			backfill.WithRate(backfillRate),
+			backfill.WithBackendStore(backendStore),
+			backfill.WithKubeClientUncached(kubeClientUncached),
+			backfill.WithKubeClientCached(mgr.GetClient()),
+			backfill.WithLogger(log))
+	} else {
+		log.Info("Backfill of buckets onto new backends is disabled")
+	}
+
 	kingpin.FatalIfError(providerconfig.Setup(mgr, o,
 		backendmonitor.NewController(
 			backendmonitor.WithKubeClient(mgr.GetClient()),
@@ -273,14 +377,88 @@ func setupProviderConfigControllers(
 			backendmonitor.WithLogger(log)),
 		healthcheck.NewController(
 			healthcheck.WithAutoPause(autoPauseBucket),
+			healthcheck.WithFailoverPeriod(failoverPeriod),
 			healthcheck.WithBackendStore(backendStore),
 			healthcheck.WithKubeClientUncached(kubeClientUncached),
 			healthcheck.WithKubeClientCached(mgr.GetClient()),
 			healthcheck.WithHttpClient(&http.Client{Timeout: s3Timeout}),
-			healthcheck.WithLogger(log))),
+			healthcheck.WithLogger(log)),
+		drain.NewController(
+			drain.WithAutoPause(autoPauseBucket),
+			drain.WithBackendStore(backendStore),
+			drain.WithKubeClientUncached(kubeClientUncached),
+			drain.WithKubeClientCached(mgr.GetClient()),
+			drain.WithLogger(log)),
+		bf),
 		"Cannot setup ProviderConfig controllers")
 }
 
+// createBucketCache creates a cache, started by the manager, for the controllers which
+// watch all Buckets, as the cache of the manager does not hold paused Buckets.
+func createBucketCache(mgr manager.Manager, httpClient *http.Client) kcache.Cache {
+	bucketCache, err := kcache.New(mgr.GetConfig(), kcache.Options{
+		HTTPClient: httpClient,
+		Scheme:     mgr.GetScheme(),
+		Mapper:     mgr.GetRESTMapper(),
+	})
+	kingpin.FatalIfError(err, "Cannot create bucket cache")
+	kingpin.FatalIfError(mgr.Add(bucketCache), "Cannot add bucket cache to controller manager")
+
+	return bucketCache
+}
+
+// setupBucketUsageController sets up the controller that periodically measures bucket usage
+// and registers its metrics. The controller is not set up if the interval is zero.
+func setupBucketUsageController(mgr manager.Manager, backendStore *backendstore.BackendStore, bucketCache kcache.Cache, interval time.Duration, log logr.Logger) {
+	if interval <= 0 {
+		log.Info("Bucket usage measurement is disabled")
+
+		return
+	}
+
+	um := bucketusage.NewMetrics()
+	metrics.Registry.MustRegister(um)
+
+	kingpin.FatalIfError(bucketusage.NewController(
+		bucketusage.WithKubeClient(mgr.GetClient()),
+		bucketusage.WithKubeReader(mgr.GetAPIReader()),
+		bucketusage.WithBucketCache(bucketCache),
+		bucketusage.WithBackendStore(backendStore),
+		bucketusage.WithMetrics(um),
+		bucketusage.WithInterval(interval),
+		bucketusage.WithLogger(log)).SetupWithManager(mgr),
+		"Cannot setup bucket usage controller")
+}
+
+// setupReplicaSyncController sets up the controller that periodically synchronises the objects
+// of buckets between their backends and registers its metrics. The controller is not set up if
+// the interval is zero.
+func setupReplicaSyncController(mgr manager.Manager, backendStore *backendstore.BackendStore, bucketCache kcache.Cache, s3ClientHandler *s3clienthandler.Handler, interval time.Duration, bandwidthLimit string, log logr.Logger) {
+	if interval <= 0 {
+		log.Info("Bucket replica sync is disabled")
+
+		return
+	}
+
+	limit, err := apiresource.ParseQuantity(bandwidthLimit)
+	kingpin.FatalIfError(err, "Cannot parse replica sync bandwidth limit")
+
+	rm := replicasync.NewMetrics()
+	metrics.Registry.MustRegister(rm)
+
+	kingpin.FatalIfError(replicasync.NewController(
+		replicasync.WithKubeClient(mgr.GetClient()),
+		replicasync.WithKubeReader(mgr.GetAPIReader()),
+		replicasync.WithBucketCache(bucketCache),
+		replicasync.WithBackendStore(backendStore),
+		replicasync.WithS3ClientHandler(s3ClientHandler),
+		replicasync.WithMetrics(rm),
+		replicasync.WithInterval(interval),
+		replicasync.WithBandwidthLimit(limit.Value()),
+		replicasync.WithLogger(log)).SetupWithManager(mgr),
+		"Cannot setup replica sync controller")
+}
+
 // createS3ClientHandler creates an S3 client handler with all required options.
 func createS3ClientHandler(
 	assumeRoleArn *string,
@@ -307,6 +485,9 @@ func main() {
 		syncTimeout             = app.Flag("sync-timeout", "Cache sync timeout.").Default("10s").Duration()
 		backendMonitorInterval  = app.Flag("backend-monitor-interval", "Interval between backend monitor controller reconciliations.").Default("60s").Duration()
 		pollInterval            = app.Flag("poll", "How often individual resources will be checked for drift from the desired state").Short('p').Default("30m").Duration()
+		bucketUsageInterval     = app.Flag("bucket-usage-interval", "How often the usage of each Bucket is measured on its backends. Set to 0 to disable.").Default("5m").Duration()
+		replicaSyncInterval     = app.Flag("replica-sync-interval", "How often the objects of each Bucket with a replica sync are synchronised from its primary backend to its other backends. Set to 0 to disable.").Default("5m").Duration()
+		replicaSyncBandwidth    = app.Flag("replica-sync-bandwidth-limit", "The maximum rate, in bytes per second, at which the objects of all Buckets are synchronised, eg 100Mi. Set to 0 for no limit.").Default("0").String()
 		pollStateMetricInterval = app.Flag("poll-state-metric", "State metric recording interval").Default("5s").Duration()
 		reconcileConcurrency    = app.Flag("reconcile-concurrency", "Set number of reconciliation loops.").Default("100").Int()
 		maxReconcileRate        = app.Flag("max-reconcile-rate", "The global maximum rate per second at which resources may checked for drift from the desired state.").Default("1000").Int()
@@ -326,18 +507,33 @@ func main() {
 		autoPauseBucket       = app.Flag("auto-pause-bucket", "Enable auto pause of reconciliation of ready buckets").Default("false").Envar("AUTO_PAUSE_BUCKET").Bool()
 		minReplicas           = app.Flag("minimum-replicas", "Minimum number of replicas of a bucket before it is considered Ready").Default("1").Envar("MINIMUM_REPLICAS").Uint()
 		recreateMissingBucket = app.Flag("recreate-missing-bucket", "Recreates existing bucket if missing").Default("true").Envar("RECREATE_MISSING_BUCKET").Bool()
+		failoverPeriod        = app.Flag("failover-period", "How long a backend must be unhealthy before failover replicas of its buckets are placed on healthy backends. Set to 0 to disable.").Default("0s").Envar("FAILOVER_PERIOD").Duration()
+		backfillRate          = app.Flag("backfill-rate", "The maximum number of existing buckets per second which are backfilled onto a newly added backend. Set to 0 to disable.").Default("10").Envar("BACKFILL_RATE").Float64()
+		failoverRecovery      = app.Flag("failover-recovery-policy", "Whether failover replicas are removed or kept once the backend they stand in for has recovered.").Default(string(bucket.FailoverRecoveryPolicyRemove)).Envar("FAILOVER_RECOVERY_POLICY").Enum(string(bucket.FailoverRecoveryPolicyRemove), string(bucket.FailoverRecoveryPolicyKeep))
 
-		assumeRoleArn = app.Flag("assume-role-arn", "Assume role ARN to be used for STS authentication").Default("").Envar("ASSUME_ROLE_ARN").String()
+		assumeRoleArn = app.Flag("assume-role-arn", "Assume role ARN to be used for STS authentication, unless overridden by the ProviderConfig or the Bucket").Default("").Envar("ASSUME_ROLE_ARN").String()
 
 		webhookHost       = app.Flag("webhook-host", "The host of the webhook server.").Default("0.0.0.0").Envar("WEBHOOK_HOST").String()
 		webhookTLSCertDir = app.Flag("webhook-tls-cert-dir", "The directory of TLS certificate that will be used by the webhook server. There should be tls.crt and tls.key files.").Default("/").Envar("WEBHOOK_TLS_CERT_DIR").String()
 		_                 = app.Flag("enable-validation-webhooks", "Enable support for Webhooks. [Deprecated, has no effect]").Default("false").Bool()
 		// Subresource Client Flags.
-		disableACLReconcile              = app.Flag("disable-acl-reconcile", "Disable reconciliation of Bucket ACLs.").Default("false").Envar("DISABLE_ACL_RECONCILE").Bool()
-		disablePolicyReconcile           = app.Flag("disable-policy-reconcile", "Disable reconciliation of Bucket Policies.").Default("false").Envar("DISABLE_POLICY_RECONCILE").Bool()
-		disableLifecycleConfigReconcile  = app.Flag("disable-lifecycle-config-reconcile", "Disable reconciliation of Bucket Lifecycle Configurations.").Default("false").Envar("DISABLE_LIFECYCLE_CONFIG_RECONCILE").Bool()
-		disableVersioningConfigReconcile = app.Flag("disable-versioning-config-reconcile", "Disable reconciliation of Bucket Versioning Configurations.").Default("false").Envar("DISABLE_VERSIONING_CONFIG_RECONCILE").Bool()
-		disableObjectLockConfigReconcile = app.Flag("disable-object-lock-config-reconcile", "Disable reconciliation of Object Lock Configurations.").Default("false").Envar("DISABLE_OBJECT_LOCK_CONFIG_RECONCILE").Bool()
+		disableACLReconcile                = app.Flag("disable-acl-reconcile", "Disable reconciliation of Bucket ACLs.").Default("false").Envar("DISABLE_ACL_RECONCILE").Bool()
+		disablePolicyReconcile             = app.Flag("disable-policy-reconcile", "Disable reconciliation of Bucket Policies.").Default("false").Envar("DISABLE_POLICY_RECONCILE").Bool()
+		disableLifecycleConfigReconcile    = app.Flag("disable-lifecycle-config-reconcile", "Disable reconciliation of Bucket Lifecycle Configurations.").Default("false").Envar("DISABLE_LIFECYCLE_CONFIG_RECONCILE").Bool()
+		disableVersioningConfigReconcile   = app.Flag("disable-versioning-config-reconcile", "Disable reconciliation of Bucket Versioning Configurations.").Default("false").Envar("DISABLE_VERSIONING_CONFIG_RECONCILE").Bool()
+		disableObjectLockConfigReconcile   = app.Flag("disable-object-lock-config-reconcile", "Disable reconciliation of Object Lock Configurations.").Default("false").Envar("DISABLE_OBJECT_LOCK_CONFIG_RECONCILE").Bool()
+		disableQuotaReconcile              = app.Flag("disable-quota-reconcile", "Disable reconciliation of Bucket Quotas.").Default("false").Envar("DISABLE_QUOTA_RECONCILE").Bool()
+		disableCORSConfigReconcile         = app.Flag("disable-cors-reconcile", "Disable reconciliation of Bucket CORS Configurations.").Default("false").Envar("DISABLE_CORS_RECONCILE").Bool()
+		disableTaggingReconcile            = app.Flag("disable-tagging-reconcile", "Disable reconciliation of Bucket Tags.").Default("false").Envar("DISABLE_TAGGING_RECONCILE").Bool()
+		disableSSEConfigReconcile          = app.Flag("disable-sse-config-reconcile", "Disable reconciliation of Bucket Server-Side Encryption Configurations.").Default("false").Envar("DISABLE_SSE_CONFIG_RECONCILE").Bool()
+		disableNotificationConfigReconcile = app.Flag("disable-notification-reconcile", "Disable reconciliation of Bucket Notification Configurations.").Default("false").Envar("DISABLE_NOTIFICATION_RECONCILE").Bool()
+		disableReplicationConfigReconcile  = app.Flag("disable-replication-config-reconcile", "Disable reconciliation of Bucket Replication Configurations.").Default("false").Envar("DISABLE_REPLICATION_CONFIG_RECONCILE").Bool()
+		disableWebsiteConfigReconcile      = app.Flag("disable-website-config-reconcile", "Disable reconciliation of Bucket Website Configurations.").Default("false").Envar("DISABLE_WEBSITE_CONFIG_RECONCILE").Bool()
+		disableOwnershipControlsReconcile  = app.Flag("disable-ownership-controls-reconcile", "Disable reconciliation of Bucket Ownership Controls.").Default("false").Envar("DISABLE_OWNERSHIP_CONTROLS_RECONCILE").Bool()
+		disablePublicAccessBlockReconcile  = app.Flag("disable-public-access-block-reconcile", "Disable reconciliation of Bucket Public Access Block Configurations.").Default("false").Envar("DISABLE_PUBLIC_ACCESS_BLOCK_RECONCILE").Bool()
+		defaultPublicAccessBlock           = app.Flag("default-public-access-block", "Block all public access of Buckets which do not specify a Public Access Block Configuration.").Default("false").Envar("DEFAULT_PUBLIC_ACCESS_BLOCK").Bool()
+		disableLoggingConfigReconcile      = app.Flag("disable-logging-config-reconcile", "Disable reconciliation of Bucket Logging Configurations.").Default("false").Envar("DISABLE_LOGGING_CONFIG_RECONCILE").Bool()
+		tagLabelPrefix                     = app.Flag("tag-label-prefix", "Prefix of the keys of Bucket labels which are merged into the tags of the bucket, eg 'tags.example.com/'. Labels are not merged when empty.").Default("").Envar("TAG_LABEL_PREFIX").String()
 	)
 
 	debugFlag := app.Flag("debug", "Enable debug logging (sets zap-log-level to debug)").Default("false").Bool()
@@ -422,7 +618,12 @@ func main() {
 				&providercephv1alpha1.Bucket{}: {
 					Label: labels.NewSelector().Add(*pausedSelector),
 				},
-				&v1alpha1.ProviderConfig{}: {},
+				&nsv1alpha1.Bucket{}: {
+					Label: labels.NewSelector().Add(*pausedSelector),
+				},
+				&v1alpha1.ProviderConfig{}:              {},
+				&apisnsv1alpha1.ProviderConfig{}:        {},
+				&apisnsv1alpha1.ClusterProviderConfig{}: {},
 			},
 		},
 		NewCache: kcache.New,
@@ -459,7 +660,7 @@ func main() {
 	})
 	kingpin.FatalIfError(err, "Cannot create Kube client")
 
-	setupBucketWebhook(mgr, backendStore)
+	setupBucketWebhook(mgr, backendStore, *defaultPublicAccessBlock)
 	setupProviderConfigControllers(
 		mgr,
 		o,
@@ -469,6 +670,8 @@ func main() {
 		*s3Timeout,
 		*backendMonitorInterval,
 		autoPauseBucket,
+		*failoverPeriod,
+		*backfillRate,
 	)
 	s3ClientHandler := createS3ClientHandler(
 		assumeRoleArn,
@@ -486,6 +689,8 @@ func main() {
 		autoPauseBucket,
 		minReplicas,
 		recreateMissingBucket,
+		*failoverPeriod,
+		bucket.FailoverRecoveryPolicy(*failoverRecovery),
 		reconcileTimeout,
 		creationGracePeriod,
 		pollInterval,
@@ -494,9 +699,44 @@ func main() {
 		disableLifecycleConfigReconcile,
 		disableVersioningConfigReconcile,
 		disableObjectLockConfigReconcile,
+		disableQuotaReconcile,
+		disableCORSConfigReconcile,
+		disableTaggingReconcile,
+		tagLabelPrefix,
+		disableSSEConfigReconcile,
+		disableNotificationConfigReconcile,
+		disableReplicationConfigReconcile,
+		disableWebsiteConfigReconcile,
+		disableOwnershipControlsReconcile,
+		disablePublicAccessBlockReconcile,
+		disableLoggingConfigReconcile,
+		defaultPublicAccessBlock,
+	)
+
+	cephUserConnector := createCephUserConnector(
+		mgr,
+		backendStore,
+		s3ClientHandler,
+		log,
+		reconcileTimeout,
+		creationGracePeriod,
+		pollInterval,
+	)
+
+	topicConnector := createTopicConnector(
+		mgr,
+		backendStore,
+		s3ClientHandler,
+		log,
+		reconcileTimeout,
+		creationGracePeriod,
+		pollInterval,
 	)
 
-	setupControllers(mgr, o, connector, canSafeStart, log)
+	setupControllers(mgr, o, connector, cephUserConnector, topicConnector, canSafeStart, log)
+	bucketCache := createBucketCache(mgr, httpClient)
+	setupBucketUsageController(mgr, backendStore, bucketCache, *bucketUsageInterval, log)
+	setupReplicaSyncController(mgr, backendStore, bucketCache, s3ClientHandler, *replicaSyncInterval, *replicaSyncBandwidth, log)
 
 	kingpin.FatalIfError(mgr.Start(ctrl.SetupSignalHandler()), "Cannot start controller manager")
 }
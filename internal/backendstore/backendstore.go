@@ -1,18 +1,49 @@
 package backendstore
 
 import (
+	"maps"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/linode/provider-ceph/apis/v1alpha1"
 )
 
+// namespaceSeparator separates the namespace and the name of a namespaced
+// ProviderConfig in the name of its backend.
+const namespaceSeparator = "/"
+
+// BackendName returns the name of the backend of a ProviderConfig. Cluster
+// scoped ProviderConfigs and ClusterProviderConfigs are stored under their
+// name, whereas namespaced ProviderConfigs are stored under "namespace/name".
+func BackendName(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+
+	return namespace + namespaceSeparator + name
+}
+
+// SplitBackendName returns the namespace and the name of the ProviderConfig of
+// the given backend. The namespace is empty if the ProviderConfig is cluster scoped.
+func SplitBackendName(backendName string) (namespace, name string) {
+	namespace, name, found := strings.Cut(backendName, namespaceSeparator)
+	if !found {
+		return "", backendName
+	}
+
+	return namespace, name
+}
+
 // s3Backends is a map of S3 backend name (eg ceph cluster name) to backend.
 type s3Backends map[string]*backend
 
 // BackendStore stores the s3 backends.
 type BackendStore struct {
 	s3Backends s3Backends
-	mu         sync.RWMutex
+	// onAdded are called with the name of each backend added to the store.
+	onAdded []func(string)
+	mu      sync.RWMutex
 }
 
 func NewBackendStore() *BackendStore {
@@ -43,6 +74,28 @@ func (b *BackendStore) GetBackendSTSClient(backendName string) STSClient {
 	return nil
 }
 
+func (b *BackendStore) GetBackendAdminClient(backendName string) AdminClient {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if _, ok := b.s3Backends[backendName]; ok {
+		return b.s3Backends[backendName].adminClient
+	}
+
+	return nil
+}
+
+// SetBackendAdminClient sets the client of the RGW Admin Ops API of the backend.
+// Backends without admin credentials have no admin client.
+func (b *BackendStore) SetBackendAdminClient(backendName string, adminC AdminClient) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.s3Backends[backendName]; ok {
+		b.s3Backends[backendName].adminClient = adminC
+	}
+}
+
 func (b *BackendStore) GetAllBackendS3Clients() []S3Client {
 	b.mu.RLock()
 	defer b.mu.RUnlock()
@@ -102,7 +155,164 @@ func (b *BackendStore) SetBackendHealthStatus(backendName string, health v1alpha
 	defer b.mu.Unlock()
 
 	if _, ok := b.s3Backends[backendName]; ok {
-		b.s3Backends[backendName].health = health
+		b.s3Backends[backendName].setHealth(health, time.Now())
+	}
+}
+
+// GetBackendUnhealthySince returns the time at which the backend was marked
+// unhealthy. The time is zero if the backend is not unhealthy.
+func (b *BackendStore) GetBackendUnhealthySince(backendName string) time.Time {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if _, ok := b.s3Backends[backendName]; ok {
+		return b.s3Backends[backendName].unhealthySince
+	}
+
+	return time.Time{}
+}
+
+// GetBackendLabels returns a copy of the labels of the ProviderConfig of the backend.
+func (b *BackendStore) GetBackendLabels(backendName string) map[string]string {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if _, ok := b.s3Backends[backendName]; ok {
+		return maps.Clone(b.s3Backends[backendName].labels)
+	}
+
+	return nil
+}
+
+// SetBackendLabels sets the labels of the ProviderConfig of the backend. The labels
+// are used to select the backends on which buckets are placed.
+func (b *BackendStore) SetBackendLabels(backendName string, labels map[string]string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.s3Backends[backendName]; ok {
+		b.s3Backends[backendName].labels = maps.Clone(labels)
+	}
+}
+
+// GetBackendMode returns the mode of the ProviderConfig of the backend.
+func (b *BackendStore) GetBackendMode(backendName string) v1alpha1.BackendMode {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if _, ok := b.s3Backends[backendName]; ok {
+		return b.s3Backends[backendName].mode
+	}
+
+	return ""
+}
+
+// SetBackendMode sets the mode of the ProviderConfig of the backend. No new
+// buckets are placed on cordoned backends.
+func (b *BackendStore) SetBackendMode(backendName string, mode v1alpha1.BackendMode) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.s3Backends[backendName]; ok {
+		b.s3Backends[backendName].mode = mode
+	}
+}
+
+// IsBackendCordoned returns true if no new buckets may be placed on the backend.
+func (b *BackendStore) IsBackendCordoned(backendName string) bool {
+	return b.GetBackendMode(backendName).IsCordoned()
+}
+
+// GetBackendAssumeRole returns the AssumeRole configuration of the ProviderConfig of the backend.
+func (b *BackendStore) GetBackendAssumeRole(backendName string) *v1alpha1.AssumeRoleConfig {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if _, ok := b.s3Backends[backendName]; ok {
+		return b.s3Backends[backendName].assumeRole
+	}
+
+	return nil
+}
+
+// SetBackendAssumeRole sets the AssumeRole configuration of the ProviderConfig of the backend.
+func (b *BackendStore) SetBackendAssumeRole(backendName string, assumeRole *v1alpha1.AssumeRoleConfig) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.s3Backends[backendName]; ok {
+		b.s3Backends[backendName].assumeRole = assumeRole.DeepCopy()
+	}
+}
+
+// GetBackendCredentialsVersion returns the version of the credentials of the backend.
+func (b *BackendStore) GetBackendCredentialsVersion(backendName string) string {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if _, ok := b.s3Backends[backendName]; ok {
+		return b.s3Backends[backendName].credentialsVersion
+	}
+
+	return ""
+}
+
+// SetBackendCredentialsVersion sets the version of the credentials of the backend.
+// The version changes whenever the ProviderConfig or its Secret changes, so that
+// anything derived from the credentials of the backend can be invalidated.
+func (b *BackendStore) SetBackendCredentialsVersion(backendName, version string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.s3Backends[backendName]; ok {
+		b.s3Backends[backendName].credentialsVersion = version
+	}
+}
+
+// IsBackendLegacy returns true if the backend belongs to a ProviderConfig of the
+// legacy cluster scoped API.
+func (b *BackendStore) IsBackendLegacy(backendName string) bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if _, ok := b.s3Backends[backendName]; ok {
+		return b.s3Backends[backendName].legacy
+	}
+
+	return false
+}
+
+// SetBackendLegacy sets whether the backend belongs to a ProviderConfig of the
+// legacy cluster scoped API rather than to a ClusterProviderConfig.
+func (b *BackendStore) SetBackendLegacy(backendName string, legacy bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.s3Backends[backendName]; ok {
+		b.s3Backends[backendName].legacy = legacy
+	}
+}
+
+// GetBackendCapabilities returns the capabilities of the ProviderConfig of the backend.
+func (b *BackendStore) GetBackendCapabilities(backendName string) v1alpha1.BackendCapabilities {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if _, ok := b.s3Backends[backendName]; ok {
+		return b.s3Backends[backendName].capabilities
+	}
+
+	return v1alpha1.BackendCapabilities{}
+}
+
+// SetBackendCapabilities sets the capabilities of the ProviderConfig of the backend.
+// Buckets which require a feature are rejected on backends which do not support it.
+func (b *BackendStore) SetBackendCapabilities(backendName string, capabilities v1alpha1.BackendCapabilities) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.s3Backends[backendName]; ok {
+		b.s3Backends[backendName].capabilities = capabilities
 	}
 }
 
@@ -117,7 +327,43 @@ func (b *BackendStore) AddOrUpdateBackend(backendName string, s3C S3Client, stsC
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
-	b.s3Backends[backendName] = newBackend(s3C, stsC, health)
+	// The time at which an existing backend became unhealthy, its mode, its
+	// capabilities and its admin client are kept, as the backend is updated
+	// periodically by the backend monitor.
+	be := newBackend(s3C, stsC, v1alpha1.HealthStatusUnknown)
+	existing, ok := b.s3Backends[backendName]
+	if ok {
+		be.adminClient = existing.adminClient
+		be.health = existing.health
+		be.unhealthySince = existing.unhealthySince
+		be.mode = existing.mode
+		be.capabilities = existing.capabilities
+		be.assumeRole = existing.assumeRole
+		be.credentialsVersion = existing.credentialsVersion
+	}
+	be.setHealth(health, time.Now())
+
+	b.s3Backends[backendName] = be
+
+	if !ok {
+		for _, f := range b.onAdded {
+			f(backendName)
+		}
+	}
+}
+
+// OnBackendAdded registers a function which is called with the name of each
+// backend added to the store, including the backends already in the store,
+// but not when an existing backend is updated. The function is called while
+// the store is locked, so it must neither block nor use the store.
+func (b *BackendStore) OnBackendAdded(f func(backendName string)) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.onAdded = append(b.onAdded, f)
+	for backendName := range b.s3Backends {
+		f(backendName)
+	}
 }
 
 func (b *BackendStore) GetBackend(backendName string) *backend {
@@ -178,6 +424,27 @@ func (b *BackendStore) GetAllBackendNames() []string {
 	return backends
 }
 
+// GetBackendNamesForNamespace returns the names of the backends which may be
+// used by managed resources in the given namespace, ie the backends of
+// ClusterProviderConfigs and of ProviderConfigs in the namespace. Cluster scoped
+// managed resources, which have no namespace, may only use the backends of
+// cluster scoped ProviderConfigs of either API. The backends of legacy
+// ProviderConfigs are never used by namespaced managed resources.
+func (b *BackendStore) GetBackendNamesForNamespace(namespace string) []string {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	backends := make([]string, 0, len(b.s3Backends))
+	for k, v := range b.s3Backends {
+		ns, _ := SplitBackendName(k)
+		if ns == namespace || ns == "" && !v.legacy {
+			backends = append(backends, k)
+		}
+	}
+
+	return backends
+}
+
 func (b *BackendStore) BackendsAreStored() bool {
 	b.mu.RLock()
 	defer b.mu.RUnlock()
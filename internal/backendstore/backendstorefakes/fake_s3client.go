@@ -10,6 +10,36 @@ import (
 )
 
 type FakeS3Client struct {
+	AbortMultipartUploadStub        func(context.Context, *s3.AbortMultipartUploadInput, ...func(*s3.Options)) (*s3.AbortMultipartUploadOutput, error)
+	abortMultipartUploadMutex       sync.RWMutex
+	abortMultipartUploadArgsForCall []struct {
+		arg1 context.Context
+		arg2 *s3.AbortMultipartUploadInput
+		arg3 []func(*s3.Options)
+	}
+	abortMultipartUploadReturns struct {
+		result1 *s3.AbortMultipartUploadOutput
+		result2 error
+	}
+	abortMultipartUploadReturnsOnCall map[int]struct {
+		result1 *s3.AbortMultipartUploadOutput
+		result2 error
+	}
+	CompleteMultipartUploadStub        func(context.Context, *s3.CompleteMultipartUploadInput, ...func(*s3.Options)) (*s3.CompleteMultipartUploadOutput, error)
+	completeMultipartUploadMutex       sync.RWMutex
+	completeMultipartUploadArgsForCall []struct {
+		arg1 context.Context
+		arg2 *s3.CompleteMultipartUploadInput
+		arg3 []func(*s3.Options)
+	}
+	completeMultipartUploadReturns struct {
+		result1 *s3.CompleteMultipartUploadOutput
+		result2 error
+	}
+	completeMultipartUploadReturnsOnCall map[int]struct {
+		result1 *s3.CompleteMultipartUploadOutput
+		result2 error
+	}
 	CreateBucketStub        func(context.Context, *s3.CreateBucketInput, ...func(*s3.Options)) (*s3.CreateBucketOutput, error)
 	createBucketMutex       sync.RWMutex
 	createBucketArgsForCall []struct {
@@ -25,6 +55,21 @@ type FakeS3Client struct {
 		result1 *s3.CreateBucketOutput
 		result2 error
 	}
+	CreateMultipartUploadStub        func(context.Context, *s3.CreateMultipartUploadInput, ...func(*s3.Options)) (*s3.CreateMultipartUploadOutput, error)
+	createMultipartUploadMutex       sync.RWMutex
+	createMultipartUploadArgsForCall []struct {
+		arg1 context.Context
+		arg2 *s3.CreateMultipartUploadInput
+		arg3 []func(*s3.Options)
+	}
+	createMultipartUploadReturns struct {
+		result1 *s3.CreateMultipartUploadOutput
+		result2 error
+	}
+	createMultipartUploadReturnsOnCall map[int]struct {
+		result1 *s3.CreateMultipartUploadOutput
+		result2 error
+	}
 	DeleteBucketStub        func(context.Context, *s3.DeleteBucketInput, ...func(*s3.Options)) (*s3.DeleteBucketOutput, error)
 	deleteBucketMutex       sync.RWMutex
 	deleteBucketArgsForCall []struct {
@@ -40,6 +85,36 @@ type FakeS3Client struct {
 		result1 *s3.DeleteBucketOutput
 		result2 error
 	}
+	DeleteBucketCorsStub        func(context.Context, *s3.DeleteBucketCorsInput, ...func(*s3.Options)) (*s3.DeleteBucketCorsOutput, error)
+	deleteBucketCorsMutex       sync.RWMutex
+	deleteBucketCorsArgsForCall []struct {
+		arg1 context.Context
+		arg2 *s3.DeleteBucketCorsInput
+		arg3 []func(*s3.Options)
+	}
+	deleteBucketCorsReturns struct {
+		result1 *s3.DeleteBucketCorsOutput
+		result2 error
+	}
+	deleteBucketCorsReturnsOnCall map[int]struct {
+		result1 *s3.DeleteBucketCorsOutput
+		result2 error
+	}
+	DeleteBucketEncryptionStub        func(context.Context, *s3.DeleteBucketEncryptionInput, ...func(*s3.Options)) (*s3.DeleteBucketEncryptionOutput, error)
+	deleteBucketEncryptionMutex       sync.RWMutex
+	deleteBucketEncryptionArgsForCall []struct {
+		arg1 context.Context
+		arg2 *s3.DeleteBucketEncryptionInput
+		arg3 []func(*s3.Options)
+	}
+	deleteBucketEncryptionReturns struct {
+		result1 *s3.DeleteBucketEncryptionOutput
+		result2 error
+	}
+	deleteBucketEncryptionReturnsOnCall map[int]struct {
+		result1 *s3.DeleteBucketEncryptionOutput
+		result2 error
+	}
 	DeleteBucketLifecycleStub        func(context.Context, *s3.DeleteBucketLifecycleInput, ...func(*s3.Options)) (*s3.DeleteBucketLifecycleOutput, error)
 	deleteBucketLifecycleMutex       sync.RWMutex
 	deleteBucketLifecycleArgsForCall []struct {
@@ -55,6 +130,21 @@ type FakeS3Client struct {
 		result1 *s3.DeleteBucketLifecycleOutput
 		result2 error
 	}
+	DeleteBucketOwnershipControlsStub        func(context.Context, *s3.DeleteBucketOwnershipControlsInput, ...func(*s3.Options)) (*s3.DeleteBucketOwnershipControlsOutput, error)
+	deleteBucketOwnershipControlsMutex       sync.RWMutex
+	deleteBucketOwnershipControlsArgsForCall []struct {
+		arg1 context.Context
+		arg2 *s3.DeleteBucketOwnershipControlsInput
+		arg3 []func(*s3.Options)
+	}
+	deleteBucketOwnershipControlsReturns struct {
+		result1 *s3.DeleteBucketOwnershipControlsOutput
+		result2 error
+	}
+	deleteBucketOwnershipControlsReturnsOnCall map[int]struct {
+		result1 *s3.DeleteBucketOwnershipControlsOutput
+		result2 error
+	}
 	DeleteBucketPolicyStub        func(context.Context, *s3.DeleteBucketPolicyInput, ...func(*s3.Options)) (*s3.DeleteBucketPolicyOutput, error)
 	deleteBucketPolicyMutex       sync.RWMutex
 	deleteBucketPolicyArgsForCall []struct {
@@ -70,6 +160,51 @@ type FakeS3Client struct {
 		result1 *s3.DeleteBucketPolicyOutput
 		result2 error
 	}
+	DeleteBucketReplicationStub        func(context.Context, *s3.DeleteBucketReplicationInput, ...func(*s3.Options)) (*s3.DeleteBucketReplicationOutput, error)
+	deleteBucketReplicationMutex       sync.RWMutex
+	deleteBucketReplicationArgsForCall []struct {
+		arg1 context.Context
+		arg2 *s3.DeleteBucketReplicationInput
+		arg3 []func(*s3.Options)
+	}
+	deleteBucketReplicationReturns struct {
+		result1 *s3.DeleteBucketReplicationOutput
+		result2 error
+	}
+	deleteBucketReplicationReturnsOnCall map[int]struct {
+		result1 *s3.DeleteBucketReplicationOutput
+		result2 error
+	}
+	DeleteBucketTaggingStub        func(context.Context, *s3.DeleteBucketTaggingInput, ...func(*s3.Options)) (*s3.DeleteBucketTaggingOutput, error)
+	deleteBucketTaggingMutex       sync.RWMutex
+	deleteBucketTaggingArgsForCall []struct {
+		arg1 context.Context
+		arg2 *s3.DeleteBucketTaggingInput
+		arg3 []func(*s3.Options)
+	}
+	deleteBucketTaggingReturns struct {
+		result1 *s3.DeleteBucketTaggingOutput
+		result2 error
+	}
+	deleteBucketTaggingReturnsOnCall map[int]struct {
+		result1 *s3.DeleteBucketTaggingOutput
+		result2 error
+	}
+	DeleteBucketWebsiteStub        func(context.Context, *s3.DeleteBucketWebsiteInput, ...func(*s3.Options)) (*s3.DeleteBucketWebsiteOutput, error)
+	deleteBucketWebsiteMutex       sync.RWMutex
+	deleteBucketWebsiteArgsForCall []struct {
+		arg1 context.Context
+		arg2 *s3.DeleteBucketWebsiteInput
+		arg3 []func(*s3.Options)
+	}
+	deleteBucketWebsiteReturns struct {
+		result1 *s3.DeleteBucketWebsiteOutput
+		result2 error
+	}
+	deleteBucketWebsiteReturnsOnCall map[int]struct {
+		result1 *s3.DeleteBucketWebsiteOutput
+		result2 error
+	}
 	DeleteObjectStub        func(context.Context, *s3.DeleteObjectInput, ...func(*s3.Options)) (*s3.DeleteObjectOutput, error)
 	deleteObjectMutex       sync.RWMutex
 	deleteObjectArgsForCall []struct {
@@ -85,6 +220,21 @@ type FakeS3Client struct {
 		result1 *s3.DeleteObjectOutput
 		result2 error
 	}
+	DeletePublicAccessBlockStub        func(context.Context, *s3.DeletePublicAccessBlockInput, ...func(*s3.Options)) (*s3.DeletePublicAccessBlockOutput, error)
+	deletePublicAccessBlockMutex       sync.RWMutex
+	deletePublicAccessBlockArgsForCall []struct {
+		arg1 context.Context
+		arg2 *s3.DeletePublicAccessBlockInput
+		arg3 []func(*s3.Options)
+	}
+	deletePublicAccessBlockReturns struct {
+		result1 *s3.DeletePublicAccessBlockOutput
+		result2 error
+	}
+	deletePublicAccessBlockReturnsOnCall map[int]struct {
+		result1 *s3.DeletePublicAccessBlockOutput
+		result2 error
+	}
 	GetBucketAclStub        func(context.Context, *s3.GetBucketAclInput, ...func(*s3.Options)) (*s3.GetBucketAclOutput, error)
 	getBucketAclMutex       sync.RWMutex
 	getBucketAclArgsForCall []struct {
@@ -100,6 +250,36 @@ type FakeS3Client struct {
 		result1 *s3.GetBucketAclOutput
 		result2 error
 	}
+	GetBucketCorsStub        func(context.Context, *s3.GetBucketCorsInput, ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error)
+	getBucketCorsMutex       sync.RWMutex
+	getBucketCorsArgsForCall []struct {
+		arg1 context.Context
+		arg2 *s3.GetBucketCorsInput
+		arg3 []func(*s3.Options)
+	}
+	getBucketCorsReturns struct {
+		result1 *s3.GetBucketCorsOutput
+		result2 error
+	}
+	getBucketCorsReturnsOnCall map[int]struct {
+		result1 *s3.GetBucketCorsOutput
+		result2 error
+	}
+	GetBucketEncryptionStub        func(context.Context, *s3.GetBucketEncryptionInput, ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error)
+	getBucketEncryptionMutex       sync.RWMutex
+	getBucketEncryptionArgsForCall []struct {
+		arg1 context.Context
+		arg2 *s3.GetBucketEncryptionInput
+		arg3 []func(*s3.Options)
+	}
+	getBucketEncryptionReturns struct {
+		result1 *s3.GetBucketEncryptionOutput
+		result2 error
+	}
+	getBucketEncryptionReturnsOnCall map[int]struct {
+		result1 *s3.GetBucketEncryptionOutput
+		result2 error
+	}
 	GetBucketLifecycleConfigurationStub        func(context.Context, *s3.GetBucketLifecycleConfigurationInput, ...func(*s3.Options)) (*s3.GetBucketLifecycleConfigurationOutput, error)
 	getBucketLifecycleConfigurationMutex       sync.RWMutex
 	getBucketLifecycleConfigurationArgsForCall []struct {
@@ -115,6 +295,51 @@ type FakeS3Client struct {
 		result1 *s3.GetBucketLifecycleConfigurationOutput
 		result2 error
 	}
+	GetBucketLoggingStub        func(context.Context, *s3.GetBucketLoggingInput, ...func(*s3.Options)) (*s3.GetBucketLoggingOutput, error)
+	getBucketLoggingMutex       sync.RWMutex
+	getBucketLoggingArgsForCall []struct {
+		arg1 context.Context
+		arg2 *s3.GetBucketLoggingInput
+		arg3 []func(*s3.Options)
+	}
+	getBucketLoggingReturns struct {
+		result1 *s3.GetBucketLoggingOutput
+		result2 error
+	}
+	getBucketLoggingReturnsOnCall map[int]struct {
+		result1 *s3.GetBucketLoggingOutput
+		result2 error
+	}
+	GetBucketNotificationConfigurationStub        func(context.Context, *s3.GetBucketNotificationConfigurationInput, ...func(*s3.Options)) (*s3.GetBucketNotificationConfigurationOutput, error)
+	getBucketNotificationConfigurationMutex       sync.RWMutex
+	getBucketNotificationConfigurationArgsForCall []struct {
+		arg1 context.Context
+		arg2 *s3.GetBucketNotificationConfigurationInput
+		arg3 []func(*s3.Options)
+	}
+	getBucketNotificationConfigurationReturns struct {
+		result1 *s3.GetBucketNotificationConfigurationOutput
+		result2 error
+	}
+	getBucketNotificationConfigurationReturnsOnCall map[int]struct {
+		result1 *s3.GetBucketNotificationConfigurationOutput
+		result2 error
+	}
+	GetBucketOwnershipControlsStub        func(context.Context, *s3.GetBucketOwnershipControlsInput, ...func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error)
+	getBucketOwnershipControlsMutex       sync.RWMutex
+	getBucketOwnershipControlsArgsForCall []struct {
+		arg1 context.Context
+		arg2 *s3.GetBucketOwnershipControlsInput
+		arg3 []func(*s3.Options)
+	}
+	getBucketOwnershipControlsReturns struct {
+		result1 *s3.GetBucketOwnershipControlsOutput
+		result2 error
+	}
+	getBucketOwnershipControlsReturnsOnCall map[int]struct {
+		result1 *s3.GetBucketOwnershipControlsOutput
+		result2 error
+	}
 	GetBucketPolicyStub        func(context.Context, *s3.GetBucketPolicyInput, ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error)
 	getBucketPolicyMutex       sync.RWMutex
 	getBucketPolicyArgsForCall []struct {
@@ -130,6 +355,36 @@ type FakeS3Client struct {
 		result1 *s3.GetBucketPolicyOutput
 		result2 error
 	}
+	GetBucketReplicationStub        func(context.Context, *s3.GetBucketReplicationInput, ...func(*s3.Options)) (*s3.GetBucketReplicationOutput, error)
+	getBucketReplicationMutex       sync.RWMutex
+	getBucketReplicationArgsForCall []struct {
+		arg1 context.Context
+		arg2 *s3.GetBucketReplicationInput
+		arg3 []func(*s3.Options)
+	}
+	getBucketReplicationReturns struct {
+		result1 *s3.GetBucketReplicationOutput
+		result2 error
+	}
+	getBucketReplicationReturnsOnCall map[int]struct {
+		result1 *s3.GetBucketReplicationOutput
+		result2 error
+	}
+	GetBucketTaggingStub        func(context.Context, *s3.GetBucketTaggingInput, ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error)
+	getBucketTaggingMutex       sync.RWMutex
+	getBucketTaggingArgsForCall []struct {
+		arg1 context.Context
+		arg2 *s3.GetBucketTaggingInput
+		arg3 []func(*s3.Options)
+	}
+	getBucketTaggingReturns struct {
+		result1 *s3.GetBucketTaggingOutput
+		result2 error
+	}
+	getBucketTaggingReturnsOnCall map[int]struct {
+		result1 *s3.GetBucketTaggingOutput
+		result2 error
+	}
 	GetBucketVersioningStub        func(context.Context, *s3.GetBucketVersioningInput, ...func(*s3.Options)) (*s3.GetBucketVersioningOutput, error)
 	getBucketVersioningMutex       sync.RWMutex
 	getBucketVersioningArgsForCall []struct {
@@ -145,6 +400,21 @@ type FakeS3Client struct {
 		result1 *s3.GetBucketVersioningOutput
 		result2 error
 	}
+	GetBucketWebsiteStub        func(context.Context, *s3.GetBucketWebsiteInput, ...func(*s3.Options)) (*s3.GetBucketWebsiteOutput, error)
+	getBucketWebsiteMutex       sync.RWMutex
+	getBucketWebsiteArgsForCall []struct {
+		arg1 context.Context
+		arg2 *s3.GetBucketWebsiteInput
+		arg3 []func(*s3.Options)
+	}
+	getBucketWebsiteReturns struct {
+		result1 *s3.GetBucketWebsiteOutput
+		result2 error
+	}
+	getBucketWebsiteReturnsOnCall map[int]struct {
+		result1 *s3.GetBucketWebsiteOutput
+		result2 error
+	}
 	GetObjectStub        func(context.Context, *s3.GetObjectInput, ...func(*s3.Options)) (*s3.GetObjectOutput, error)
 	getObjectMutex       sync.RWMutex
 	getObjectArgsForCall []struct {
@@ -175,6 +445,21 @@ type FakeS3Client struct {
 		result1 *s3.GetObjectLockConfigurationOutput
 		result2 error
 	}
+	GetPublicAccessBlockStub        func(context.Context, *s3.GetPublicAccessBlockInput, ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error)
+	getPublicAccessBlockMutex       sync.RWMutex
+	getPublicAccessBlockArgsForCall []struct {
+		arg1 context.Context
+		arg2 *s3.GetPublicAccessBlockInput
+		arg3 []func(*s3.Options)
+	}
+	getPublicAccessBlockReturns struct {
+		result1 *s3.GetPublicAccessBlockOutput
+		result2 error
+	}
+	getPublicAccessBlockReturnsOnCall map[int]struct {
+		result1 *s3.GetPublicAccessBlockOutput
+		result2 error
+	}
 	HeadBucketStub        func(context.Context, *s3.HeadBucketInput, ...func(*s3.Options)) (*s3.HeadBucketOutput, error)
 	headBucketMutex       sync.RWMutex
 	headBucketArgsForCall []struct {
@@ -235,6 +520,36 @@ type FakeS3Client struct {
 		result1 *s3.PutBucketAclOutput
 		result2 error
 	}
+	PutBucketCorsStub        func(context.Context, *s3.PutBucketCorsInput, ...func(*s3.Options)) (*s3.PutBucketCorsOutput, error)
+	putBucketCorsMutex       sync.RWMutex
+	putBucketCorsArgsForCall []struct {
+		arg1 context.Context
+		arg2 *s3.PutBucketCorsInput
+		arg3 []func(*s3.Options)
+	}
+	putBucketCorsReturns struct {
+		result1 *s3.PutBucketCorsOutput
+		result2 error
+	}
+	putBucketCorsReturnsOnCall map[int]struct {
+		result1 *s3.PutBucketCorsOutput
+		result2 error
+	}
+	PutBucketEncryptionStub        func(context.Context, *s3.PutBucketEncryptionInput, ...func(*s3.Options)) (*s3.PutBucketEncryptionOutput, error)
+	putBucketEncryptionMutex       sync.RWMutex
+	putBucketEncryptionArgsForCall []struct {
+		arg1 context.Context
+		arg2 *s3.PutBucketEncryptionInput
+		arg3 []func(*s3.Options)
+	}
+	putBucketEncryptionReturns struct {
+		result1 *s3.PutBucketEncryptionOutput
+		result2 error
+	}
+	putBucketEncryptionReturnsOnCall map[int]struct {
+		result1 *s3.PutBucketEncryptionOutput
+		result2 error
+	}
 	PutBucketLifecycleConfigurationStub        func(context.Context, *s3.PutBucketLifecycleConfigurationInput, ...func(*s3.Options)) (*s3.PutBucketLifecycleConfigurationOutput, error)
 	putBucketLifecycleConfigurationMutex       sync.RWMutex
 	putBucketLifecycleConfigurationArgsForCall []struct {
@@ -250,6 +565,51 @@ type FakeS3Client struct {
 		result1 *s3.PutBucketLifecycleConfigurationOutput
 		result2 error
 	}
+	PutBucketLoggingStub        func(context.Context, *s3.PutBucketLoggingInput, ...func(*s3.Options)) (*s3.PutBucketLoggingOutput, error)
+	putBucketLoggingMutex       sync.RWMutex
+	putBucketLoggingArgsForCall []struct {
+		arg1 context.Context
+		arg2 *s3.PutBucketLoggingInput
+		arg3 []func(*s3.Options)
+	}
+	putBucketLoggingReturns struct {
+		result1 *s3.PutBucketLoggingOutput
+		result2 error
+	}
+	putBucketLoggingReturnsOnCall map[int]struct {
+		result1 *s3.PutBucketLoggingOutput
+		result2 error
+	}
+	PutBucketNotificationConfigurationStub        func(context.Context, *s3.PutBucketNotificationConfigurationInput, ...func(*s3.Options)) (*s3.PutBucketNotificationConfigurationOutput, error)
+	putBucketNotificationConfigurationMutex       sync.RWMutex
+	putBucketNotificationConfigurationArgsForCall []struct {
+		arg1 context.Context
+		arg2 *s3.PutBucketNotificationConfigurationInput
+		arg3 []func(*s3.Options)
+	}
+	putBucketNotificationConfigurationReturns struct {
+		result1 *s3.PutBucketNotificationConfigurationOutput
+		result2 error
+	}
+	putBucketNotificationConfigurationReturnsOnCall map[int]struct {
+		result1 *s3.PutBucketNotificationConfigurationOutput
+		result2 error
+	}
+	PutBucketOwnershipControlsStub        func(context.Context, *s3.PutBucketOwnershipControlsInput, ...func(*s3.Options)) (*s3.PutBucketOwnershipControlsOutput, error)
+	putBucketOwnershipControlsMutex       sync.RWMutex
+	putBucketOwnershipControlsArgsForCall []struct {
+		arg1 context.Context
+		arg2 *s3.PutBucketOwnershipControlsInput
+		arg3 []func(*s3.Options)
+	}
+	putBucketOwnershipControlsReturns struct {
+		result1 *s3.PutBucketOwnershipControlsOutput
+		result2 error
+	}
+	putBucketOwnershipControlsReturnsOnCall map[int]struct {
+		result1 *s3.PutBucketOwnershipControlsOutput
+		result2 error
+	}
 	PutBucketPolicyStub        func(context.Context, *s3.PutBucketPolicyInput, ...func(*s3.Options)) (*s3.PutBucketPolicyOutput, error)
 	putBucketPolicyMutex       sync.RWMutex
 	putBucketPolicyArgsForCall []struct {
@@ -265,6 +625,36 @@ type FakeS3Client struct {
 		result1 *s3.PutBucketPolicyOutput
 		result2 error
 	}
+	PutBucketReplicationStub        func(context.Context, *s3.PutBucketReplicationInput, ...func(*s3.Options)) (*s3.PutBucketReplicationOutput, error)
+	putBucketReplicationMutex       sync.RWMutex
+	putBucketReplicationArgsForCall []struct {
+		arg1 context.Context
+		arg2 *s3.PutBucketReplicationInput
+		arg3 []func(*s3.Options)
+	}
+	putBucketReplicationReturns struct {
+		result1 *s3.PutBucketReplicationOutput
+		result2 error
+	}
+	putBucketReplicationReturnsOnCall map[int]struct {
+		result1 *s3.PutBucketReplicationOutput
+		result2 error
+	}
+	PutBucketTaggingStub        func(context.Context, *s3.PutBucketTaggingInput, ...func(*s3.Options)) (*s3.PutBucketTaggingOutput, error)
+	putBucketTaggingMutex       sync.RWMutex
+	putBucketTaggingArgsForCall []struct {
+		arg1 context.Context
+		arg2 *s3.PutBucketTaggingInput
+		arg3 []func(*s3.Options)
+	}
+	putBucketTaggingReturns struct {
+		result1 *s3.PutBucketTaggingOutput
+		result2 error
+	}
+	putBucketTaggingReturnsOnCall map[int]struct {
+		result1 *s3.PutBucketTaggingOutput
+		result2 error
+	}
 	PutBucketVersioningStub        func(context.Context, *s3.PutBucketVersioningInput, ...func(*s3.Options)) (*s3.PutBucketVersioningOutput, error)
 	putBucketVersioningMutex       sync.RWMutex
 	putBucketVersioningArgsForCall []struct {
@@ -280,6 +670,21 @@ type FakeS3Client struct {
 		result1 *s3.PutBucketVersioningOutput
 		result2 error
 	}
+	PutBucketWebsiteStub        func(context.Context, *s3.PutBucketWebsiteInput, ...func(*s3.Options)) (*s3.PutBucketWebsiteOutput, error)
+	putBucketWebsiteMutex       sync.RWMutex
+	putBucketWebsiteArgsForCall []struct {
+		arg1 context.Context
+		arg2 *s3.PutBucketWebsiteInput
+		arg3 []func(*s3.Options)
+	}
+	putBucketWebsiteReturns struct {
+		result1 *s3.PutBucketWebsiteOutput
+		result2 error
+	}
+	putBucketWebsiteReturnsOnCall map[int]struct {
+		result1 *s3.PutBucketWebsiteOutput
+		result2 error
+	}
 	PutObjectStub        func(context.Context, *s3.PutObjectInput, ...func(*s3.Options)) (*s3.PutObjectOutput, error)
 	putObjectMutex       sync.RWMutex
 	putObjectArgsForCall []struct {
@@ -310,22 +715,52 @@ type FakeS3Client struct {
 		result1 *s3.PutObjectLockConfigurationOutput
 		result2 error
 	}
+	PutPublicAccessBlockStub        func(context.Context, *s3.PutPublicAccessBlockInput, ...func(*s3.Options)) (*s3.PutPublicAccessBlockOutput, error)
+	putPublicAccessBlockMutex       sync.RWMutex
+	putPublicAccessBlockArgsForCall []struct {
+		arg1 context.Context
+		arg2 *s3.PutPublicAccessBlockInput
+		arg3 []func(*s3.Options)
+	}
+	putPublicAccessBlockReturns struct {
+		result1 *s3.PutPublicAccessBlockOutput
+		result2 error
+	}
+	putPublicAccessBlockReturnsOnCall map[int]struct {
+		result1 *s3.PutPublicAccessBlockOutput
+		result2 error
+	}
+	UploadPartStub        func(context.Context, *s3.UploadPartInput, ...func(*s3.Options)) (*s3.UploadPartOutput, error)
+	uploadPartMutex       sync.RWMutex
+	uploadPartArgsForCall []struct {
+		arg1 context.Context
+		arg2 *s3.UploadPartInput
+		arg3 []func(*s3.Options)
+	}
+	uploadPartReturns struct {
+		result1 *s3.UploadPartOutput
+		result2 error
+	}
+	uploadPartReturnsOnCall map[int]struct {
+		result1 *s3.UploadPartOutput
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeS3Client) CreateBucket(arg1 context.Context, arg2 *s3.CreateBucketInput, arg3 ...func(*s3.Options)) (*s3.CreateBucketOutput, error) {
-	fake.createBucketMutex.Lock()
-	ret, specificReturn := fake.createBucketReturnsOnCall[len(fake.createBucketArgsForCall)]
-	fake.createBucketArgsForCall = append(fake.createBucketArgsForCall, struct {
+func (fake *FakeS3Client) AbortMultipartUpload(arg1 context.Context, arg2 *s3.AbortMultipartUploadInput, arg3 ...func(*s3.Options)) (*s3.AbortMultipartUploadOutput, error) {
+	fake.abortMultipartUploadMutex.Lock()
+	ret, specificReturn := fake.abortMultipartUploadReturnsOnCall[len(fake.abortMultipartUploadArgsForCall)]
+	fake.abortMultipartUploadArgsForCall = append(fake.abortMultipartUploadArgsForCall, struct {
 		arg1 context.Context
-		arg2 *s3.CreateBucketInput
+		arg2 *s3.AbortMultipartUploadInput
 		arg3 []func(*s3.Options)
 	}{arg1, arg2, arg3})
-	stub := fake.CreateBucketStub
-	fakeReturns := fake.createBucketReturns
-	fake.recordInvocation("CreateBucket", []interface{}{arg1, arg2, arg3})
-	fake.createBucketMutex.Unlock()
+	stub := fake.AbortMultipartUploadStub
+	fakeReturns := fake.abortMultipartUploadReturns
+	fake.recordInvocation("AbortMultipartUpload", []interface{}{arg1, arg2, arg3})
+	fake.abortMultipartUploadMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3...)
 	}
@@ -335,63 +770,63 @@ func (fake *FakeS3Client) CreateBucket(arg1 context.Context, arg2 *s3.CreateBuck
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeS3Client) CreateBucketCallCount() int {
-	fake.createBucketMutex.RLock()
-	defer fake.createBucketMutex.RUnlock()
-	return len(fake.createBucketArgsForCall)
+func (fake *FakeS3Client) AbortMultipartUploadCallCount() int {
+	fake.abortMultipartUploadMutex.RLock()
+	defer fake.abortMultipartUploadMutex.RUnlock()
+	return len(fake.abortMultipartUploadArgsForCall)
 }
 
-func (fake *FakeS3Client) CreateBucketCalls(stub func(context.Context, *s3.CreateBucketInput, ...func(*s3.Options)) (*s3.CreateBucketOutput, error)) {
-	fake.createBucketMutex.Lock()
-	defer fake.createBucketMutex.Unlock()
-	fake.CreateBucketStub = stub
+func (fake *FakeS3Client) AbortMultipartUploadCalls(stub func(context.Context, *s3.AbortMultipartUploadInput, ...func(*s3.Options)) (*s3.AbortMultipartUploadOutput, error)) {
+	fake.abortMultipartUploadMutex.Lock()
+	defer fake.abortMultipartUploadMutex.Unlock()
+	fake.AbortMultipartUploadStub = stub
 }
 
-func (fake *FakeS3Client) CreateBucketArgsForCall(i int) (context.Context, *s3.CreateBucketInput, []func(*s3.Options)) {
-	fake.createBucketMutex.RLock()
-	defer fake.createBucketMutex.RUnlock()
-	argsForCall := fake.createBucketArgsForCall[i]
+func (fake *FakeS3Client) AbortMultipartUploadArgsForCall(i int) (context.Context, *s3.AbortMultipartUploadInput, []func(*s3.Options)) {
+	fake.abortMultipartUploadMutex.RLock()
+	defer fake.abortMultipartUploadMutex.RUnlock()
+	argsForCall := fake.abortMultipartUploadArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeS3Client) CreateBucketReturns(result1 *s3.CreateBucketOutput, result2 error) {
-	fake.createBucketMutex.Lock()
-	defer fake.createBucketMutex.Unlock()
-	fake.CreateBucketStub = nil
-	fake.createBucketReturns = struct {
-		result1 *s3.CreateBucketOutput
+func (fake *FakeS3Client) AbortMultipartUploadReturns(result1 *s3.AbortMultipartUploadOutput, result2 error) {
+	fake.abortMultipartUploadMutex.Lock()
+	defer fake.abortMultipartUploadMutex.Unlock()
+	fake.AbortMultipartUploadStub = nil
+	fake.abortMultipartUploadReturns = struct {
+		result1 *s3.AbortMultipartUploadOutput
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeS3Client) CreateBucketReturnsOnCall(i int, result1 *s3.CreateBucketOutput, result2 error) {
-	fake.createBucketMutex.Lock()
-	defer fake.createBucketMutex.Unlock()
-	fake.CreateBucketStub = nil
-	if fake.createBucketReturnsOnCall == nil {
-		fake.createBucketReturnsOnCall = make(map[int]struct {
-			result1 *s3.CreateBucketOutput
+func (fake *FakeS3Client) AbortMultipartUploadReturnsOnCall(i int, result1 *s3.AbortMultipartUploadOutput, result2 error) {
+	fake.abortMultipartUploadMutex.Lock()
+	defer fake.abortMultipartUploadMutex.Unlock()
+	fake.AbortMultipartUploadStub = nil
+	if fake.abortMultipartUploadReturnsOnCall == nil {
+		fake.abortMultipartUploadReturnsOnCall = make(map[int]struct {
+			result1 *s3.AbortMultipartUploadOutput
 			result2 error
 		})
 	}
-	fake.createBucketReturnsOnCall[i] = struct {
-		result1 *s3.CreateBucketOutput
+	fake.abortMultipartUploadReturnsOnCall[i] = struct {
+		result1 *s3.AbortMultipartUploadOutput
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeS3Client) DeleteBucket(arg1 context.Context, arg2 *s3.DeleteBucketInput, arg3 ...func(*s3.Options)) (*s3.DeleteBucketOutput, error) {
-	fake.deleteBucketMutex.Lock()
-	ret, specificReturn := fake.deleteBucketReturnsOnCall[len(fake.deleteBucketArgsForCall)]
-	fake.deleteBucketArgsForCall = append(fake.deleteBucketArgsForCall, struct {
+func (fake *FakeS3Client) CompleteMultipartUpload(arg1 context.Context, arg2 *s3.CompleteMultipartUploadInput, arg3 ...func(*s3.Options)) (*s3.CompleteMultipartUploadOutput, error) {
+	fake.completeMultipartUploadMutex.Lock()
+	ret, specificReturn := fake.completeMultipartUploadReturnsOnCall[len(fake.completeMultipartUploadArgsForCall)]
+	fake.completeMultipartUploadArgsForCall = append(fake.completeMultipartUploadArgsForCall, struct {
 		arg1 context.Context
-		arg2 *s3.DeleteBucketInput
+		arg2 *s3.CompleteMultipartUploadInput
 		arg3 []func(*s3.Options)
 	}{arg1, arg2, arg3})
-	stub := fake.DeleteBucketStub
-	fakeReturns := fake.deleteBucketReturns
-	fake.recordInvocation("DeleteBucket", []interface{}{arg1, arg2, arg3})
-	fake.deleteBucketMutex.Unlock()
+	stub := fake.CompleteMultipartUploadStub
+	fakeReturns := fake.completeMultipartUploadReturns
+	fake.recordInvocation("CompleteMultipartUpload", []interface{}{arg1, arg2, arg3})
+	fake.completeMultipartUploadMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3...)
 	}
@@ -401,16 +836,214 @@ func (fake *FakeS3Client) DeleteBucket(arg1 context.Context, arg2 *s3.DeleteBuck
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeS3Client) DeleteBucketCallCount() int {
-	fake.deleteBucketMutex.RLock()
-	defer fake.deleteBucketMutex.RUnlock()
-	return len(fake.deleteBucketArgsForCall)
+func (fake *FakeS3Client) CompleteMultipartUploadCallCount() int {
+	fake.completeMultipartUploadMutex.RLock()
+	defer fake.completeMultipartUploadMutex.RUnlock()
+	return len(fake.completeMultipartUploadArgsForCall)
 }
 
-func (fake *FakeS3Client) DeleteBucketCalls(stub func(context.Context, *s3.DeleteBucketInput, ...func(*s3.Options)) (*s3.DeleteBucketOutput, error)) {
-	fake.deleteBucketMutex.Lock()
-	defer fake.deleteBucketMutex.Unlock()
-	fake.DeleteBucketStub = stub
+func (fake *FakeS3Client) CompleteMultipartUploadCalls(stub func(context.Context, *s3.CompleteMultipartUploadInput, ...func(*s3.Options)) (*s3.CompleteMultipartUploadOutput, error)) {
+	fake.completeMultipartUploadMutex.Lock()
+	defer fake.completeMultipartUploadMutex.Unlock()
+	fake.CompleteMultipartUploadStub = stub
+}
+
+func (fake *FakeS3Client) CompleteMultipartUploadArgsForCall(i int) (context.Context, *s3.CompleteMultipartUploadInput, []func(*s3.Options)) {
+	fake.completeMultipartUploadMutex.RLock()
+	defer fake.completeMultipartUploadMutex.RUnlock()
+	argsForCall := fake.completeMultipartUploadArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3Client) CompleteMultipartUploadReturns(result1 *s3.CompleteMultipartUploadOutput, result2 error) {
+	fake.completeMultipartUploadMutex.Lock()
+	defer fake.completeMultipartUploadMutex.Unlock()
+	fake.CompleteMultipartUploadStub = nil
+	fake.completeMultipartUploadReturns = struct {
+		result1 *s3.CompleteMultipartUploadOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) CompleteMultipartUploadReturnsOnCall(i int, result1 *s3.CompleteMultipartUploadOutput, result2 error) {
+	fake.completeMultipartUploadMutex.Lock()
+	defer fake.completeMultipartUploadMutex.Unlock()
+	fake.CompleteMultipartUploadStub = nil
+	if fake.completeMultipartUploadReturnsOnCall == nil {
+		fake.completeMultipartUploadReturnsOnCall = make(map[int]struct {
+			result1 *s3.CompleteMultipartUploadOutput
+			result2 error
+		})
+	}
+	fake.completeMultipartUploadReturnsOnCall[i] = struct {
+		result1 *s3.CompleteMultipartUploadOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) CreateBucket(arg1 context.Context, arg2 *s3.CreateBucketInput, arg3 ...func(*s3.Options)) (*s3.CreateBucketOutput, error) {
+	fake.createBucketMutex.Lock()
+	ret, specificReturn := fake.createBucketReturnsOnCall[len(fake.createBucketArgsForCall)]
+	fake.createBucketArgsForCall = append(fake.createBucketArgsForCall, struct {
+		arg1 context.Context
+		arg2 *s3.CreateBucketInput
+		arg3 []func(*s3.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.CreateBucketStub
+	fakeReturns := fake.createBucketReturns
+	fake.recordInvocation("CreateBucket", []interface{}{arg1, arg2, arg3})
+	fake.createBucketMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3Client) CreateBucketCallCount() int {
+	fake.createBucketMutex.RLock()
+	defer fake.createBucketMutex.RUnlock()
+	return len(fake.createBucketArgsForCall)
+}
+
+func (fake *FakeS3Client) CreateBucketCalls(stub func(context.Context, *s3.CreateBucketInput, ...func(*s3.Options)) (*s3.CreateBucketOutput, error)) {
+	fake.createBucketMutex.Lock()
+	defer fake.createBucketMutex.Unlock()
+	fake.CreateBucketStub = stub
+}
+
+func (fake *FakeS3Client) CreateBucketArgsForCall(i int) (context.Context, *s3.CreateBucketInput, []func(*s3.Options)) {
+	fake.createBucketMutex.RLock()
+	defer fake.createBucketMutex.RUnlock()
+	argsForCall := fake.createBucketArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3Client) CreateBucketReturns(result1 *s3.CreateBucketOutput, result2 error) {
+	fake.createBucketMutex.Lock()
+	defer fake.createBucketMutex.Unlock()
+	fake.CreateBucketStub = nil
+	fake.createBucketReturns = struct {
+		result1 *s3.CreateBucketOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) CreateBucketReturnsOnCall(i int, result1 *s3.CreateBucketOutput, result2 error) {
+	fake.createBucketMutex.Lock()
+	defer fake.createBucketMutex.Unlock()
+	fake.CreateBucketStub = nil
+	if fake.createBucketReturnsOnCall == nil {
+		fake.createBucketReturnsOnCall = make(map[int]struct {
+			result1 *s3.CreateBucketOutput
+			result2 error
+		})
+	}
+	fake.createBucketReturnsOnCall[i] = struct {
+		result1 *s3.CreateBucketOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) CreateMultipartUpload(arg1 context.Context, arg2 *s3.CreateMultipartUploadInput, arg3 ...func(*s3.Options)) (*s3.CreateMultipartUploadOutput, error) {
+	fake.createMultipartUploadMutex.Lock()
+	ret, specificReturn := fake.createMultipartUploadReturnsOnCall[len(fake.createMultipartUploadArgsForCall)]
+	fake.createMultipartUploadArgsForCall = append(fake.createMultipartUploadArgsForCall, struct {
+		arg1 context.Context
+		arg2 *s3.CreateMultipartUploadInput
+		arg3 []func(*s3.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.CreateMultipartUploadStub
+	fakeReturns := fake.createMultipartUploadReturns
+	fake.recordInvocation("CreateMultipartUpload", []interface{}{arg1, arg2, arg3})
+	fake.createMultipartUploadMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3Client) CreateMultipartUploadCallCount() int {
+	fake.createMultipartUploadMutex.RLock()
+	defer fake.createMultipartUploadMutex.RUnlock()
+	return len(fake.createMultipartUploadArgsForCall)
+}
+
+func (fake *FakeS3Client) CreateMultipartUploadCalls(stub func(context.Context, *s3.CreateMultipartUploadInput, ...func(*s3.Options)) (*s3.CreateMultipartUploadOutput, error)) {
+	fake.createMultipartUploadMutex.Lock()
+	defer fake.createMultipartUploadMutex.Unlock()
+	fake.CreateMultipartUploadStub = stub
+}
+
+func (fake *FakeS3Client) CreateMultipartUploadArgsForCall(i int) (context.Context, *s3.CreateMultipartUploadInput, []func(*s3.Options)) {
+	fake.createMultipartUploadMutex.RLock()
+	defer fake.createMultipartUploadMutex.RUnlock()
+	argsForCall := fake.createMultipartUploadArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3Client) CreateMultipartUploadReturns(result1 *s3.CreateMultipartUploadOutput, result2 error) {
+	fake.createMultipartUploadMutex.Lock()
+	defer fake.createMultipartUploadMutex.Unlock()
+	fake.CreateMultipartUploadStub = nil
+	fake.createMultipartUploadReturns = struct {
+		result1 *s3.CreateMultipartUploadOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) CreateMultipartUploadReturnsOnCall(i int, result1 *s3.CreateMultipartUploadOutput, result2 error) {
+	fake.createMultipartUploadMutex.Lock()
+	defer fake.createMultipartUploadMutex.Unlock()
+	fake.CreateMultipartUploadStub = nil
+	if fake.createMultipartUploadReturnsOnCall == nil {
+		fake.createMultipartUploadReturnsOnCall = make(map[int]struct {
+			result1 *s3.CreateMultipartUploadOutput
+			result2 error
+		})
+	}
+	fake.createMultipartUploadReturnsOnCall[i] = struct {
+		result1 *s3.CreateMultipartUploadOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) DeleteBucket(arg1 context.Context, arg2 *s3.DeleteBucketInput, arg3 ...func(*s3.Options)) (*s3.DeleteBucketOutput, error) {
+	fake.deleteBucketMutex.Lock()
+	ret, specificReturn := fake.deleteBucketReturnsOnCall[len(fake.deleteBucketArgsForCall)]
+	fake.deleteBucketArgsForCall = append(fake.deleteBucketArgsForCall, struct {
+		arg1 context.Context
+		arg2 *s3.DeleteBucketInput
+		arg3 []func(*s3.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteBucketStub
+	fakeReturns := fake.deleteBucketReturns
+	fake.recordInvocation("DeleteBucket", []interface{}{arg1, arg2, arg3})
+	fake.deleteBucketMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3Client) DeleteBucketCallCount() int {
+	fake.deleteBucketMutex.RLock()
+	defer fake.deleteBucketMutex.RUnlock()
+	return len(fake.deleteBucketArgsForCall)
+}
+
+func (fake *FakeS3Client) DeleteBucketCalls(stub func(context.Context, *s3.DeleteBucketInput, ...func(*s3.Options)) (*s3.DeleteBucketOutput, error)) {
+	fake.deleteBucketMutex.Lock()
+	defer fake.deleteBucketMutex.Unlock()
+	fake.DeleteBucketStub = stub
 }
 
 func (fake *FakeS3Client) DeleteBucketArgsForCall(i int) (context.Context, *s3.DeleteBucketInput, []func(*s3.Options)) {
@@ -446,6 +1079,138 @@ func (fake *FakeS3Client) DeleteBucketReturnsOnCall(i int, result1 *s3.DeleteBuc
 	}{result1, result2}
 }
 
+func (fake *FakeS3Client) DeleteBucketCors(arg1 context.Context, arg2 *s3.DeleteBucketCorsInput, arg3 ...func(*s3.Options)) (*s3.DeleteBucketCorsOutput, error) {
+	fake.deleteBucketCorsMutex.Lock()
+	ret, specificReturn := fake.deleteBucketCorsReturnsOnCall[len(fake.deleteBucketCorsArgsForCall)]
+	fake.deleteBucketCorsArgsForCall = append(fake.deleteBucketCorsArgsForCall, struct {
+		arg1 context.Context
+		arg2 *s3.DeleteBucketCorsInput
+		arg3 []func(*s3.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteBucketCorsStub
+	fakeReturns := fake.deleteBucketCorsReturns
+	fake.recordInvocation("DeleteBucketCors", []interface{}{arg1, arg2, arg3})
+	fake.deleteBucketCorsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3Client) DeleteBucketCorsCallCount() int {
+	fake.deleteBucketCorsMutex.RLock()
+	defer fake.deleteBucketCorsMutex.RUnlock()
+	return len(fake.deleteBucketCorsArgsForCall)
+}
+
+func (fake *FakeS3Client) DeleteBucketCorsCalls(stub func(context.Context, *s3.DeleteBucketCorsInput, ...func(*s3.Options)) (*s3.DeleteBucketCorsOutput, error)) {
+	fake.deleteBucketCorsMutex.Lock()
+	defer fake.deleteBucketCorsMutex.Unlock()
+	fake.DeleteBucketCorsStub = stub
+}
+
+func (fake *FakeS3Client) DeleteBucketCorsArgsForCall(i int) (context.Context, *s3.DeleteBucketCorsInput, []func(*s3.Options)) {
+	fake.deleteBucketCorsMutex.RLock()
+	defer fake.deleteBucketCorsMutex.RUnlock()
+	argsForCall := fake.deleteBucketCorsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3Client) DeleteBucketCorsReturns(result1 *s3.DeleteBucketCorsOutput, result2 error) {
+	fake.deleteBucketCorsMutex.Lock()
+	defer fake.deleteBucketCorsMutex.Unlock()
+	fake.DeleteBucketCorsStub = nil
+	fake.deleteBucketCorsReturns = struct {
+		result1 *s3.DeleteBucketCorsOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) DeleteBucketCorsReturnsOnCall(i int, result1 *s3.DeleteBucketCorsOutput, result2 error) {
+	fake.deleteBucketCorsMutex.Lock()
+	defer fake.deleteBucketCorsMutex.Unlock()
+	fake.DeleteBucketCorsStub = nil
+	if fake.deleteBucketCorsReturnsOnCall == nil {
+		fake.deleteBucketCorsReturnsOnCall = make(map[int]struct {
+			result1 *s3.DeleteBucketCorsOutput
+			result2 error
+		})
+	}
+	fake.deleteBucketCorsReturnsOnCall[i] = struct {
+		result1 *s3.DeleteBucketCorsOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) DeleteBucketEncryption(arg1 context.Context, arg2 *s3.DeleteBucketEncryptionInput, arg3 ...func(*s3.Options)) (*s3.DeleteBucketEncryptionOutput, error) {
+	fake.deleteBucketEncryptionMutex.Lock()
+	ret, specificReturn := fake.deleteBucketEncryptionReturnsOnCall[len(fake.deleteBucketEncryptionArgsForCall)]
+	fake.deleteBucketEncryptionArgsForCall = append(fake.deleteBucketEncryptionArgsForCall, struct {
+		arg1 context.Context
+		arg2 *s3.DeleteBucketEncryptionInput
+		arg3 []func(*s3.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteBucketEncryptionStub
+	fakeReturns := fake.deleteBucketEncryptionReturns
+	fake.recordInvocation("DeleteBucketEncryption", []interface{}{arg1, arg2, arg3})
+	fake.deleteBucketEncryptionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3Client) DeleteBucketEncryptionCallCount() int {
+	fake.deleteBucketEncryptionMutex.RLock()
+	defer fake.deleteBucketEncryptionMutex.RUnlock()
+	return len(fake.deleteBucketEncryptionArgsForCall)
+}
+
+func (fake *FakeS3Client) DeleteBucketEncryptionCalls(stub func(context.Context, *s3.DeleteBucketEncryptionInput, ...func(*s3.Options)) (*s3.DeleteBucketEncryptionOutput, error)) {
+	fake.deleteBucketEncryptionMutex.Lock()
+	defer fake.deleteBucketEncryptionMutex.Unlock()
+	fake.DeleteBucketEncryptionStub = stub
+}
+
+func (fake *FakeS3Client) DeleteBucketEncryptionArgsForCall(i int) (context.Context, *s3.DeleteBucketEncryptionInput, []func(*s3.Options)) {
+	fake.deleteBucketEncryptionMutex.RLock()
+	defer fake.deleteBucketEncryptionMutex.RUnlock()
+	argsForCall := fake.deleteBucketEncryptionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3Client) DeleteBucketEncryptionReturns(result1 *s3.DeleteBucketEncryptionOutput, result2 error) {
+	fake.deleteBucketEncryptionMutex.Lock()
+	defer fake.deleteBucketEncryptionMutex.Unlock()
+	fake.DeleteBucketEncryptionStub = nil
+	fake.deleteBucketEncryptionReturns = struct {
+		result1 *s3.DeleteBucketEncryptionOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) DeleteBucketEncryptionReturnsOnCall(i int, result1 *s3.DeleteBucketEncryptionOutput, result2 error) {
+	fake.deleteBucketEncryptionMutex.Lock()
+	defer fake.deleteBucketEncryptionMutex.Unlock()
+	fake.DeleteBucketEncryptionStub = nil
+	if fake.deleteBucketEncryptionReturnsOnCall == nil {
+		fake.deleteBucketEncryptionReturnsOnCall = make(map[int]struct {
+			result1 *s3.DeleteBucketEncryptionOutput
+			result2 error
+		})
+	}
+	fake.deleteBucketEncryptionReturnsOnCall[i] = struct {
+		result1 *s3.DeleteBucketEncryptionOutput
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeS3Client) DeleteBucketLifecycle(arg1 context.Context, arg2 *s3.DeleteBucketLifecycleInput, arg3 ...func(*s3.Options)) (*s3.DeleteBucketLifecycleOutput, error) {
 	fake.deleteBucketLifecycleMutex.Lock()
 	ret, specificReturn := fake.deleteBucketLifecycleReturnsOnCall[len(fake.deleteBucketLifecycleArgsForCall)]
@@ -512,6 +1277,72 @@ func (fake *FakeS3Client) DeleteBucketLifecycleReturnsOnCall(i int, result1 *s3.
 	}{result1, result2}
 }
 
+func (fake *FakeS3Client) DeleteBucketOwnershipControls(arg1 context.Context, arg2 *s3.DeleteBucketOwnershipControlsInput, arg3 ...func(*s3.Options)) (*s3.DeleteBucketOwnershipControlsOutput, error) {
+	fake.deleteBucketOwnershipControlsMutex.Lock()
+	ret, specificReturn := fake.deleteBucketOwnershipControlsReturnsOnCall[len(fake.deleteBucketOwnershipControlsArgsForCall)]
+	fake.deleteBucketOwnershipControlsArgsForCall = append(fake.deleteBucketOwnershipControlsArgsForCall, struct {
+		arg1 context.Context
+		arg2 *s3.DeleteBucketOwnershipControlsInput
+		arg3 []func(*s3.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteBucketOwnershipControlsStub
+	fakeReturns := fake.deleteBucketOwnershipControlsReturns
+	fake.recordInvocation("DeleteBucketOwnershipControls", []interface{}{arg1, arg2, arg3})
+	fake.deleteBucketOwnershipControlsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3Client) DeleteBucketOwnershipControlsCallCount() int {
+	fake.deleteBucketOwnershipControlsMutex.RLock()
+	defer fake.deleteBucketOwnershipControlsMutex.RUnlock()
+	return len(fake.deleteBucketOwnershipControlsArgsForCall)
+}
+
+func (fake *FakeS3Client) DeleteBucketOwnershipControlsCalls(stub func(context.Context, *s3.DeleteBucketOwnershipControlsInput, ...func(*s3.Options)) (*s3.DeleteBucketOwnershipControlsOutput, error)) {
+	fake.deleteBucketOwnershipControlsMutex.Lock()
+	defer fake.deleteBucketOwnershipControlsMutex.Unlock()
+	fake.DeleteBucketOwnershipControlsStub = stub
+}
+
+func (fake *FakeS3Client) DeleteBucketOwnershipControlsArgsForCall(i int) (context.Context, *s3.DeleteBucketOwnershipControlsInput, []func(*s3.Options)) {
+	fake.deleteBucketOwnershipControlsMutex.RLock()
+	defer fake.deleteBucketOwnershipControlsMutex.RUnlock()
+	argsForCall := fake.deleteBucketOwnershipControlsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3Client) DeleteBucketOwnershipControlsReturns(result1 *s3.DeleteBucketOwnershipControlsOutput, result2 error) {
+	fake.deleteBucketOwnershipControlsMutex.Lock()
+	defer fake.deleteBucketOwnershipControlsMutex.Unlock()
+	fake.DeleteBucketOwnershipControlsStub = nil
+	fake.deleteBucketOwnershipControlsReturns = struct {
+		result1 *s3.DeleteBucketOwnershipControlsOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) DeleteBucketOwnershipControlsReturnsOnCall(i int, result1 *s3.DeleteBucketOwnershipControlsOutput, result2 error) {
+	fake.deleteBucketOwnershipControlsMutex.Lock()
+	defer fake.deleteBucketOwnershipControlsMutex.Unlock()
+	fake.DeleteBucketOwnershipControlsStub = nil
+	if fake.deleteBucketOwnershipControlsReturnsOnCall == nil {
+		fake.deleteBucketOwnershipControlsReturnsOnCall = make(map[int]struct {
+			result1 *s3.DeleteBucketOwnershipControlsOutput
+			result2 error
+		})
+	}
+	fake.deleteBucketOwnershipControlsReturnsOnCall[i] = struct {
+		result1 *s3.DeleteBucketOwnershipControlsOutput
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeS3Client) DeleteBucketPolicy(arg1 context.Context, arg2 *s3.DeleteBucketPolicyInput, arg3 ...func(*s3.Options)) (*s3.DeleteBucketPolicyOutput, error) {
 	fake.deleteBucketPolicyMutex.Lock()
 	ret, specificReturn := fake.deleteBucketPolicyReturnsOnCall[len(fake.deleteBucketPolicyArgsForCall)]
@@ -578,18 +1409,18 @@ func (fake *FakeS3Client) DeleteBucketPolicyReturnsOnCall(i int, result1 *s3.Del
 	}{result1, result2}
 }
 
-func (fake *FakeS3Client) DeleteObject(arg1 context.Context, arg2 *s3.DeleteObjectInput, arg3 ...func(*s3.Options)) (*s3.DeleteObjectOutput, error) {
-	fake.deleteObjectMutex.Lock()
-	ret, specificReturn := fake.deleteObjectReturnsOnCall[len(fake.deleteObjectArgsForCall)]
-	fake.deleteObjectArgsForCall = append(fake.deleteObjectArgsForCall, struct {
+func (fake *FakeS3Client) DeleteBucketReplication(arg1 context.Context, arg2 *s3.DeleteBucketReplicationInput, arg3 ...func(*s3.Options)) (*s3.DeleteBucketReplicationOutput, error) {
+	fake.deleteBucketReplicationMutex.Lock()
+	ret, specificReturn := fake.deleteBucketReplicationReturnsOnCall[len(fake.deleteBucketReplicationArgsForCall)]
+	fake.deleteBucketReplicationArgsForCall = append(fake.deleteBucketReplicationArgsForCall, struct {
 		arg1 context.Context
-		arg2 *s3.DeleteObjectInput
+		arg2 *s3.DeleteBucketReplicationInput
 		arg3 []func(*s3.Options)
 	}{arg1, arg2, arg3})
-	stub := fake.DeleteObjectStub
-	fakeReturns := fake.deleteObjectReturns
-	fake.recordInvocation("DeleteObject", []interface{}{arg1, arg2, arg3})
-	fake.deleteObjectMutex.Unlock()
+	stub := fake.DeleteBucketReplicationStub
+	fakeReturns := fake.deleteBucketReplicationReturns
+	fake.recordInvocation("DeleteBucketReplication", []interface{}{arg1, arg2, arg3})
+	fake.deleteBucketReplicationMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3...)
 	}
@@ -599,27 +1430,225 @@ func (fake *FakeS3Client) DeleteObject(arg1 context.Context, arg2 *s3.DeleteObje
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeS3Client) DeleteObjectCallCount() int {
-	fake.deleteObjectMutex.RLock()
-	defer fake.deleteObjectMutex.RUnlock()
-	return len(fake.deleteObjectArgsForCall)
+func (fake *FakeS3Client) DeleteBucketReplicationCallCount() int {
+	fake.deleteBucketReplicationMutex.RLock()
+	defer fake.deleteBucketReplicationMutex.RUnlock()
+	return len(fake.deleteBucketReplicationArgsForCall)
 }
 
-func (fake *FakeS3Client) DeleteObjectCalls(stub func(context.Context, *s3.DeleteObjectInput, ...func(*s3.Options)) (*s3.DeleteObjectOutput, error)) {
-	fake.deleteObjectMutex.Lock()
-	defer fake.deleteObjectMutex.Unlock()
-	fake.DeleteObjectStub = stub
+func (fake *FakeS3Client) DeleteBucketReplicationCalls(stub func(context.Context, *s3.DeleteBucketReplicationInput, ...func(*s3.Options)) (*s3.DeleteBucketReplicationOutput, error)) {
+	fake.deleteBucketReplicationMutex.Lock()
+	defer fake.deleteBucketReplicationMutex.Unlock()
+	fake.DeleteBucketReplicationStub = stub
 }
 
-func (fake *FakeS3Client) DeleteObjectArgsForCall(i int) (context.Context, *s3.DeleteObjectInput, []func(*s3.Options)) {
-	fake.deleteObjectMutex.RLock()
-	defer fake.deleteObjectMutex.RUnlock()
-	argsForCall := fake.deleteObjectArgsForCall[i]
+func (fake *FakeS3Client) DeleteBucketReplicationArgsForCall(i int) (context.Context, *s3.DeleteBucketReplicationInput, []func(*s3.Options)) {
+	fake.deleteBucketReplicationMutex.RLock()
+	defer fake.deleteBucketReplicationMutex.RUnlock()
+	argsForCall := fake.deleteBucketReplicationArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeS3Client) DeleteObjectReturns(result1 *s3.DeleteObjectOutput, result2 error) {
-	fake.deleteObjectMutex.Lock()
+func (fake *FakeS3Client) DeleteBucketReplicationReturns(result1 *s3.DeleteBucketReplicationOutput, result2 error) {
+	fake.deleteBucketReplicationMutex.Lock()
+	defer fake.deleteBucketReplicationMutex.Unlock()
+	fake.DeleteBucketReplicationStub = nil
+	fake.deleteBucketReplicationReturns = struct {
+		result1 *s3.DeleteBucketReplicationOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) DeleteBucketReplicationReturnsOnCall(i int, result1 *s3.DeleteBucketReplicationOutput, result2 error) {
+	fake.deleteBucketReplicationMutex.Lock()
+	defer fake.deleteBucketReplicationMutex.Unlock()
+	fake.DeleteBucketReplicationStub = nil
+	if fake.deleteBucketReplicationReturnsOnCall == nil {
+		fake.deleteBucketReplicationReturnsOnCall = make(map[int]struct {
+			result1 *s3.DeleteBucketReplicationOutput
+			result2 error
+		})
+	}
+	fake.deleteBucketReplicationReturnsOnCall[i] = struct {
+		result1 *s3.DeleteBucketReplicationOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) DeleteBucketTagging(arg1 context.Context, arg2 *s3.DeleteBucketTaggingInput, arg3 ...func(*s3.Options)) (*s3.DeleteBucketTaggingOutput, error) {
+	fake.deleteBucketTaggingMutex.Lock()
+	ret, specificReturn := fake.deleteBucketTaggingReturnsOnCall[len(fake.deleteBucketTaggingArgsForCall)]
+	fake.deleteBucketTaggingArgsForCall = append(fake.deleteBucketTaggingArgsForCall, struct {
+		arg1 context.Context
+		arg2 *s3.DeleteBucketTaggingInput
+		arg3 []func(*s3.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteBucketTaggingStub
+	fakeReturns := fake.deleteBucketTaggingReturns
+	fake.recordInvocation("DeleteBucketTagging", []interface{}{arg1, arg2, arg3})
+	fake.deleteBucketTaggingMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3Client) DeleteBucketTaggingCallCount() int {
+	fake.deleteBucketTaggingMutex.RLock()
+	defer fake.deleteBucketTaggingMutex.RUnlock()
+	return len(fake.deleteBucketTaggingArgsForCall)
+}
+
+func (fake *FakeS3Client) DeleteBucketTaggingCalls(stub func(context.Context, *s3.DeleteBucketTaggingInput, ...func(*s3.Options)) (*s3.DeleteBucketTaggingOutput, error)) {
+	fake.deleteBucketTaggingMutex.Lock()
+	defer fake.deleteBucketTaggingMutex.Unlock()
+	fake.DeleteBucketTaggingStub = stub
+}
+
+func (fake *FakeS3Client) DeleteBucketTaggingArgsForCall(i int) (context.Context, *s3.DeleteBucketTaggingInput, []func(*s3.Options)) {
+	fake.deleteBucketTaggingMutex.RLock()
+	defer fake.deleteBucketTaggingMutex.RUnlock()
+	argsForCall := fake.deleteBucketTaggingArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3Client) DeleteBucketTaggingReturns(result1 *s3.DeleteBucketTaggingOutput, result2 error) {
+	fake.deleteBucketTaggingMutex.Lock()
+	defer fake.deleteBucketTaggingMutex.Unlock()
+	fake.DeleteBucketTaggingStub = nil
+	fake.deleteBucketTaggingReturns = struct {
+		result1 *s3.DeleteBucketTaggingOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) DeleteBucketTaggingReturnsOnCall(i int, result1 *s3.DeleteBucketTaggingOutput, result2 error) {
+	fake.deleteBucketTaggingMutex.Lock()
+	defer fake.deleteBucketTaggingMutex.Unlock()
+	fake.DeleteBucketTaggingStub = nil
+	if fake.deleteBucketTaggingReturnsOnCall == nil {
+		fake.deleteBucketTaggingReturnsOnCall = make(map[int]struct {
+			result1 *s3.DeleteBucketTaggingOutput
+			result2 error
+		})
+	}
+	fake.deleteBucketTaggingReturnsOnCall[i] = struct {
+		result1 *s3.DeleteBucketTaggingOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) DeleteBucketWebsite(arg1 context.Context, arg2 *s3.DeleteBucketWebsiteInput, arg3 ...func(*s3.Options)) (*s3.DeleteBucketWebsiteOutput, error) {
+	fake.deleteBucketWebsiteMutex.Lock()
+	ret, specificReturn := fake.deleteBucketWebsiteReturnsOnCall[len(fake.deleteBucketWebsiteArgsForCall)]
+	fake.deleteBucketWebsiteArgsForCall = append(fake.deleteBucketWebsiteArgsForCall, struct {
+		arg1 context.Context
+		arg2 *s3.DeleteBucketWebsiteInput
+		arg3 []func(*s3.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteBucketWebsiteStub
+	fakeReturns := fake.deleteBucketWebsiteReturns
+	fake.recordInvocation("DeleteBucketWebsite", []interface{}{arg1, arg2, arg3})
+	fake.deleteBucketWebsiteMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3Client) DeleteBucketWebsiteCallCount() int {
+	fake.deleteBucketWebsiteMutex.RLock()
+	defer fake.deleteBucketWebsiteMutex.RUnlock()
+	return len(fake.deleteBucketWebsiteArgsForCall)
+}
+
+func (fake *FakeS3Client) DeleteBucketWebsiteCalls(stub func(context.Context, *s3.DeleteBucketWebsiteInput, ...func(*s3.Options)) (*s3.DeleteBucketWebsiteOutput, error)) {
+	fake.deleteBucketWebsiteMutex.Lock()
+	defer fake.deleteBucketWebsiteMutex.Unlock()
+	fake.DeleteBucketWebsiteStub = stub
+}
+
+func (fake *FakeS3Client) DeleteBucketWebsiteArgsForCall(i int) (context.Context, *s3.DeleteBucketWebsiteInput, []func(*s3.Options)) {
+	fake.deleteBucketWebsiteMutex.RLock()
+	defer fake.deleteBucketWebsiteMutex.RUnlock()
+	argsForCall := fake.deleteBucketWebsiteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3Client) DeleteBucketWebsiteReturns(result1 *s3.DeleteBucketWebsiteOutput, result2 error) {
+	fake.deleteBucketWebsiteMutex.Lock()
+	defer fake.deleteBucketWebsiteMutex.Unlock()
+	fake.DeleteBucketWebsiteStub = nil
+	fake.deleteBucketWebsiteReturns = struct {
+		result1 *s3.DeleteBucketWebsiteOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) DeleteBucketWebsiteReturnsOnCall(i int, result1 *s3.DeleteBucketWebsiteOutput, result2 error) {
+	fake.deleteBucketWebsiteMutex.Lock()
+	defer fake.deleteBucketWebsiteMutex.Unlock()
+	fake.DeleteBucketWebsiteStub = nil
+	if fake.deleteBucketWebsiteReturnsOnCall == nil {
+		fake.deleteBucketWebsiteReturnsOnCall = make(map[int]struct {
+			result1 *s3.DeleteBucketWebsiteOutput
+			result2 error
+		})
+	}
+	fake.deleteBucketWebsiteReturnsOnCall[i] = struct {
+		result1 *s3.DeleteBucketWebsiteOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) DeleteObject(arg1 context.Context, arg2 *s3.DeleteObjectInput, arg3 ...func(*s3.Options)) (*s3.DeleteObjectOutput, error) {
+	fake.deleteObjectMutex.Lock()
+	ret, specificReturn := fake.deleteObjectReturnsOnCall[len(fake.deleteObjectArgsForCall)]
+	fake.deleteObjectArgsForCall = append(fake.deleteObjectArgsForCall, struct {
+		arg1 context.Context
+		arg2 *s3.DeleteObjectInput
+		arg3 []func(*s3.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteObjectStub
+	fakeReturns := fake.deleteObjectReturns
+	fake.recordInvocation("DeleteObject", []interface{}{arg1, arg2, arg3})
+	fake.deleteObjectMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3Client) DeleteObjectCallCount() int {
+	fake.deleteObjectMutex.RLock()
+	defer fake.deleteObjectMutex.RUnlock()
+	return len(fake.deleteObjectArgsForCall)
+}
+
+func (fake *FakeS3Client) DeleteObjectCalls(stub func(context.Context, *s3.DeleteObjectInput, ...func(*s3.Options)) (*s3.DeleteObjectOutput, error)) {
+	fake.deleteObjectMutex.Lock()
+	defer fake.deleteObjectMutex.Unlock()
+	fake.DeleteObjectStub = stub
+}
+
+func (fake *FakeS3Client) DeleteObjectArgsForCall(i int) (context.Context, *s3.DeleteObjectInput, []func(*s3.Options)) {
+	fake.deleteObjectMutex.RLock()
+	defer fake.deleteObjectMutex.RUnlock()
+	argsForCall := fake.deleteObjectArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3Client) DeleteObjectReturns(result1 *s3.DeleteObjectOutput, result2 error) {
+	fake.deleteObjectMutex.Lock()
 	defer fake.deleteObjectMutex.Unlock()
 	fake.DeleteObjectStub = nil
 	fake.deleteObjectReturns = struct {
@@ -644,6 +1673,72 @@ func (fake *FakeS3Client) DeleteObjectReturnsOnCall(i int, result1 *s3.DeleteObj
 	}{result1, result2}
 }
 
+func (fake *FakeS3Client) DeletePublicAccessBlock(arg1 context.Context, arg2 *s3.DeletePublicAccessBlockInput, arg3 ...func(*s3.Options)) (*s3.DeletePublicAccessBlockOutput, error) {
+	fake.deletePublicAccessBlockMutex.Lock()
+	ret, specificReturn := fake.deletePublicAccessBlockReturnsOnCall[len(fake.deletePublicAccessBlockArgsForCall)]
+	fake.deletePublicAccessBlockArgsForCall = append(fake.deletePublicAccessBlockArgsForCall, struct {
+		arg1 context.Context
+		arg2 *s3.DeletePublicAccessBlockInput
+		arg3 []func(*s3.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.DeletePublicAccessBlockStub
+	fakeReturns := fake.deletePublicAccessBlockReturns
+	fake.recordInvocation("DeletePublicAccessBlock", []interface{}{arg1, arg2, arg3})
+	fake.deletePublicAccessBlockMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3Client) DeletePublicAccessBlockCallCount() int {
+	fake.deletePublicAccessBlockMutex.RLock()
+	defer fake.deletePublicAccessBlockMutex.RUnlock()
+	return len(fake.deletePublicAccessBlockArgsForCall)
+}
+
+func (fake *FakeS3Client) DeletePublicAccessBlockCalls(stub func(context.Context, *s3.DeletePublicAccessBlockInput, ...func(*s3.Options)) (*s3.DeletePublicAccessBlockOutput, error)) {
+	fake.deletePublicAccessBlockMutex.Lock()
+	defer fake.deletePublicAccessBlockMutex.Unlock()
+	fake.DeletePublicAccessBlockStub = stub
+}
+
+func (fake *FakeS3Client) DeletePublicAccessBlockArgsForCall(i int) (context.Context, *s3.DeletePublicAccessBlockInput, []func(*s3.Options)) {
+	fake.deletePublicAccessBlockMutex.RLock()
+	defer fake.deletePublicAccessBlockMutex.RUnlock()
+	argsForCall := fake.deletePublicAccessBlockArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3Client) DeletePublicAccessBlockReturns(result1 *s3.DeletePublicAccessBlockOutput, result2 error) {
+	fake.deletePublicAccessBlockMutex.Lock()
+	defer fake.deletePublicAccessBlockMutex.Unlock()
+	fake.DeletePublicAccessBlockStub = nil
+	fake.deletePublicAccessBlockReturns = struct {
+		result1 *s3.DeletePublicAccessBlockOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) DeletePublicAccessBlockReturnsOnCall(i int, result1 *s3.DeletePublicAccessBlockOutput, result2 error) {
+	fake.deletePublicAccessBlockMutex.Lock()
+	defer fake.deletePublicAccessBlockMutex.Unlock()
+	fake.DeletePublicAccessBlockStub = nil
+	if fake.deletePublicAccessBlockReturnsOnCall == nil {
+		fake.deletePublicAccessBlockReturnsOnCall = make(map[int]struct {
+			result1 *s3.DeletePublicAccessBlockOutput
+			result2 error
+		})
+	}
+	fake.deletePublicAccessBlockReturnsOnCall[i] = struct {
+		result1 *s3.DeletePublicAccessBlockOutput
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeS3Client) GetBucketAcl(arg1 context.Context, arg2 *s3.GetBucketAclInput, arg3 ...func(*s3.Options)) (*s3.GetBucketAclOutput, error) {
 	fake.getBucketAclMutex.Lock()
 	ret, specificReturn := fake.getBucketAclReturnsOnCall[len(fake.getBucketAclArgsForCall)]
@@ -710,6 +1805,138 @@ func (fake *FakeS3Client) GetBucketAclReturnsOnCall(i int, result1 *s3.GetBucket
 	}{result1, result2}
 }
 
+func (fake *FakeS3Client) GetBucketCors(arg1 context.Context, arg2 *s3.GetBucketCorsInput, arg3 ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error) {
+	fake.getBucketCorsMutex.Lock()
+	ret, specificReturn := fake.getBucketCorsReturnsOnCall[len(fake.getBucketCorsArgsForCall)]
+	fake.getBucketCorsArgsForCall = append(fake.getBucketCorsArgsForCall, struct {
+		arg1 context.Context
+		arg2 *s3.GetBucketCorsInput
+		arg3 []func(*s3.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.GetBucketCorsStub
+	fakeReturns := fake.getBucketCorsReturns
+	fake.recordInvocation("GetBucketCors", []interface{}{arg1, arg2, arg3})
+	fake.getBucketCorsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3Client) GetBucketCorsCallCount() int {
+	fake.getBucketCorsMutex.RLock()
+	defer fake.getBucketCorsMutex.RUnlock()
+	return len(fake.getBucketCorsArgsForCall)
+}
+
+func (fake *FakeS3Client) GetBucketCorsCalls(stub func(context.Context, *s3.GetBucketCorsInput, ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error)) {
+	fake.getBucketCorsMutex.Lock()
+	defer fake.getBucketCorsMutex.Unlock()
+	fake.GetBucketCorsStub = stub
+}
+
+func (fake *FakeS3Client) GetBucketCorsArgsForCall(i int) (context.Context, *s3.GetBucketCorsInput, []func(*s3.Options)) {
+	fake.getBucketCorsMutex.RLock()
+	defer fake.getBucketCorsMutex.RUnlock()
+	argsForCall := fake.getBucketCorsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3Client) GetBucketCorsReturns(result1 *s3.GetBucketCorsOutput, result2 error) {
+	fake.getBucketCorsMutex.Lock()
+	defer fake.getBucketCorsMutex.Unlock()
+	fake.GetBucketCorsStub = nil
+	fake.getBucketCorsReturns = struct {
+		result1 *s3.GetBucketCorsOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) GetBucketCorsReturnsOnCall(i int, result1 *s3.GetBucketCorsOutput, result2 error) {
+	fake.getBucketCorsMutex.Lock()
+	defer fake.getBucketCorsMutex.Unlock()
+	fake.GetBucketCorsStub = nil
+	if fake.getBucketCorsReturnsOnCall == nil {
+		fake.getBucketCorsReturnsOnCall = make(map[int]struct {
+			result1 *s3.GetBucketCorsOutput
+			result2 error
+		})
+	}
+	fake.getBucketCorsReturnsOnCall[i] = struct {
+		result1 *s3.GetBucketCorsOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) GetBucketEncryption(arg1 context.Context, arg2 *s3.GetBucketEncryptionInput, arg3 ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+	fake.getBucketEncryptionMutex.Lock()
+	ret, specificReturn := fake.getBucketEncryptionReturnsOnCall[len(fake.getBucketEncryptionArgsForCall)]
+	fake.getBucketEncryptionArgsForCall = append(fake.getBucketEncryptionArgsForCall, struct {
+		arg1 context.Context
+		arg2 *s3.GetBucketEncryptionInput
+		arg3 []func(*s3.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.GetBucketEncryptionStub
+	fakeReturns := fake.getBucketEncryptionReturns
+	fake.recordInvocation("GetBucketEncryption", []interface{}{arg1, arg2, arg3})
+	fake.getBucketEncryptionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3Client) GetBucketEncryptionCallCount() int {
+	fake.getBucketEncryptionMutex.RLock()
+	defer fake.getBucketEncryptionMutex.RUnlock()
+	return len(fake.getBucketEncryptionArgsForCall)
+}
+
+func (fake *FakeS3Client) GetBucketEncryptionCalls(stub func(context.Context, *s3.GetBucketEncryptionInput, ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error)) {
+	fake.getBucketEncryptionMutex.Lock()
+	defer fake.getBucketEncryptionMutex.Unlock()
+	fake.GetBucketEncryptionStub = stub
+}
+
+func (fake *FakeS3Client) GetBucketEncryptionArgsForCall(i int) (context.Context, *s3.GetBucketEncryptionInput, []func(*s3.Options)) {
+	fake.getBucketEncryptionMutex.RLock()
+	defer fake.getBucketEncryptionMutex.RUnlock()
+	argsForCall := fake.getBucketEncryptionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3Client) GetBucketEncryptionReturns(result1 *s3.GetBucketEncryptionOutput, result2 error) {
+	fake.getBucketEncryptionMutex.Lock()
+	defer fake.getBucketEncryptionMutex.Unlock()
+	fake.GetBucketEncryptionStub = nil
+	fake.getBucketEncryptionReturns = struct {
+		result1 *s3.GetBucketEncryptionOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) GetBucketEncryptionReturnsOnCall(i int, result1 *s3.GetBucketEncryptionOutput, result2 error) {
+	fake.getBucketEncryptionMutex.Lock()
+	defer fake.getBucketEncryptionMutex.Unlock()
+	fake.GetBucketEncryptionStub = nil
+	if fake.getBucketEncryptionReturnsOnCall == nil {
+		fake.getBucketEncryptionReturnsOnCall = make(map[int]struct {
+			result1 *s3.GetBucketEncryptionOutput
+			result2 error
+		})
+	}
+	fake.getBucketEncryptionReturnsOnCall[i] = struct {
+		result1 *s3.GetBucketEncryptionOutput
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeS3Client) GetBucketLifecycleConfiguration(arg1 context.Context, arg2 *s3.GetBucketLifecycleConfigurationInput, arg3 ...func(*s3.Options)) (*s3.GetBucketLifecycleConfigurationOutput, error) {
 	fake.getBucketLifecycleConfigurationMutex.Lock()
 	ret, specificReturn := fake.getBucketLifecycleConfigurationReturnsOnCall[len(fake.getBucketLifecycleConfigurationArgsForCall)]
@@ -776,18 +2003,18 @@ func (fake *FakeS3Client) GetBucketLifecycleConfigurationReturnsOnCall(i int, re
 	}{result1, result2}
 }
 
-func (fake *FakeS3Client) GetBucketPolicy(arg1 context.Context, arg2 *s3.GetBucketPolicyInput, arg3 ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
-	fake.getBucketPolicyMutex.Lock()
-	ret, specificReturn := fake.getBucketPolicyReturnsOnCall[len(fake.getBucketPolicyArgsForCall)]
-	fake.getBucketPolicyArgsForCall = append(fake.getBucketPolicyArgsForCall, struct {
+func (fake *FakeS3Client) GetBucketLogging(arg1 context.Context, arg2 *s3.GetBucketLoggingInput, arg3 ...func(*s3.Options)) (*s3.GetBucketLoggingOutput, error) {
+	fake.getBucketLoggingMutex.Lock()
+	ret, specificReturn := fake.getBucketLoggingReturnsOnCall[len(fake.getBucketLoggingArgsForCall)]
+	fake.getBucketLoggingArgsForCall = append(fake.getBucketLoggingArgsForCall, struct {
 		arg1 context.Context
-		arg2 *s3.GetBucketPolicyInput
+		arg2 *s3.GetBucketLoggingInput
 		arg3 []func(*s3.Options)
 	}{arg1, arg2, arg3})
-	stub := fake.GetBucketPolicyStub
-	fakeReturns := fake.getBucketPolicyReturns
-	fake.recordInvocation("GetBucketPolicy", []interface{}{arg1, arg2, arg3})
-	fake.getBucketPolicyMutex.Unlock()
+	stub := fake.GetBucketLoggingStub
+	fakeReturns := fake.getBucketLoggingReturns
+	fake.recordInvocation("GetBucketLogging", []interface{}{arg1, arg2, arg3})
+	fake.getBucketLoggingMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3...)
 	}
@@ -797,63 +2024,63 @@ func (fake *FakeS3Client) GetBucketPolicy(arg1 context.Context, arg2 *s3.GetBuck
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeS3Client) GetBucketPolicyCallCount() int {
-	fake.getBucketPolicyMutex.RLock()
-	defer fake.getBucketPolicyMutex.RUnlock()
-	return len(fake.getBucketPolicyArgsForCall)
+func (fake *FakeS3Client) GetBucketLoggingCallCount() int {
+	fake.getBucketLoggingMutex.RLock()
+	defer fake.getBucketLoggingMutex.RUnlock()
+	return len(fake.getBucketLoggingArgsForCall)
 }
 
-func (fake *FakeS3Client) GetBucketPolicyCalls(stub func(context.Context, *s3.GetBucketPolicyInput, ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error)) {
-	fake.getBucketPolicyMutex.Lock()
-	defer fake.getBucketPolicyMutex.Unlock()
-	fake.GetBucketPolicyStub = stub
+func (fake *FakeS3Client) GetBucketLoggingCalls(stub func(context.Context, *s3.GetBucketLoggingInput, ...func(*s3.Options)) (*s3.GetBucketLoggingOutput, error)) {
+	fake.getBucketLoggingMutex.Lock()
+	defer fake.getBucketLoggingMutex.Unlock()
+	fake.GetBucketLoggingStub = stub
 }
 
-func (fake *FakeS3Client) GetBucketPolicyArgsForCall(i int) (context.Context, *s3.GetBucketPolicyInput, []func(*s3.Options)) {
-	fake.getBucketPolicyMutex.RLock()
-	defer fake.getBucketPolicyMutex.RUnlock()
-	argsForCall := fake.getBucketPolicyArgsForCall[i]
+func (fake *FakeS3Client) GetBucketLoggingArgsForCall(i int) (context.Context, *s3.GetBucketLoggingInput, []func(*s3.Options)) {
+	fake.getBucketLoggingMutex.RLock()
+	defer fake.getBucketLoggingMutex.RUnlock()
+	argsForCall := fake.getBucketLoggingArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeS3Client) GetBucketPolicyReturns(result1 *s3.GetBucketPolicyOutput, result2 error) {
-	fake.getBucketPolicyMutex.Lock()
-	defer fake.getBucketPolicyMutex.Unlock()
-	fake.GetBucketPolicyStub = nil
-	fake.getBucketPolicyReturns = struct {
-		result1 *s3.GetBucketPolicyOutput
+func (fake *FakeS3Client) GetBucketLoggingReturns(result1 *s3.GetBucketLoggingOutput, result2 error) {
+	fake.getBucketLoggingMutex.Lock()
+	defer fake.getBucketLoggingMutex.Unlock()
+	fake.GetBucketLoggingStub = nil
+	fake.getBucketLoggingReturns = struct {
+		result1 *s3.GetBucketLoggingOutput
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeS3Client) GetBucketPolicyReturnsOnCall(i int, result1 *s3.GetBucketPolicyOutput, result2 error) {
-	fake.getBucketPolicyMutex.Lock()
-	defer fake.getBucketPolicyMutex.Unlock()
-	fake.GetBucketPolicyStub = nil
-	if fake.getBucketPolicyReturnsOnCall == nil {
-		fake.getBucketPolicyReturnsOnCall = make(map[int]struct {
-			result1 *s3.GetBucketPolicyOutput
+func (fake *FakeS3Client) GetBucketLoggingReturnsOnCall(i int, result1 *s3.GetBucketLoggingOutput, result2 error) {
+	fake.getBucketLoggingMutex.Lock()
+	defer fake.getBucketLoggingMutex.Unlock()
+	fake.GetBucketLoggingStub = nil
+	if fake.getBucketLoggingReturnsOnCall == nil {
+		fake.getBucketLoggingReturnsOnCall = make(map[int]struct {
+			result1 *s3.GetBucketLoggingOutput
 			result2 error
 		})
 	}
-	fake.getBucketPolicyReturnsOnCall[i] = struct {
-		result1 *s3.GetBucketPolicyOutput
+	fake.getBucketLoggingReturnsOnCall[i] = struct {
+		result1 *s3.GetBucketLoggingOutput
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeS3Client) GetBucketVersioning(arg1 context.Context, arg2 *s3.GetBucketVersioningInput, arg3 ...func(*s3.Options)) (*s3.GetBucketVersioningOutput, error) {
-	fake.getBucketVersioningMutex.Lock()
-	ret, specificReturn := fake.getBucketVersioningReturnsOnCall[len(fake.getBucketVersioningArgsForCall)]
-	fake.getBucketVersioningArgsForCall = append(fake.getBucketVersioningArgsForCall, struct {
+func (fake *FakeS3Client) GetBucketNotificationConfiguration(arg1 context.Context, arg2 *s3.GetBucketNotificationConfigurationInput, arg3 ...func(*s3.Options)) (*s3.GetBucketNotificationConfigurationOutput, error) {
+	fake.getBucketNotificationConfigurationMutex.Lock()
+	ret, specificReturn := fake.getBucketNotificationConfigurationReturnsOnCall[len(fake.getBucketNotificationConfigurationArgsForCall)]
+	fake.getBucketNotificationConfigurationArgsForCall = append(fake.getBucketNotificationConfigurationArgsForCall, struct {
 		arg1 context.Context
-		arg2 *s3.GetBucketVersioningInput
+		arg2 *s3.GetBucketNotificationConfigurationInput
 		arg3 []func(*s3.Options)
 	}{arg1, arg2, arg3})
-	stub := fake.GetBucketVersioningStub
-	fakeReturns := fake.getBucketVersioningReturns
-	fake.recordInvocation("GetBucketVersioning", []interface{}{arg1, arg2, arg3})
-	fake.getBucketVersioningMutex.Unlock()
+	stub := fake.GetBucketNotificationConfigurationStub
+	fakeReturns := fake.getBucketNotificationConfigurationReturns
+	fake.recordInvocation("GetBucketNotificationConfiguration", []interface{}{arg1, arg2, arg3})
+	fake.getBucketNotificationConfigurationMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3...)
 	}
@@ -863,16 +2090,346 @@ func (fake *FakeS3Client) GetBucketVersioning(arg1 context.Context, arg2 *s3.Get
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeS3Client) GetBucketVersioningCallCount() int {
-	fake.getBucketVersioningMutex.RLock()
-	defer fake.getBucketVersioningMutex.RUnlock()
-	return len(fake.getBucketVersioningArgsForCall)
+func (fake *FakeS3Client) GetBucketNotificationConfigurationCallCount() int {
+	fake.getBucketNotificationConfigurationMutex.RLock()
+	defer fake.getBucketNotificationConfigurationMutex.RUnlock()
+	return len(fake.getBucketNotificationConfigurationArgsForCall)
 }
 
-func (fake *FakeS3Client) GetBucketVersioningCalls(stub func(context.Context, *s3.GetBucketVersioningInput, ...func(*s3.Options)) (*s3.GetBucketVersioningOutput, error)) {
-	fake.getBucketVersioningMutex.Lock()
-	defer fake.getBucketVersioningMutex.Unlock()
-	fake.GetBucketVersioningStub = stub
+func (fake *FakeS3Client) GetBucketNotificationConfigurationCalls(stub func(context.Context, *s3.GetBucketNotificationConfigurationInput, ...func(*s3.Options)) (*s3.GetBucketNotificationConfigurationOutput, error)) {
+	fake.getBucketNotificationConfigurationMutex.Lock()
+	defer fake.getBucketNotificationConfigurationMutex.Unlock()
+	fake.GetBucketNotificationConfigurationStub = stub
+}
+
+func (fake *FakeS3Client) GetBucketNotificationConfigurationArgsForCall(i int) (context.Context, *s3.GetBucketNotificationConfigurationInput, []func(*s3.Options)) {
+	fake.getBucketNotificationConfigurationMutex.RLock()
+	defer fake.getBucketNotificationConfigurationMutex.RUnlock()
+	argsForCall := fake.getBucketNotificationConfigurationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3Client) GetBucketNotificationConfigurationReturns(result1 *s3.GetBucketNotificationConfigurationOutput, result2 error) {
+	fake.getBucketNotificationConfigurationMutex.Lock()
+	defer fake.getBucketNotificationConfigurationMutex.Unlock()
+	fake.GetBucketNotificationConfigurationStub = nil
+	fake.getBucketNotificationConfigurationReturns = struct {
+		result1 *s3.GetBucketNotificationConfigurationOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) GetBucketNotificationConfigurationReturnsOnCall(i int, result1 *s3.GetBucketNotificationConfigurationOutput, result2 error) {
+	fake.getBucketNotificationConfigurationMutex.Lock()
+	defer fake.getBucketNotificationConfigurationMutex.Unlock()
+	fake.GetBucketNotificationConfigurationStub = nil
+	if fake.getBucketNotificationConfigurationReturnsOnCall == nil {
+		fake.getBucketNotificationConfigurationReturnsOnCall = make(map[int]struct {
+			result1 *s3.GetBucketNotificationConfigurationOutput
+			result2 error
+		})
+	}
+	fake.getBucketNotificationConfigurationReturnsOnCall[i] = struct {
+		result1 *s3.GetBucketNotificationConfigurationOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) GetBucketOwnershipControls(arg1 context.Context, arg2 *s3.GetBucketOwnershipControlsInput, arg3 ...func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+	fake.getBucketOwnershipControlsMutex.Lock()
+	ret, specificReturn := fake.getBucketOwnershipControlsReturnsOnCall[len(fake.getBucketOwnershipControlsArgsForCall)]
+	fake.getBucketOwnershipControlsArgsForCall = append(fake.getBucketOwnershipControlsArgsForCall, struct {
+		arg1 context.Context
+		arg2 *s3.GetBucketOwnershipControlsInput
+		arg3 []func(*s3.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.GetBucketOwnershipControlsStub
+	fakeReturns := fake.getBucketOwnershipControlsReturns
+	fake.recordInvocation("GetBucketOwnershipControls", []interface{}{arg1, arg2, arg3})
+	fake.getBucketOwnershipControlsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3Client) GetBucketOwnershipControlsCallCount() int {
+	fake.getBucketOwnershipControlsMutex.RLock()
+	defer fake.getBucketOwnershipControlsMutex.RUnlock()
+	return len(fake.getBucketOwnershipControlsArgsForCall)
+}
+
+func (fake *FakeS3Client) GetBucketOwnershipControlsCalls(stub func(context.Context, *s3.GetBucketOwnershipControlsInput, ...func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error)) {
+	fake.getBucketOwnershipControlsMutex.Lock()
+	defer fake.getBucketOwnershipControlsMutex.Unlock()
+	fake.GetBucketOwnershipControlsStub = stub
+}
+
+func (fake *FakeS3Client) GetBucketOwnershipControlsArgsForCall(i int) (context.Context, *s3.GetBucketOwnershipControlsInput, []func(*s3.Options)) {
+	fake.getBucketOwnershipControlsMutex.RLock()
+	defer fake.getBucketOwnershipControlsMutex.RUnlock()
+	argsForCall := fake.getBucketOwnershipControlsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3Client) GetBucketOwnershipControlsReturns(result1 *s3.GetBucketOwnershipControlsOutput, result2 error) {
+	fake.getBucketOwnershipControlsMutex.Lock()
+	defer fake.getBucketOwnershipControlsMutex.Unlock()
+	fake.GetBucketOwnershipControlsStub = nil
+	fake.getBucketOwnershipControlsReturns = struct {
+		result1 *s3.GetBucketOwnershipControlsOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) GetBucketOwnershipControlsReturnsOnCall(i int, result1 *s3.GetBucketOwnershipControlsOutput, result2 error) {
+	fake.getBucketOwnershipControlsMutex.Lock()
+	defer fake.getBucketOwnershipControlsMutex.Unlock()
+	fake.GetBucketOwnershipControlsStub = nil
+	if fake.getBucketOwnershipControlsReturnsOnCall == nil {
+		fake.getBucketOwnershipControlsReturnsOnCall = make(map[int]struct {
+			result1 *s3.GetBucketOwnershipControlsOutput
+			result2 error
+		})
+	}
+	fake.getBucketOwnershipControlsReturnsOnCall[i] = struct {
+		result1 *s3.GetBucketOwnershipControlsOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) GetBucketPolicy(arg1 context.Context, arg2 *s3.GetBucketPolicyInput, arg3 ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+	fake.getBucketPolicyMutex.Lock()
+	ret, specificReturn := fake.getBucketPolicyReturnsOnCall[len(fake.getBucketPolicyArgsForCall)]
+	fake.getBucketPolicyArgsForCall = append(fake.getBucketPolicyArgsForCall, struct {
+		arg1 context.Context
+		arg2 *s3.GetBucketPolicyInput
+		arg3 []func(*s3.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.GetBucketPolicyStub
+	fakeReturns := fake.getBucketPolicyReturns
+	fake.recordInvocation("GetBucketPolicy", []interface{}{arg1, arg2, arg3})
+	fake.getBucketPolicyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3Client) GetBucketPolicyCallCount() int {
+	fake.getBucketPolicyMutex.RLock()
+	defer fake.getBucketPolicyMutex.RUnlock()
+	return len(fake.getBucketPolicyArgsForCall)
+}
+
+func (fake *FakeS3Client) GetBucketPolicyCalls(stub func(context.Context, *s3.GetBucketPolicyInput, ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error)) {
+	fake.getBucketPolicyMutex.Lock()
+	defer fake.getBucketPolicyMutex.Unlock()
+	fake.GetBucketPolicyStub = stub
+}
+
+func (fake *FakeS3Client) GetBucketPolicyArgsForCall(i int) (context.Context, *s3.GetBucketPolicyInput, []func(*s3.Options)) {
+	fake.getBucketPolicyMutex.RLock()
+	defer fake.getBucketPolicyMutex.RUnlock()
+	argsForCall := fake.getBucketPolicyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3Client) GetBucketPolicyReturns(result1 *s3.GetBucketPolicyOutput, result2 error) {
+	fake.getBucketPolicyMutex.Lock()
+	defer fake.getBucketPolicyMutex.Unlock()
+	fake.GetBucketPolicyStub = nil
+	fake.getBucketPolicyReturns = struct {
+		result1 *s3.GetBucketPolicyOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) GetBucketPolicyReturnsOnCall(i int, result1 *s3.GetBucketPolicyOutput, result2 error) {
+	fake.getBucketPolicyMutex.Lock()
+	defer fake.getBucketPolicyMutex.Unlock()
+	fake.GetBucketPolicyStub = nil
+	if fake.getBucketPolicyReturnsOnCall == nil {
+		fake.getBucketPolicyReturnsOnCall = make(map[int]struct {
+			result1 *s3.GetBucketPolicyOutput
+			result2 error
+		})
+	}
+	fake.getBucketPolicyReturnsOnCall[i] = struct {
+		result1 *s3.GetBucketPolicyOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) GetBucketReplication(arg1 context.Context, arg2 *s3.GetBucketReplicationInput, arg3 ...func(*s3.Options)) (*s3.GetBucketReplicationOutput, error) {
+	fake.getBucketReplicationMutex.Lock()
+	ret, specificReturn := fake.getBucketReplicationReturnsOnCall[len(fake.getBucketReplicationArgsForCall)]
+	fake.getBucketReplicationArgsForCall = append(fake.getBucketReplicationArgsForCall, struct {
+		arg1 context.Context
+		arg2 *s3.GetBucketReplicationInput
+		arg3 []func(*s3.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.GetBucketReplicationStub
+	fakeReturns := fake.getBucketReplicationReturns
+	fake.recordInvocation("GetBucketReplication", []interface{}{arg1, arg2, arg3})
+	fake.getBucketReplicationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3Client) GetBucketReplicationCallCount() int {
+	fake.getBucketReplicationMutex.RLock()
+	defer fake.getBucketReplicationMutex.RUnlock()
+	return len(fake.getBucketReplicationArgsForCall)
+}
+
+func (fake *FakeS3Client) GetBucketReplicationCalls(stub func(context.Context, *s3.GetBucketReplicationInput, ...func(*s3.Options)) (*s3.GetBucketReplicationOutput, error)) {
+	fake.getBucketReplicationMutex.Lock()
+	defer fake.getBucketReplicationMutex.Unlock()
+	fake.GetBucketReplicationStub = stub
+}
+
+func (fake *FakeS3Client) GetBucketReplicationArgsForCall(i int) (context.Context, *s3.GetBucketReplicationInput, []func(*s3.Options)) {
+	fake.getBucketReplicationMutex.RLock()
+	defer fake.getBucketReplicationMutex.RUnlock()
+	argsForCall := fake.getBucketReplicationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3Client) GetBucketReplicationReturns(result1 *s3.GetBucketReplicationOutput, result2 error) {
+	fake.getBucketReplicationMutex.Lock()
+	defer fake.getBucketReplicationMutex.Unlock()
+	fake.GetBucketReplicationStub = nil
+	fake.getBucketReplicationReturns = struct {
+		result1 *s3.GetBucketReplicationOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) GetBucketReplicationReturnsOnCall(i int, result1 *s3.GetBucketReplicationOutput, result2 error) {
+	fake.getBucketReplicationMutex.Lock()
+	defer fake.getBucketReplicationMutex.Unlock()
+	fake.GetBucketReplicationStub = nil
+	if fake.getBucketReplicationReturnsOnCall == nil {
+		fake.getBucketReplicationReturnsOnCall = make(map[int]struct {
+			result1 *s3.GetBucketReplicationOutput
+			result2 error
+		})
+	}
+	fake.getBucketReplicationReturnsOnCall[i] = struct {
+		result1 *s3.GetBucketReplicationOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) GetBucketTagging(arg1 context.Context, arg2 *s3.GetBucketTaggingInput, arg3 ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error) {
+	fake.getBucketTaggingMutex.Lock()
+	ret, specificReturn := fake.getBucketTaggingReturnsOnCall[len(fake.getBucketTaggingArgsForCall)]
+	fake.getBucketTaggingArgsForCall = append(fake.getBucketTaggingArgsForCall, struct {
+		arg1 context.Context
+		arg2 *s3.GetBucketTaggingInput
+		arg3 []func(*s3.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.GetBucketTaggingStub
+	fakeReturns := fake.getBucketTaggingReturns
+	fake.recordInvocation("GetBucketTagging", []interface{}{arg1, arg2, arg3})
+	fake.getBucketTaggingMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3Client) GetBucketTaggingCallCount() int {
+	fake.getBucketTaggingMutex.RLock()
+	defer fake.getBucketTaggingMutex.RUnlock()
+	return len(fake.getBucketTaggingArgsForCall)
+}
+
+func (fake *FakeS3Client) GetBucketTaggingCalls(stub func(context.Context, *s3.GetBucketTaggingInput, ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error)) {
+	fake.getBucketTaggingMutex.Lock()
+	defer fake.getBucketTaggingMutex.Unlock()
+	fake.GetBucketTaggingStub = stub
+}
+
+func (fake *FakeS3Client) GetBucketTaggingArgsForCall(i int) (context.Context, *s3.GetBucketTaggingInput, []func(*s3.Options)) {
+	fake.getBucketTaggingMutex.RLock()
+	defer fake.getBucketTaggingMutex.RUnlock()
+	argsForCall := fake.getBucketTaggingArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3Client) GetBucketTaggingReturns(result1 *s3.GetBucketTaggingOutput, result2 error) {
+	fake.getBucketTaggingMutex.Lock()
+	defer fake.getBucketTaggingMutex.Unlock()
+	fake.GetBucketTaggingStub = nil
+	fake.getBucketTaggingReturns = struct {
+		result1 *s3.GetBucketTaggingOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) GetBucketTaggingReturnsOnCall(i int, result1 *s3.GetBucketTaggingOutput, result2 error) {
+	fake.getBucketTaggingMutex.Lock()
+	defer fake.getBucketTaggingMutex.Unlock()
+	fake.GetBucketTaggingStub = nil
+	if fake.getBucketTaggingReturnsOnCall == nil {
+		fake.getBucketTaggingReturnsOnCall = make(map[int]struct {
+			result1 *s3.GetBucketTaggingOutput
+			result2 error
+		})
+	}
+	fake.getBucketTaggingReturnsOnCall[i] = struct {
+		result1 *s3.GetBucketTaggingOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) GetBucketVersioning(arg1 context.Context, arg2 *s3.GetBucketVersioningInput, arg3 ...func(*s3.Options)) (*s3.GetBucketVersioningOutput, error) {
+	fake.getBucketVersioningMutex.Lock()
+	ret, specificReturn := fake.getBucketVersioningReturnsOnCall[len(fake.getBucketVersioningArgsForCall)]
+	fake.getBucketVersioningArgsForCall = append(fake.getBucketVersioningArgsForCall, struct {
+		arg1 context.Context
+		arg2 *s3.GetBucketVersioningInput
+		arg3 []func(*s3.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.GetBucketVersioningStub
+	fakeReturns := fake.getBucketVersioningReturns
+	fake.recordInvocation("GetBucketVersioning", []interface{}{arg1, arg2, arg3})
+	fake.getBucketVersioningMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3Client) GetBucketVersioningCallCount() int {
+	fake.getBucketVersioningMutex.RLock()
+	defer fake.getBucketVersioningMutex.RUnlock()
+	return len(fake.getBucketVersioningArgsForCall)
+}
+
+func (fake *FakeS3Client) GetBucketVersioningCalls(stub func(context.Context, *s3.GetBucketVersioningInput, ...func(*s3.Options)) (*s3.GetBucketVersioningOutput, error)) {
+	fake.getBucketVersioningMutex.Lock()
+	defer fake.getBucketVersioningMutex.Unlock()
+	fake.GetBucketVersioningStub = stub
 }
 
 func (fake *FakeS3Client) GetBucketVersioningArgsForCall(i int) (context.Context, *s3.GetBucketVersioningInput, []func(*s3.Options)) {
@@ -908,6 +2465,72 @@ func (fake *FakeS3Client) GetBucketVersioningReturnsOnCall(i int, result1 *s3.Ge
 	}{result1, result2}
 }
 
+func (fake *FakeS3Client) GetBucketWebsite(arg1 context.Context, arg2 *s3.GetBucketWebsiteInput, arg3 ...func(*s3.Options)) (*s3.GetBucketWebsiteOutput, error) {
+	fake.getBucketWebsiteMutex.Lock()
+	ret, specificReturn := fake.getBucketWebsiteReturnsOnCall[len(fake.getBucketWebsiteArgsForCall)]
+	fake.getBucketWebsiteArgsForCall = append(fake.getBucketWebsiteArgsForCall, struct {
+		arg1 context.Context
+		arg2 *s3.GetBucketWebsiteInput
+		arg3 []func(*s3.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.GetBucketWebsiteStub
+	fakeReturns := fake.getBucketWebsiteReturns
+	fake.recordInvocation("GetBucketWebsite", []interface{}{arg1, arg2, arg3})
+	fake.getBucketWebsiteMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3Client) GetBucketWebsiteCallCount() int {
+	fake.getBucketWebsiteMutex.RLock()
+	defer fake.getBucketWebsiteMutex.RUnlock()
+	return len(fake.getBucketWebsiteArgsForCall)
+}
+
+func (fake *FakeS3Client) GetBucketWebsiteCalls(stub func(context.Context, *s3.GetBucketWebsiteInput, ...func(*s3.Options)) (*s3.GetBucketWebsiteOutput, error)) {
+	fake.getBucketWebsiteMutex.Lock()
+	defer fake.getBucketWebsiteMutex.Unlock()
+	fake.GetBucketWebsiteStub = stub
+}
+
+func (fake *FakeS3Client) GetBucketWebsiteArgsForCall(i int) (context.Context, *s3.GetBucketWebsiteInput, []func(*s3.Options)) {
+	fake.getBucketWebsiteMutex.RLock()
+	defer fake.getBucketWebsiteMutex.RUnlock()
+	argsForCall := fake.getBucketWebsiteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3Client) GetBucketWebsiteReturns(result1 *s3.GetBucketWebsiteOutput, result2 error) {
+	fake.getBucketWebsiteMutex.Lock()
+	defer fake.getBucketWebsiteMutex.Unlock()
+	fake.GetBucketWebsiteStub = nil
+	fake.getBucketWebsiteReturns = struct {
+		result1 *s3.GetBucketWebsiteOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) GetBucketWebsiteReturnsOnCall(i int, result1 *s3.GetBucketWebsiteOutput, result2 error) {
+	fake.getBucketWebsiteMutex.Lock()
+	defer fake.getBucketWebsiteMutex.Unlock()
+	fake.GetBucketWebsiteStub = nil
+	if fake.getBucketWebsiteReturnsOnCall == nil {
+		fake.getBucketWebsiteReturnsOnCall = make(map[int]struct {
+			result1 *s3.GetBucketWebsiteOutput
+			result2 error
+		})
+	}
+	fake.getBucketWebsiteReturnsOnCall[i] = struct {
+		result1 *s3.GetBucketWebsiteOutput
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeS3Client) GetObject(arg1 context.Context, arg2 *s3.GetObjectInput, arg3 ...func(*s3.Options)) (*s3.GetObjectOutput, error) {
 	fake.getObjectMutex.Lock()
 	ret, specificReturn := fake.getObjectReturnsOnCall[len(fake.getObjectArgsForCall)]
@@ -1040,18 +2663,18 @@ func (fake *FakeS3Client) GetObjectLockConfigurationReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
-func (fake *FakeS3Client) HeadBucket(arg1 context.Context, arg2 *s3.HeadBucketInput, arg3 ...func(*s3.Options)) (*s3.HeadBucketOutput, error) {
-	fake.headBucketMutex.Lock()
-	ret, specificReturn := fake.headBucketReturnsOnCall[len(fake.headBucketArgsForCall)]
-	fake.headBucketArgsForCall = append(fake.headBucketArgsForCall, struct {
+func (fake *FakeS3Client) GetPublicAccessBlock(arg1 context.Context, arg2 *s3.GetPublicAccessBlockInput, arg3 ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error) {
+	fake.getPublicAccessBlockMutex.Lock()
+	ret, specificReturn := fake.getPublicAccessBlockReturnsOnCall[len(fake.getPublicAccessBlockArgsForCall)]
+	fake.getPublicAccessBlockArgsForCall = append(fake.getPublicAccessBlockArgsForCall, struct {
 		arg1 context.Context
-		arg2 *s3.HeadBucketInput
+		arg2 *s3.GetPublicAccessBlockInput
 		arg3 []func(*s3.Options)
 	}{arg1, arg2, arg3})
-	stub := fake.HeadBucketStub
-	fakeReturns := fake.headBucketReturns
-	fake.recordInvocation("HeadBucket", []interface{}{arg1, arg2, arg3})
-	fake.headBucketMutex.Unlock()
+	stub := fake.GetPublicAccessBlockStub
+	fakeReturns := fake.getPublicAccessBlockReturns
+	fake.recordInvocation("GetPublicAccessBlock", []interface{}{arg1, arg2, arg3})
+	fake.getPublicAccessBlockMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3...)
 	}
@@ -1061,27 +2684,93 @@ func (fake *FakeS3Client) HeadBucket(arg1 context.Context, arg2 *s3.HeadBucketIn
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeS3Client) HeadBucketCallCount() int {
-	fake.headBucketMutex.RLock()
-	defer fake.headBucketMutex.RUnlock()
-	return len(fake.headBucketArgsForCall)
+func (fake *FakeS3Client) GetPublicAccessBlockCallCount() int {
+	fake.getPublicAccessBlockMutex.RLock()
+	defer fake.getPublicAccessBlockMutex.RUnlock()
+	return len(fake.getPublicAccessBlockArgsForCall)
 }
 
-func (fake *FakeS3Client) HeadBucketCalls(stub func(context.Context, *s3.HeadBucketInput, ...func(*s3.Options)) (*s3.HeadBucketOutput, error)) {
-	fake.headBucketMutex.Lock()
-	defer fake.headBucketMutex.Unlock()
-	fake.HeadBucketStub = stub
+func (fake *FakeS3Client) GetPublicAccessBlockCalls(stub func(context.Context, *s3.GetPublicAccessBlockInput, ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error)) {
+	fake.getPublicAccessBlockMutex.Lock()
+	defer fake.getPublicAccessBlockMutex.Unlock()
+	fake.GetPublicAccessBlockStub = stub
 }
 
-func (fake *FakeS3Client) HeadBucketArgsForCall(i int) (context.Context, *s3.HeadBucketInput, []func(*s3.Options)) {
-	fake.headBucketMutex.RLock()
-	defer fake.headBucketMutex.RUnlock()
-	argsForCall := fake.headBucketArgsForCall[i]
+func (fake *FakeS3Client) GetPublicAccessBlockArgsForCall(i int) (context.Context, *s3.GetPublicAccessBlockInput, []func(*s3.Options)) {
+	fake.getPublicAccessBlockMutex.RLock()
+	defer fake.getPublicAccessBlockMutex.RUnlock()
+	argsForCall := fake.getPublicAccessBlockArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeS3Client) HeadBucketReturns(result1 *s3.HeadBucketOutput, result2 error) {
-	fake.headBucketMutex.Lock()
+func (fake *FakeS3Client) GetPublicAccessBlockReturns(result1 *s3.GetPublicAccessBlockOutput, result2 error) {
+	fake.getPublicAccessBlockMutex.Lock()
+	defer fake.getPublicAccessBlockMutex.Unlock()
+	fake.GetPublicAccessBlockStub = nil
+	fake.getPublicAccessBlockReturns = struct {
+		result1 *s3.GetPublicAccessBlockOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) GetPublicAccessBlockReturnsOnCall(i int, result1 *s3.GetPublicAccessBlockOutput, result2 error) {
+	fake.getPublicAccessBlockMutex.Lock()
+	defer fake.getPublicAccessBlockMutex.Unlock()
+	fake.GetPublicAccessBlockStub = nil
+	if fake.getPublicAccessBlockReturnsOnCall == nil {
+		fake.getPublicAccessBlockReturnsOnCall = make(map[int]struct {
+			result1 *s3.GetPublicAccessBlockOutput
+			result2 error
+		})
+	}
+	fake.getPublicAccessBlockReturnsOnCall[i] = struct {
+		result1 *s3.GetPublicAccessBlockOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) HeadBucket(arg1 context.Context, arg2 *s3.HeadBucketInput, arg3 ...func(*s3.Options)) (*s3.HeadBucketOutput, error) {
+	fake.headBucketMutex.Lock()
+	ret, specificReturn := fake.headBucketReturnsOnCall[len(fake.headBucketArgsForCall)]
+	fake.headBucketArgsForCall = append(fake.headBucketArgsForCall, struct {
+		arg1 context.Context
+		arg2 *s3.HeadBucketInput
+		arg3 []func(*s3.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.HeadBucketStub
+	fakeReturns := fake.headBucketReturns
+	fake.recordInvocation("HeadBucket", []interface{}{arg1, arg2, arg3})
+	fake.headBucketMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3Client) HeadBucketCallCount() int {
+	fake.headBucketMutex.RLock()
+	defer fake.headBucketMutex.RUnlock()
+	return len(fake.headBucketArgsForCall)
+}
+
+func (fake *FakeS3Client) HeadBucketCalls(stub func(context.Context, *s3.HeadBucketInput, ...func(*s3.Options)) (*s3.HeadBucketOutput, error)) {
+	fake.headBucketMutex.Lock()
+	defer fake.headBucketMutex.Unlock()
+	fake.HeadBucketStub = stub
+}
+
+func (fake *FakeS3Client) HeadBucketArgsForCall(i int) (context.Context, *s3.HeadBucketInput, []func(*s3.Options)) {
+	fake.headBucketMutex.RLock()
+	defer fake.headBucketMutex.RUnlock()
+	argsForCall := fake.headBucketArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3Client) HeadBucketReturns(result1 *s3.HeadBucketOutput, result2 error) {
+	fake.headBucketMutex.Lock()
 	defer fake.headBucketMutex.Unlock()
 	fake.HeadBucketStub = nil
 	fake.headBucketReturns = struct {
@@ -1177,13 +2866,343 @@ func (fake *FakeS3Client) ListObjectsV2(arg1 context.Context, arg2 *s3.ListObjec
 	ret, specificReturn := fake.listObjectsV2ReturnsOnCall[len(fake.listObjectsV2ArgsForCall)]
 	fake.listObjectsV2ArgsForCall = append(fake.listObjectsV2ArgsForCall, struct {
 		arg1 context.Context
-		arg2 *s3.ListObjectsV2Input
+		arg2 *s3.ListObjectsV2Input
+		arg3 []func(*s3.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.ListObjectsV2Stub
+	fakeReturns := fake.listObjectsV2Returns
+	fake.recordInvocation("ListObjectsV2", []interface{}{arg1, arg2, arg3})
+	fake.listObjectsV2Mutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3Client) ListObjectsV2CallCount() int {
+	fake.listObjectsV2Mutex.RLock()
+	defer fake.listObjectsV2Mutex.RUnlock()
+	return len(fake.listObjectsV2ArgsForCall)
+}
+
+func (fake *FakeS3Client) ListObjectsV2Calls(stub func(context.Context, *s3.ListObjectsV2Input, ...func(*s3.Options)) (*s3.ListObjectsV2Output, error)) {
+	fake.listObjectsV2Mutex.Lock()
+	defer fake.listObjectsV2Mutex.Unlock()
+	fake.ListObjectsV2Stub = stub
+}
+
+func (fake *FakeS3Client) ListObjectsV2ArgsForCall(i int) (context.Context, *s3.ListObjectsV2Input, []func(*s3.Options)) {
+	fake.listObjectsV2Mutex.RLock()
+	defer fake.listObjectsV2Mutex.RUnlock()
+	argsForCall := fake.listObjectsV2ArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3Client) ListObjectsV2Returns(result1 *s3.ListObjectsV2Output, result2 error) {
+	fake.listObjectsV2Mutex.Lock()
+	defer fake.listObjectsV2Mutex.Unlock()
+	fake.ListObjectsV2Stub = nil
+	fake.listObjectsV2Returns = struct {
+		result1 *s3.ListObjectsV2Output
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) ListObjectsV2ReturnsOnCall(i int, result1 *s3.ListObjectsV2Output, result2 error) {
+	fake.listObjectsV2Mutex.Lock()
+	defer fake.listObjectsV2Mutex.Unlock()
+	fake.ListObjectsV2Stub = nil
+	if fake.listObjectsV2ReturnsOnCall == nil {
+		fake.listObjectsV2ReturnsOnCall = make(map[int]struct {
+			result1 *s3.ListObjectsV2Output
+			result2 error
+		})
+	}
+	fake.listObjectsV2ReturnsOnCall[i] = struct {
+		result1 *s3.ListObjectsV2Output
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) PutBucketAcl(arg1 context.Context, arg2 *s3.PutBucketAclInput, arg3 ...func(*s3.Options)) (*s3.PutBucketAclOutput, error) {
+	fake.putBucketAclMutex.Lock()
+	ret, specificReturn := fake.putBucketAclReturnsOnCall[len(fake.putBucketAclArgsForCall)]
+	fake.putBucketAclArgsForCall = append(fake.putBucketAclArgsForCall, struct {
+		arg1 context.Context
+		arg2 *s3.PutBucketAclInput
+		arg3 []func(*s3.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.PutBucketAclStub
+	fakeReturns := fake.putBucketAclReturns
+	fake.recordInvocation("PutBucketAcl", []interface{}{arg1, arg2, arg3})
+	fake.putBucketAclMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3Client) PutBucketAclCallCount() int {
+	fake.putBucketAclMutex.RLock()
+	defer fake.putBucketAclMutex.RUnlock()
+	return len(fake.putBucketAclArgsForCall)
+}
+
+func (fake *FakeS3Client) PutBucketAclCalls(stub func(context.Context, *s3.PutBucketAclInput, ...func(*s3.Options)) (*s3.PutBucketAclOutput, error)) {
+	fake.putBucketAclMutex.Lock()
+	defer fake.putBucketAclMutex.Unlock()
+	fake.PutBucketAclStub = stub
+}
+
+func (fake *FakeS3Client) PutBucketAclArgsForCall(i int) (context.Context, *s3.PutBucketAclInput, []func(*s3.Options)) {
+	fake.putBucketAclMutex.RLock()
+	defer fake.putBucketAclMutex.RUnlock()
+	argsForCall := fake.putBucketAclArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3Client) PutBucketAclReturns(result1 *s3.PutBucketAclOutput, result2 error) {
+	fake.putBucketAclMutex.Lock()
+	defer fake.putBucketAclMutex.Unlock()
+	fake.PutBucketAclStub = nil
+	fake.putBucketAclReturns = struct {
+		result1 *s3.PutBucketAclOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) PutBucketAclReturnsOnCall(i int, result1 *s3.PutBucketAclOutput, result2 error) {
+	fake.putBucketAclMutex.Lock()
+	defer fake.putBucketAclMutex.Unlock()
+	fake.PutBucketAclStub = nil
+	if fake.putBucketAclReturnsOnCall == nil {
+		fake.putBucketAclReturnsOnCall = make(map[int]struct {
+			result1 *s3.PutBucketAclOutput
+			result2 error
+		})
+	}
+	fake.putBucketAclReturnsOnCall[i] = struct {
+		result1 *s3.PutBucketAclOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) PutBucketCors(arg1 context.Context, arg2 *s3.PutBucketCorsInput, arg3 ...func(*s3.Options)) (*s3.PutBucketCorsOutput, error) {
+	fake.putBucketCorsMutex.Lock()
+	ret, specificReturn := fake.putBucketCorsReturnsOnCall[len(fake.putBucketCorsArgsForCall)]
+	fake.putBucketCorsArgsForCall = append(fake.putBucketCorsArgsForCall, struct {
+		arg1 context.Context
+		arg2 *s3.PutBucketCorsInput
+		arg3 []func(*s3.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.PutBucketCorsStub
+	fakeReturns := fake.putBucketCorsReturns
+	fake.recordInvocation("PutBucketCors", []interface{}{arg1, arg2, arg3})
+	fake.putBucketCorsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3Client) PutBucketCorsCallCount() int {
+	fake.putBucketCorsMutex.RLock()
+	defer fake.putBucketCorsMutex.RUnlock()
+	return len(fake.putBucketCorsArgsForCall)
+}
+
+func (fake *FakeS3Client) PutBucketCorsCalls(stub func(context.Context, *s3.PutBucketCorsInput, ...func(*s3.Options)) (*s3.PutBucketCorsOutput, error)) {
+	fake.putBucketCorsMutex.Lock()
+	defer fake.putBucketCorsMutex.Unlock()
+	fake.PutBucketCorsStub = stub
+}
+
+func (fake *FakeS3Client) PutBucketCorsArgsForCall(i int) (context.Context, *s3.PutBucketCorsInput, []func(*s3.Options)) {
+	fake.putBucketCorsMutex.RLock()
+	defer fake.putBucketCorsMutex.RUnlock()
+	argsForCall := fake.putBucketCorsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3Client) PutBucketCorsReturns(result1 *s3.PutBucketCorsOutput, result2 error) {
+	fake.putBucketCorsMutex.Lock()
+	defer fake.putBucketCorsMutex.Unlock()
+	fake.PutBucketCorsStub = nil
+	fake.putBucketCorsReturns = struct {
+		result1 *s3.PutBucketCorsOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) PutBucketCorsReturnsOnCall(i int, result1 *s3.PutBucketCorsOutput, result2 error) {
+	fake.putBucketCorsMutex.Lock()
+	defer fake.putBucketCorsMutex.Unlock()
+	fake.PutBucketCorsStub = nil
+	if fake.putBucketCorsReturnsOnCall == nil {
+		fake.putBucketCorsReturnsOnCall = make(map[int]struct {
+			result1 *s3.PutBucketCorsOutput
+			result2 error
+		})
+	}
+	fake.putBucketCorsReturnsOnCall[i] = struct {
+		result1 *s3.PutBucketCorsOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) PutBucketEncryption(arg1 context.Context, arg2 *s3.PutBucketEncryptionInput, arg3 ...func(*s3.Options)) (*s3.PutBucketEncryptionOutput, error) {
+	fake.putBucketEncryptionMutex.Lock()
+	ret, specificReturn := fake.putBucketEncryptionReturnsOnCall[len(fake.putBucketEncryptionArgsForCall)]
+	fake.putBucketEncryptionArgsForCall = append(fake.putBucketEncryptionArgsForCall, struct {
+		arg1 context.Context
+		arg2 *s3.PutBucketEncryptionInput
+		arg3 []func(*s3.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.PutBucketEncryptionStub
+	fakeReturns := fake.putBucketEncryptionReturns
+	fake.recordInvocation("PutBucketEncryption", []interface{}{arg1, arg2, arg3})
+	fake.putBucketEncryptionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3Client) PutBucketEncryptionCallCount() int {
+	fake.putBucketEncryptionMutex.RLock()
+	defer fake.putBucketEncryptionMutex.RUnlock()
+	return len(fake.putBucketEncryptionArgsForCall)
+}
+
+func (fake *FakeS3Client) PutBucketEncryptionCalls(stub func(context.Context, *s3.PutBucketEncryptionInput, ...func(*s3.Options)) (*s3.PutBucketEncryptionOutput, error)) {
+	fake.putBucketEncryptionMutex.Lock()
+	defer fake.putBucketEncryptionMutex.Unlock()
+	fake.PutBucketEncryptionStub = stub
+}
+
+func (fake *FakeS3Client) PutBucketEncryptionArgsForCall(i int) (context.Context, *s3.PutBucketEncryptionInput, []func(*s3.Options)) {
+	fake.putBucketEncryptionMutex.RLock()
+	defer fake.putBucketEncryptionMutex.RUnlock()
+	argsForCall := fake.putBucketEncryptionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3Client) PutBucketEncryptionReturns(result1 *s3.PutBucketEncryptionOutput, result2 error) {
+	fake.putBucketEncryptionMutex.Lock()
+	defer fake.putBucketEncryptionMutex.Unlock()
+	fake.PutBucketEncryptionStub = nil
+	fake.putBucketEncryptionReturns = struct {
+		result1 *s3.PutBucketEncryptionOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) PutBucketEncryptionReturnsOnCall(i int, result1 *s3.PutBucketEncryptionOutput, result2 error) {
+	fake.putBucketEncryptionMutex.Lock()
+	defer fake.putBucketEncryptionMutex.Unlock()
+	fake.PutBucketEncryptionStub = nil
+	if fake.putBucketEncryptionReturnsOnCall == nil {
+		fake.putBucketEncryptionReturnsOnCall = make(map[int]struct {
+			result1 *s3.PutBucketEncryptionOutput
+			result2 error
+		})
+	}
+	fake.putBucketEncryptionReturnsOnCall[i] = struct {
+		result1 *s3.PutBucketEncryptionOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) PutBucketLifecycleConfiguration(arg1 context.Context, arg2 *s3.PutBucketLifecycleConfigurationInput, arg3 ...func(*s3.Options)) (*s3.PutBucketLifecycleConfigurationOutput, error) {
+	fake.putBucketLifecycleConfigurationMutex.Lock()
+	ret, specificReturn := fake.putBucketLifecycleConfigurationReturnsOnCall[len(fake.putBucketLifecycleConfigurationArgsForCall)]
+	fake.putBucketLifecycleConfigurationArgsForCall = append(fake.putBucketLifecycleConfigurationArgsForCall, struct {
+		arg1 context.Context
+		arg2 *s3.PutBucketLifecycleConfigurationInput
+		arg3 []func(*s3.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.PutBucketLifecycleConfigurationStub
+	fakeReturns := fake.putBucketLifecycleConfigurationReturns
+	fake.recordInvocation("PutBucketLifecycleConfiguration", []interface{}{arg1, arg2, arg3})
+	fake.putBucketLifecycleConfigurationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3Client) PutBucketLifecycleConfigurationCallCount() int {
+	fake.putBucketLifecycleConfigurationMutex.RLock()
+	defer fake.putBucketLifecycleConfigurationMutex.RUnlock()
+	return len(fake.putBucketLifecycleConfigurationArgsForCall)
+}
+
+func (fake *FakeS3Client) PutBucketLifecycleConfigurationCalls(stub func(context.Context, *s3.PutBucketLifecycleConfigurationInput, ...func(*s3.Options)) (*s3.PutBucketLifecycleConfigurationOutput, error)) {
+	fake.putBucketLifecycleConfigurationMutex.Lock()
+	defer fake.putBucketLifecycleConfigurationMutex.Unlock()
+	fake.PutBucketLifecycleConfigurationStub = stub
+}
+
+func (fake *FakeS3Client) PutBucketLifecycleConfigurationArgsForCall(i int) (context.Context, *s3.PutBucketLifecycleConfigurationInput, []func(*s3.Options)) {
+	fake.putBucketLifecycleConfigurationMutex.RLock()
+	defer fake.putBucketLifecycleConfigurationMutex.RUnlock()
+	argsForCall := fake.putBucketLifecycleConfigurationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3Client) PutBucketLifecycleConfigurationReturns(result1 *s3.PutBucketLifecycleConfigurationOutput, result2 error) {
+	fake.putBucketLifecycleConfigurationMutex.Lock()
+	defer fake.putBucketLifecycleConfigurationMutex.Unlock()
+	fake.PutBucketLifecycleConfigurationStub = nil
+	fake.putBucketLifecycleConfigurationReturns = struct {
+		result1 *s3.PutBucketLifecycleConfigurationOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) PutBucketLifecycleConfigurationReturnsOnCall(i int, result1 *s3.PutBucketLifecycleConfigurationOutput, result2 error) {
+	fake.putBucketLifecycleConfigurationMutex.Lock()
+	defer fake.putBucketLifecycleConfigurationMutex.Unlock()
+	fake.PutBucketLifecycleConfigurationStub = nil
+	if fake.putBucketLifecycleConfigurationReturnsOnCall == nil {
+		fake.putBucketLifecycleConfigurationReturnsOnCall = make(map[int]struct {
+			result1 *s3.PutBucketLifecycleConfigurationOutput
+			result2 error
+		})
+	}
+	fake.putBucketLifecycleConfigurationReturnsOnCall[i] = struct {
+		result1 *s3.PutBucketLifecycleConfigurationOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) PutBucketLogging(arg1 context.Context, arg2 *s3.PutBucketLoggingInput, arg3 ...func(*s3.Options)) (*s3.PutBucketLoggingOutput, error) {
+	fake.putBucketLoggingMutex.Lock()
+	ret, specificReturn := fake.putBucketLoggingReturnsOnCall[len(fake.putBucketLoggingArgsForCall)]
+	fake.putBucketLoggingArgsForCall = append(fake.putBucketLoggingArgsForCall, struct {
+		arg1 context.Context
+		arg2 *s3.PutBucketLoggingInput
 		arg3 []func(*s3.Options)
 	}{arg1, arg2, arg3})
-	stub := fake.ListObjectsV2Stub
-	fakeReturns := fake.listObjectsV2Returns
-	fake.recordInvocation("ListObjectsV2", []interface{}{arg1, arg2, arg3})
-	fake.listObjectsV2Mutex.Unlock()
+	stub := fake.PutBucketLoggingStub
+	fakeReturns := fake.putBucketLoggingReturns
+	fake.recordInvocation("PutBucketLogging", []interface{}{arg1, arg2, arg3})
+	fake.putBucketLoggingMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3...)
 	}
@@ -1193,63 +3212,63 @@ func (fake *FakeS3Client) ListObjectsV2(arg1 context.Context, arg2 *s3.ListObjec
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeS3Client) ListObjectsV2CallCount() int {
-	fake.listObjectsV2Mutex.RLock()
-	defer fake.listObjectsV2Mutex.RUnlock()
-	return len(fake.listObjectsV2ArgsForCall)
+func (fake *FakeS3Client) PutBucketLoggingCallCount() int {
+	fake.putBucketLoggingMutex.RLock()
+	defer fake.putBucketLoggingMutex.RUnlock()
+	return len(fake.putBucketLoggingArgsForCall)
 }
 
-func (fake *FakeS3Client) ListObjectsV2Calls(stub func(context.Context, *s3.ListObjectsV2Input, ...func(*s3.Options)) (*s3.ListObjectsV2Output, error)) {
-	fake.listObjectsV2Mutex.Lock()
-	defer fake.listObjectsV2Mutex.Unlock()
-	fake.ListObjectsV2Stub = stub
+func (fake *FakeS3Client) PutBucketLoggingCalls(stub func(context.Context, *s3.PutBucketLoggingInput, ...func(*s3.Options)) (*s3.PutBucketLoggingOutput, error)) {
+	fake.putBucketLoggingMutex.Lock()
+	defer fake.putBucketLoggingMutex.Unlock()
+	fake.PutBucketLoggingStub = stub
 }
 
-func (fake *FakeS3Client) ListObjectsV2ArgsForCall(i int) (context.Context, *s3.ListObjectsV2Input, []func(*s3.Options)) {
-	fake.listObjectsV2Mutex.RLock()
-	defer fake.listObjectsV2Mutex.RUnlock()
-	argsForCall := fake.listObjectsV2ArgsForCall[i]
+func (fake *FakeS3Client) PutBucketLoggingArgsForCall(i int) (context.Context, *s3.PutBucketLoggingInput, []func(*s3.Options)) {
+	fake.putBucketLoggingMutex.RLock()
+	defer fake.putBucketLoggingMutex.RUnlock()
+	argsForCall := fake.putBucketLoggingArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeS3Client) ListObjectsV2Returns(result1 *s3.ListObjectsV2Output, result2 error) {
-	fake.listObjectsV2Mutex.Lock()
-	defer fake.listObjectsV2Mutex.Unlock()
-	fake.ListObjectsV2Stub = nil
-	fake.listObjectsV2Returns = struct {
-		result1 *s3.ListObjectsV2Output
+func (fake *FakeS3Client) PutBucketLoggingReturns(result1 *s3.PutBucketLoggingOutput, result2 error) {
+	fake.putBucketLoggingMutex.Lock()
+	defer fake.putBucketLoggingMutex.Unlock()
+	fake.PutBucketLoggingStub = nil
+	fake.putBucketLoggingReturns = struct {
+		result1 *s3.PutBucketLoggingOutput
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeS3Client) ListObjectsV2ReturnsOnCall(i int, result1 *s3.ListObjectsV2Output, result2 error) {
-	fake.listObjectsV2Mutex.Lock()
-	defer fake.listObjectsV2Mutex.Unlock()
-	fake.ListObjectsV2Stub = nil
-	if fake.listObjectsV2ReturnsOnCall == nil {
-		fake.listObjectsV2ReturnsOnCall = make(map[int]struct {
-			result1 *s3.ListObjectsV2Output
+func (fake *FakeS3Client) PutBucketLoggingReturnsOnCall(i int, result1 *s3.PutBucketLoggingOutput, result2 error) {
+	fake.putBucketLoggingMutex.Lock()
+	defer fake.putBucketLoggingMutex.Unlock()
+	fake.PutBucketLoggingStub = nil
+	if fake.putBucketLoggingReturnsOnCall == nil {
+		fake.putBucketLoggingReturnsOnCall = make(map[int]struct {
+			result1 *s3.PutBucketLoggingOutput
 			result2 error
 		})
 	}
-	fake.listObjectsV2ReturnsOnCall[i] = struct {
-		result1 *s3.ListObjectsV2Output
+	fake.putBucketLoggingReturnsOnCall[i] = struct {
+		result1 *s3.PutBucketLoggingOutput
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeS3Client) PutBucketAcl(arg1 context.Context, arg2 *s3.PutBucketAclInput, arg3 ...func(*s3.Options)) (*s3.PutBucketAclOutput, error) {
-	fake.putBucketAclMutex.Lock()
-	ret, specificReturn := fake.putBucketAclReturnsOnCall[len(fake.putBucketAclArgsForCall)]
-	fake.putBucketAclArgsForCall = append(fake.putBucketAclArgsForCall, struct {
+func (fake *FakeS3Client) PutBucketNotificationConfiguration(arg1 context.Context, arg2 *s3.PutBucketNotificationConfigurationInput, arg3 ...func(*s3.Options)) (*s3.PutBucketNotificationConfigurationOutput, error) {
+	fake.putBucketNotificationConfigurationMutex.Lock()
+	ret, specificReturn := fake.putBucketNotificationConfigurationReturnsOnCall[len(fake.putBucketNotificationConfigurationArgsForCall)]
+	fake.putBucketNotificationConfigurationArgsForCall = append(fake.putBucketNotificationConfigurationArgsForCall, struct {
 		arg1 context.Context
-		arg2 *s3.PutBucketAclInput
+		arg2 *s3.PutBucketNotificationConfigurationInput
 		arg3 []func(*s3.Options)
 	}{arg1, arg2, arg3})
-	stub := fake.PutBucketAclStub
-	fakeReturns := fake.putBucketAclReturns
-	fake.recordInvocation("PutBucketAcl", []interface{}{arg1, arg2, arg3})
-	fake.putBucketAclMutex.Unlock()
+	stub := fake.PutBucketNotificationConfigurationStub
+	fakeReturns := fake.putBucketNotificationConfigurationReturns
+	fake.recordInvocation("PutBucketNotificationConfiguration", []interface{}{arg1, arg2, arg3})
+	fake.putBucketNotificationConfigurationMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3...)
 	}
@@ -1259,63 +3278,63 @@ func (fake *FakeS3Client) PutBucketAcl(arg1 context.Context, arg2 *s3.PutBucketA
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeS3Client) PutBucketAclCallCount() int {
-	fake.putBucketAclMutex.RLock()
-	defer fake.putBucketAclMutex.RUnlock()
-	return len(fake.putBucketAclArgsForCall)
+func (fake *FakeS3Client) PutBucketNotificationConfigurationCallCount() int {
+	fake.putBucketNotificationConfigurationMutex.RLock()
+	defer fake.putBucketNotificationConfigurationMutex.RUnlock()
+	return len(fake.putBucketNotificationConfigurationArgsForCall)
 }
 
-func (fake *FakeS3Client) PutBucketAclCalls(stub func(context.Context, *s3.PutBucketAclInput, ...func(*s3.Options)) (*s3.PutBucketAclOutput, error)) {
-	fake.putBucketAclMutex.Lock()
-	defer fake.putBucketAclMutex.Unlock()
-	fake.PutBucketAclStub = stub
+func (fake *FakeS3Client) PutBucketNotificationConfigurationCalls(stub func(context.Context, *s3.PutBucketNotificationConfigurationInput, ...func(*s3.Options)) (*s3.PutBucketNotificationConfigurationOutput, error)) {
+	fake.putBucketNotificationConfigurationMutex.Lock()
+	defer fake.putBucketNotificationConfigurationMutex.Unlock()
+	fake.PutBucketNotificationConfigurationStub = stub
 }
 
-func (fake *FakeS3Client) PutBucketAclArgsForCall(i int) (context.Context, *s3.PutBucketAclInput, []func(*s3.Options)) {
-	fake.putBucketAclMutex.RLock()
-	defer fake.putBucketAclMutex.RUnlock()
-	argsForCall := fake.putBucketAclArgsForCall[i]
+func (fake *FakeS3Client) PutBucketNotificationConfigurationArgsForCall(i int) (context.Context, *s3.PutBucketNotificationConfigurationInput, []func(*s3.Options)) {
+	fake.putBucketNotificationConfigurationMutex.RLock()
+	defer fake.putBucketNotificationConfigurationMutex.RUnlock()
+	argsForCall := fake.putBucketNotificationConfigurationArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeS3Client) PutBucketAclReturns(result1 *s3.PutBucketAclOutput, result2 error) {
-	fake.putBucketAclMutex.Lock()
-	defer fake.putBucketAclMutex.Unlock()
-	fake.PutBucketAclStub = nil
-	fake.putBucketAclReturns = struct {
-		result1 *s3.PutBucketAclOutput
+func (fake *FakeS3Client) PutBucketNotificationConfigurationReturns(result1 *s3.PutBucketNotificationConfigurationOutput, result2 error) {
+	fake.putBucketNotificationConfigurationMutex.Lock()
+	defer fake.putBucketNotificationConfigurationMutex.Unlock()
+	fake.PutBucketNotificationConfigurationStub = nil
+	fake.putBucketNotificationConfigurationReturns = struct {
+		result1 *s3.PutBucketNotificationConfigurationOutput
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeS3Client) PutBucketAclReturnsOnCall(i int, result1 *s3.PutBucketAclOutput, result2 error) {
-	fake.putBucketAclMutex.Lock()
-	defer fake.putBucketAclMutex.Unlock()
-	fake.PutBucketAclStub = nil
-	if fake.putBucketAclReturnsOnCall == nil {
-		fake.putBucketAclReturnsOnCall = make(map[int]struct {
-			result1 *s3.PutBucketAclOutput
+func (fake *FakeS3Client) PutBucketNotificationConfigurationReturnsOnCall(i int, result1 *s3.PutBucketNotificationConfigurationOutput, result2 error) {
+	fake.putBucketNotificationConfigurationMutex.Lock()
+	defer fake.putBucketNotificationConfigurationMutex.Unlock()
+	fake.PutBucketNotificationConfigurationStub = nil
+	if fake.putBucketNotificationConfigurationReturnsOnCall == nil {
+		fake.putBucketNotificationConfigurationReturnsOnCall = make(map[int]struct {
+			result1 *s3.PutBucketNotificationConfigurationOutput
 			result2 error
 		})
 	}
-	fake.putBucketAclReturnsOnCall[i] = struct {
-		result1 *s3.PutBucketAclOutput
+	fake.putBucketNotificationConfigurationReturnsOnCall[i] = struct {
+		result1 *s3.PutBucketNotificationConfigurationOutput
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeS3Client) PutBucketLifecycleConfiguration(arg1 context.Context, arg2 *s3.PutBucketLifecycleConfigurationInput, arg3 ...func(*s3.Options)) (*s3.PutBucketLifecycleConfigurationOutput, error) {
-	fake.putBucketLifecycleConfigurationMutex.Lock()
-	ret, specificReturn := fake.putBucketLifecycleConfigurationReturnsOnCall[len(fake.putBucketLifecycleConfigurationArgsForCall)]
-	fake.putBucketLifecycleConfigurationArgsForCall = append(fake.putBucketLifecycleConfigurationArgsForCall, struct {
+func (fake *FakeS3Client) PutBucketOwnershipControls(arg1 context.Context, arg2 *s3.PutBucketOwnershipControlsInput, arg3 ...func(*s3.Options)) (*s3.PutBucketOwnershipControlsOutput, error) {
+	fake.putBucketOwnershipControlsMutex.Lock()
+	ret, specificReturn := fake.putBucketOwnershipControlsReturnsOnCall[len(fake.putBucketOwnershipControlsArgsForCall)]
+	fake.putBucketOwnershipControlsArgsForCall = append(fake.putBucketOwnershipControlsArgsForCall, struct {
 		arg1 context.Context
-		arg2 *s3.PutBucketLifecycleConfigurationInput
+		arg2 *s3.PutBucketOwnershipControlsInput
 		arg3 []func(*s3.Options)
 	}{arg1, arg2, arg3})
-	stub := fake.PutBucketLifecycleConfigurationStub
-	fakeReturns := fake.putBucketLifecycleConfigurationReturns
-	fake.recordInvocation("PutBucketLifecycleConfiguration", []interface{}{arg1, arg2, arg3})
-	fake.putBucketLifecycleConfigurationMutex.Unlock()
+	stub := fake.PutBucketOwnershipControlsStub
+	fakeReturns := fake.putBucketOwnershipControlsReturns
+	fake.recordInvocation("PutBucketOwnershipControls", []interface{}{arg1, arg2, arg3})
+	fake.putBucketOwnershipControlsMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3...)
 	}
@@ -1325,47 +3344,47 @@ func (fake *FakeS3Client) PutBucketLifecycleConfiguration(arg1 context.Context,
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeS3Client) PutBucketLifecycleConfigurationCallCount() int {
-	fake.putBucketLifecycleConfigurationMutex.RLock()
-	defer fake.putBucketLifecycleConfigurationMutex.RUnlock()
-	return len(fake.putBucketLifecycleConfigurationArgsForCall)
+func (fake *FakeS3Client) PutBucketOwnershipControlsCallCount() int {
+	fake.putBucketOwnershipControlsMutex.RLock()
+	defer fake.putBucketOwnershipControlsMutex.RUnlock()
+	return len(fake.putBucketOwnershipControlsArgsForCall)
 }
 
-func (fake *FakeS3Client) PutBucketLifecycleConfigurationCalls(stub func(context.Context, *s3.PutBucketLifecycleConfigurationInput, ...func(*s3.Options)) (*s3.PutBucketLifecycleConfigurationOutput, error)) {
-	fake.putBucketLifecycleConfigurationMutex.Lock()
-	defer fake.putBucketLifecycleConfigurationMutex.Unlock()
-	fake.PutBucketLifecycleConfigurationStub = stub
+func (fake *FakeS3Client) PutBucketOwnershipControlsCalls(stub func(context.Context, *s3.PutBucketOwnershipControlsInput, ...func(*s3.Options)) (*s3.PutBucketOwnershipControlsOutput, error)) {
+	fake.putBucketOwnershipControlsMutex.Lock()
+	defer fake.putBucketOwnershipControlsMutex.Unlock()
+	fake.PutBucketOwnershipControlsStub = stub
 }
 
-func (fake *FakeS3Client) PutBucketLifecycleConfigurationArgsForCall(i int) (context.Context, *s3.PutBucketLifecycleConfigurationInput, []func(*s3.Options)) {
-	fake.putBucketLifecycleConfigurationMutex.RLock()
-	defer fake.putBucketLifecycleConfigurationMutex.RUnlock()
-	argsForCall := fake.putBucketLifecycleConfigurationArgsForCall[i]
+func (fake *FakeS3Client) PutBucketOwnershipControlsArgsForCall(i int) (context.Context, *s3.PutBucketOwnershipControlsInput, []func(*s3.Options)) {
+	fake.putBucketOwnershipControlsMutex.RLock()
+	defer fake.putBucketOwnershipControlsMutex.RUnlock()
+	argsForCall := fake.putBucketOwnershipControlsArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeS3Client) PutBucketLifecycleConfigurationReturns(result1 *s3.PutBucketLifecycleConfigurationOutput, result2 error) {
-	fake.putBucketLifecycleConfigurationMutex.Lock()
-	defer fake.putBucketLifecycleConfigurationMutex.Unlock()
-	fake.PutBucketLifecycleConfigurationStub = nil
-	fake.putBucketLifecycleConfigurationReturns = struct {
-		result1 *s3.PutBucketLifecycleConfigurationOutput
+func (fake *FakeS3Client) PutBucketOwnershipControlsReturns(result1 *s3.PutBucketOwnershipControlsOutput, result2 error) {
+	fake.putBucketOwnershipControlsMutex.Lock()
+	defer fake.putBucketOwnershipControlsMutex.Unlock()
+	fake.PutBucketOwnershipControlsStub = nil
+	fake.putBucketOwnershipControlsReturns = struct {
+		result1 *s3.PutBucketOwnershipControlsOutput
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeS3Client) PutBucketLifecycleConfigurationReturnsOnCall(i int, result1 *s3.PutBucketLifecycleConfigurationOutput, result2 error) {
-	fake.putBucketLifecycleConfigurationMutex.Lock()
-	defer fake.putBucketLifecycleConfigurationMutex.Unlock()
-	fake.PutBucketLifecycleConfigurationStub = nil
-	if fake.putBucketLifecycleConfigurationReturnsOnCall == nil {
-		fake.putBucketLifecycleConfigurationReturnsOnCall = make(map[int]struct {
-			result1 *s3.PutBucketLifecycleConfigurationOutput
+func (fake *FakeS3Client) PutBucketOwnershipControlsReturnsOnCall(i int, result1 *s3.PutBucketOwnershipControlsOutput, result2 error) {
+	fake.putBucketOwnershipControlsMutex.Lock()
+	defer fake.putBucketOwnershipControlsMutex.Unlock()
+	fake.PutBucketOwnershipControlsStub = nil
+	if fake.putBucketOwnershipControlsReturnsOnCall == nil {
+		fake.putBucketOwnershipControlsReturnsOnCall = make(map[int]struct {
+			result1 *s3.PutBucketOwnershipControlsOutput
 			result2 error
 		})
 	}
-	fake.putBucketLifecycleConfigurationReturnsOnCall[i] = struct {
-		result1 *s3.PutBucketLifecycleConfigurationOutput
+	fake.putBucketOwnershipControlsReturnsOnCall[i] = struct {
+		result1 *s3.PutBucketOwnershipControlsOutput
 		result2 error
 	}{result1, result2}
 }
@@ -1436,6 +3455,138 @@ func (fake *FakeS3Client) PutBucketPolicyReturnsOnCall(i int, result1 *s3.PutBuc
 	}{result1, result2}
 }
 
+func (fake *FakeS3Client) PutBucketReplication(arg1 context.Context, arg2 *s3.PutBucketReplicationInput, arg3 ...func(*s3.Options)) (*s3.PutBucketReplicationOutput, error) {
+	fake.putBucketReplicationMutex.Lock()
+	ret, specificReturn := fake.putBucketReplicationReturnsOnCall[len(fake.putBucketReplicationArgsForCall)]
+	fake.putBucketReplicationArgsForCall = append(fake.putBucketReplicationArgsForCall, struct {
+		arg1 context.Context
+		arg2 *s3.PutBucketReplicationInput
+		arg3 []func(*s3.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.PutBucketReplicationStub
+	fakeReturns := fake.putBucketReplicationReturns
+	fake.recordInvocation("PutBucketReplication", []interface{}{arg1, arg2, arg3})
+	fake.putBucketReplicationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3Client) PutBucketReplicationCallCount() int {
+	fake.putBucketReplicationMutex.RLock()
+	defer fake.putBucketReplicationMutex.RUnlock()
+	return len(fake.putBucketReplicationArgsForCall)
+}
+
+func (fake *FakeS3Client) PutBucketReplicationCalls(stub func(context.Context, *s3.PutBucketReplicationInput, ...func(*s3.Options)) (*s3.PutBucketReplicationOutput, error)) {
+	fake.putBucketReplicationMutex.Lock()
+	defer fake.putBucketReplicationMutex.Unlock()
+	fake.PutBucketReplicationStub = stub
+}
+
+func (fake *FakeS3Client) PutBucketReplicationArgsForCall(i int) (context.Context, *s3.PutBucketReplicationInput, []func(*s3.Options)) {
+	fake.putBucketReplicationMutex.RLock()
+	defer fake.putBucketReplicationMutex.RUnlock()
+	argsForCall := fake.putBucketReplicationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3Client) PutBucketReplicationReturns(result1 *s3.PutBucketReplicationOutput, result2 error) {
+	fake.putBucketReplicationMutex.Lock()
+	defer fake.putBucketReplicationMutex.Unlock()
+	fake.PutBucketReplicationStub = nil
+	fake.putBucketReplicationReturns = struct {
+		result1 *s3.PutBucketReplicationOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) PutBucketReplicationReturnsOnCall(i int, result1 *s3.PutBucketReplicationOutput, result2 error) {
+	fake.putBucketReplicationMutex.Lock()
+	defer fake.putBucketReplicationMutex.Unlock()
+	fake.PutBucketReplicationStub = nil
+	if fake.putBucketReplicationReturnsOnCall == nil {
+		fake.putBucketReplicationReturnsOnCall = make(map[int]struct {
+			result1 *s3.PutBucketReplicationOutput
+			result2 error
+		})
+	}
+	fake.putBucketReplicationReturnsOnCall[i] = struct {
+		result1 *s3.PutBucketReplicationOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) PutBucketTagging(arg1 context.Context, arg2 *s3.PutBucketTaggingInput, arg3 ...func(*s3.Options)) (*s3.PutBucketTaggingOutput, error) {
+	fake.putBucketTaggingMutex.Lock()
+	ret, specificReturn := fake.putBucketTaggingReturnsOnCall[len(fake.putBucketTaggingArgsForCall)]
+	fake.putBucketTaggingArgsForCall = append(fake.putBucketTaggingArgsForCall, struct {
+		arg1 context.Context
+		arg2 *s3.PutBucketTaggingInput
+		arg3 []func(*s3.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.PutBucketTaggingStub
+	fakeReturns := fake.putBucketTaggingReturns
+	fake.recordInvocation("PutBucketTagging", []interface{}{arg1, arg2, arg3})
+	fake.putBucketTaggingMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3Client) PutBucketTaggingCallCount() int {
+	fake.putBucketTaggingMutex.RLock()
+	defer fake.putBucketTaggingMutex.RUnlock()
+	return len(fake.putBucketTaggingArgsForCall)
+}
+
+func (fake *FakeS3Client) PutBucketTaggingCalls(stub func(context.Context, *s3.PutBucketTaggingInput, ...func(*s3.Options)) (*s3.PutBucketTaggingOutput, error)) {
+	fake.putBucketTaggingMutex.Lock()
+	defer fake.putBucketTaggingMutex.Unlock()
+	fake.PutBucketTaggingStub = stub
+}
+
+func (fake *FakeS3Client) PutBucketTaggingArgsForCall(i int) (context.Context, *s3.PutBucketTaggingInput, []func(*s3.Options)) {
+	fake.putBucketTaggingMutex.RLock()
+	defer fake.putBucketTaggingMutex.RUnlock()
+	argsForCall := fake.putBucketTaggingArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3Client) PutBucketTaggingReturns(result1 *s3.PutBucketTaggingOutput, result2 error) {
+	fake.putBucketTaggingMutex.Lock()
+	defer fake.putBucketTaggingMutex.Unlock()
+	fake.PutBucketTaggingStub = nil
+	fake.putBucketTaggingReturns = struct {
+		result1 *s3.PutBucketTaggingOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) PutBucketTaggingReturnsOnCall(i int, result1 *s3.PutBucketTaggingOutput, result2 error) {
+	fake.putBucketTaggingMutex.Lock()
+	defer fake.putBucketTaggingMutex.Unlock()
+	fake.PutBucketTaggingStub = nil
+	if fake.putBucketTaggingReturnsOnCall == nil {
+		fake.putBucketTaggingReturnsOnCall = make(map[int]struct {
+			result1 *s3.PutBucketTaggingOutput
+			result2 error
+		})
+	}
+	fake.putBucketTaggingReturnsOnCall[i] = struct {
+		result1 *s3.PutBucketTaggingOutput
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeS3Client) PutBucketVersioning(arg1 context.Context, arg2 *s3.PutBucketVersioningInput, arg3 ...func(*s3.Options)) (*s3.PutBucketVersioningOutput, error) {
 	fake.putBucketVersioningMutex.Lock()
 	ret, specificReturn := fake.putBucketVersioningReturnsOnCall[len(fake.putBucketVersioningArgsForCall)]
@@ -1502,6 +3653,72 @@ func (fake *FakeS3Client) PutBucketVersioningReturnsOnCall(i int, result1 *s3.Pu
 	}{result1, result2}
 }
 
+func (fake *FakeS3Client) PutBucketWebsite(arg1 context.Context, arg2 *s3.PutBucketWebsiteInput, arg3 ...func(*s3.Options)) (*s3.PutBucketWebsiteOutput, error) {
+	fake.putBucketWebsiteMutex.Lock()
+	ret, specificReturn := fake.putBucketWebsiteReturnsOnCall[len(fake.putBucketWebsiteArgsForCall)]
+	fake.putBucketWebsiteArgsForCall = append(fake.putBucketWebsiteArgsForCall, struct {
+		arg1 context.Context
+		arg2 *s3.PutBucketWebsiteInput
+		arg3 []func(*s3.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.PutBucketWebsiteStub
+	fakeReturns := fake.putBucketWebsiteReturns
+	fake.recordInvocation("PutBucketWebsite", []interface{}{arg1, arg2, arg3})
+	fake.putBucketWebsiteMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3Client) PutBucketWebsiteCallCount() int {
+	fake.putBucketWebsiteMutex.RLock()
+	defer fake.putBucketWebsiteMutex.RUnlock()
+	return len(fake.putBucketWebsiteArgsForCall)
+}
+
+func (fake *FakeS3Client) PutBucketWebsiteCalls(stub func(context.Context, *s3.PutBucketWebsiteInput, ...func(*s3.Options)) (*s3.PutBucketWebsiteOutput, error)) {
+	fake.putBucketWebsiteMutex.Lock()
+	defer fake.putBucketWebsiteMutex.Unlock()
+	fake.PutBucketWebsiteStub = stub
+}
+
+func (fake *FakeS3Client) PutBucketWebsiteArgsForCall(i int) (context.Context, *s3.PutBucketWebsiteInput, []func(*s3.Options)) {
+	fake.putBucketWebsiteMutex.RLock()
+	defer fake.putBucketWebsiteMutex.RUnlock()
+	argsForCall := fake.putBucketWebsiteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3Client) PutBucketWebsiteReturns(result1 *s3.PutBucketWebsiteOutput, result2 error) {
+	fake.putBucketWebsiteMutex.Lock()
+	defer fake.putBucketWebsiteMutex.Unlock()
+	fake.PutBucketWebsiteStub = nil
+	fake.putBucketWebsiteReturns = struct {
+		result1 *s3.PutBucketWebsiteOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) PutBucketWebsiteReturnsOnCall(i int, result1 *s3.PutBucketWebsiteOutput, result2 error) {
+	fake.putBucketWebsiteMutex.Lock()
+	defer fake.putBucketWebsiteMutex.Unlock()
+	fake.PutBucketWebsiteStub = nil
+	if fake.putBucketWebsiteReturnsOnCall == nil {
+		fake.putBucketWebsiteReturnsOnCall = make(map[int]struct {
+			result1 *s3.PutBucketWebsiteOutput
+			result2 error
+		})
+	}
+	fake.putBucketWebsiteReturnsOnCall[i] = struct {
+		result1 *s3.PutBucketWebsiteOutput
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeS3Client) PutObject(arg1 context.Context, arg2 *s3.PutObjectInput, arg3 ...func(*s3.Options)) (*s3.PutObjectOutput, error) {
 	fake.putObjectMutex.Lock()
 	ret, specificReturn := fake.putObjectReturnsOnCall[len(fake.putObjectArgsForCall)]
@@ -1634,6 +3851,138 @@ func (fake *FakeS3Client) PutObjectLockConfigurationReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
+func (fake *FakeS3Client) PutPublicAccessBlock(arg1 context.Context, arg2 *s3.PutPublicAccessBlockInput, arg3 ...func(*s3.Options)) (*s3.PutPublicAccessBlockOutput, error) {
+	fake.putPublicAccessBlockMutex.Lock()
+	ret, specificReturn := fake.putPublicAccessBlockReturnsOnCall[len(fake.putPublicAccessBlockArgsForCall)]
+	fake.putPublicAccessBlockArgsForCall = append(fake.putPublicAccessBlockArgsForCall, struct {
+		arg1 context.Context
+		arg2 *s3.PutPublicAccessBlockInput
+		arg3 []func(*s3.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.PutPublicAccessBlockStub
+	fakeReturns := fake.putPublicAccessBlockReturns
+	fake.recordInvocation("PutPublicAccessBlock", []interface{}{arg1, arg2, arg3})
+	fake.putPublicAccessBlockMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3Client) PutPublicAccessBlockCallCount() int {
+	fake.putPublicAccessBlockMutex.RLock()
+	defer fake.putPublicAccessBlockMutex.RUnlock()
+	return len(fake.putPublicAccessBlockArgsForCall)
+}
+
+func (fake *FakeS3Client) PutPublicAccessBlockCalls(stub func(context.Context, *s3.PutPublicAccessBlockInput, ...func(*s3.Options)) (*s3.PutPublicAccessBlockOutput, error)) {
+	fake.putPublicAccessBlockMutex.Lock()
+	defer fake.putPublicAccessBlockMutex.Unlock()
+	fake.PutPublicAccessBlockStub = stub
+}
+
+func (fake *FakeS3Client) PutPublicAccessBlockArgsForCall(i int) (context.Context, *s3.PutPublicAccessBlockInput, []func(*s3.Options)) {
+	fake.putPublicAccessBlockMutex.RLock()
+	defer fake.putPublicAccessBlockMutex.RUnlock()
+	argsForCall := fake.putPublicAccessBlockArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3Client) PutPublicAccessBlockReturns(result1 *s3.PutPublicAccessBlockOutput, result2 error) {
+	fake.putPublicAccessBlockMutex.Lock()
+	defer fake.putPublicAccessBlockMutex.Unlock()
+	fake.PutPublicAccessBlockStub = nil
+	fake.putPublicAccessBlockReturns = struct {
+		result1 *s3.PutPublicAccessBlockOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) PutPublicAccessBlockReturnsOnCall(i int, result1 *s3.PutPublicAccessBlockOutput, result2 error) {
+	fake.putPublicAccessBlockMutex.Lock()
+	defer fake.putPublicAccessBlockMutex.Unlock()
+	fake.PutPublicAccessBlockStub = nil
+	if fake.putPublicAccessBlockReturnsOnCall == nil {
+		fake.putPublicAccessBlockReturnsOnCall = make(map[int]struct {
+			result1 *s3.PutPublicAccessBlockOutput
+			result2 error
+		})
+	}
+	fake.putPublicAccessBlockReturnsOnCall[i] = struct {
+		result1 *s3.PutPublicAccessBlockOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) UploadPart(arg1 context.Context, arg2 *s3.UploadPartInput, arg3 ...func(*s3.Options)) (*s3.UploadPartOutput, error) {
+	fake.uploadPartMutex.Lock()
+	ret, specificReturn := fake.uploadPartReturnsOnCall[len(fake.uploadPartArgsForCall)]
+	fake.uploadPartArgsForCall = append(fake.uploadPartArgsForCall, struct {
+		arg1 context.Context
+		arg2 *s3.UploadPartInput
+		arg3 []func(*s3.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.UploadPartStub
+	fakeReturns := fake.uploadPartReturns
+	fake.recordInvocation("UploadPart", []interface{}{arg1, arg2, arg3})
+	fake.uploadPartMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3Client) UploadPartCallCount() int {
+	fake.uploadPartMutex.RLock()
+	defer fake.uploadPartMutex.RUnlock()
+	return len(fake.uploadPartArgsForCall)
+}
+
+func (fake *FakeS3Client) UploadPartCalls(stub func(context.Context, *s3.UploadPartInput, ...func(*s3.Options)) (*s3.UploadPartOutput, error)) {
+	fake.uploadPartMutex.Lock()
+	defer fake.uploadPartMutex.Unlock()
+	fake.UploadPartStub = stub
+}
+
+func (fake *FakeS3Client) UploadPartArgsForCall(i int) (context.Context, *s3.UploadPartInput, []func(*s3.Options)) {
+	fake.uploadPartMutex.RLock()
+	defer fake.uploadPartMutex.RUnlock()
+	argsForCall := fake.uploadPartArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeS3Client) UploadPartReturns(result1 *s3.UploadPartOutput, result2 error) {
+	fake.uploadPartMutex.Lock()
+	defer fake.uploadPartMutex.Unlock()
+	fake.UploadPartStub = nil
+	fake.uploadPartReturns = struct {
+		result1 *s3.UploadPartOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Client) UploadPartReturnsOnCall(i int, result1 *s3.UploadPartOutput, result2 error) {
+	fake.uploadPartMutex.Lock()
+	defer fake.uploadPartMutex.Unlock()
+	fake.UploadPartStub = nil
+	if fake.uploadPartReturnsOnCall == nil {
+		fake.uploadPartReturnsOnCall = make(map[int]struct {
+			result1 *s3.UploadPartOutput
+			result2 error
+		})
+	}
+	fake.uploadPartReturnsOnCall[i] = struct {
+		result1 *s3.UploadPartOutput
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeS3Client) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -25,6 +25,21 @@ type FakeSTSClient struct {
 		result1 *sts.AssumeRoleOutput
 		result2 error
 	}
+	AssumeRoleWithWebIdentityStub        func(context.Context, *sts.AssumeRoleWithWebIdentityInput, ...func(*sts.Options)) (*sts.AssumeRoleWithWebIdentityOutput, error)
+	assumeRoleWithWebIdentityMutex       sync.RWMutex
+	assumeRoleWithWebIdentityArgsForCall []struct {
+		arg1 context.Context
+		arg2 *sts.AssumeRoleWithWebIdentityInput
+		arg3 []func(*sts.Options)
+	}
+	assumeRoleWithWebIdentityReturns struct {
+		result1 *sts.AssumeRoleWithWebIdentityOutput
+		result2 error
+	}
+	assumeRoleWithWebIdentityReturnsOnCall map[int]struct {
+		result1 *sts.AssumeRoleWithWebIdentityOutput
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -95,6 +110,72 @@ func (fake *FakeSTSClient) AssumeRoleReturnsOnCall(i int, result1 *sts.AssumeRol
 	}{result1, result2}
 }
 
+func (fake *FakeSTSClient) AssumeRoleWithWebIdentity(arg1 context.Context, arg2 *sts.AssumeRoleWithWebIdentityInput, arg3 ...func(*sts.Options)) (*sts.AssumeRoleWithWebIdentityOutput, error) {
+	fake.assumeRoleWithWebIdentityMutex.Lock()
+	ret, specificReturn := fake.assumeRoleWithWebIdentityReturnsOnCall[len(fake.assumeRoleWithWebIdentityArgsForCall)]
+	fake.assumeRoleWithWebIdentityArgsForCall = append(fake.assumeRoleWithWebIdentityArgsForCall, struct {
+		arg1 context.Context
+		arg2 *sts.AssumeRoleWithWebIdentityInput
+		arg3 []func(*sts.Options)
+	}{arg1, arg2, arg3})
+	stub := fake.AssumeRoleWithWebIdentityStub
+	fakeReturns := fake.assumeRoleWithWebIdentityReturns
+	fake.recordInvocation("AssumeRoleWithWebIdentity", []interface{}{arg1, arg2, arg3})
+	fake.assumeRoleWithWebIdentityMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSTSClient) AssumeRoleWithWebIdentityCallCount() int {
+	fake.assumeRoleWithWebIdentityMutex.RLock()
+	defer fake.assumeRoleWithWebIdentityMutex.RUnlock()
+	return len(fake.assumeRoleWithWebIdentityArgsForCall)
+}
+
+func (fake *FakeSTSClient) AssumeRoleWithWebIdentityCalls(stub func(context.Context, *sts.AssumeRoleWithWebIdentityInput, ...func(*sts.Options)) (*sts.AssumeRoleWithWebIdentityOutput, error)) {
+	fake.assumeRoleWithWebIdentityMutex.Lock()
+	defer fake.assumeRoleWithWebIdentityMutex.Unlock()
+	fake.AssumeRoleWithWebIdentityStub = stub
+}
+
+func (fake *FakeSTSClient) AssumeRoleWithWebIdentityArgsForCall(i int) (context.Context, *sts.AssumeRoleWithWebIdentityInput, []func(*sts.Options)) {
+	fake.assumeRoleWithWebIdentityMutex.RLock()
+	defer fake.assumeRoleWithWebIdentityMutex.RUnlock()
+	argsForCall := fake.assumeRoleWithWebIdentityArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSTSClient) AssumeRoleWithWebIdentityReturns(result1 *sts.AssumeRoleWithWebIdentityOutput, result2 error) {
+	fake.assumeRoleWithWebIdentityMutex.Lock()
+	defer fake.assumeRoleWithWebIdentityMutex.Unlock()
+	fake.AssumeRoleWithWebIdentityStub = nil
+	fake.assumeRoleWithWebIdentityReturns = struct {
+		result1 *sts.AssumeRoleWithWebIdentityOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSTSClient) AssumeRoleWithWebIdentityReturnsOnCall(i int, result1 *sts.AssumeRoleWithWebIdentityOutput, result2 error) {
+	fake.assumeRoleWithWebIdentityMutex.Lock()
+	defer fake.assumeRoleWithWebIdentityMutex.Unlock()
+	fake.AssumeRoleWithWebIdentityStub = nil
+	if fake.assumeRoleWithWebIdentityReturnsOnCall == nil {
+		fake.assumeRoleWithWebIdentityReturnsOnCall = make(map[int]struct {
+			result1 *sts.AssumeRoleWithWebIdentityOutput
+			result2 error
+		})
+	}
+	fake.assumeRoleWithWebIdentityReturnsOnCall[i] = struct {
+		result1 *sts.AssumeRoleWithWebIdentityOutput
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSTSClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
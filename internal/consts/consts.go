@@ -10,6 +10,12 @@ const (
 	KeyAccessKey = "access_key"
 	KeySecretKey = "secret_key"
 
+	// Connection secret keys. The access and secret keys of a CephUser are
+	// published with the same keys as used in ProviderConfig Secrets.
+	KeyEndpoint   = "endpoint"
+	KeyBucket     = "bucket"
+	KeyWebsiteURL = "website_url"
+
 	// API request header keys.
 	KeySecurityToken = "x-amz-security-token"
 
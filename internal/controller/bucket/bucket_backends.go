@@ -111,6 +111,36 @@ func (b *bucketBackends) getVersioningConfigCondition(bucketName, backendName st
 	return b.backends[bucketName][backendName].VersioningConfigurationCondition
 }
 
+func (b *bucketBackends) setQuotaCondition(bucketName, backendName string, c *xpv1.Condition) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.backends[bucketName] == nil {
+		b.backends[bucketName] = make(v1alpha1.Backends)
+	}
+
+	if b.backends[bucketName][backendName] == nil {
+		b.backends[bucketName][backendName] = &v1alpha1.BackendInfo{}
+	}
+
+	b.backends[bucketName][backendName].QuotaCondition = c
+}
+
+func (b *bucketBackends) getQuotaCondition(bucketName, backendName string) *xpv1.Condition {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if _, ok := b.backends[bucketName]; !ok {
+		return nil
+	}
+
+	if _, ok := b.backends[bucketName][backendName]; !ok {
+		return nil
+	}
+
+	return b.backends[bucketName][backendName].QuotaCondition
+}
+
 func (b *bucketBackends) setObjectLockConfigCondition(bucketName, backendName string, c *xpv1.Condition) {
 	b.mu.Lock()
 	defer b.mu.Unlock()
@@ -141,6 +171,276 @@ func (b *bucketBackends) getObjectLockConfigCondition(bucketName, backendName st
 	return b.backends[bucketName][backendName].ObjectLockConfigurationCondition
 }
 
+func (b *bucketBackends) setCORSConfigCondition(bucketName, backendName string, c *xpv1.Condition) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.backends[bucketName] == nil {
+		b.backends[bucketName] = make(v1alpha1.Backends)
+	}
+
+	if b.backends[bucketName][backendName] == nil {
+		b.backends[bucketName][backendName] = &v1alpha1.BackendInfo{}
+	}
+
+	b.backends[bucketName][backendName].CORSConfigurationCondition = c
+}
+
+func (b *bucketBackends) getCORSConfigCondition(bucketName, backendName string) *xpv1.Condition {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if _, ok := b.backends[bucketName]; !ok {
+		return nil
+	}
+
+	if _, ok := b.backends[bucketName][backendName]; !ok {
+		return nil
+	}
+
+	return b.backends[bucketName][backendName].CORSConfigurationCondition
+}
+
+func (b *bucketBackends) setTaggingCondition(bucketName, backendName string, c *xpv1.Condition) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.backends[bucketName] == nil {
+		b.backends[bucketName] = make(v1alpha1.Backends)
+	}
+
+	if b.backends[bucketName][backendName] == nil {
+		b.backends[bucketName][backendName] = &v1alpha1.BackendInfo{}
+	}
+
+	b.backends[bucketName][backendName].TaggingCondition = c
+}
+
+func (b *bucketBackends) getTaggingCondition(bucketName, backendName string) *xpv1.Condition {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if _, ok := b.backends[bucketName]; !ok {
+		return nil
+	}
+
+	if _, ok := b.backends[bucketName][backendName]; !ok {
+		return nil
+	}
+
+	return b.backends[bucketName][backendName].TaggingCondition
+}
+
+func (b *bucketBackends) setSSEConfigCondition(bucketName, backendName string, c *xpv1.Condition) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.backends[bucketName] == nil {
+		b.backends[bucketName] = make(v1alpha1.Backends)
+	}
+
+	if b.backends[bucketName][backendName] == nil {
+		b.backends[bucketName][backendName] = &v1alpha1.BackendInfo{}
+	}
+
+	b.backends[bucketName][backendName].ServerSideEncryptionConfigurationCondition = c
+}
+
+func (b *bucketBackends) getSSEConfigCondition(bucketName, backendName string) *xpv1.Condition {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if _, ok := b.backends[bucketName]; !ok {
+		return nil
+	}
+
+	if _, ok := b.backends[bucketName][backendName]; !ok {
+		return nil
+	}
+
+	return b.backends[bucketName][backendName].ServerSideEncryptionConfigurationCondition
+}
+
+func (b *bucketBackends) setNotificationConfigCondition(bucketName, backendName string, c *xpv1.Condition) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.backends[bucketName] == nil {
+		b.backends[bucketName] = make(v1alpha1.Backends)
+	}
+
+	if b.backends[bucketName][backendName] == nil {
+		b.backends[bucketName][backendName] = &v1alpha1.BackendInfo{}
+	}
+
+	b.backends[bucketName][backendName].NotificationConfigurationCondition = c
+}
+
+func (b *bucketBackends) getNotificationConfigCondition(bucketName, backendName string) *xpv1.Condition {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if _, ok := b.backends[bucketName]; !ok {
+		return nil
+	}
+
+	if _, ok := b.backends[bucketName][backendName]; !ok {
+		return nil
+	}
+
+	return b.backends[bucketName][backendName].NotificationConfigurationCondition
+}
+
+func (b *bucketBackends) setReplicationConfigCondition(bucketName, backendName string, c *xpv1.Condition) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.backends[bucketName] == nil {
+		b.backends[bucketName] = make(v1alpha1.Backends)
+	}
+
+	if b.backends[bucketName][backendName] == nil {
+		b.backends[bucketName][backendName] = &v1alpha1.BackendInfo{}
+	}
+
+	b.backends[bucketName][backendName].ReplicationConfigurationCondition = c
+}
+
+func (b *bucketBackends) getReplicationConfigCondition(bucketName, backendName string) *xpv1.Condition {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if _, ok := b.backends[bucketName]; !ok {
+		return nil
+	}
+
+	if _, ok := b.backends[bucketName][backendName]; !ok {
+		return nil
+	}
+
+	return b.backends[bucketName][backendName].ReplicationConfigurationCondition
+}
+
+func (b *bucketBackends) setWebsiteConfigCondition(bucketName, backendName string, c *xpv1.Condition) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.backends[bucketName] == nil {
+		b.backends[bucketName] = make(v1alpha1.Backends)
+	}
+
+	if b.backends[bucketName][backendName] == nil {
+		b.backends[bucketName][backendName] = &v1alpha1.BackendInfo{}
+	}
+
+	b.backends[bucketName][backendName].WebsiteConfigurationCondition = c
+}
+
+func (b *bucketBackends) getWebsiteConfigCondition(bucketName, backendName string) *xpv1.Condition {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if _, ok := b.backends[bucketName]; !ok {
+		return nil
+	}
+
+	if _, ok := b.backends[bucketName][backendName]; !ok {
+		return nil
+	}
+
+	return b.backends[bucketName][backendName].WebsiteConfigurationCondition
+}
+
+func (b *bucketBackends) setOwnershipControlsCondition(bucketName, backendName string, c *xpv1.Condition) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.backends[bucketName] == nil {
+		b.backends[bucketName] = make(v1alpha1.Backends)
+	}
+
+	if b.backends[bucketName][backendName] == nil {
+		b.backends[bucketName][backendName] = &v1alpha1.BackendInfo{}
+	}
+
+	b.backends[bucketName][backendName].OwnershipControlsCondition = c
+}
+
+func (b *bucketBackends) getOwnershipControlsCondition(bucketName, backendName string) *xpv1.Condition {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if _, ok := b.backends[bucketName]; !ok {
+		return nil
+	}
+
+	if _, ok := b.backends[bucketName][backendName]; !ok {
+		return nil
+	}
+
+	return b.backends[bucketName][backendName].OwnershipControlsCondition
+}
+
+func (b *bucketBackends) setPublicAccessBlockCondition(bucketName, backendName string, c *xpv1.Condition) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.backends[bucketName] == nil {
+		b.backends[bucketName] = make(v1alpha1.Backends)
+	}
+
+	if b.backends[bucketName][backendName] == nil {
+		b.backends[bucketName][backendName] = &v1alpha1.BackendInfo{}
+	}
+
+	b.backends[bucketName][backendName].PublicAccessBlockCondition = c
+}
+
+func (b *bucketBackends) getPublicAccessBlockCondition(bucketName, backendName string) *xpv1.Condition {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if _, ok := b.backends[bucketName]; !ok {
+		return nil
+	}
+
+	if _, ok := b.backends[bucketName][backendName]; !ok {
+		return nil
+	}
+
+	return b.backends[bucketName][backendName].PublicAccessBlockCondition
+}
+
+func (b *bucketBackends) setLoggingConfigCondition(bucketName, backendName string, c *xpv1.Condition) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.backends[bucketName] == nil {
+		b.backends[bucketName] = make(v1alpha1.Backends)
+	}
+
+	if b.backends[bucketName][backendName] == nil {
+		b.backends[bucketName][backendName] = &v1alpha1.BackendInfo{}
+	}
+
+	b.backends[bucketName][backendName].LoggingConfigurationCondition = c
+}
+
+func (b *bucketBackends) getLoggingConfigCondition(bucketName, backendName string) *xpv1.Condition {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if _, ok := b.backends[bucketName]; !ok {
+		return nil
+	}
+
+	if _, ok := b.backends[bucketName][backendName]; !ok {
+		return nil
+	}
+
+	return b.backends[bucketName][backendName].LoggingConfigurationCondition
+}
+
 func (b *bucketBackends) deleteBackend(bucketName, backendName string) {
 	b.mu.Lock()
 	defer b.mu.Unlock()
@@ -301,3 +601,360 @@ func (b *bucketBackends) isObjectLockConfigAvailableOnBackends(bucketName string
 
 	return true
 }
+
+// isQuotaAvailableOnBackends checks the backends listed in providerNames against
+// bucketBackends to ensure quotas are considered Available on all desired backends.
+func (b *bucketBackends) isQuotaAvailableOnBackends(bucketName string, providerNames []string, c map[string]backendstore.S3Client) bool {
+	for _, backendName := range providerNames {
+		if _, ok := c[backendName]; !ok {
+			// This backend does not exist in the list of available backends.
+			// The backend may be offline, so it is skipped.
+			continue
+		}
+
+		qCondition := b.getQuotaCondition(bucketName, backendName)
+		if qCondition == nil || !qCondition.Equal(xpv1.Available()) {
+			// The quota is not Available on this backend.
+			return false
+		}
+	}
+
+	return true
+}
+
+// isQuotaRemovedFromBackends checks the backends listed in providerNames against
+// bucketBackends to verify a quota is not enabled on any backend.
+func (b *bucketBackends) isQuotaRemovedFromBackends(bucketName string, providerNames []string, c map[string]backendstore.S3Client) bool {
+	for _, backendName := range providerNames {
+		if _, ok := c[backendName]; !ok {
+			// This backend does not exist in the list of available backends.
+			// The backend may be offline, so it is skipped.
+			continue
+		}
+
+		qCondition := b.getQuotaCondition(bucketName, backendName)
+		if qCondition != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isCORSConfigAvailableOnBackends checks the backends listed in providerNames against
+// bucketBackends to ensure CORS configurations are considered Available on all desired backends.
+func (b *bucketBackends) isCORSConfigAvailableOnBackends(bucketName string, providerNames []string, c map[string]backendstore.S3Client) bool {
+	for _, backendName := range providerNames {
+		if _, ok := c[backendName]; !ok {
+			// This backend does not exist in the list of available backends.
+			// The backend may be offline, so it is skipped.
+			continue
+		}
+
+		corsCondition := b.getCORSConfigCondition(bucketName, backendName)
+		if corsCondition == nil || !corsCondition.Equal(xpv1.Available()) {
+			// The CORS config is not Available on this backend.
+			return false
+		}
+	}
+
+	return true
+}
+
+// isCORSConfigRemovedFromBackends checks the backends listed in providerNames against
+// bucketBackends to verify a CORS configuration does not exist on any backend.
+func (b *bucketBackends) isCORSConfigRemovedFromBackends(bucketName string, providerNames []string, c map[string]backendstore.S3Client) bool {
+	for _, backendName := range providerNames {
+		if _, ok := c[backendName]; !ok {
+			// This backend does not exist in the list of available backends.
+			// The backend may be offline, so it is skipped.
+			continue
+		}
+
+		corsCondition := b.getCORSConfigCondition(bucketName, backendName)
+		if corsCondition != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isTaggingSyncedOnBackends checks the backends listed in providerNames against
+// bucketBackends to ensure the tags have neither failed to be applied to, nor to be
+// removed from, any desired backend. The tags are considered synced on a backend
+// without a tagging condition, as the tags of a bucket may be derived from its labels.
+func (b *bucketBackends) isTaggingSyncedOnBackends(bucketName string, providerNames []string, c map[string]backendstore.S3Client) bool {
+	for _, backendName := range providerNames {
+		if _, ok := c[backendName]; !ok {
+			// This backend does not exist in the list of available backends.
+			// The backend may be offline, so it is skipped.
+			continue
+		}
+
+		tCondition := b.getTaggingCondition(bucketName, backendName)
+		if tCondition != nil && !tCondition.Equal(xpv1.Available()) {
+			// The tags are not Available on this backend.
+			return false
+		}
+	}
+
+	return true
+}
+
+// isSSEConfigAvailableOnBackends checks the backends listed in providerNames against
+// bucketBackends to ensure server-side encryption configurations are considered Available on all desired backends.
+func (b *bucketBackends) isSSEConfigAvailableOnBackends(bucketName string, providerNames []string, c map[string]backendstore.S3Client) bool {
+	for _, backendName := range providerNames {
+		if _, ok := c[backendName]; !ok {
+			// This backend does not exist in the list of available backends.
+			// The backend may be offline, so it is skipped.
+			continue
+		}
+
+		sseCondition := b.getSSEConfigCondition(bucketName, backendName)
+		if sseCondition == nil || !sseCondition.Equal(xpv1.Available()) {
+			// The server-side encryption config is not Available on this backend.
+			return false
+		}
+	}
+
+	return true
+}
+
+// isSSEConfigRemovedFromBackends checks the backends listed in providerNames against
+// bucketBackends to verify a server-side encryption configuration does not exist on any backend.
+func (b *bucketBackends) isSSEConfigRemovedFromBackends(bucketName string, providerNames []string, c map[string]backendstore.S3Client) bool {
+	for _, backendName := range providerNames {
+		if _, ok := c[backendName]; !ok {
+			// This backend does not exist in the list of available backends.
+			// The backend may be offline, so it is skipped.
+			continue
+		}
+
+		sseCondition := b.getSSEConfigCondition(bucketName, backendName)
+		if sseCondition != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isNotificationConfigAvailableOnBackends checks the backends listed in providerNames against
+// bucketBackends to ensure notification configurations are considered Available on all desired backends.
+func (b *bucketBackends) isNotificationConfigAvailableOnBackends(bucketName string, providerNames []string, c map[string]backendstore.S3Client) bool {
+	for _, backendName := range providerNames {
+		if _, ok := c[backendName]; !ok {
+			// This backend does not exist in the list of available backends.
+			// The backend may be offline, so it is skipped.
+			continue
+		}
+
+		notificationCondition := b.getNotificationConfigCondition(bucketName, backendName)
+		if notificationCondition == nil || !notificationCondition.Equal(xpv1.Available()) {
+			// The notification config is not Available on this backend.
+			return false
+		}
+	}
+
+	return true
+}
+
+// isNotificationConfigRemovedFromBackends checks the backends listed in providerNames against
+// bucketBackends to verify a notification configuration does not exist on any backend.
+func (b *bucketBackends) isNotificationConfigRemovedFromBackends(bucketName string, providerNames []string, c map[string]backendstore.S3Client) bool {
+	for _, backendName := range providerNames {
+		if _, ok := c[backendName]; !ok {
+			// This backend does not exist in the list of available backends.
+			// The backend may be offline, so it is skipped.
+			continue
+		}
+
+		notificationCondition := b.getNotificationConfigCondition(bucketName, backendName)
+		if notificationCondition != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isReplicationConfigAvailableOnBackends checks the backends listed in providerNames against
+// bucketBackends to ensure replication configurations are considered Available on all desired backends.
+func (b *bucketBackends) isReplicationConfigAvailableOnBackends(bucketName string, providerNames []string, c map[string]backendstore.S3Client) bool {
+	for _, backendName := range providerNames {
+		if _, ok := c[backendName]; !ok {
+			// This backend does not exist in the list of available backends.
+			// The backend may be offline, so it is skipped.
+			continue
+		}
+
+		replicationCondition := b.getReplicationConfigCondition(bucketName, backendName)
+		if replicationCondition == nil || !replicationCondition.Equal(xpv1.Available()) {
+			// The replication configuration is not Available on this backend.
+			return false
+		}
+	}
+
+	return true
+}
+
+// isReplicationConfigRemovedFromBackends checks the backends listed in providerNames against
+// bucketBackends to verify a replication configuration does not exist on any backend.
+func (b *bucketBackends) isReplicationConfigRemovedFromBackends(bucketName string, providerNames []string, c map[string]backendstore.S3Client) bool {
+	for _, backendName := range providerNames {
+		if _, ok := c[backendName]; !ok {
+			// This backend does not exist in the list of available backends.
+			// The backend may be offline, so it is skipped.
+			continue
+		}
+
+		replicationCondition := b.getReplicationConfigCondition(bucketName, backendName)
+		if replicationCondition != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isWebsiteConfigAvailableOnBackends checks the backends listed in providerNames against
+// bucketBackends to ensure website configurations are considered Available on all desired backends.
+func (b *bucketBackends) isWebsiteConfigAvailableOnBackends(bucketName string, providerNames []string, c map[string]backendstore.S3Client) bool {
+	for _, backendName := range providerNames {
+		if _, ok := c[backendName]; !ok {
+			// This backend does not exist in the list of available backends.
+			// The backend may be offline, so it is skipped.
+			continue
+		}
+
+		websiteCondition := b.getWebsiteConfigCondition(bucketName, backendName)
+		if websiteCondition == nil || !websiteCondition.Equal(xpv1.Available()) {
+			// The website configuration is not Available on this backend.
+			return false
+		}
+	}
+
+	return true
+}
+
+// isWebsiteConfigRemovedFromBackends checks the backends listed in providerNames against
+// bucketBackends to verify a website configuration does not exist on any backend.
+func (b *bucketBackends) isWebsiteConfigRemovedFromBackends(bucketName string, providerNames []string, c map[string]backendstore.S3Client) bool {
+	for _, backendName := range providerNames {
+		if _, ok := c[backendName]; !ok {
+			// This backend does not exist in the list of available backends.
+			// The backend may be offline, so it is skipped.
+			continue
+		}
+
+		websiteCondition := b.getWebsiteConfigCondition(bucketName, backendName)
+		if websiteCondition != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isOwnershipControlsAvailableOnBackends checks the backends listed in providerNames against
+// bucketBackends to ensure ownership controls are considered Available on all desired backends.
+func (b *bucketBackends) isOwnershipControlsAvailableOnBackends(bucketName string, providerNames []string, c map[string]backendstore.S3Client) bool {
+	for _, backendName := range providerNames {
+		if _, ok := c[backendName]; !ok {
+			// This backend does not exist in the list of available backends.
+			// The backend may be offline, so it is skipped.
+			continue
+		}
+
+		ownershipCondition := b.getOwnershipControlsCondition(bucketName, backendName)
+		if ownershipCondition == nil || !ownershipCondition.Equal(xpv1.Available()) {
+			// The ownership controls are not Available on this backend.
+			return false
+		}
+	}
+
+	return true
+}
+
+// isOwnershipControlsRemovedFromBackends checks the backends listed in providerNames against
+// bucketBackends to verify ownership controls do not exist on any backend.
+func (b *bucketBackends) isOwnershipControlsRemovedFromBackends(bucketName string, providerNames []string, c map[string]backendstore.S3Client) bool {
+	for _, backendName := range providerNames {
+		if _, ok := c[backendName]; !ok {
+			// This backend does not exist in the list of available backends.
+			// The backend may be offline, so it is skipped.
+			continue
+		}
+
+		ownershipCondition := b.getOwnershipControlsCondition(bucketName, backendName)
+		if ownershipCondition != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isPublicAccessBlockSyncedOnBackends checks the backends listed in providerNames
+// against bucketBackends to ensure the public access block configuration has neither
+// failed to be applied to, nor to be removed from, any desired backend. It is considered
+// synced on a backend without a condition, as the public access block configuration of
+// a bucket may be a provider-wide default.
+func (b *bucketBackends) isPublicAccessBlockSyncedOnBackends(bucketName string, providerNames []string, c map[string]backendstore.S3Client) bool {
+	for _, backendName := range providerNames {
+		if _, ok := c[backendName]; !ok {
+			// This backend does not exist in the list of available backends.
+			// The backend may be offline, so it is skipped.
+			continue
+		}
+
+		pabCondition := b.getPublicAccessBlockCondition(bucketName, backendName)
+		if pabCondition != nil && !pabCondition.Equal(xpv1.Available()) {
+			// The public access block configuration is not Available on this backend.
+			return false
+		}
+	}
+
+	return true
+}
+
+// isLoggingConfigAvailableOnBackends checks the backends listed in providerNames against
+// bucketBackends to ensure logging configurations are considered Available on all desired backends.
+func (b *bucketBackends) isLoggingConfigAvailableOnBackends(bucketName string, providerNames []string, c map[string]backendstore.S3Client) bool {
+	for _, backendName := range providerNames {
+		if _, ok := c[backendName]; !ok {
+			// This backend does not exist in the list of available backends.
+			// The backend may be offline, so it is skipped.
+			continue
+		}
+
+		loggingCondition := b.getLoggingConfigCondition(bucketName, backendName)
+		if loggingCondition == nil || !loggingCondition.Equal(xpv1.Available()) {
+			// The logging configuration is not Available on this backend.
+			return false
+		}
+	}
+
+	return true
+}
+
+// isLoggingConfigRemovedFromBackends checks the backends listed in providerNames against
+// bucketBackends to verify a logging configuration does not exist on any backend.
+func (b *bucketBackends) isLoggingConfigRemovedFromBackends(bucketName string, providerNames []string, c map[string]backendstore.S3Client) bool {
+	for _, backendName := range providerNames {
+		if _, ok := c[backendName]; !ok {
+			// This backend does not exist in the list of available backends.
+			// The backend may be offline, so it is skipped.
+			continue
+		}
+
+		loggingCondition := b.getLoggingConfigCondition(bucketName, backendName)
+		if loggingCondition != nil {
+			return false
+		}
+	}
+
+	return true
+}
@@ -19,45 +19,82 @@ package bucket
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	nsv1alpha1 "github.com/linode/provider-ceph/apis/namespaced/provider-ceph/v1alpha1"
 	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
 	"github.com/linode/provider-ceph/internal/backendstore"
 	"github.com/linode/provider-ceph/internal/rgw"
 	"github.com/linode/provider-ceph/internal/utils"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
-const errValidatingLifecycleConfig = "unable to validate lifecycle configuration"
+const (
+	errValidatingLifecycleConfig = "unable to validate lifecycle configuration"
+
+	// bucketNameField is the field selector of the name of a Bucket, which is
+	// supported by the API server for custom resources.
+	bucketNameField = "metadata.name"
+)
+
+// publicACLGroups are the URIs of the grantee groups which make a bucket public.
+var publicACLGroups = []string{
+	"http://acs.amazonaws.com/groups/global/AllUsers",
+	"http://acs.amazonaws.com/groups/global/AuthenticatedUsers",
+}
 
 type BucketValidator struct {
 	backendStore *backendstore.BackendStore
+	// kube reads Buckets from the API server, as paused Buckets are not
+	// cached by the manager.
+	kube client.Reader
+	// defaultPublicAccessBlock specifies whether all public access is blocked
+	// for buckets which do not specify a public access block configuration.
+	defaultPublicAccessBlock bool
 }
 
-func NewBucketValidator(b *backendstore.BackendStore) *BucketValidator {
+func NewBucketValidator(b *backendstore.BackendStore, kube client.Reader, defaultPublicAccessBlock bool) *BucketValidator {
 	bucketValidator := &BucketValidator{
-		backendStore: b,
+		backendStore:             b,
+		kube:                     kube,
+		defaultPublicAccessBlock: defaultPublicAccessBlock,
 	}
 
 	return bucketValidator
 }
 
 //+kubebuilder:webhook:path=/validate-provider-ceph-ceph-crossplane-io-v1alpha1-bucket,mutating=false,failurePolicy=fail,sideEffects=None,groups=provider-ceph.ceph.crossplane.io,resources=buckets,verbs=create;update,versions=v1alpha1,name=bucket-validation.providerceph.crossplane.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-provider-ceph-m-ceph-crossplane-io-v1alpha1-bucket,mutating=false,failurePolicy=fail,sideEffects=None,groups=provider-ceph.m.ceph.crossplane.io,resources=buckets,verbs=create;update,versions=v1alpha1,name=namespaced-bucket-validation.providerceph.crossplane.io,admissionReviewVersions=v1
 
 func (b *BucketValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
-	bucket, ok := obj.(*v1alpha1.Bucket)
-	if !ok {
-		return nil, errors.New(errNotBucket)
+	bucket, err := asClusterBucket(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	// The name of a bucket cannot change, so its uniqueness is only
+	// validated on creation.
+	if err := b.validateUniqueName(ctx, bucket); err != nil {
+		return nil, err
 	}
 
 	return nil, b.validateCreateOrUpdate(ctx, bucket)
 }
 
 func (b *BucketValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
-	bucket, ok := newObj.(*v1alpha1.Bucket)
-	if !ok {
-		return nil, errors.New(errNotBucket)
+	bucket, err := asClusterBucket(newObj)
+	if err != nil {
+		return nil, err
 	}
 
 	if bucket.DeletionTimestamp != nil {
@@ -71,14 +108,74 @@ func (b *BucketValidator) ValidateDelete(ctx context.Context, obj runtime.Object
 	return nil, nil
 }
 
+// asClusterBucket returns the Bucket of either scope as a cluster scoped Bucket.
+// A namespaced Bucket is validated by means of its cluster scoped view.
+func asClusterBucket(obj runtime.Object) (*v1alpha1.Bucket, error) {
+	switch bucket := obj.(type) {
+	case *v1alpha1.Bucket:
+		return bucket, nil
+	case *nsv1alpha1.Bucket:
+		return toClusterBucket(bucket), nil
+	default:
+		return nil, errors.New(errNotBucket)
+	}
+}
+
 func (b *BucketValidator) validateCreateOrUpdate(ctx context.Context, bucket *v1alpha1.Bucket) error {
 	if len(bucket.Spec.Providers) != 0 {
-		missingProviders := utils.MissingStrings(bucket.Spec.Providers, b.backendStore.GetAllBackendNames())
+		backendNames := b.backendStore.GetBackendNamesForNamespace(bucket.Namespace)
+		missingProviders := utils.MissingStrings(resolveBucketProviders(bucket, backendNames), backendNames)
 		if len(missingProviders) != 0 {
 			return errors.New(fmt.Sprintf("providers %v listed in bucket.Spec.Providers cannot be found", missingProviders))
 		}
 	}
 
+	if bucket.Spec.Placement != nil && bucket.Spec.Placement.Selector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(bucket.Spec.Placement.Selector); err != nil {
+			return errors.Wrap(err, errPlacementSelector)
+		}
+	}
+
+	if bucket.Spec.ReplicaSync != nil {
+		if err := validateReplicaSync(bucket); err != nil {
+			return err
+		}
+	}
+
+	if bucket.Spec.ForProvider.ServerSideEncryptionConfiguration.HasKMSMasterKeyID() {
+		if err := b.validateKMSSupport(bucket); err != nil {
+			return err
+		}
+	}
+
+	if bucket.Spec.ForProvider.ReplicationConfiguration != nil {
+		if err := validateReplicationConfiguration(bucket); err != nil {
+			return err
+		}
+	}
+
+	if err := b.validatePublicAccessBlock(bucket); err != nil {
+		return err
+	}
+
+	if err := validateBucketPolicy(bucket); err != nil {
+		return err
+	}
+
+	if err := validateAssumeRolePolicy(bucket); err != nil {
+		return err
+	}
+
+	if err := b.validateAssumeRoleArn(bucket); err != nil {
+		return err
+	}
+
+	if bucket.Spec.ForProvider.LoggingConfiguration != nil {
+		if err := b.validateLoggingConfiguration(ctx, bucket); err != nil {
+			return err
+		}
+	}
+
 	if !bucket.Spec.LifecycleConfigurationDisabled && bucket.Spec.ForProvider.LifecycleConfiguration != nil {
 		if err := b.validateLifecycleConfiguration(ctx, bucket); err != nil {
 			return errors.Wrap(err, errValidatingLifecycleConfig)
@@ -88,6 +185,223 @@ func (b *BucketValidator) validateCreateOrUpdate(ctx context.Context, bucket *v1
 	return nil
 }
 
+// validateReplicaSync checks that the primary backend of the replica sync is one
+// of the providers of the bucket, if they are specified, and that the bandwidth
+// limit is positive.
+func validateReplicaSync(bucket *v1alpha1.Bucket) error {
+	sync := bucket.Spec.ReplicaSync
+	if len(bucket.Spec.Providers) != 0 && !slices.Contains(bucket.Spec.Providers, sync.Primary) {
+		return errors.New(fmt.Sprintf("primary %q of bucket.Spec.ReplicaSync is not listed in bucket.Spec.Providers", sync.Primary))
+	}
+	if sync.BandwidthLimit != nil && sync.BandwidthLimit.Sign() <= 0 {
+		return errors.New(errReplicaSyncBandwidthLimit)
+	}
+
+	return nil
+}
+
+// validateReplicationConfiguration checks that versioning is Enabled on the
+// bucket, as backends reject the replication configuration of an unversioned
+// bucket.
+func validateReplicationConfiguration(bucket *v1alpha1.Bucket) error {
+	versioning := bucket.Spec.ForProvider.VersioningConfiguration
+	if versioning == nil || versioning.Status == nil || *versioning.Status != v1alpha1.VersioningStatusEnabled {
+		return errors.New(errReplicationRequiresVersioning)
+	}
+
+	return nil
+}
+
+// validatePublicAccessBlock checks that the ACL of the bucket does not grant
+// public access when public ACLs are blocked, either by the public access block
+// configuration of the bucket or by default.
+func (b *BucketValidator) validatePublicAccessBlock(bucket *v1alpha1.Bucket) error {
+	config := bucket.Spec.ForProvider.PublicAccessBlockConfiguration
+	if config == nil && !b.defaultPublicAccessBlock || config != nil && !config.BlocksPublicAcls() {
+		return nil
+	}
+	if hasPublicACL(&bucket.Spec.ForProvider) {
+		return errors.New(errPublicACLBlocked)
+	}
+
+	return nil
+}
+
+// hasPublicACL returns true if the canned ACL, the grants or the access control
+// policy of the bucket grant access to all users or to all authenticated users.
+func hasPublicACL(params *v1alpha1.BucketParameters) bool {
+	switch aws.ToString(params.ACL) {
+	case string(s3types.BucketCannedACLPublicRead), string(s3types.BucketCannedACLPublicReadWrite), string(s3types.BucketCannedACLAuthenticatedRead):
+		return true
+	}
+
+	for _, grant := range []*string{params.GrantFullControl, params.GrantRead, params.GrantReadACP, params.GrantWrite, params.GrantWriteACP} {
+		for _, group := range publicACLGroups {
+			if strings.Contains(aws.ToString(grant), group) {
+				return true
+			}
+		}
+	}
+
+	if params.AccessControlPolicy != nil {
+		for _, grant := range params.AccessControlPolicy.Grants {
+			if grant.Grantee != nil && slices.Contains(publicACLGroups, aws.ToString(grant.Grantee.URI)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// validateBucketPolicy checks that the bucket does not specify both a JSON and
+// a structured bucket policy, and that its bucket policy is well formed, as
+// backends reject malformed policies.
+func validateBucketPolicy(bucket *v1alpha1.Bucket) error {
+	if bucket.Spec.ForProvider.Policy != "" && bucket.Spec.ForProvider.BucketPolicy != nil {
+		return errors.New(errPolicyAndBucketPolicy)
+	}
+
+	policy, err := rgw.GenerateBucketPolicy(&bucket.Spec.ForProvider)
+	if err != nil {
+		return err
+	}
+	if policy == "" {
+		return nil
+	}
+
+	return errors.Wrap(rgw.ValidateBucketPolicy(policy), errInvalidBucketPolicy)
+}
+
+// validateAssumeRolePolicy checks that the session policy of the bucket is a
+// well formed policy, as AssumeRole requests with a malformed session policy
+// fail and no S3 operation could be performed for the bucket.
+func validateAssumeRolePolicy(bucket *v1alpha1.Bucket) error {
+	if bucket.Spec.ForProvider.AssumeRolePolicy == nil {
+		return nil
+	}
+
+	return errors.Wrap(rgw.ValidateSessionPolicy(*bucket.Spec.ForProvider.AssumeRolePolicy), errInvalidAssumeRolePolicy)
+}
+
+// validateAssumeRoleArn checks that the role of the bucket is allowed by the
+// ProviderConfig of each backend of the bucket, as no S3 operation could be
+// performed for the bucket on the other backends.
+func (b *BucketValidator) validateAssumeRoleArn(bucket *v1alpha1.Bucket) error {
+	roleArn := aws.ToString(bucket.Spec.ForProvider.AssumeRoleArn)
+	if roleArn == "" {
+		return nil
+	}
+
+	disallowed := []string{}
+	for _, backendName := range getBucketProvidersFilterDisabledLabel(bucket, b.backendStore.GetBackendNamesForNamespace(bucket.Namespace)) {
+		if !b.backendStore.GetBackendAssumeRole(backendName).AllowsRoleARN(roleArn) {
+			disallowed = append(disallowed, backendName)
+		}
+	}
+	if len(disallowed) != 0 {
+		return errors.New(fmt.Sprintf("role %q of bucket.Spec.ForProvider.AssumeRoleArn is not allowed by the ProviderConfigs of backends %v", roleArn, disallowed))
+	}
+
+	return nil
+}
+
+// validateLoggingConfiguration checks that the target bucket of the logging
+// configuration is a Bucket managed by provider-ceph, in the namespace of the
+// bucket if it is namespaced, on all the backends of the bucket, as the logs
+// of the bucket are delivered to the target bucket on the same backend.
+func (b *BucketValidator) validateLoggingConfiguration(ctx context.Context, bucket *v1alpha1.Bucket) error {
+	targetName := bucket.Spec.ForProvider.LoggingConfiguration.TargetBucket
+
+	target, err := b.getBucket(ctx, bucket.Namespace, targetName)
+	if kerrors.IsNotFound(err) {
+		return errors.New(fmt.Sprintf("target bucket %q of bucket.Spec.ForProvider.LoggingConfiguration is not managed by provider-ceph", targetName))
+	}
+	if err != nil {
+		return errors.Wrap(err, errGetLoggingTargetBucket)
+	}
+
+	backendNames := b.backendStore.GetBackendNamesForNamespace(bucket.Namespace)
+	missingBackends := utils.MissingStrings(getBucketProvidersFilterDisabledLabel(bucket, backendNames), getBucketProvidersFilterDisabledLabel(target, backendNames))
+	if len(missingBackends) != 0 {
+		return errors.New(fmt.Sprintf("target bucket %q of bucket.Spec.ForProvider.LoggingConfiguration is not managed on backends %v", targetName, missingBackends))
+	}
+
+	return nil
+}
+
+// validateUniqueName checks that no other Bucket of either scope has the name
+// of the bucket. The name of a Bucket is the name of its bucket on the backends
+// regardless of its namespace, so Buckets with the same name in different
+// namespaces, or a namespaced and a cluster scoped Bucket with the same name,
+// would manage the same bucket.
+func (b *BucketValidator) validateUniqueName(ctx context.Context, bucket *v1alpha1.Bucket) error {
+	if bucket.Namespace != "" {
+		err := b.kube.Get(ctx, types.NamespacedName{Name: bucket.Name}, &v1alpha1.Bucket{})
+		if err == nil {
+			return errors.New(fmt.Sprintf("bucket name %q is already used by a cluster scoped Bucket", bucket.Name))
+		}
+		if !kerrors.IsNotFound(err) {
+			return errors.Wrap(err, errGetBucketsWithName)
+		}
+	}
+
+	namespaced := &nsv1alpha1.BucketList{}
+	if err := b.kube.List(ctx, namespaced, client.MatchingFields{bucketNameField: bucket.Name}); err != nil {
+		return errors.Wrap(err, errGetBucketsWithName)
+	}
+	for i := range namespaced.Items {
+		if namespaced.Items[i].Namespace != bucket.Namespace {
+			return errors.New(fmt.Sprintf("bucket name %q is already used by a Bucket in namespace %q", bucket.Name, namespaced.Items[i].Namespace))
+		}
+	}
+
+	return nil
+}
+
+// getBucket returns the Bucket with the given name as a cluster scoped Bucket.
+// A namespaced Bucket is looked up in the given namespace, if it is not empty.
+func (b *BucketValidator) getBucket(ctx context.Context, namespace, name string) (*v1alpha1.Bucket, error) {
+	if namespace == "" {
+		bucket := &v1alpha1.Bucket{}
+		if err := b.kube.Get(ctx, types.NamespacedName{Name: name}, bucket); err != nil {
+			return nil, err
+		}
+
+		return bucket, nil
+	}
+
+	bucket := &nsv1alpha1.Bucket{}
+	if err := b.kube.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, bucket); err != nil {
+		return nil, err
+	}
+
+	return toClusterBucket(bucket), nil
+}
+
+// validateKMSSupport checks that all the backends on which the bucket may be
+// created support KMS, which is required by a KMS key ID in the server-side
+// encryption configuration of the bucket. The bucket may be created on the
+// providers of the bucket, if they are specified, or on any backend otherwise.
+func (b *BucketValidator) validateKMSSupport(bucket *v1alpha1.Bucket) error {
+	backendNames := b.backendStore.GetBackendNamesForNamespace(bucket.Namespace)
+	if len(bucket.Spec.Providers) != 0 {
+		backendNames = resolveBucketProviders(bucket, backendNames)
+	}
+
+	unsupported := []string{}
+	for _, backendName := range backendNames {
+		if !b.backendStore.GetBackendCapabilities(backendName).KMS {
+			unsupported = append(unsupported, backendName)
+		}
+	}
+	if len(unsupported) != 0 {
+		return errors.New(fmt.Sprintf("backends %v do not support the KMS key ID of bucket.Spec.ForProvider.ServerSideEncryptionConfiguration", unsupported))
+	}
+
+	return nil
+}
+
 func (b *BucketValidator) validateLifecycleConfiguration(ctx context.Context, bucket *v1alpha1.Bucket) error {
 	s3Client := b.backendStore.GetAllBackends().GetFirst()
 	if s3Client == nil {
@@ -0,0 +1,653 @@
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	nsv1alpha1 "github.com/linode/provider-ceph/apis/namespaced/provider-ceph/v1alpha1"
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/backendstore/backendstorefakes"
+	"github.com/linode/provider-ceph/internal/consts"
+)
+
+func TestValidateKMSSupport(t *testing.T) {
+	t.Parallel()
+
+	withKMSKey := func(providers ...string) *v1alpha1.Bucket {
+		return &v1alpha1.Bucket{
+			ObjectMeta: metav1.ObjectMeta{Name: consts.TestBucket},
+			Spec: v1alpha1.BucketSpec{
+				Providers: providers,
+				ForProvider: v1alpha1.BucketParameters{
+					ServerSideEncryptionConfiguration: &v1alpha1.ServerSideEncryptionConfiguration{
+						Rules: []v1alpha1.ServerSideEncryptionRule{
+							{
+								ApplyServerSideEncryptionByDefault: v1alpha1.ServerSideEncryptionByDefault{
+									SSEAlgorithm:   "aws:kms",
+									KMSMasterKeyID: aws.String("key"),
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		bucket  *v1alpha1.Bucket
+		wantErr bool
+	}{
+		"KMS key ID is rejected when a backend has no KMS support": {
+			bucket:  withKMSKey(),
+			wantErr: true,
+		},
+		"KMS key ID is allowed on the providers with KMS support": {
+			bucket: withKMSKey(consts.S3Backend1),
+		},
+		"KMS key ID is rejected on providers without KMS support": {
+			bucket:  withKMSKey(consts.S3Backend1, consts.S3Backend2),
+			wantErr: true,
+		},
+		"Default encryption without KMS key ID is allowed on all backends": {
+			bucket: &v1alpha1.Bucket{
+				ObjectMeta: metav1.ObjectMeta{Name: consts.TestBucket},
+				Spec: v1alpha1.BucketSpec{
+					ForProvider: v1alpha1.BucketParameters{
+						ServerSideEncryptionConfiguration: &v1alpha1.ServerSideEncryptionConfiguration{
+							Rules: []v1alpha1.ServerSideEncryptionRule{
+								{ApplyServerSideEncryptionByDefault: v1alpha1.ServerSideEncryptionByDefault{SSEAlgorithm: "AES256"}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			bs := backendstore.NewBackendStore()
+			bs.AddOrUpdateBackend(consts.S3Backend1, &backendstorefakes.FakeS3Client{}, nil, apisv1alpha1.HealthStatusHealthy)
+			bs.SetBackendCapabilities(consts.S3Backend1, apisv1alpha1.BackendCapabilities{KMS: true})
+			bs.AddOrUpdateBackend(consts.S3Backend2, &backendstorefakes.FakeS3Client{}, nil, apisv1alpha1.HealthStatusHealthy)
+
+			_, err := NewBucketValidator(bs, newFakeBucketReader(), false).ValidateCreate(context.Background(), tc.bucket)
+			if tc.wantErr {
+				assert.Error(t, err, "expected bucket to be rejected")
+			} else {
+				assert.NoError(t, err, "unexpected error")
+			}
+		})
+	}
+}
+
+func TestValidateReplicationConfiguration(t *testing.T) {
+	t.Parallel()
+
+	withReplication := func(versioning *v1alpha1.VersioningConfiguration) *v1alpha1.Bucket {
+		return &v1alpha1.Bucket{
+			ObjectMeta: metav1.ObjectMeta{Name: consts.TestBucket},
+			Spec: v1alpha1.BucketSpec{
+				ForProvider: v1alpha1.BucketParameters{
+					VersioningConfiguration: versioning,
+					ReplicationConfiguration: &v1alpha1.ReplicationConfiguration{
+						Rules: []v1alpha1.ReplicationRule{
+							{
+								ID:          "replicate",
+								Status:      "Enabled",
+								Destination: v1alpha1.ReplicationDestination{Bucket: "destination"},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	status := func(s v1alpha1.VersioningStatus) *v1alpha1.VersioningConfiguration {
+		return &v1alpha1.VersioningConfiguration{Status: &s}
+	}
+
+	cases := map[string]struct {
+		bucket  *v1alpha1.Bucket
+		wantErr bool
+	}{
+		"Replication is rejected without versioning": {
+			bucket:  withReplication(nil),
+			wantErr: true,
+		},
+		"Replication is rejected without versioning status": {
+			bucket:  withReplication(&v1alpha1.VersioningConfiguration{}),
+			wantErr: true,
+		},
+		"Replication is rejected with suspended versioning": {
+			bucket:  withReplication(status(v1alpha1.VersioningStatusSuspended)),
+			wantErr: true,
+		},
+		"Replication is allowed with enabled versioning": {
+			bucket: withReplication(status(v1alpha1.VersioningStatusEnabled)),
+		},
+		"Suspended versioning is allowed without replication": {
+			bucket: &v1alpha1.Bucket{
+				ObjectMeta: metav1.ObjectMeta{Name: consts.TestBucket},
+				Spec: v1alpha1.BucketSpec{
+					ForProvider: v1alpha1.BucketParameters{
+						VersioningConfiguration: status(v1alpha1.VersioningStatusSuspended),
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewBucketValidator(backendstore.NewBackendStore(), newFakeBucketReader(), false).ValidateCreate(context.Background(), tc.bucket)
+			if tc.wantErr {
+				assert.Error(t, err, "expected bucket to be rejected")
+			} else {
+				assert.NoError(t, err, "unexpected error")
+			}
+		})
+	}
+}
+
+func TestValidatePublicAccessBlock(t *testing.T) {
+	t.Parallel()
+
+	withACL := func(params v1alpha1.BucketParameters) *v1alpha1.Bucket {
+		return &v1alpha1.Bucket{
+			ObjectMeta: metav1.ObjectMeta{Name: consts.TestBucket},
+			Spec: v1alpha1.BucketSpec{
+				ForProvider: params,
+			},
+		}
+	}
+	allowPublicAcls := &v1alpha1.PublicAccessBlockConfiguration{BlockPublicAcls: aws.Bool(false)}
+	blockPublicAcls := &v1alpha1.PublicAccessBlockConfiguration{BlockPublicAcls: aws.Bool(true)}
+
+	cases := map[string]struct {
+		defaultBlock bool
+		bucket       *v1alpha1.Bucket
+		wantErr      bool
+	}{
+		"Public canned ACL is allowed without a block": {
+			bucket: withACL(v1alpha1.BucketParameters{ACL: aws.String("public-read")}),
+		},
+		"Public canned ACL is rejected by default block": {
+			defaultBlock: true,
+			bucket:       withACL(v1alpha1.BucketParameters{ACL: aws.String("public-read-write")}),
+			wantErr:      true,
+		},
+		"Private canned ACL is allowed by default block": {
+			defaultBlock: true,
+			bucket:       withACL(v1alpha1.BucketParameters{ACL: aws.String("private")}),
+		},
+		"Public canned ACL is allowed when bucket opts out of default block": {
+			defaultBlock: true,
+			bucket:       withACL(v1alpha1.BucketParameters{ACL: aws.String("public-read"), PublicAccessBlockConfiguration: allowPublicAcls}),
+		},
+		"Public canned ACL is rejected by block of bucket": {
+			bucket:  withACL(v1alpha1.BucketParameters{ACL: aws.String("authenticated-read"), PublicAccessBlockConfiguration: blockPublicAcls}),
+			wantErr: true,
+		},
+		"Public grant is rejected by block of bucket": {
+			bucket: withACL(v1alpha1.BucketParameters{
+				GrantRead:                      aws.String(`id="owner", uri="http://acs.amazonaws.com/groups/global/AllUsers"`),
+				PublicAccessBlockConfiguration: blockPublicAcls,
+			}),
+			wantErr: true,
+		},
+		"Public access control policy is rejected by default block": {
+			defaultBlock: true,
+			bucket: withACL(v1alpha1.BucketParameters{
+				AccessControlPolicy: &v1alpha1.AccessControlPolicy{
+					Grants: []v1alpha1.Grant{
+						{
+							Grantee:    &v1alpha1.Grantee{Type: v1alpha1.TypeGroup, URI: aws.String("http://acs.amazonaws.com/groups/global/AuthenticatedUsers")},
+							Permission: v1alpha1.PermissionRead,
+						},
+					},
+				},
+			}),
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewBucketValidator(backendstore.NewBackendStore(), newFakeBucketReader(), tc.defaultBlock).ValidateCreate(context.Background(), tc.bucket)
+			if tc.wantErr {
+				assert.Error(t, err, "expected bucket to be rejected")
+			} else {
+				assert.NoError(t, err, "unexpected error")
+			}
+		})
+	}
+}
+
+func TestValidateBucketPolicy(t *testing.T) {
+	t.Parallel()
+
+	withPolicy := func(policy string, bucketPolicy *v1alpha1.BucketPolicy) *v1alpha1.Bucket {
+		return &v1alpha1.Bucket{
+			ObjectMeta: metav1.ObjectMeta{Name: consts.TestBucket},
+			Spec: v1alpha1.BucketSpec{
+				ForProvider: v1alpha1.BucketParameters{
+					Policy:       policy,
+					BucketPolicy: bucketPolicy,
+				},
+			},
+		}
+	}
+	bucketPolicy := &v1alpha1.BucketPolicy{
+		Statements: []v1alpha1.BucketPolicyStatement{
+			{
+				Effect:    "Allow",
+				Principal: v1alpha1.BucketPolicyPrincipal{AllPrincipals: aws.Bool(true)},
+				Actions:   []string{"s3:GetObject"},
+				Resources: []string{"arn:aws:s3:::bucket/*"},
+			},
+		},
+	}
+	policy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`
+
+	cases := map[string]struct {
+		bucket  *v1alpha1.Bucket
+		wantErr bool
+	}{
+		"No policy is allowed": {
+			bucket: withPolicy("", nil),
+		},
+		"Valid JSON policy is allowed": {
+			bucket: withPolicy(policy, nil),
+		},
+		"Structured policy is allowed": {
+			bucket: withPolicy("", bucketPolicy),
+		},
+		"Malformed JSON policy is rejected": {
+			bucket:  withPolicy(`{"Statement": [{"Effect": "Allow"},]}`, nil),
+			wantErr: true,
+		},
+		"JSON policy without resources is rejected": {
+			bucket:  withPolicy(`{"Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject"}]}`, nil),
+			wantErr: true,
+		},
+		"JSON and structured policies are rejected": {
+			bucket:  withPolicy(policy, bucketPolicy),
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewBucketValidator(backendstore.NewBackendStore(), newFakeBucketReader(), false).ValidateCreate(context.Background(), tc.bucket)
+			if tc.wantErr {
+				assert.Error(t, err, "expected bucket to be rejected")
+			} else {
+				assert.NoError(t, err, "unexpected error")
+			}
+		})
+	}
+}
+
+func TestValidateAssumeRolePolicy(t *testing.T) {
+	t.Parallel()
+
+	withAssumeRolePolicy := func(policy *string) *v1alpha1.Bucket {
+		return &v1alpha1.Bucket{
+			ObjectMeta: metav1.ObjectMeta{Name: consts.TestBucket},
+			Spec: v1alpha1.BucketSpec{
+				ForProvider: v1alpha1.BucketParameters{
+					AssumeRolePolicy: policy,
+				},
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		bucket  *v1alpha1.Bucket
+		wantErr bool
+	}{
+		"No session policy is allowed": {
+			bucket: withAssumeRolePolicy(nil),
+		},
+		"JSON object session policy is allowed": {
+			bucket: withAssumeRolePolicy(aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"arn:aws:s3:::bucket"}]}`)),
+		},
+		"Malformed session policy is rejected": {
+			bucket:  withAssumeRolePolicy(aws.String(`{"Statement": [}`)),
+			wantErr: true,
+		},
+		"Session policy which is not a JSON object is rejected": {
+			bucket:  withAssumeRolePolicy(aws.String(`["s3:*"]`)),
+			wantErr: true,
+		},
+		"Session policy without version is rejected": {
+			bucket:  withAssumeRolePolicy(aws.String(`{"Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`)),
+			wantErr: true,
+		},
+		"Session policy without statements is rejected": {
+			bucket:  withAssumeRolePolicy(aws.String(`{"Version":"2012-10-17"}`)),
+			wantErr: true,
+		},
+		"Session policy statement without action is rejected": {
+			bucket:  withAssumeRolePolicy(aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Resource":"*"}]}`)),
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewBucketValidator(backendstore.NewBackendStore(), newFakeBucketReader(), false).ValidateCreate(context.Background(), tc.bucket)
+			if tc.wantErr {
+				assert.ErrorContains(t, err, errInvalidAssumeRolePolicy, "expected bucket to be rejected")
+			} else {
+				assert.NoError(t, err, "unexpected error")
+			}
+		})
+	}
+}
+
+func TestValidateAssumeRoleArn(t *testing.T) {
+	t.Parallel()
+
+	withAssumeRoleArn := func(roleArn string, providers ...string) *v1alpha1.Bucket {
+		return &v1alpha1.Bucket{
+			ObjectMeta: metav1.ObjectMeta{Name: consts.TestBucket},
+			Spec: v1alpha1.BucketSpec{
+				Providers: providers,
+				ForProvider: v1alpha1.BucketParameters{
+					AssumeRoleArn: aws.String(roleArn),
+				},
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		bucket  *v1alpha1.Bucket
+		wantErr bool
+	}{
+		"No role is allowed": {
+			bucket: &v1alpha1.Bucket{ObjectMeta: metav1.ObjectMeta{Name: consts.TestBucket}},
+		},
+		"Role allowed on the providers is allowed": {
+			bucket: withAssumeRoleArn("bucket-role", consts.S3Backend1),
+		},
+		"Role of the provider config is allowed": {
+			bucket: withAssumeRoleArn("pc-role", consts.S3Backend1),
+		},
+		"Role is rejected when a backend does not allow it": {
+			bucket:  withAssumeRoleArn("bucket-role"),
+			wantErr: true,
+		},
+		"Role not allowed on the providers is rejected": {
+			bucket:  withAssumeRoleArn("other-role", consts.S3Backend1),
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			bs := backendstore.NewBackendStore()
+			bs.AddOrUpdateBackend(consts.S3Backend1, &backendstorefakes.FakeS3Client{}, nil, apisv1alpha1.HealthStatusHealthy)
+			bs.SetBackendAssumeRole(consts.S3Backend1, &apisv1alpha1.AssumeRoleConfig{
+				RoleARN:         aws.String("pc-role"),
+				AllowedRoleARNs: []string{"bucket-role"},
+			})
+			bs.AddOrUpdateBackend(consts.S3Backend2, &backendstorefakes.FakeS3Client{}, nil, apisv1alpha1.HealthStatusHealthy)
+
+			_, err := NewBucketValidator(bs, newFakeBucketReader(), false).ValidateCreate(context.Background(), tc.bucket)
+			if tc.wantErr {
+				assert.Error(t, err, "expected bucket to be rejected")
+			} else {
+				assert.NoError(t, err, "unexpected error")
+			}
+		})
+	}
+}
+
+func TestValidateProviders(t *testing.T) {
+	t.Parallel()
+
+	// Backend 1 is of a legacy ProviderConfig, backend 2 of a ClusterProviderConfig
+	// and backend 3 of a ProviderConfig in namespace team-a.
+	namespacedBucket := func(providers ...string) *nsv1alpha1.Bucket {
+		return &nsv1alpha1.Bucket{
+			ObjectMeta: metav1.ObjectMeta{Name: consts.TestBucket, Namespace: "team-a"},
+			Spec:       nsv1alpha1.BucketSpec{Providers: providers},
+		}
+	}
+
+	cases := map[string]struct {
+		bucket  runtime.Object
+		wantErr bool
+	}{
+		"Cluster scoped bucket may target a legacy provider config": {
+			bucket: &v1alpha1.Bucket{
+				ObjectMeta: metav1.ObjectMeta{Name: consts.TestBucket},
+				Spec:       v1alpha1.BucketSpec{Providers: []string{consts.S3Backend1}},
+			},
+		},
+		"Namespaced bucket may target a cluster provider config": {
+			bucket: namespacedBucket(consts.S3Backend2),
+		},
+		"Namespaced bucket may target a provider config in its namespace": {
+			bucket: namespacedBucket(consts.S3Backend3),
+		},
+		"Namespaced bucket cannot target a legacy provider config": {
+			bucket:  namespacedBucket(consts.S3Backend1),
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			bs := backendstore.NewBackendStore()
+			bs.AddOrUpdateBackend(consts.S3Backend1, &backendstorefakes.FakeS3Client{}, nil, apisv1alpha1.HealthStatusHealthy)
+			bs.SetBackendLegacy(consts.S3Backend1, true)
+			bs.AddOrUpdateBackend(consts.S3Backend2, &backendstorefakes.FakeS3Client{}, nil, apisv1alpha1.HealthStatusHealthy)
+			bs.AddOrUpdateBackend(backendstore.BackendName("team-a", consts.S3Backend3), &backendstorefakes.FakeS3Client{}, nil, apisv1alpha1.HealthStatusHealthy)
+
+			_, err := NewBucketValidator(bs, newFakeBucketReader(), false).ValidateCreate(context.Background(), tc.bucket)
+			if tc.wantErr {
+				assert.Error(t, err, "expected bucket to be rejected")
+			} else {
+				assert.NoError(t, err, "unexpected error")
+			}
+		})
+	}
+}
+
+func TestValidateLoggingConfiguration(t *testing.T) {
+	t.Parallel()
+
+	logging := &v1alpha1.LoggingConfiguration{TargetBucket: testLogBucket}
+	target := func(providers ...string) *v1alpha1.Bucket {
+		return &v1alpha1.Bucket{
+			ObjectMeta: metav1.ObjectMeta{Name: testLogBucket},
+			Spec:       v1alpha1.BucketSpec{Providers: providers},
+		}
+	}
+
+	cases := map[string]struct {
+		bucket  runtime.Object
+		others  []client.Object
+		wantErr bool
+	}{
+		"Target bucket on all backends is allowed": {
+			bucket: &v1alpha1.Bucket{
+				ObjectMeta: metav1.ObjectMeta{Name: consts.TestBucket},
+				Spec: v1alpha1.BucketSpec{
+					Providers:   []string{consts.S3Backend1},
+					ForProvider: v1alpha1.BucketParameters{LoggingConfiguration: logging},
+				},
+			},
+			others: []client.Object{target()},
+		},
+		"Target bucket on the same backends is allowed": {
+			bucket: &v1alpha1.Bucket{
+				ObjectMeta: metav1.ObjectMeta{Name: consts.TestBucket},
+				Spec: v1alpha1.BucketSpec{
+					Providers:   []string{consts.S3Backend1, consts.S3Backend2},
+					ForProvider: v1alpha1.BucketParameters{LoggingConfiguration: logging},
+				},
+			},
+			others: []client.Object{target(consts.S3Backend2, consts.S3Backend1)},
+		},
+		"Target bucket which is not managed is rejected": {
+			bucket: &v1alpha1.Bucket{
+				ObjectMeta: metav1.ObjectMeta{Name: consts.TestBucket},
+				Spec: v1alpha1.BucketSpec{
+					ForProvider: v1alpha1.BucketParameters{LoggingConfiguration: logging},
+				},
+			},
+			wantErr: true,
+		},
+		"Target bucket missing from a backend is rejected": {
+			bucket: &v1alpha1.Bucket{
+				ObjectMeta: metav1.ObjectMeta{Name: consts.TestBucket},
+				Spec: v1alpha1.BucketSpec{
+					ForProvider: v1alpha1.BucketParameters{LoggingConfiguration: logging},
+				},
+			},
+			others:  []client.Object{target(consts.S3Backend1)},
+			wantErr: true,
+		},
+		"Target bucket in another namespace is rejected": {
+			bucket: &nsv1alpha1.Bucket{
+				ObjectMeta: metav1.ObjectMeta{Name: consts.TestBucket, Namespace: "team-a"},
+				Spec: nsv1alpha1.BucketSpec{
+					ForProvider: v1alpha1.BucketParameters{LoggingConfiguration: logging},
+				},
+			},
+			others: []client.Object{
+				&nsv1alpha1.Bucket{ObjectMeta: metav1.ObjectMeta{Name: testLogBucket, Namespace: "team-b"}},
+			},
+			wantErr: true,
+		},
+		"Target bucket in the same namespace is allowed": {
+			bucket: &nsv1alpha1.Bucket{
+				ObjectMeta: metav1.ObjectMeta{Name: consts.TestBucket, Namespace: "team-a"},
+				Spec: nsv1alpha1.BucketSpec{
+					ForProvider: v1alpha1.BucketParameters{LoggingConfiguration: logging},
+				},
+			},
+			others: []client.Object{
+				&nsv1alpha1.Bucket{ObjectMeta: metav1.ObjectMeta{Name: testLogBucket, Namespace: "team-a"}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			bs := backendstore.NewBackendStore()
+			bs.AddOrUpdateBackend(consts.S3Backend1, &backendstorefakes.FakeS3Client{}, nil, apisv1alpha1.HealthStatusHealthy)
+			bs.AddOrUpdateBackend(consts.S3Backend2, &backendstorefakes.FakeS3Client{}, nil, apisv1alpha1.HealthStatusHealthy)
+
+			_, err := NewBucketValidator(bs, newFakeBucketReader(tc.others...), false).ValidateCreate(context.Background(), tc.bucket)
+			if tc.wantErr {
+				assert.Error(t, err, "expected bucket to be rejected")
+			} else {
+				assert.NoError(t, err, "unexpected error")
+			}
+		})
+	}
+}
+
+func TestValidateUniqueName(t *testing.T) {
+	t.Parallel()
+
+	clusterBucket := func(name string) *v1alpha1.Bucket {
+		return &v1alpha1.Bucket{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	}
+	namespacedBucket := func(namespace, name string) *nsv1alpha1.Bucket {
+		return &nsv1alpha1.Bucket{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+	}
+
+	cases := map[string]struct {
+		bucket  runtime.Object
+		others  []client.Object
+		wantErr bool
+	}{
+		"Namespaced bucket with a unique name is allowed": {
+			bucket: namespacedBucket("team-a", consts.TestBucket),
+			others: []client.Object{
+				clusterBucket("other-bucket"),
+				namespacedBucket("team-b", "other-bucket"),
+			},
+		},
+		"Namespaced bucket with the name of a bucket in another namespace is rejected": {
+			bucket:  namespacedBucket("team-a", consts.TestBucket),
+			others:  []client.Object{namespacedBucket("team-b", consts.TestBucket)},
+			wantErr: true,
+		},
+		"Namespaced bucket with the name of a cluster scoped bucket is rejected": {
+			bucket:  namespacedBucket("team-a", consts.TestBucket),
+			others:  []client.Object{clusterBucket(consts.TestBucket)},
+			wantErr: true,
+		},
+		"Cluster scoped bucket with a unique name is allowed": {
+			bucket: clusterBucket(consts.TestBucket),
+			others: []client.Object{namespacedBucket("team-a", "other-bucket")},
+		},
+		"Cluster scoped bucket with the name of a namespaced bucket is rejected": {
+			bucket:  clusterBucket(consts.TestBucket),
+			others:  []client.Object{namespacedBucket("team-a", consts.TestBucket)},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewBucketValidator(backendstore.NewBackendStore(), newFakeBucketReader(tc.others...), false).ValidateCreate(context.Background(), tc.bucket)
+			if tc.wantErr {
+				assert.Error(t, err, "expected bucket to be rejected")
+			} else {
+				assert.NoError(t, err, "unexpected error")
+			}
+		})
+	}
+}
+
+// newFakeBucketReader returns a fake client of Buckets of both scopes which
+// supports listing namespaced Buckets by name.
+func newFakeBucketReader(objs ...client.Object) client.Reader {
+	s := runtime.NewScheme()
+	s.AddKnownTypes(v1alpha1.SchemeGroupVersion, &v1alpha1.Bucket{}, &v1alpha1.BucketList{})
+	s.AddKnownTypes(nsv1alpha1.SchemeGroupVersion, &nsv1alpha1.Bucket{}, &nsv1alpha1.BucketList{})
+
+	return fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(objs...).
+		WithIndex(&nsv1alpha1.Bucket{}, bucketNameField, func(o client.Object) []string {
+			return []string{o.GetName()}
+		}).
+		Build()
+}
@@ -2,6 +2,7 @@ package bucket
 
 import (
 	"context"
+	"sync/atomic"
 	"time"
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
@@ -16,20 +17,26 @@ import (
 // A Connector is expected to produce an ExternalClient when its Connect method
 // is called.
 type Connector struct {
-	kubeClient            client.Client
-	kubeReader            client.Reader
-	autoPauseBucket       bool
-	minReplicas           uint
-	recreateMissingBucket bool
-	backendStore          *backendstore.BackendStore
-	subresourceClients    []SubresourceClient
-	s3ClientHandler       *s3clienthandler.Handler
-	log                   logr.Logger
-	operationTimeout      time.Duration
-	creationGracePeriod   time.Duration
-	pollInterval          time.Duration
-	usage                 *resource.LegacyProviderConfigUsageTracker
-	newServiceFn          func(creds []byte) (interface{}, error)
+	kubeClient             client.Client
+	kubeReader             client.Reader
+	autoPauseBucket        bool
+	minReplicas            uint
+	recreateMissingBucket  bool
+	backendStore           *backendstore.BackendStore
+	subresourceClients     []SubresourceClient
+	s3ClientHandler        *s3clienthandler.Handler
+	log                    logr.Logger
+	operationTimeout       time.Duration
+	creationGracePeriod    time.Duration
+	pollInterval           time.Duration
+	usage                  *resource.LegacyProviderConfigUsageTracker
+	namespacedUsage        *resource.ProviderConfigUsageTracker
+	newServiceFn           func(creds []byte) (interface{}, error)
+	failoverPeriod         time.Duration
+	failoverRecoveryPolicy FailoverRecoveryPolicy
+	// placementCounter rotates the backends chosen by the placement policies
+	// of consecutive buckets.
+	placementCounter atomic.Uint64
 }
 
 func NewConnector(options ...func(*Connector)) *Connector {
@@ -89,12 +96,32 @@ func WithPollInterval(t time.Duration) func(*Connector) {
 	}
 }
 
+// WithFailoverPeriod enables failover replicas for backends which have been
+// unhealthy for longer than the given period. Failover is disabled if zero.
+func WithFailoverPeriod(t time.Duration) func(*Connector) {
+	return func(c *Connector) {
+		c.failoverPeriod = t
+	}
+}
+
+func WithFailoverRecoveryPolicy(p FailoverRecoveryPolicy) func(*Connector) {
+	return func(c *Connector) {
+		c.failoverRecoveryPolicy = p
+	}
+}
+
 func WithUsage(u *resource.LegacyProviderConfigUsageTracker) func(*Connector) {
 	return func(c *Connector) {
 		c.usage = u
 	}
 }
 
+func WithNamespacedUsage(u *resource.ProviderConfigUsageTracker) func(*Connector) {
+	return func(c *Connector) {
+		c.namespacedUsage = u
+	}
+}
+
 func WithBackendStore(s *backendstore.BackendStore) func(*Connector) {
 	return func(c *Connector) {
 		c.backendStore = s
@@ -126,40 +153,56 @@ func WithNewServiceFn(s func(creds []byte) (interface{}, error)) func(*Connector
 }
 
 func (c *Connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	legacyMg, ok := mg.(resource.LegacyManaged)
-	if !ok {
-		return nil, errors.Wrap(errors.New("failed to assert to legacy managed type"), errTrackPCUsage)
-	}
-	if err := c.usage.Track(ctx, legacyMg); err != nil {
-		return nil, errors.Wrap(err, errTrackPCUsage)
+	switch mg := mg.(type) {
+	case resource.LegacyManaged:
+		if err := c.usage.Track(ctx, mg); err != nil {
+			return nil, errors.Wrap(err, errTrackPCUsage)
+		}
+
+		return c.newExternal(), nil
+	case resource.ModernManaged:
+		if err := c.namespacedUsage.Track(ctx, mg); err != nil {
+			return nil, errors.Wrap(err, errTrackPCUsage)
+		}
+
+		return &namespacedExternal{external: c.newExternal()}, nil
+	default:
+		return nil, errors.Wrap(errors.New("failed to assert to legacy or modern managed type"), errTrackPCUsage)
 	}
+}
 
+func (c *Connector) newExternal() *external {
 	return &external{
-			kubeClient:            c.kubeClient,
-			kubeReader:            c.kubeReader,
-			autoPauseBucket:       c.autoPauseBucket,
-			minReplicas:           c.minReplicas,
-			recreateMissingBucket: c.recreateMissingBucket,
-			operationTimeout:      c.operationTimeout,
-			backendStore:          c.backendStore,
-			subresourceClients:    c.subresourceClients,
-			s3ClientHandler:       c.s3ClientHandler,
-			log:                   c.log,
-		},
-		nil
+		kubeClient:             c.kubeClient,
+		kubeReader:             c.kubeReader,
+		autoPauseBucket:        c.autoPauseBucket,
+		minReplicas:            c.minReplicas,
+		recreateMissingBucket:  c.recreateMissingBucket,
+		operationTimeout:       c.operationTimeout,
+		backendStore:           c.backendStore,
+		subresourceClients:     c.subresourceClients,
+		s3ClientHandler:        c.s3ClientHandler,
+		log:                    c.log,
+		failoverPeriod:         c.failoverPeriod,
+		failoverRecoveryPolicy: c.failoverRecoveryPolicy,
+		placementCounter:       &c.placementCounter,
+	}
 }
 
 // external observes, then either creates, updates, or deletes an external
 // resource to ensure it reflects the managed resource's desired state.
 type external struct {
-	kubeClient            client.Client
-	kubeReader            client.Reader
-	autoPauseBucket       bool
-	minReplicas           uint
-	recreateMissingBucket bool
-	operationTimeout      time.Duration
-	backendStore          *backendstore.BackendStore
-	subresourceClients    []SubresourceClient
-	s3ClientHandler       *s3clienthandler.Handler
-	log                   logr.Logger
+	kubeClient             client.Client
+	kubeReader             client.Reader
+	autoPauseBucket        bool
+	minReplicas            uint
+	recreateMissingBucket  bool
+	operationTimeout       time.Duration
+	backendStore           *backendstore.BackendStore
+	subresourceClients     []SubresourceClient
+	s3ClientHandler        *s3clienthandler.Handler
+	log                    logr.Logger
+	failoverPeriod         time.Duration
+	failoverRecoveryPolicy FailoverRecoveryPolicy
+	placementCounter       *atomic.Uint64
 }
@@ -2,7 +2,10 @@ package bucket
 
 import "github.com/crossplane/crossplane-runtime/v2/pkg/errors"
 
-var errUnhealthyBackend = errors.New("backend marked as unhealthy in backendstore")
+var (
+	errUnhealthyBackend       = errors.New("backend marked as unhealthy in backendstore")
+	errBucketNotFoundForQuota = errors.New("bucket not found on backend, unable to determine owner for quota")
+)
 
 const (
 	// k8s error messages.
@@ -15,6 +18,14 @@ const (
 	errNoS3BackendsStored    = "no s3 backends stored in backendstore"
 	errAllS3BackendsDisabled = "all s3 backends have been disabled for this bucket - please check cr labels"
 
+	// Placement error messages.
+	errPlacementSelector     = "failed to parse placement selector"
+	errNoPlacementCandidates = "no s3 backends match the placement policy of this bucket"
+	errListBuckets           = "failed to list Buckets"
+
+	// Replica sync error messages.
+	errReplicaSyncBandwidthLimit = "bandwidth limit of bucket.Spec.ReplicaSync must be positive"
+
 	// Subresource error messages.
 	errObserveSubresource = "failed to observe bucket subresource"
 	errHandleSubresource  = "failed to handle bucket subresource"
@@ -38,4 +49,56 @@ const (
 	// Policy error messages.
 	errObservePolicy = "failed to observe bucket policy"
 	errHandlePolicy  = "failed to handle bucket policy"
+
+	errPolicyAndBucketPolicy = "bucket.Spec.ForProvider.Policy and bucket.Spec.ForProvider.BucketPolicy are mutually exclusive"
+	errInvalidBucketPolicy   = "invalid bucket policy"
+
+	errInvalidAssumeRolePolicy = "invalid bucket.Spec.ForProvider.AssumeRolePolicy"
+
+	// Quota error messages.
+	errObserveQuota = "failed to observe bucket quota"
+	errHandleQuota  = "failed to handle bucket quota"
+
+	// CORS configuration error messages.
+	errObserveCORSConfig = "failed to observe bucket cors configuration"
+	errHandleCORSConfig  = "failed to handle bucket cors configuration"
+
+	// Tagging error messages.
+	errObserveTagging = "failed to observe bucket tagging"
+	errHandleTagging  = "failed to handle bucket tagging"
+
+	// Server-side encryption configuration error messages.
+	errObserveSSEConfig = "failed to observe bucket server-side encryption configuration"
+	errHandleSSEConfig  = "failed to handle bucket server-side encryption configuration"
+
+	// Notification configuration error messages.
+	errObserveNotificationConfig = "failed to observe bucket notification configuration"
+	errHandleNotificationConfig  = "failed to handle bucket notification configuration"
+	errMissingTopic              = "topic %s does not exist on backend"
+
+	// Replication configuration error messages.
+	errObserveReplicationConfig      = "failed to observe bucket replication configuration"
+	errHandleReplicationConfig       = "failed to handle bucket replication configuration"
+	errReplicationRequiresVersioning = "bucket.Spec.ForProvider.ReplicationConfiguration requires bucket.Spec.ForProvider.VersioningConfiguration.Status to be Enabled"
+
+	// Website configuration error messages.
+	errObserveWebsiteConfig = "failed to observe bucket website configuration"
+	errHandleWebsiteConfig  = "failed to handle bucket website configuration"
+
+	// Ownership controls error messages.
+	errObserveOwnershipControls = "failed to observe bucket ownership controls"
+	errHandleOwnershipControls  = "failed to handle bucket ownership controls"
+
+	// Public access block configuration error messages.
+	errObservePublicAccessBlock = "failed to observe bucket public access block configuration"
+	errHandlePublicAccessBlock  = "failed to handle bucket public access block configuration"
+	errPublicACLBlocked         = "bucket.Spec.ForProvider grants public access by ACL, which is blocked by the public access block configuration of the bucket"
+
+	// Logging configuration error messages.
+	errObserveLoggingConfig   = "failed to observe bucket logging configuration"
+	errHandleLoggingConfig    = "failed to handle bucket logging configuration"
+	errGetLoggingTargetBucket = "failed to get target bucket of logging configuration"
+
+	// Bucket name uniqueness error messages.
+	errGetBucketsWithName = "failed to get buckets with the same name"
 )
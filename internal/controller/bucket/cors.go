@@ -0,0 +1,215 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go/document"
+	"github.com/go-logr/logr"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/controller/s3clienthandler"
+	"github.com/linode/provider-ceph/internal/otel/traces"
+	"github.com/linode/provider-ceph/internal/rgw"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"go.opentelemetry.io/otel"
+)
+
+// CORSConfigurationClient is the client for API methods and reconciling the CORSConfiguration
+type CORSConfigurationClient struct {
+	backendStore    *backendstore.BackendStore
+	s3ClientHandler *s3clienthandler.Handler
+	log             logr.Logger
+}
+
+func NewCORSConfigurationClient(b *backendstore.BackendStore, h *s3clienthandler.Handler, l logr.Logger) *CORSConfigurationClient {
+	return &CORSConfigurationClient{backendStore: b, s3ClientHandler: h, log: l}
+}
+
+//nolint:dupl // CORSConfiguration and LifecycleConfiguration are different feature.
+func (c *CORSConfigurationClient) Observe(ctx context.Context, bucket *v1alpha1.Bucket, backendNames []string) (ResourceStatus, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "bucket.CORSConfigurationClient.Observe")
+	defer span.End()
+	ctx, log := traces.InjectTraceAndLogger(ctx, c.log)
+
+	observationChan := make(chan ResourceStatus)
+	errChan := make(chan error)
+
+	for _, backendName := range backendNames {
+		beName := backendName
+		go func() {
+			if c.backendStore.GetBackendHealthStatus(beName) == apisv1alpha1.HealthStatusUnhealthy {
+				// If a backend is marked as unhealthy, we can ignore it for now by returning NoAction.
+				// The backend may be down for some time and we do not want to block Create/Update/Delete
+				// calls on other backends. By returning NoAction here, we would never pass the Observe
+				// phase until the backend becomes Healthy or Disabled.
+				observationChan <- NoAction
+
+				return
+			}
+
+			observation, err := c.observeBackend(ctx, bucket, beName)
+			if err != nil {
+				errChan <- err
+
+				return
+			}
+			observationChan <- observation
+		}()
+	}
+
+	for i := 0; i < len(backendNames); i++ {
+		select {
+		case <-ctx.Done():
+			log.Info("Context timeout during bucket cors configuration observation", consts.KeyBucketName, bucket.Name)
+			err := errors.Wrap(ctx.Err(), errObserveCORSConfig)
+			traces.SetAndRecordError(span, err)
+
+			return NeedsUpdate, err
+		case observation := <-observationChan:
+			if observation == NeedsUpdate || observation == NeedsDeletion {
+				return observation, nil
+			}
+		case err := <-errChan:
+			err = errors.Wrap(err, errObserveCORSConfig)
+			traces.SetAndRecordError(span, err)
+
+			return NeedsUpdate, err
+		}
+	}
+
+	return Updated, nil
+}
+
+func (c *CORSConfigurationClient) observeBackend(ctx context.Context, bucket *v1alpha1.Bucket, backendName string) (ResourceStatus, error) {
+	ctx, log := traces.InjectTraceAndLogger(ctx, c.log)
+
+	log.V(1).Info("Observing subresource cors configuration on backend", consts.KeyBucketName, bucket.Name, consts.KeyBackendName, backendName)
+
+	s3Client, err := c.s3ClientHandler.GetS3Client(ctx, bucket, backendName)
+	if err != nil {
+		return NeedsUpdate, err
+	}
+	response, err := rgw.GetBucketCors(ctx, s3Client, aws.String(bucket.Name))
+	if err != nil {
+		return NeedsUpdate, err
+	}
+
+	var external []s3types.CORSRule
+	if response != nil {
+		external = response.CORSRules
+	}
+
+	if bucket.Spec.ForProvider.CORSConfiguration == nil {
+		// No cors config is specified, so it should not exist on any backend.
+		if len(external) == 0 {
+			log.V(1).Info("No cors configuration found on backend - no action required", consts.KeyBucketName, bucket.Name, consts.KeyBackendName, backendName)
+
+			return NoAction, nil
+		}
+		log.V(1).Info("Cors configuration found on backend - requires deletion", consts.KeyBucketName, bucket.Name, consts.KeyBackendName, backendName)
+
+		return NeedsDeletion, nil
+	}
+
+	local := rgw.GenerateCORSRules(bucket.Spec.ForProvider.CORSConfiguration.CORSRules)
+	if !cmp.Equal(external, local, cmpopts.IgnoreTypes(document.NoSerde{}), cmpopts.EquateEmpty()) {
+		log.V(1).Info("Cors configuration requires update on backend", consts.KeyBucketName, bucket.Name, consts.KeyBackendName, backendName)
+
+		return NeedsUpdate, nil
+	}
+
+	return Updated, nil
+}
+
+func (c *CORSConfigurationClient) Handle(ctx context.Context, b *v1alpha1.Bucket, backendName string, bb *bucketBackends) error {
+	ctx, span := otel.Tracer("").Start(ctx, "bucket.CORSConfigurationClient.Handle")
+	defer span.End()
+
+	if c.backendStore.GetBackendHealthStatus(backendName) == apisv1alpha1.HealthStatusUnhealthy {
+		traces.SetAndRecordError(span, errUnhealthyBackend)
+
+		return errUnhealthyBackend
+	}
+
+	observation, err := c.observeBackend(ctx, b, backendName)
+	if err != nil {
+		err = errors.Wrap(err, errHandleCORSConfig)
+		traces.SetAndRecordError(span, err)
+
+		return err
+	}
+
+	switch observation {
+	case NoAction:
+		return nil
+	case Updated:
+		// The cors config is updated, so we can consider this
+		// sub resource Available.
+		available := xpv1.Available()
+		bb.setCORSConfigCondition(b.Name, backendName, &available)
+
+	case NeedsDeletion:
+		if err := c.delete(ctx, b, backendName); err != nil {
+			err = errors.Wrap(err, errHandleCORSConfig)
+			deleting := xpv1.Deleting().WithMessage(err.Error())
+			bb.setCORSConfigCondition(b.Name, backendName, &deleting)
+
+			traces.SetAndRecordError(span, err)
+
+			return err
+		}
+		bb.setCORSConfigCondition(b.Name, backendName, nil)
+
+	case NeedsUpdate:
+		if err := c.createOrUpdate(ctx, b, backendName); err != nil {
+			err = errors.Wrap(err, errHandleCORSConfig)
+			unavailable := xpv1.Unavailable().WithMessage(err.Error())
+			bb.setCORSConfigCondition(b.Name, backendName, &unavailable)
+
+			traces.SetAndRecordError(span, err)
+
+			return err
+		}
+		available := xpv1.Available()
+		bb.setCORSConfigCondition(b.Name, backendName, &available)
+	}
+
+	return nil
+}
+
+func (c *CORSConfigurationClient) createOrUpdate(ctx context.Context, b *v1alpha1.Bucket, backendName string) error {
+	ctx, log := traces.InjectTraceAndLogger(ctx, c.log)
+
+	log.Info("Updating cors configuration", consts.KeyBucketName, b.Name, consts.KeyBackendName, backendName)
+	s3Client, err := c.s3ClientHandler.GetS3Client(ctx, b, backendName)
+	if err != nil {
+		return err
+	}
+
+	_, err = rgw.PutBucketCors(ctx, s3Client, b)
+
+	return err
+}
+
+func (c *CORSConfigurationClient) delete(ctx context.Context, b *v1alpha1.Bucket, backendName string) error {
+	ctx, log := traces.InjectTraceAndLogger(ctx, c.log)
+
+	log.Info("Deleting cors configuration", consts.KeyBucketName, b.Name, consts.KeyBackendName, backendName)
+	s3Client, err := c.s3ClientHandler.GetS3Client(ctx, b, backendName)
+	if err != nil {
+		return err
+	}
+
+	return rgw.DeleteBucketCors(ctx, s3Client, aws.String(b.Name))
+}
@@ -0,0 +1,658 @@
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/backendstore/backendstorefakes"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/controller/s3clienthandler"
+	"github.com/linode/provider-ceph/internal/rgw"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// errUnexpectedInput is returned by fake S3 clients which receive a request
+// other than the one expected by the test case.
+var errUnexpectedInput = errors.New("unexpected input")
+
+//nolint:maintidx // Function requires numerous checks.
+func TestCORSConfigObserveBackend(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		backendStore *backendstore.BackendStore
+	}
+
+	type args struct {
+		bucket      *v1alpha1.Bucket
+		backendName string
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		fields fields
+		args   args
+		want   want
+	}{
+		"External error getting cors config": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketCorsStub: func(ctx context.Context, in *s3.GetBucketCorsInput, f ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error) {
+							return nil, errExternal
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							CORSConfiguration: &v1alpha1.CORSConfiguration{
+								CORSRules: []v1alpha1.CORSRule{
+									{AllowedMethods: []string{"GET"}, AllowedOrigins: []string{"*"}},
+								},
+							},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    errExternal,
+			},
+		},
+		"Cors config not specified in CR and NoSuchCORSConfiguration on backend so NoAction": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketCorsStub: func(ctx context.Context, in *s3.GetBucketCorsInput, f ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error) {
+							return nil, &smithy.GenericAPIError{Code: rgw.CORSNotFoundErrCode}
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NoAction,
+			},
+		},
+		"Cors config not specified in CR but rules exist on backend so NeedsDeletion": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketCorsStub: func(ctx context.Context, in *s3.GetBucketCorsInput, f ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error) {
+							return &s3.GetBucketCorsOutput{
+								CORSRules: []s3types.CORSRule{
+									{AllowedMethods: []string{"GET"}, AllowedOrigins: []string{"*"}},
+								},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsDeletion,
+			},
+		},
+		"Cors config specified in CR but NoSuchCORSConfiguration on backend so NeedsUpdate": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketCorsStub: func(ctx context.Context, in *s3.GetBucketCorsInput, f ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error) {
+							return nil, &smithy.GenericAPIError{Code: rgw.CORSNotFoundErrCode}
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							CORSConfiguration: &v1alpha1.CORSConfiguration{
+								CORSRules: []v1alpha1.CORSRule{
+									{AllowedMethods: []string{"GET"}, AllowedOrigins: []string{"*"}},
+								},
+							},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"Cors rules in a different order on backend so NeedsUpdate": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketCorsStub: func(ctx context.Context, in *s3.GetBucketCorsInput, f ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error) {
+							return &s3.GetBucketCorsOutput{
+								CORSRules: []s3types.CORSRule{
+									{AllowedMethods: []string{"PUT"}, AllowedOrigins: []string{"https://example.com"}},
+									{AllowedMethods: []string{"GET"}, AllowedOrigins: []string{"*"}},
+								},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							CORSConfiguration: &v1alpha1.CORSConfiguration{
+								CORSRules: []v1alpha1.CORSRule{
+									{AllowedMethods: []string{"GET"}, AllowedOrigins: []string{"*"}},
+									{AllowedMethods: []string{"PUT"}, AllowedOrigins: []string{"https://example.com"}},
+								},
+							},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"Cors rule exposes other headers on backend so NeedsUpdate": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketCorsStub: func(ctx context.Context, in *s3.GetBucketCorsInput, f ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error) {
+							return &s3.GetBucketCorsOutput{
+								CORSRules: []s3types.CORSRule{
+									{AllowedMethods: []string{"GET"}, AllowedOrigins: []string{"*"}, ExposeHeaders: []string{"ETag"}},
+								},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							CORSConfiguration: &v1alpha1.CORSConfiguration{
+								CORSRules: []v1alpha1.CORSRule{
+									{AllowedMethods: []string{"GET"}, AllowedOrigins: []string{"*"}, ExposeHeaders: []string{"x-amz-request-id"}},
+								},
+							},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"Cors rule with empty allowed headers returned without them by backend so is Updated": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketCorsStub: func(ctx context.Context, in *s3.GetBucketCorsInput, f ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error) {
+							return &s3.GetBucketCorsOutput{
+								CORSRules: []s3types.CORSRule{
+									{
+										ID:             aws.String("web"),
+										AllowedMethods: []string{"GET", "PUT"},
+										AllowedOrigins: []string{"https://example.com"},
+										MaxAgeSeconds:  aws.Int32(3000),
+									},
+								},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							CORSConfiguration: &v1alpha1.CORSConfiguration{
+								CORSRules: []v1alpha1.CORSRule{
+									{
+										ID:             aws.String("web"),
+										AllowedHeaders: []string{},
+										AllowedMethods: []string{"GET", "PUT"},
+										AllowedOrigins: []string{"https://example.com"},
+										MaxAgeSeconds:  aws.Int32(3000),
+									},
+								},
+							},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := NewCORSConfigurationClient(
+				tc.fields.backendStore,
+				s3clienthandler.NewHandler(
+					s3clienthandler.WithAssumeRoleArn(nil),
+					s3clienthandler.WithBackendStore(tc.fields.backendStore)),
+				logr.Discard())
+
+			got, err := c.observeBackend(context.Background(), tc.args.bucket, tc.args.backendName)
+			require.ErrorIs(t, err, tc.want.err, "unexpected error")
+			assert.Equal(t, tc.want.status, got, "unexpected status")
+		})
+	}
+}
+
+//nolint:maintidx // Function requires numerous checks.
+func TestCORSConfigurationHandle(t *testing.T) {
+	t.Parallel()
+
+	bucketName := consts.TestBucket
+	beName := consts.S3Backend1
+
+	type fields struct {
+		backendStore *backendstore.BackendStore
+	}
+
+	type args struct {
+		bucket      *v1alpha1.Bucket
+		backendName string
+	}
+
+	type want struct {
+		err          error
+		specificDiff func(t *testing.T, bb *bucketBackends)
+	}
+
+	cases := map[string]struct {
+		fields fields
+		args   args
+		want   want
+	}{
+		"Unhealthy backend": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusUnhealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							CORSConfiguration: &v1alpha1.CORSConfiguration{
+								CORSRules: []v1alpha1.CORSRule{
+									{AllowedMethods: []string{"GET"}, AllowedOrigins: []string{"*"}},
+								},
+							},
+						},
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				err: errUnhealthyBackend,
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					assert.Nil(t, bb.getCORSConfigCondition(bucketName, beName), "unexpected cors config condition")
+				},
+			},
+		},
+		"Cors rules of CR are put on backend": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketCorsStub: func(ctx context.Context, in *s3.GetBucketCorsInput, f ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error) {
+							return nil, &smithy.GenericAPIError{Code: rgw.CORSNotFoundErrCode}
+						},
+						PutBucketCorsStub: func(ctx context.Context, in *s3.PutBucketCorsInput, f ...func(*s3.Options)) (*s3.PutBucketCorsOutput, error) {
+							want := []s3types.CORSRule{
+								{
+									ID:             aws.String("web"),
+									AllowedHeaders: []string{"Authorization"},
+									AllowedMethods: []string{"GET"},
+									AllowedOrigins: []string{"*"},
+									ExposeHeaders:  []string{"ETag"},
+									MaxAgeSeconds:  aws.Int32(600),
+								},
+							}
+							if aws.ToString(in.Bucket) != bucketName || !cmp.Equal(in.CORSConfiguration.CORSRules, want, cmpopts.IgnoreUnexported(s3types.CORSRule{})) {
+								return nil, errUnexpectedInput
+							}
+
+							return &s3.PutBucketCorsOutput{}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							CORSConfiguration: &v1alpha1.CORSConfiguration{
+								CORSRules: []v1alpha1.CORSRule{
+									{
+										ID:             aws.String("web"),
+										AllowedHeaders: []string{"Authorization"},
+										AllowedMethods: []string{"GET"},
+										AllowedOrigins: []string{"*"},
+										ExposeHeaders:  []string{"ETag"},
+										MaxAgeSeconds:  aws.Int32(600),
+									},
+								},
+							},
+						},
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					assert.True(t, bb.getCORSConfigCondition(bucketName, beName).Equal(v1.Available()), "unexpected cors config condition")
+				},
+			},
+		},
+		"Cors config is up to date so it is not put again": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketCorsStub: func(ctx context.Context, in *s3.GetBucketCorsInput, f ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error) {
+							return &s3.GetBucketCorsOutput{
+								CORSRules: []s3types.CORSRule{
+									{AllowedMethods: []string{"GET"}, AllowedOrigins: []string{"*"}},
+								},
+							}, nil
+						},
+						PutBucketCorsStub: func(ctx context.Context, in *s3.PutBucketCorsInput, f ...func(*s3.Options)) (*s3.PutBucketCorsOutput, error) {
+							return nil, errUnexpectedInput
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							CORSConfiguration: &v1alpha1.CORSConfiguration{
+								CORSRules: []v1alpha1.CORSRule{
+									{AllowedMethods: []string{"GET"}, AllowedOrigins: []string{"*"}},
+								},
+							},
+						},
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					assert.True(t, bb.getCORSConfigCondition(bucketName, beName).Equal(v1.Available()), "unexpected cors config condition")
+				},
+			},
+		},
+		"Cors config is deleted from backend when removed from CR": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketCorsStub: func(ctx context.Context, in *s3.GetBucketCorsInput, f ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error) {
+							return &s3.GetBucketCorsOutput{
+								CORSRules: []s3types.CORSRule{
+									{AllowedMethods: []string{"GET"}, AllowedOrigins: []string{"*"}},
+								},
+							}, nil
+						},
+						DeleteBucketCorsStub: func(ctx context.Context, in *s3.DeleteBucketCorsInput, f ...func(*s3.Options)) (*s3.DeleteBucketCorsOutput, error) {
+							if aws.ToString(in.Bucket) != bucketName {
+								return nil, errUnexpectedInput
+							}
+
+							return &s3.DeleteBucketCorsOutput{}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					assert.Nil(t, bb.getCORSConfigCondition(bucketName, beName), "unexpected cors config condition")
+				},
+			},
+		},
+		"Error deleting cors config": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketCorsStub: func(ctx context.Context, in *s3.GetBucketCorsInput, f ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error) {
+							return &s3.GetBucketCorsOutput{
+								CORSRules: []s3types.CORSRule{
+									{AllowedMethods: []string{"GET"}, AllowedOrigins: []string{"*"}},
+								},
+							}, nil
+						},
+						DeleteBucketCorsStub: func(ctx context.Context, in *s3.DeleteBucketCorsInput, f ...func(*s3.Options)) (*s3.DeleteBucketCorsOutput, error) {
+							return nil, errExternal
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				err: errExternal,
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					condition := bb.getCORSConfigCondition(bucketName, beName)
+					require.NotNil(t, condition, "missing cors config condition")
+					assert.Equal(t, v1.Deleting().Reason, condition.Reason, "unexpected cors config condition")
+				},
+			},
+		},
+		"Error putting cors config": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketCorsStub: func(ctx context.Context, in *s3.GetBucketCorsInput, f ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error) {
+							return nil, &smithy.GenericAPIError{Code: rgw.CORSNotFoundErrCode}
+						},
+						PutBucketCorsStub: func(ctx context.Context, in *s3.PutBucketCorsInput, f ...func(*s3.Options)) (*s3.PutBucketCorsOutput, error) {
+							return nil, errExternal
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							CORSConfiguration: &v1alpha1.CORSConfiguration{
+								CORSRules: []v1alpha1.CORSRule{
+									{AllowedMethods: []string{"GET"}, AllowedOrigins: []string{"*"}},
+								},
+							},
+						},
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				err: errExternal,
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					condition := bb.getCORSConfigCondition(bucketName, beName)
+					require.NotNil(t, condition, "missing cors config condition")
+					assert.Equal(t, v1.Unavailable().Reason, condition.Reason, "unexpected cors config condition")
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := NewCORSConfigurationClient(
+				tc.fields.backendStore,
+				s3clienthandler.NewHandler(
+					s3clienthandler.WithAssumeRoleArn(nil),
+					s3clienthandler.WithBackendStore(tc.fields.backendStore)),
+				logr.Discard())
+
+			bb := newBucketBackends()
+			err := c.Handle(context.Background(), tc.args.bucket, tc.args.backendName, bb)
+			require.ErrorIs(t, err, tc.want.err, "unexpected error")
+			if tc.want.specificDiff != nil {
+				tc.want.specificDiff(t, bb)
+			}
+		})
+	}
+}
@@ -56,13 +56,20 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, err
 	}
 
-	// allBackendNames is a list of the names of all backends in the backend store.
-	allBackendNames := c.backendStore.GetAllBackendNames()
+	// allBackendNames is a list of the names of all backends in the backend store
+	// which may be used by the bucket.
+	allBackendNames := c.getBucketBackendNames(bucket)
+
+	// Choose the backends of the bucket if it is placed by its placement policy.
+	if err := c.placeBucket(ctx, bucket, allBackendNames); err != nil {
+		return managed.ExternalCreation{}, err
+	}
 
 	// backendsToCreateOnNames is a list of names of all backends on which this S3 bucket
 	// is to be created. This will either be:
 	// 1. The list of bucket.Spec.Providers, if specified.
-	// 2. Otherwise, the allBackendNames list.
+	// 2. The backends chosen by bucket.Spec.Placement, if specified.
+	// 3. Otherwise, the allBackendNames list.
 	// In either case, the list will exclude any backends which have been specified as
 	// disabled on the Bucket CR. A backend is specified as disabled for a given bucket
 	// if it has been given the backend label (eg 'provider-ceph.backends.<backend-name>: "false"').
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
@@ -752,9 +753,10 @@ func TestDelete(t *testing.T) {
 					s3clienthandler.WithAssumeRoleArn(tc.fields.roleArn),
 					s3clienthandler.WithBackendStore(tc.fields.backendStore),
 					s3clienthandler.WithKubeClient(kubeClient)),
-				log:        logr.Discard(),
-				kubeClient: kubeClient,
-				kubeReader: kubeClient,
+				log:              logr.Discard(),
+				kubeClient:       kubeClient,
+				kubeReader:       kubeClient,
+				operationTimeout: time.Second * 5,
 			}
 
 			_, err := e.Delete(context.Background(), tc.args.mg)
@@ -0,0 +1,246 @@
+package bucket
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"go.opentelemetry.io/otel"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/otel/traces"
+	"github.com/linode/provider-ceph/internal/rgw"
+	"github.com/linode/provider-ceph/internal/utils"
+)
+
+// FailoverRecoveryPolicy determines what happens to a failover replica once the
+// backend it stands in for has recovered.
+type FailoverRecoveryPolicy string
+
+const (
+	// FailoverRecoveryPolicyRemove deletes the failover replica once the bucket is
+	// available on the recovered backend again. A failover replica which is not
+	// empty is kept until it has been emptied.
+	FailoverRecoveryPolicyRemove FailoverRecoveryPolicy = "Remove"
+	// FailoverRecoveryPolicyKeep keeps the failover replica as an extra replica.
+	FailoverRecoveryPolicyKeep FailoverRecoveryPolicy = "Keep"
+)
+
+// failoverChanges are the failover replicas of a bucket to be added, mapped to the
+// backends they stand in for, and the failover replicas to be removed.
+type failoverChanges struct {
+	add    map[string]string
+	remove []string
+}
+
+func (f failoverChanges) empty() bool {
+	return len(f.add) == 0 && len(f.remove) == 0
+}
+
+// isFailedOver returns true if failover is enabled and the backend has been
+// unhealthy for longer than the failover period.
+func (c *external) isFailedOver(backendName string) bool {
+	if c.failoverPeriod <= 0 || c.backendStore.GetBackendHealthStatus(backendName) != apisv1alpha1.HealthStatusUnhealthy {
+		return false
+	}
+
+	since := c.backendStore.GetBackendUnhealthySince(backendName)
+
+	return !since.IsZero() && time.Since(since) >= c.failoverPeriod
+}
+
+// getFailoverChanges returns the failover replicas to be added to the bucket so that
+// it has at least minReplicas replicas on backends which are not unhealthy, and the
+// failover replicas to be removed according to the recovery policy.
+func (c *external) getFailoverChanges(bucket *v1alpha1.Bucket, backendNames []string) (failoverChanges, error) {
+	changes := failoverChanges{add: map[string]string{}}
+	if c.failoverPeriod <= 0 {
+		return changes, nil
+	}
+
+	if c.failoverRecoveryPolicy == FailoverRecoveryPolicyRemove {
+		for replica, replaced := range bucket.Status.AtProvider.FailoverBackends {
+			if c.backendStore.GetBackendHealthStatus(replaced) == apisv1alpha1.HealthStatusUnhealthy {
+				continue
+			}
+			if backend, ok := bucket.Status.AtProvider.Backends[replaced]; !ok || backend == nil || !backend.BucketCondition.Equal(xpv1.Available()) {
+				continue
+			}
+
+			changes.remove = append(changes.remove, replica)
+		}
+		slices.Sort(changes.remove)
+	}
+
+	targets := getBucketProvidersFilterDisabledLabel(bucket, backendNames)
+	replaced := slices.Collect(maps.Values(bucket.Status.AtProvider.FailoverBackends))
+
+	healthy := 0
+	for _, backendName := range targets {
+		if slices.Contains(changes.remove, backendName) {
+			continue
+		}
+		if c.backendStore.GetBackendHealthStatus(backendName) != apisv1alpha1.HealthStatusUnhealthy {
+			healthy++
+		}
+	}
+
+	failed := []string{}
+	for _, backendName := range targets {
+		if c.isFailedOver(backendName) && !slices.Contains(replaced, backendName) {
+			failed = append(failed, backendName)
+		}
+	}
+	slices.Sort(failed)
+
+	if healthy >= int(c.minReplicas) || len(failed) == 0 {
+		return changes, nil
+	}
+
+	candidates, err := c.getFailoverCandidates(bucket, backendNames, targets)
+	if err != nil {
+		return changes, err
+	}
+
+	for i := 0; i < len(failed) && i < len(candidates) && healthy < int(c.minReplicas); i++ {
+		changes.add[candidates[i]] = failed[i]
+		healthy++
+	}
+
+	return changes, nil
+}
+
+// getFailoverCandidates returns the backends, sorted by name, which may hold a failover
+// replica of the bucket. A candidate is healthy, is not cordoned, is not a backend of the
+// bucket yet, has not been disabled by label on the Bucket CR and matches the selector of
+// the placement policy of the bucket, if any.
+func (c *external) getFailoverCandidates(bucket *v1alpha1.Bucket, backendNames, targets []string) ([]string, error) {
+	selector := labels.Everything()
+	if isPlacedByPolicy(bucket) && bucket.Spec.Placement.Selector != nil {
+		var err error
+		selector, err = metav1.LabelSelectorAsSelector(bucket.Spec.Placement.Selector)
+		if err != nil {
+			return nil, errors.Wrap(err, errPlacementSelector)
+		}
+	}
+
+	candidates := []string{}
+	for _, backendName := range backendNames {
+		if slices.Contains(targets, backendName) {
+			continue
+		}
+		if status, ok := bucket.Labels[utils.GetBackendLabel(backendName)]; ok && status != consts.TrueStr {
+			continue
+		}
+		if c.backendStore.GetBackendHealthStatus(backendName) == apisv1alpha1.HealthStatusUnhealthy {
+			continue
+		}
+		if c.backendStore.IsBackendCordoned(backendName) {
+			continue
+		}
+		if !selector.Matches(labels.Set(c.backendStore.GetBackendLabels(backendName))) {
+			continue
+		}
+
+		candidates = append(candidates, backendName)
+	}
+	slices.Sort(candidates)
+
+	return candidates, nil
+}
+
+// failoverBucket adds failover replicas to the bucket for backends which have been
+// unhealthy for longer than the failover period, and removes the failover replicas
+// of recovered backends according to the recovery policy. The failover replicas are
+// recorded in the Bucket CR status. Added replicas are created on their backends by
+// the subsequent update of the bucket.
+func (c *external) failoverBucket(ctx context.Context, bucket *v1alpha1.Bucket, backendNames []string) error {
+	ctx, span := otel.Tracer("").Start(ctx, "bucket.external.failoverBucket")
+	defer span.End()
+	ctx, log := traces.InjectTraceAndLogger(ctx, c.log)
+
+	changes, err := c.getFailoverChanges(bucket, backendNames)
+	if err != nil {
+		traces.SetAndRecordError(span, err)
+
+		return err
+	}
+	if changes.empty() {
+		return nil
+	}
+
+	removed := []string{}
+	for _, backendName := range changes.remove {
+		cl, err := c.s3ClientHandler.GetS3Client(ctx, bucket, backendName)
+		if err != nil {
+			log.Info("Failed to get client for backend - failover replica cannot be removed", consts.KeyBucketName, bucket.Name, consts.KeyBackendName, backendName, "error", err.Error())
+
+			continue
+		}
+
+		log.Info("Removing failover replica of bucket from backend", consts.KeyBucketName, bucket.Name, consts.KeyBackendName, backendName)
+		if err := rgw.DeleteBucket(ctx, cl, aws.String(bucket.Name), false); err != nil {
+			log.Info("Failed to remove failover replica of bucket from backend", consts.KeyBucketName, bucket.Name, consts.KeyBackendName, backendName, "error", err.Error())
+			traces.SetAndRecordError(span, err)
+
+			continue
+		}
+		removed = append(removed, backendName)
+	}
+
+	for replica, replaced := range changes.add {
+		log.Info("Adding failover replica of bucket on backend", consts.KeyBucketName, bucket.Name, consts.KeyBackendName, replica, "replaced", replaced)
+	}
+
+	if len(removed) == 0 && len(changes.add) == 0 {
+		return nil
+	}
+
+	failoverBackends := maps.Clone(bucket.Status.AtProvider.FailoverBackends)
+	if failoverBackends == nil {
+		failoverBackends = map[string]string{}
+	}
+	for _, backendName := range removed {
+		delete(failoverBackends, backendName)
+	}
+	maps.Copy(failoverBackends, changes.add)
+
+	bucket.Status.AtProvider.FailoverBackends = failoverBackends
+	callbacks := []func(*v1alpha1.Bucket) UpdateRequired{
+		func(bucketLatest *v1alpha1.Bucket) UpdateRequired {
+			bucketLatest.Status.AtProvider.FailoverBackends = failoverBackends
+			for _, backendName := range removed {
+				delete(bucketLatest.Status.AtProvider.Backends, backendName)
+			}
+
+			return NeedsStatusUpdate
+		},
+	}
+	if len(removed) != 0 {
+		// The bucket no longer exists on the backends of removed failover replicas.
+		callbacks = append(callbacks, func(bucketLatest *v1alpha1.Bucket) UpdateRequired {
+			for _, backendName := range removed {
+				delete(bucketLatest.Labels, utils.GetBackendLabel(backendName))
+			}
+
+			return NeedsObjectUpdate
+		})
+	}
+
+	if err := c.updateBucketCR(ctx, bucket, callbacks...); err != nil {
+		err = errors.Wrap(err, errUpdateBucketCR)
+		traces.SetAndRecordError(span, err)
+
+		return err
+	}
+
+	return nil
+}
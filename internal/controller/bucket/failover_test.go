@@ -0,0 +1,264 @@
+package bucket
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/backendstore/backendstorefakes"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/controller/s3clienthandler"
+	"github.com/linode/provider-ceph/internal/utils"
+)
+
+//nolint:maintidx // Requires many scenarios for full coverage.
+func TestGetFailoverChanges(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		health         map[string]apisv1alpha1.HealthStatus
+		failoverPeriod time.Duration
+		recovery       FailoverRecoveryPolicy
+		minReplicas    uint
+	}
+
+	type want struct {
+		add    map[string]string
+		remove []string
+	}
+
+	available := &v1alpha1.BackendInfo{BucketCondition: xpv1.Available()}
+
+	cases := map[string]struct {
+		fields fields
+		bucket *v1alpha1.Bucket
+		want   want
+	}{
+		"Failover is disabled": {
+			fields: fields{
+				health: map[string]apisv1alpha1.HealthStatus{
+					consts.S3Backend1: apisv1alpha1.HealthStatusUnhealthy,
+				},
+				minReplicas: 2,
+			},
+			bucket: &v1alpha1.Bucket{
+				Spec: v1alpha1.BucketSpec{Providers: []string{consts.S3Backend1, consts.S3Backend2}},
+			},
+			want: want{add: map[string]string{}},
+		},
+		"Failover replica replaces backend unhealthy for longer than failover period": {
+			fields: fields{
+				health: map[string]apisv1alpha1.HealthStatus{
+					consts.S3Backend1: apisv1alpha1.HealthStatusUnhealthy,
+				},
+				failoverPeriod: time.Nanosecond,
+				minReplicas:    2,
+			},
+			bucket: &v1alpha1.Bucket{
+				Spec: v1alpha1.BucketSpec{Providers: []string{consts.S3Backend1, consts.S3Backend2}},
+			},
+			want: want{add: map[string]string{consts.S3Backend3: consts.S3Backend1}},
+		},
+		"No failover replica for backend unhealthy for less than failover period": {
+			fields: fields{
+				health: map[string]apisv1alpha1.HealthStatus{
+					consts.S3Backend1: apisv1alpha1.HealthStatusUnhealthy,
+				},
+				failoverPeriod: time.Hour,
+				minReplicas:    2,
+			},
+			bucket: &v1alpha1.Bucket{
+				Spec: v1alpha1.BucketSpec{Providers: []string{consts.S3Backend1, consts.S3Backend2}},
+			},
+			want: want{add: map[string]string{}},
+		},
+		"No failover replica while bucket has minimum replicas": {
+			fields: fields{
+				health: map[string]apisv1alpha1.HealthStatus{
+					consts.S3Backend1: apisv1alpha1.HealthStatusUnhealthy,
+				},
+				failoverPeriod: time.Nanosecond,
+				minReplicas:    1,
+			},
+			bucket: &v1alpha1.Bucket{
+				Spec: v1alpha1.BucketSpec{Providers: []string{consts.S3Backend1, consts.S3Backend2}},
+			},
+			want: want{add: map[string]string{}},
+		},
+		"No failover replica on backend disabled by label": {
+			fields: fields{
+				health: map[string]apisv1alpha1.HealthStatus{
+					consts.S3Backend1: apisv1alpha1.HealthStatusUnhealthy,
+				},
+				failoverPeriod: time.Nanosecond,
+				minReplicas:    2,
+			},
+			bucket: &v1alpha1.Bucket{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{utils.GetBackendLabel(consts.S3Backend3): consts.FalseStr},
+				},
+				Spec: v1alpha1.BucketSpec{Providers: []string{consts.S3Backend1, consts.S3Backend2}},
+			},
+			want: want{add: map[string]string{}},
+		},
+		"Backend is replaced only once": {
+			fields: fields{
+				health: map[string]apisv1alpha1.HealthStatus{
+					consts.S3Backend1: apisv1alpha1.HealthStatusUnhealthy,
+				},
+				failoverPeriod: time.Nanosecond,
+				minReplicas:    2,
+			},
+			bucket: &v1alpha1.Bucket{
+				Spec: v1alpha1.BucketSpec{Providers: []string{consts.S3Backend1, consts.S3Backend2}},
+				Status: v1alpha1.BucketStatus{AtProvider: v1alpha1.BucketObservation{
+					FailoverBackends: map[string]string{consts.S3Backend3: consts.S3Backend1},
+				}},
+			},
+			want: want{add: map[string]string{}},
+		},
+		"Failover replica is removed once bucket is available on recovered backend": {
+			fields: fields{
+				failoverPeriod: time.Nanosecond,
+				recovery:       FailoverRecoveryPolicyRemove,
+				minReplicas:    2,
+			},
+			bucket: &v1alpha1.Bucket{
+				Spec: v1alpha1.BucketSpec{Providers: []string{consts.S3Backend1, consts.S3Backend2}},
+				Status: v1alpha1.BucketStatus{AtProvider: v1alpha1.BucketObservation{
+					Backends:         v1alpha1.Backends{consts.S3Backend1: available},
+					FailoverBackends: map[string]string{consts.S3Backend3: consts.S3Backend1},
+				}},
+			},
+			want: want{add: map[string]string{}, remove: []string{consts.S3Backend3}},
+		},
+		"Failover replica is kept until bucket is available on recovered backend": {
+			fields: fields{
+				failoverPeriod: time.Nanosecond,
+				recovery:       FailoverRecoveryPolicyRemove,
+				minReplicas:    2,
+			},
+			bucket: &v1alpha1.Bucket{
+				Spec: v1alpha1.BucketSpec{Providers: []string{consts.S3Backend1, consts.S3Backend2}},
+				Status: v1alpha1.BucketStatus{AtProvider: v1alpha1.BucketObservation{
+					Backends:         v1alpha1.Backends{consts.S3Backend1: &v1alpha1.BackendInfo{BucketCondition: xpv1.Unavailable()}},
+					FailoverBackends: map[string]string{consts.S3Backend3: consts.S3Backend1},
+				}},
+			},
+			want: want{add: map[string]string{}},
+		},
+		"Failover replica is kept by keep policy": {
+			fields: fields{
+				failoverPeriod: time.Nanosecond,
+				recovery:       FailoverRecoveryPolicyKeep,
+				minReplicas:    2,
+			},
+			bucket: &v1alpha1.Bucket{
+				Spec: v1alpha1.BucketSpec{Providers: []string{consts.S3Backend1, consts.S3Backend2}},
+				Status: v1alpha1.BucketStatus{AtProvider: v1alpha1.BucketObservation{
+					Backends:         v1alpha1.Backends{consts.S3Backend1: available},
+					FailoverBackends: map[string]string{consts.S3Backend3: consts.S3Backend1},
+				}},
+			},
+			want: want{add: map[string]string{}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			bs := backendstore.NewBackendStore()
+			for _, backendName := range []string{consts.S3Backend1, consts.S3Backend2, consts.S3Backend3} {
+				health, ok := tc.fields.health[backendName]
+				if !ok {
+					health = apisv1alpha1.HealthStatusHealthy
+				}
+				bs.AddOrUpdateBackend(backendName, &backendstorefakes.FakeS3Client{}, nil, health)
+			}
+
+			e := external{
+				backendStore:           bs,
+				log:                    logr.Discard(),
+				minReplicas:            tc.fields.minReplicas,
+				failoverPeriod:         tc.fields.failoverPeriod,
+				failoverRecoveryPolicy: tc.fields.recovery,
+			}
+
+			changes, err := e.getFailoverChanges(tc.bucket, bs.GetAllBackendNames())
+			require.NoError(t, err, "unexpected error")
+			assert.Equal(t, tc.want.add, changes.add, "unexpected failover replicas to add")
+			assert.Equal(t, tc.want.remove, changes.remove, "unexpected failover replicas to remove")
+		})
+	}
+}
+
+func TestFailoverBucketRemovesReplica(t *testing.T) {
+	t.Parallel()
+
+	bucket := &v1alpha1.Bucket{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "bucket",
+			Labels: map[string]string{
+				utils.GetBackendLabel(consts.S3Backend1): consts.TrueStr,
+				utils.GetBackendLabel(consts.S3Backend2): consts.TrueStr,
+			},
+		},
+		Spec: v1alpha1.BucketSpec{Providers: []string{consts.S3Backend1}},
+		Status: v1alpha1.BucketStatus{AtProvider: v1alpha1.BucketObservation{
+			Backends: v1alpha1.Backends{
+				consts.S3Backend1: &v1alpha1.BackendInfo{BucketCondition: xpv1.Available()},
+				consts.S3Backend2: &v1alpha1.BackendInfo{BucketCondition: xpv1.Available()},
+			},
+			FailoverBackends: map[string]string{consts.S3Backend2: consts.S3Backend1},
+		}},
+	}
+
+	replicaClient := &backendstorefakes.FakeS3Client{}
+	replicaClient.HeadBucketReturns(&s3.HeadBucketOutput{}, nil)
+
+	bs := backendstore.NewBackendStore()
+	bs.AddOrUpdateBackend(consts.S3Backend1, &backendstorefakes.FakeS3Client{}, nil, apisv1alpha1.HealthStatusHealthy)
+	bs.AddOrUpdateBackend(consts.S3Backend2, replicaClient, nil, apisv1alpha1.HealthStatusHealthy)
+
+	s := runtime.NewScheme()
+	s.AddKnownTypes(v1alpha1.SchemeGroupVersion, &v1alpha1.Bucket{}, &v1alpha1.BucketList{})
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(bucket).
+		WithStatusSubresource(bucket).
+		Build()
+
+	e := external{
+		kubeClient:             cl,
+		kubeReader:             cl,
+		backendStore:           bs,
+		s3ClientHandler:        s3clienthandler.NewHandler(s3clienthandler.WithBackendStore(bs), s3clienthandler.WithKubeClient(cl)),
+		log:                    logr.Discard(),
+		minReplicas:            1,
+		failoverPeriod:         time.Nanosecond,
+		failoverRecoveryPolicy: FailoverRecoveryPolicyRemove,
+	}
+
+	require.NoError(t, e.failoverBucket(context.Background(), bucket, bs.GetAllBackendNames()))
+	assert.Equal(t, 1, replicaClient.DeleteBucketCallCount(), "failover replica must be deleted")
+
+	latest := &v1alpha1.Bucket{}
+	require.NoError(t, cl.Get(context.Background(), types.NamespacedName{Name: bucket.Name}, latest))
+	assert.Empty(t, latest.Status.AtProvider.FailoverBackends, "failover replica must be removed from status")
+	assert.NotContains(t, latest.Status.AtProvider.Backends, consts.S3Backend2, "failover replica backend must be removed from status")
+	assert.NotContains(t, latest.Labels, utils.GetBackendLabel(consts.S3Backend2), "failover replica backend label must be removed")
+	assert.Equal(t, []string{consts.S3Backend1}, getBucketProvidersFilterDisabledLabel(latest, bs.GetAllBackendNames()))
+}
@@ -3,12 +3,14 @@ package bucket
 import (
 	"context"
 	"fmt"
+	"maps"
 	"slices"
 	"strings"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
 	"github.com/linode/provider-ceph/internal/backendstore"
@@ -80,6 +82,113 @@ func isPauseRequired(bucket *v1alpha1.Bucket, providerNames []string, c map[stri
 		return false
 	}
 
+	// Avoid pausing when a quota is specified in the spec, but not all quotas are available.
+	if bucket.Spec.ForProvider.Quota != nil && !bb.isQuotaAvailableOnBackends(bucket.Name, providerNames, c) {
+		return false
+	}
+
+	// Avoid pausing when a quota has been removed from the spec, but has not
+	// yet been disabled on all backends.
+	if bucket.Spec.ForProvider.Quota == nil && !bb.isQuotaRemovedFromBackends(bucket.Name, providerNames, c) {
+		return false
+	}
+
+	// Avoid pausing when a CORS configuration is specified in the spec, but not all
+	// CORS configs are available.
+	if bucket.Spec.ForProvider.CORSConfiguration != nil && !bb.isCORSConfigAvailableOnBackends(bucket.Name, providerNames, c) {
+		return false
+	}
+
+	// Avoid pausing when a CORS configuration has been removed from the spec, but
+	// has not yet been removed from all backends.
+	if bucket.Spec.ForProvider.CORSConfiguration == nil && !bb.isCORSConfigRemovedFromBackends(bucket.Name, providerNames, c) {
+		return false
+	}
+
+	// Avoid pausing when a server-side encryption configuration is specified in
+	// the spec, but not all server-side encryption configs are available.
+	if bucket.Spec.ForProvider.ServerSideEncryptionConfiguration != nil && !bb.isSSEConfigAvailableOnBackends(bucket.Name, providerNames, c) {
+		return false
+	}
+
+	// Avoid pausing when a server-side encryption configuration has been removed
+	// from the spec, but has not yet been removed from all backends.
+	if bucket.Spec.ForProvider.ServerSideEncryptionConfiguration == nil && !bb.isSSEConfigRemovedFromBackends(bucket.Name, providerNames, c) {
+		return false
+	}
+
+	// Avoid pausing when a notification configuration is specified in the spec,
+	// but not all notification configs are available.
+	if bucket.Spec.ForProvider.NotificationConfiguration != nil && !bb.isNotificationConfigAvailableOnBackends(bucket.Name, providerNames, c) {
+		return false
+	}
+
+	// Avoid pausing when a notification configuration has been removed from the
+	// spec, but has not yet been removed from all backends.
+	if bucket.Spec.ForProvider.NotificationConfiguration == nil && !bb.isNotificationConfigRemovedFromBackends(bucket.Name, providerNames, c) {
+		return false
+	}
+
+	// Avoid pausing when a replication configuration is specified in the spec,
+	// but not all replication configurations are available.
+	if bucket.Spec.ForProvider.ReplicationConfiguration != nil && !bb.isReplicationConfigAvailableOnBackends(bucket.Name, providerNames, c) {
+		return false
+	}
+
+	// Avoid pausing when a replication configuration has been removed from the
+	// spec, but has not yet been removed from all backends.
+	if bucket.Spec.ForProvider.ReplicationConfiguration == nil && !bb.isReplicationConfigRemovedFromBackends(bucket.Name, providerNames, c) {
+		return false
+	}
+
+	// Avoid pausing when a website configuration is specified in the spec,
+	// but not all website configurations are available.
+	if bucket.Spec.ForProvider.WebsiteConfiguration != nil && !bb.isWebsiteConfigAvailableOnBackends(bucket.Name, providerNames, c) {
+		return false
+	}
+
+	// Avoid pausing when a website configuration has been removed from the
+	// spec, but has not yet been removed from all backends.
+	if bucket.Spec.ForProvider.WebsiteConfiguration == nil && !bb.isWebsiteConfigRemovedFromBackends(bucket.Name, providerNames, c) {
+		return false
+	}
+
+	// Avoid pausing when an object ownership is specified in the spec,
+	// but the ownership controls are not available on all backends.
+	if bucket.Spec.ForProvider.ObjectOwnership != nil && !bb.isOwnershipControlsAvailableOnBackends(bucket.Name, providerNames, c) {
+		return false
+	}
+
+	// Avoid pausing when an object ownership has been removed from the spec,
+	// but the ownership controls have not yet been removed from all backends.
+	if bucket.Spec.ForProvider.ObjectOwnership == nil && !bb.isOwnershipControlsRemovedFromBackends(bucket.Name, providerNames, c) {
+		return false
+	}
+
+	// Avoid pausing when the public access block configuration has not been
+	// applied to, or removed from, all backends.
+	if !bb.isPublicAccessBlockSyncedOnBackends(bucket.Name, providerNames, c) {
+		return false
+	}
+
+	// Avoid pausing when a logging configuration is specified in the spec,
+	// but not all logging configurations are available.
+	if bucket.Spec.ForProvider.LoggingConfiguration != nil && !bb.isLoggingConfigAvailableOnBackends(bucket.Name, providerNames, c) {
+		return false
+	}
+
+	// Avoid pausing when a logging configuration has been removed from the
+	// spec, but has not yet been removed from all backends.
+	if bucket.Spec.ForProvider.LoggingConfiguration == nil && !bb.isLoggingConfigRemovedFromBackends(bucket.Name, providerNames, c) {
+		return false
+	}
+
+	// Avoid pausing when the tags have not been applied to, or removed from,
+	// all backends.
+	if !bb.isTaggingSyncedOnBackends(bucket.Name, providerNames, c) {
+		return false
+	}
+
 	return (bucket.Spec.AutoPause || autopauseEnabled) &&
 		// Only return true if this label value is "".
 		// This is to allow the user to delete a paused bucket with autopause enabled.
@@ -143,14 +252,71 @@ func setAllBackendLabels(bucket *v1alpha1.Bucket, providerNames []string) {
 	}
 }
 
-// getBucketProvidersFilterDisabledLabel returns the specified providers or default providers,
-// and filters out providers disabled by label.
+// resolveBucketProviders returns the names of the backends of the providers specified
+// in the Bucket CR. The providers of a namespaced Bucket refer to a ProviderConfig in
+// the namespace of the Bucket or, if there is none with that name, to a ClusterProviderConfig
+// among providerNames, which must not hold the backends of legacy ProviderConfigs. Other
+// providers of a namespaced Bucket resolve to missing backends in its namespace.
+func resolveBucketProviders(bucket *v1alpha1.Bucket, providerNames []string) []string {
+	if bucket.Namespace == "" {
+		return bucket.Spec.Providers
+	}
+
+	providers := make([]string, 0, len(bucket.Spec.Providers))
+	for _, provider := range bucket.Spec.Providers {
+		backendName := backendstore.BackendName(bucket.Namespace, provider)
+		if !slices.Contains(providerNames, backendName) && slices.Contains(providerNames, provider) {
+			backendName = provider
+		}
+		providers = append(providers, backendName)
+	}
+
+	return providers
+}
+
+// getBucketBackendNames returns the names of the backends which may be used by the bucket,
+// ie the backends available in its namespace except the cordoned backends on which it has
+// not been placed. No new buckets are placed on a cordoned backend, unless the backend is
+// specified as one of the providers of the bucket.
+func (c *external) getBucketBackendNames(bucket *v1alpha1.Bucket) []string {
+	return slices.DeleteFunc(c.backendStore.GetBackendNamesForNamespace(bucket.Namespace), func(backendName string) bool {
+		return c.backendStore.IsBackendCordoned(backendName) && !isPlacedOnBackend(bucket, backendName)
+	})
+}
+
+// isPlacedOnBackend returns true if the bucket has been placed on the backend, or the
+// backend is specified as one of its providers.
+func isPlacedOnBackend(bucket *v1alpha1.Bucket, backendName string) bool {
+	if _, ok := bucket.Labels[utils.GetBackendLabel(backendName)]; ok {
+		return true
+	}
+	if _, ok := bucket.Status.AtProvider.Backends[backendName]; ok {
+		return true
+	}
+
+	namespace, name := backendstore.SplitBackendName(backendName)
+
+	return (namespace == "" || namespace == bucket.Namespace) && slices.Contains(bucket.Spec.Providers, name)
+}
+
+// getBucketProvidersFilterDisabledLabel returns the specified providers, the backends chosen by
+// the placement policy or default providers together with any failover replicas, and filters
+// out providers disabled by label.
 func getBucketProvidersFilterDisabledLabel(bucket *v1alpha1.Bucket, providerNames []string) []string {
-	providers := bucket.Spec.Providers
-	if len(providers) == 0 {
+	providers := resolveBucketProviders(bucket, providerNames)
+	if isPlacedByPolicy(bucket) {
+		providers = bucket.Status.AtProvider.PlacedBackends
+	} else if len(providers) == 0 {
 		providers = providerNames
 	}
 
+	// Failover replicas are kept in addition to the backends of the bucket.
+	for _, replica := range slices.Sorted(maps.Keys(bucket.Status.AtProvider.FailoverBackends)) {
+		if !slices.Contains(providers, replica) {
+			providers = append(slices.Clone(providers), replica)
+		}
+	}
+
 	okProviders := []string{}
 	for i := range providers {
 		// Skip explicitly disabled backends
@@ -249,23 +415,21 @@ func (c *external) updateBucketCR(ctx context.Context, bucket *v1alpha1.Bucket,
 			// the first Get(). Subsequent Get() calls must use the kube reader which reads
 			// directly from the API. This is necessary as we are doing Patch and Get calls
 			// in quick succession and need to avoid reading stale data from the client cache.
-			switch i {
-			case 0:
-				if err := c.kubeClient.Get(
-					ctx,
-					types.NamespacedName{Name: bucket.GetName()},
-					bucket,
-				); err != nil {
-					return err
-				}
-			default:
-				if err := c.kubeReader.Get(
-					ctx,
-					types.NamespacedName{Name: bucket.GetName()},
-					bucket,
-				); err != nil {
-					return err
-				}
+			var reader client.Reader = c.kubeClient
+			if i > 0 {
+				reader = c.kubeReader
+			}
+
+			if bucket.GetNamespace() != "" {
+				return c.updateNamespacedBucketCR(ctx, reader, bucket, cb)
+			}
+
+			if err := reader.Get(
+				ctx,
+				types.NamespacedName{Name: bucket.GetName()},
+				bucket,
+			); err != nil {
+				return err
 			}
 
 			bucketCopy := bucket.DeepCopy()
@@ -292,3 +456,44 @@ func (c *external) updateBucketCR(ctx context.Context, bucket *v1alpha1.Bucket,
 
 	return nil
 }
+
+// getConnectionDetails returns the name of the bucket together with the
+// endpoint of the first backend (in alphabetical order) on which the bucket
+// is available, and the URL of the website of the bucket on that backend if
+// the bucket has a website configuration and the backend a website endpoint.
+func (c *external) getConnectionDetails(ctx context.Context, bucket *v1alpha1.Bucket, providerNames []string) (managed.ConnectionDetails, error) {
+	sorted := slices.Clone(providerNames)
+	slices.Sort(sorted)
+
+	for _, backendName := range sorted {
+		backend, ok := bucket.Status.AtProvider.Backends[backendName]
+		if !ok || backend == nil || !backend.BucketCondition.Equal(xpv1.Available()) {
+			continue
+		}
+
+		pc, err := utils.GetProviderConfig(ctx, c.kubeClient, backendName)
+		if err != nil {
+			return nil, errors.Wrap(err, errGetPC)
+		}
+
+		details := managed.ConnectionDetails{
+			consts.KeyEndpoint: []byte(utils.ResolveHostBase(pc.GetSpec().HostBase, pc.GetSpec().UseHTTPS)),
+			consts.KeyBucket:   []byte(bucket.Name),
+		}
+		if bucket.Spec.ForProvider.WebsiteConfiguration != nil && pc.GetSpec().WebsiteEndpoint != nil {
+			details[consts.KeyWebsiteURL] = []byte(getWebsiteURL(bucket.Name, *pc.GetSpec().WebsiteEndpoint, pc.GetSpec().UseHTTPS))
+		}
+
+		return details, nil
+	}
+
+	return managed.ConnectionDetails{}, nil
+}
+
+// getWebsiteURL returns the URL of the website of the bucket, which is served
+// from the virtual host of the bucket on the website endpoint.
+func getWebsiteURL(bucketName, websiteEndpoint string, useHTTPS bool) string {
+	scheme, host, _ := strings.Cut(utils.ResolveHostBase(websiteEndpoint, useHTTPS), "://")
+
+	return scheme + "://" + bucketName + "." + host
+}
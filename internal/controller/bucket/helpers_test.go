@@ -19,14 +19,17 @@ package bucket
 import (
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/stretchr/testify/assert"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
 	"github.com/linode/provider-ceph/internal/backendstore"
 	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/utils"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -36,6 +39,7 @@ func TestIsPauseRequired(t *testing.T) {
 	available := xpv1.Available()
 	unavailable := xpv1.Unavailable()
 	vEnabled := v1alpha1.VersioningStatusEnabled
+	maxObjects := int64(10)
 	someErr := errors.New("some error")
 	type args struct {
 		bucket           *v1alpha1.Bucket
@@ -1542,6 +1546,837 @@ func TestIsPauseRequired(t *testing.T) {
 				pauseIsRequired: true,
 			},
 		},
+		"Quota specified but unavailable on one backend - no pause": {
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+						Labels: map[string]string{
+							meta.AnnotationKeyReconciliationPaused: "",
+						},
+					},
+					Spec: v1alpha1.BucketSpec{
+						AutoPause: true,
+						ForProvider: v1alpha1.BucketParameters{
+							Quota: &v1alpha1.BucketQuota{
+								MaxObjects: &maxObjects,
+							},
+						},
+					},
+					Status: v1alpha1.BucketStatus{
+						ResourceStatus: xpv1.ResourceStatus{
+							ConditionedStatus: xpv1.ConditionedStatus{
+								Conditions: []xpv1.Condition{
+									xpv1.Available(),
+									xpv1.ReconcileSuccess(),
+								},
+							},
+						},
+					},
+				},
+				providerNames: []string{consts.S3Backend1, consts.S3Backend2},
+				clients: map[string]backendstore.S3Client{
+					consts.S3Backend1: nil,
+					consts.S3Backend2: nil,
+				},
+				bucketBackends: &bucketBackends{
+					backends: map[string]v1alpha1.Backends{
+						consts.TestBucket: {
+							consts.S3Backend1: &v1alpha1.BackendInfo{
+								BucketCondition: xpv1.Available(),
+								QuotaCondition:  &available,
+							},
+							consts.S3Backend2: &v1alpha1.BackendInfo{
+								BucketCondition: xpv1.Available(),
+								QuotaCondition:  &unavailable,
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				pauseIsRequired: false,
+			},
+		},
+		"Quota specified and available on all backends - pause": {
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+						Labels: map[string]string{
+							meta.AnnotationKeyReconciliationPaused: "",
+						},
+					},
+					Spec: v1alpha1.BucketSpec{
+						AutoPause: true,
+						ForProvider: v1alpha1.BucketParameters{
+							Quota: &v1alpha1.BucketQuota{
+								MaxObjects: &maxObjects,
+							},
+						},
+					},
+					Status: v1alpha1.BucketStatus{
+						ResourceStatus: xpv1.ResourceStatus{
+							ConditionedStatus: xpv1.ConditionedStatus{
+								Conditions: []xpv1.Condition{
+									xpv1.Available(),
+									xpv1.ReconcileSuccess(),
+								},
+							},
+						},
+					},
+				},
+				providerNames: []string{consts.S3Backend1, consts.S3Backend2},
+				clients: map[string]backendstore.S3Client{
+					consts.S3Backend1: nil,
+					consts.S3Backend2: nil,
+				},
+				bucketBackends: &bucketBackends{
+					backends: map[string]v1alpha1.Backends{
+						consts.TestBucket: {
+							consts.S3Backend1: &v1alpha1.BackendInfo{
+								BucketCondition: xpv1.Available(),
+								QuotaCondition:  &available,
+							},
+							consts.S3Backend2: &v1alpha1.BackendInfo{
+								BucketCondition: xpv1.Available(),
+								QuotaCondition:  &available,
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				pauseIsRequired: true,
+			},
+		},
+		"Quota not specified but not yet removed from one backend - no pause": {
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+						Labels: map[string]string{
+							meta.AnnotationKeyReconciliationPaused: "",
+						},
+					},
+					Spec: v1alpha1.BucketSpec{
+						AutoPause: true,
+					},
+					Status: v1alpha1.BucketStatus{
+						ResourceStatus: xpv1.ResourceStatus{
+							ConditionedStatus: xpv1.ConditionedStatus{
+								Conditions: []xpv1.Condition{
+									xpv1.Available(),
+									xpv1.ReconcileSuccess(),
+								},
+							},
+						},
+					},
+				},
+				providerNames: []string{consts.S3Backend1, consts.S3Backend2},
+				clients: map[string]backendstore.S3Client{
+					consts.S3Backend1: nil,
+					consts.S3Backend2: nil,
+				},
+				bucketBackends: &bucketBackends{
+					backends: map[string]v1alpha1.Backends{
+						consts.TestBucket: {
+							consts.S3Backend1: &v1alpha1.BackendInfo{
+								BucketCondition: xpv1.Available(),
+								QuotaCondition:  &available,
+							},
+							consts.S3Backend2: &v1alpha1.BackendInfo{
+								BucketCondition: xpv1.Available(),
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				pauseIsRequired: false,
+			},
+		},
+		"CORS config specified but unavailable on one backend - no pause": {
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+						Labels: map[string]string{
+							meta.AnnotationKeyReconciliationPaused: "",
+						},
+					},
+					Spec: v1alpha1.BucketSpec{
+						AutoPause: true,
+						ForProvider: v1alpha1.BucketParameters{
+							CORSConfiguration: &v1alpha1.CORSConfiguration{
+								CORSRules: []v1alpha1.CORSRule{
+									{AllowedMethods: []string{"GET"}, AllowedOrigins: []string{"*"}},
+								},
+							},
+						},
+					},
+					Status: v1alpha1.BucketStatus{
+						ResourceStatus: xpv1.ResourceStatus{
+							ConditionedStatus: xpv1.ConditionedStatus{
+								Conditions: []xpv1.Condition{
+									xpv1.Available(),
+									xpv1.ReconcileSuccess(),
+								},
+							},
+						},
+					},
+				},
+				providerNames: []string{consts.S3Backend1, consts.S3Backend2},
+				clients: map[string]backendstore.S3Client{
+					consts.S3Backend1: nil,
+					consts.S3Backend2: nil,
+				},
+				bucketBackends: &bucketBackends{
+					backends: map[string]v1alpha1.Backends{
+						consts.TestBucket: {
+							consts.S3Backend1: &v1alpha1.BackendInfo{
+								BucketCondition:            xpv1.Available(),
+								CORSConfigurationCondition: &available,
+							},
+							consts.S3Backend2: &v1alpha1.BackendInfo{
+								BucketCondition:            xpv1.Available(),
+								CORSConfigurationCondition: &unavailable,
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				pauseIsRequired: false,
+			},
+		},
+		"CORS config not specified but not yet removed from one backend - no pause": {
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+						Labels: map[string]string{
+							meta.AnnotationKeyReconciliationPaused: "",
+						},
+					},
+					Spec: v1alpha1.BucketSpec{
+						AutoPause: true,
+					},
+					Status: v1alpha1.BucketStatus{
+						ResourceStatus: xpv1.ResourceStatus{
+							ConditionedStatus: xpv1.ConditionedStatus{
+								Conditions: []xpv1.Condition{
+									xpv1.Available(),
+									xpv1.ReconcileSuccess(),
+								},
+							},
+						},
+					},
+				},
+				providerNames: []string{consts.S3Backend1, consts.S3Backend2},
+				clients: map[string]backendstore.S3Client{
+					consts.S3Backend1: nil,
+					consts.S3Backend2: nil,
+				},
+				bucketBackends: &bucketBackends{
+					backends: map[string]v1alpha1.Backends{
+						consts.TestBucket: {
+							consts.S3Backend1: &v1alpha1.BackendInfo{
+								BucketCondition:            xpv1.Available(),
+								CORSConfigurationCondition: &available,
+							},
+							consts.S3Backend2: &v1alpha1.BackendInfo{
+								BucketCondition: xpv1.Available(),
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				pauseIsRequired: false,
+			},
+		},
+		"Tags failed to be applied on one backend - no pause": {
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+						Labels: map[string]string{
+							meta.AnnotationKeyReconciliationPaused: "",
+						},
+					},
+					Spec: v1alpha1.BucketSpec{
+						AutoPause: true,
+						ForProvider: v1alpha1.BucketParameters{
+							Tags: []v1alpha1.Tag{{Key: "team", Value: "storage"}},
+						},
+					},
+					Status: v1alpha1.BucketStatus{
+						ResourceStatus: xpv1.ResourceStatus{
+							ConditionedStatus: xpv1.ConditionedStatus{
+								Conditions: []xpv1.Condition{
+									xpv1.Available(),
+									xpv1.ReconcileSuccess(),
+								},
+							},
+						},
+					},
+				},
+				providerNames: []string{consts.S3Backend1, consts.S3Backend2},
+				clients: map[string]backendstore.S3Client{
+					consts.S3Backend1: nil,
+					consts.S3Backend2: nil,
+				},
+				bucketBackends: &bucketBackends{
+					backends: map[string]v1alpha1.Backends{
+						consts.TestBucket: {
+							consts.S3Backend1: &v1alpha1.BackendInfo{
+								BucketCondition:  xpv1.Available(),
+								TaggingCondition: &available,
+							},
+							consts.S3Backend2: &v1alpha1.BackendInfo{
+								BucketCondition:  xpv1.Available(),
+								TaggingCondition: &unavailable,
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				pauseIsRequired: false,
+			},
+		},
+		"Default public access block failed to be applied on one backend - no pause": {
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+						Labels: map[string]string{
+							meta.AnnotationKeyReconciliationPaused: "",
+						},
+					},
+					Spec: v1alpha1.BucketSpec{
+						AutoPause: true,
+					},
+					Status: v1alpha1.BucketStatus{
+						ResourceStatus: xpv1.ResourceStatus{
+							ConditionedStatus: xpv1.ConditionedStatus{
+								Conditions: []xpv1.Condition{
+									xpv1.Available(),
+									xpv1.ReconcileSuccess(),
+								},
+							},
+						},
+					},
+				},
+				providerNames: []string{consts.S3Backend1, consts.S3Backend2},
+				clients: map[string]backendstore.S3Client{
+					consts.S3Backend1: nil,
+					consts.S3Backend2: nil,
+				},
+				bucketBackends: &bucketBackends{
+					backends: map[string]v1alpha1.Backends{
+						consts.TestBucket: {
+							consts.S3Backend1: &v1alpha1.BackendInfo{
+								BucketCondition:            xpv1.Available(),
+								PublicAccessBlockCondition: &available,
+							},
+							consts.S3Backend2: &v1alpha1.BackendInfo{
+								BucketCondition:            xpv1.Available(),
+								PublicAccessBlockCondition: &unavailable,
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				pauseIsRequired: false,
+			},
+		},
+		"Notifications failed to be applied on one backend - no pause": {
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+						Labels: map[string]string{
+							meta.AnnotationKeyReconciliationPaused: "",
+						},
+					},
+					Spec: v1alpha1.BucketSpec{
+						AutoPause: true,
+						ForProvider: v1alpha1.BucketParameters{
+							NotificationConfiguration: &v1alpha1.NotificationConfiguration{
+								TopicConfigurations: []v1alpha1.TopicConfiguration{
+									{ID: "uploads", Topic: "topic", Events: []string{"s3:ObjectCreated:*"}},
+								},
+							},
+						},
+					},
+					Status: v1alpha1.BucketStatus{
+						ResourceStatus: xpv1.ResourceStatus{
+							ConditionedStatus: xpv1.ConditionedStatus{
+								Conditions: []xpv1.Condition{
+									xpv1.Available(),
+									xpv1.ReconcileSuccess(),
+								},
+							},
+						},
+					},
+				},
+				providerNames: []string{consts.S3Backend1, consts.S3Backend2},
+				clients: map[string]backendstore.S3Client{
+					consts.S3Backend1: nil,
+					consts.S3Backend2: nil,
+				},
+				bucketBackends: &bucketBackends{
+					backends: map[string]v1alpha1.Backends{
+						consts.TestBucket: {
+							consts.S3Backend1: &v1alpha1.BackendInfo{
+								BucketCondition:                    xpv1.Available(),
+								NotificationConfigurationCondition: &available,
+							},
+							consts.S3Backend2: &v1alpha1.BackendInfo{
+								BucketCondition:                    xpv1.Available(),
+								NotificationConfigurationCondition: &unavailable,
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				pauseIsRequired: false,
+			},
+		},
+		"Notifications not specified but not yet removed from one backend - no pause": {
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+						Labels: map[string]string{
+							meta.AnnotationKeyReconciliationPaused: "",
+						},
+					},
+					Spec: v1alpha1.BucketSpec{
+						AutoPause: true,
+					},
+					Status: v1alpha1.BucketStatus{
+						ResourceStatus: xpv1.ResourceStatus{
+							ConditionedStatus: xpv1.ConditionedStatus{
+								Conditions: []xpv1.Condition{
+									xpv1.Available(),
+									xpv1.ReconcileSuccess(),
+								},
+							},
+						},
+					},
+				},
+				providerNames: []string{consts.S3Backend1, consts.S3Backend2},
+				clients: map[string]backendstore.S3Client{
+					consts.S3Backend1: nil,
+					consts.S3Backend2: nil,
+				},
+				bucketBackends: &bucketBackends{
+					backends: map[string]v1alpha1.Backends{
+						consts.TestBucket: {
+							consts.S3Backend1: &v1alpha1.BackendInfo{
+								BucketCondition: xpv1.Available(),
+							},
+							consts.S3Backend2: &v1alpha1.BackendInfo{
+								BucketCondition:                    xpv1.Available(),
+								NotificationConfigurationCondition: &available,
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				pauseIsRequired: false,
+			},
+		},
+		"Replication config failed to be applied on one backend - no pause": {
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+						Labels: map[string]string{
+							meta.AnnotationKeyReconciliationPaused: "",
+						},
+					},
+					Spec: v1alpha1.BucketSpec{
+						AutoPause: true,
+						ForProvider: v1alpha1.BucketParameters{
+							ReplicationConfiguration: &v1alpha1.ReplicationConfiguration{Rules: []v1alpha1.ReplicationRule{{ID: "all", Status: "Enabled", Destination: v1alpha1.ReplicationDestination{Bucket: "destination"}}}},
+						},
+					},
+					Status: v1alpha1.BucketStatus{
+						ResourceStatus: xpv1.ResourceStatus{
+							ConditionedStatus: xpv1.ConditionedStatus{
+								Conditions: []xpv1.Condition{
+									xpv1.Available(),
+									xpv1.ReconcileSuccess(),
+								},
+							},
+						},
+					},
+				},
+				providerNames: []string{consts.S3Backend1, consts.S3Backend2},
+				clients: map[string]backendstore.S3Client{
+					consts.S3Backend1: nil,
+					consts.S3Backend2: nil,
+				},
+				bucketBackends: &bucketBackends{
+					backends: map[string]v1alpha1.Backends{
+						consts.TestBucket: {
+							consts.S3Backend1: &v1alpha1.BackendInfo{
+								BucketCondition:                   xpv1.Available(),
+								ReplicationConfigurationCondition: &available,
+							},
+							consts.S3Backend2: &v1alpha1.BackendInfo{
+								BucketCondition:                   xpv1.Available(),
+								ReplicationConfigurationCondition: &unavailable,
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				pauseIsRequired: false,
+			},
+		},
+		"Replication config not specified but not yet removed from one backend - no pause": {
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+						Labels: map[string]string{
+							meta.AnnotationKeyReconciliationPaused: "",
+						},
+					},
+					Spec: v1alpha1.BucketSpec{
+						AutoPause: true,
+					},
+					Status: v1alpha1.BucketStatus{
+						ResourceStatus: xpv1.ResourceStatus{
+							ConditionedStatus: xpv1.ConditionedStatus{
+								Conditions: []xpv1.Condition{
+									xpv1.Available(),
+									xpv1.ReconcileSuccess(),
+								},
+							},
+						},
+					},
+				},
+				providerNames: []string{consts.S3Backend1, consts.S3Backend2},
+				clients: map[string]backendstore.S3Client{
+					consts.S3Backend1: nil,
+					consts.S3Backend2: nil,
+				},
+				bucketBackends: &bucketBackends{
+					backends: map[string]v1alpha1.Backends{
+						consts.TestBucket: {
+							consts.S3Backend1: &v1alpha1.BackendInfo{
+								BucketCondition: xpv1.Available(),
+							},
+							consts.S3Backend2: &v1alpha1.BackendInfo{
+								BucketCondition:                   xpv1.Available(),
+								ReplicationConfigurationCondition: &available,
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				pauseIsRequired: false,
+			},
+		},
+		"Website config failed to be applied on one backend - no pause": {
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+						Labels: map[string]string{
+							meta.AnnotationKeyReconciliationPaused: "",
+						},
+					},
+					Spec: v1alpha1.BucketSpec{
+						AutoPause: true,
+						ForProvider: v1alpha1.BucketParameters{
+							WebsiteConfiguration: &v1alpha1.WebsiteConfiguration{IndexDocument: &v1alpha1.IndexDocument{Suffix: "index.html"}},
+						},
+					},
+					Status: v1alpha1.BucketStatus{
+						ResourceStatus: xpv1.ResourceStatus{
+							ConditionedStatus: xpv1.ConditionedStatus{
+								Conditions: []xpv1.Condition{
+									xpv1.Available(),
+									xpv1.ReconcileSuccess(),
+								},
+							},
+						},
+					},
+				},
+				providerNames: []string{consts.S3Backend1, consts.S3Backend2},
+				clients: map[string]backendstore.S3Client{
+					consts.S3Backend1: nil,
+					consts.S3Backend2: nil,
+				},
+				bucketBackends: &bucketBackends{
+					backends: map[string]v1alpha1.Backends{
+						consts.TestBucket: {
+							consts.S3Backend1: &v1alpha1.BackendInfo{
+								BucketCondition:               xpv1.Available(),
+								WebsiteConfigurationCondition: &available,
+							},
+							consts.S3Backend2: &v1alpha1.BackendInfo{
+								BucketCondition:               xpv1.Available(),
+								WebsiteConfigurationCondition: &unavailable,
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				pauseIsRequired: false,
+			},
+		},
+		"Website config not specified but not yet removed from one backend - no pause": {
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+						Labels: map[string]string{
+							meta.AnnotationKeyReconciliationPaused: "",
+						},
+					},
+					Spec: v1alpha1.BucketSpec{
+						AutoPause: true,
+					},
+					Status: v1alpha1.BucketStatus{
+						ResourceStatus: xpv1.ResourceStatus{
+							ConditionedStatus: xpv1.ConditionedStatus{
+								Conditions: []xpv1.Condition{
+									xpv1.Available(),
+									xpv1.ReconcileSuccess(),
+								},
+							},
+						},
+					},
+				},
+				providerNames: []string{consts.S3Backend1, consts.S3Backend2},
+				clients: map[string]backendstore.S3Client{
+					consts.S3Backend1: nil,
+					consts.S3Backend2: nil,
+				},
+				bucketBackends: &bucketBackends{
+					backends: map[string]v1alpha1.Backends{
+						consts.TestBucket: {
+							consts.S3Backend1: &v1alpha1.BackendInfo{
+								BucketCondition: xpv1.Available(),
+							},
+							consts.S3Backend2: &v1alpha1.BackendInfo{
+								BucketCondition:               xpv1.Available(),
+								WebsiteConfigurationCondition: &available,
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				pauseIsRequired: false,
+			},
+		},
+		"Ownership controls failed to be applied on one backend - no pause": {
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+						Labels: map[string]string{
+							meta.AnnotationKeyReconciliationPaused: "",
+						},
+					},
+					Spec: v1alpha1.BucketSpec{
+						AutoPause: true,
+						ForProvider: v1alpha1.BucketParameters{
+							ObjectOwnership: aws.String("BucketOwnerEnforced"),
+						},
+					},
+					Status: v1alpha1.BucketStatus{
+						ResourceStatus: xpv1.ResourceStatus{
+							ConditionedStatus: xpv1.ConditionedStatus{
+								Conditions: []xpv1.Condition{
+									xpv1.Available(),
+									xpv1.ReconcileSuccess(),
+								},
+							},
+						},
+					},
+				},
+				providerNames: []string{consts.S3Backend1, consts.S3Backend2},
+				clients: map[string]backendstore.S3Client{
+					consts.S3Backend1: nil,
+					consts.S3Backend2: nil,
+				},
+				bucketBackends: &bucketBackends{
+					backends: map[string]v1alpha1.Backends{
+						consts.TestBucket: {
+							consts.S3Backend1: &v1alpha1.BackendInfo{
+								BucketCondition:            xpv1.Available(),
+								OwnershipControlsCondition: &available,
+							},
+							consts.S3Backend2: &v1alpha1.BackendInfo{
+								BucketCondition:            xpv1.Available(),
+								OwnershipControlsCondition: &unavailable,
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				pauseIsRequired: false,
+			},
+		},
+		"Ownership controls not specified but not yet removed from one backend - no pause": {
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+						Labels: map[string]string{
+							meta.AnnotationKeyReconciliationPaused: "",
+						},
+					},
+					Spec: v1alpha1.BucketSpec{
+						AutoPause: true,
+					},
+					Status: v1alpha1.BucketStatus{
+						ResourceStatus: xpv1.ResourceStatus{
+							ConditionedStatus: xpv1.ConditionedStatus{
+								Conditions: []xpv1.Condition{
+									xpv1.Available(),
+									xpv1.ReconcileSuccess(),
+								},
+							},
+						},
+					},
+				},
+				providerNames: []string{consts.S3Backend1, consts.S3Backend2},
+				clients: map[string]backendstore.S3Client{
+					consts.S3Backend1: nil,
+					consts.S3Backend2: nil,
+				},
+				bucketBackends: &bucketBackends{
+					backends: map[string]v1alpha1.Backends{
+						consts.TestBucket: {
+							consts.S3Backend1: &v1alpha1.BackendInfo{
+								BucketCondition: xpv1.Available(),
+							},
+							consts.S3Backend2: &v1alpha1.BackendInfo{
+								BucketCondition:            xpv1.Available(),
+								OwnershipControlsCondition: &available,
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				pauseIsRequired: false,
+			},
+		},
+		"Logging config failed to be applied on one backend - no pause": {
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+						Labels: map[string]string{
+							meta.AnnotationKeyReconciliationPaused: "",
+						},
+					},
+					Spec: v1alpha1.BucketSpec{
+						AutoPause: true,
+						ForProvider: v1alpha1.BucketParameters{
+							LoggingConfiguration: &v1alpha1.LoggingConfiguration{TargetBucket: "log-bucket"},
+						},
+					},
+					Status: v1alpha1.BucketStatus{
+						ResourceStatus: xpv1.ResourceStatus{
+							ConditionedStatus: xpv1.ConditionedStatus{
+								Conditions: []xpv1.Condition{
+									xpv1.Available(),
+									xpv1.ReconcileSuccess(),
+								},
+							},
+						},
+					},
+				},
+				providerNames: []string{consts.S3Backend1, consts.S3Backend2},
+				clients: map[string]backendstore.S3Client{
+					consts.S3Backend1: nil,
+					consts.S3Backend2: nil,
+				},
+				bucketBackends: &bucketBackends{
+					backends: map[string]v1alpha1.Backends{
+						consts.TestBucket: {
+							consts.S3Backend1: &v1alpha1.BackendInfo{
+								BucketCondition:               xpv1.Available(),
+								LoggingConfigurationCondition: &available,
+							},
+							consts.S3Backend2: &v1alpha1.BackendInfo{
+								BucketCondition:               xpv1.Available(),
+								LoggingConfigurationCondition: &unavailable,
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				pauseIsRequired: false,
+			},
+		},
+		"Logging config not specified but not yet removed from one backend - no pause": {
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+						Labels: map[string]string{
+							meta.AnnotationKeyReconciliationPaused: "",
+						},
+					},
+					Spec: v1alpha1.BucketSpec{
+						AutoPause: true,
+					},
+					Status: v1alpha1.BucketStatus{
+						ResourceStatus: xpv1.ResourceStatus{
+							ConditionedStatus: xpv1.ConditionedStatus{
+								Conditions: []xpv1.Condition{
+									xpv1.Available(),
+									xpv1.ReconcileSuccess(),
+								},
+							},
+						},
+					},
+				},
+				providerNames: []string{consts.S3Backend1, consts.S3Backend2},
+				clients: map[string]backendstore.S3Client{
+					consts.S3Backend1: nil,
+					consts.S3Backend2: nil,
+				},
+				bucketBackends: &bucketBackends{
+					backends: map[string]v1alpha1.Backends{
+						consts.TestBucket: {
+							consts.S3Backend1: &v1alpha1.BackendInfo{
+								BucketCondition: xpv1.Available(),
+							},
+							consts.S3Backend2: &v1alpha1.BackendInfo{
+								BucketCondition:               xpv1.Available(),
+								LoggingConfigurationCondition: &available,
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				pauseIsRequired: false,
+			},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
@@ -1557,3 +2392,99 @@ func TestIsPauseRequired(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveBucketProviders(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		bucket        *v1alpha1.Bucket
+		providerNames []string
+		want          []string
+	}{
+		"Cluster scoped bucket uses providers as is": {
+			bucket: &v1alpha1.Bucket{
+				Spec: v1alpha1.BucketSpec{Providers: []string{consts.S3Backend1}},
+			},
+			providerNames: []string{consts.S3Backend1, "ns/" + consts.S3Backend1},
+			want:          []string{consts.S3Backend1},
+		},
+		"Namespaced bucket prefers provider config in its namespace": {
+			bucket: &v1alpha1.Bucket{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns"},
+				Spec:       v1alpha1.BucketSpec{Providers: []string{consts.S3Backend1}},
+			},
+			providerNames: []string{consts.S3Backend1, "ns/" + consts.S3Backend1},
+			want:          []string{"ns/" + consts.S3Backend1},
+		},
+		"Namespaced bucket falls back to cluster provider config": {
+			bucket: &v1alpha1.Bucket{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns"},
+				Spec:       v1alpha1.BucketSpec{Providers: []string{consts.S3Backend1, consts.S3Backend2}},
+			},
+			providerNames: []string{consts.S3Backend1, "ns/" + consts.S3Backend2},
+			want:          []string{consts.S3Backend1, "ns/" + consts.S3Backend2},
+		},
+		"Namespaced bucket does not fall back to legacy provider config": {
+			bucket: &v1alpha1.Bucket{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns"},
+				Spec:       v1alpha1.BucketSpec{Providers: []string{consts.S3Backend1}},
+			},
+			providerNames: []string{consts.S3Backend2},
+			want:          []string{"ns/" + consts.S3Backend1},
+		},
+		"Namespaced bucket without providers": {
+			bucket: &v1alpha1.Bucket{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns"},
+			},
+			providerNames: []string{consts.S3Backend1},
+			want:          []string{},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.want, resolveBucketProviders(tc.bucket, tc.providerNames))
+		})
+	}
+}
+
+func TestGetBucketBackendNames(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		bucket *v1alpha1.Bucket
+		want   []string
+	}{
+		"New bucket is not placed on cordoned backend": {
+			bucket: &v1alpha1.Bucket{},
+			want:   []string{consts.S3Backend1},
+		},
+		"Bucket is kept on cordoned backend": {
+			bucket: &v1alpha1.Bucket{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{utils.GetBackendLabel(consts.S3Backend2): consts.TrueStr},
+				},
+			},
+			want: []string{consts.S3Backend1, consts.S3Backend2},
+		},
+		"Bucket is placed on cordoned backend specified as provider": {
+			bucket: &v1alpha1.Bucket{
+				Spec: v1alpha1.BucketSpec{Providers: []string{consts.S3Backend2}},
+			},
+			want: []string{consts.S3Backend1, consts.S3Backend2},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			bs := backendstore.NewBackendStore()
+			bs.AddOrUpdateBackend(consts.S3Backend1, nil, nil, apisv1alpha1.HealthStatusHealthy)
+			bs.AddOrUpdateBackend(consts.S3Backend2, nil, nil, apisv1alpha1.HealthStatusHealthy)
+			bs.SetBackendMode(consts.S3Backend2, apisv1alpha1.BackendModeCordoned)
+
+			e := external{backendStore: bs}
+
+			assert.ElementsMatch(t, tc.want, e.getBucketBackendNames(tc.bucket))
+		})
+	}
+}
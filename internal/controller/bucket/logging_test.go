@@ -0,0 +1,571 @@
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/backendstore/backendstorefakes"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/controller/s3clienthandler"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const testLogBucket = "log-bucket"
+
+//nolint:maintidx // Function requires numerous checks.
+func TestLoggingConfigObserveBackend(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		backendStore *backendstore.BackendStore
+	}
+
+	type args struct {
+		bucket      *v1alpha1.Bucket
+		backendName string
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		fields fields
+		args   args
+		want   want
+	}{
+		"External error getting logging config": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketLoggingStub: func(ctx context.Context, in *s3.GetBucketLoggingInput, f ...func(*s3.Options)) (*s3.GetBucketLoggingOutput, error) {
+							return nil, errExternal
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							LoggingConfiguration: &v1alpha1.LoggingConfiguration{TargetBucket: testLogBucket},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    errExternal,
+			},
+		},
+		"Logging config not specified in CR and logging disabled on backend so NoAction": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketLoggingStub: func(ctx context.Context, in *s3.GetBucketLoggingInput, f ...func(*s3.Options)) (*s3.GetBucketLoggingOutput, error) {
+							return &s3.GetBucketLoggingOutput{}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NoAction,
+			},
+		},
+		"Logging config not specified in CR but logging enabled on backend so NeedsDeletion": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketLoggingStub: func(ctx context.Context, in *s3.GetBucketLoggingInput, f ...func(*s3.Options)) (*s3.GetBucketLoggingOutput, error) {
+							return &s3.GetBucketLoggingOutput{
+								LoggingEnabled: &s3types.LoggingEnabled{TargetBucket: aws.String(testLogBucket)},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsDeletion,
+			},
+		},
+		"Target prefix differs on backend so NeedsUpdate": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketLoggingStub: func(ctx context.Context, in *s3.GetBucketLoggingInput, f ...func(*s3.Options)) (*s3.GetBucketLoggingOutput, error) {
+							return &s3.GetBucketLoggingOutput{
+								LoggingEnabled: &s3types.LoggingEnabled{
+									TargetBucket: aws.String(testLogBucket),
+									TargetPrefix: aws.String("old-logs/"),
+								},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							LoggingConfiguration: &v1alpha1.LoggingConfiguration{
+								TargetBucket: testLogBucket,
+								TargetPrefix: aws.String("logs/"),
+							},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"Partitioned log format on backend but simple prefix in CR so NeedsUpdate": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketLoggingStub: func(ctx context.Context, in *s3.GetBucketLoggingInput, f ...func(*s3.Options)) (*s3.GetBucketLoggingOutput, error) {
+							return &s3.GetBucketLoggingOutput{
+								LoggingEnabled: &s3types.LoggingEnabled{
+									TargetBucket: aws.String(testLogBucket),
+									TargetPrefix: aws.String("logs/"),
+									TargetObjectKeyFormat: &s3types.TargetObjectKeyFormat{
+										PartitionedPrefix: &s3types.PartitionedPrefix{PartitionDateSource: s3types.PartitionDateSourceEventTime},
+									},
+								},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							LoggingConfiguration: &v1alpha1.LoggingConfiguration{
+								TargetBucket: testLogBucket,
+								TargetPrefix: aws.String("logs/"),
+							},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"Simple prefix without key format on backend is Updated": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketLoggingStub: func(ctx context.Context, in *s3.GetBucketLoggingInput, f ...func(*s3.Options)) (*s3.GetBucketLoggingOutput, error) {
+							return &s3.GetBucketLoggingOutput{
+								LoggingEnabled: &s3types.LoggingEnabled{
+									TargetBucket: aws.String(testLogBucket),
+									TargetPrefix: aws.String("logs/"),
+								},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							LoggingConfiguration: &v1alpha1.LoggingConfiguration{
+								TargetBucket: testLogBucket,
+								TargetPrefix: aws.String("logs/"),
+							},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"Partitioned prefix without date source on backend defaults to delivery time and is Updated": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketLoggingStub: func(ctx context.Context, in *s3.GetBucketLoggingInput, f ...func(*s3.Options)) (*s3.GetBucketLoggingOutput, error) {
+							return &s3.GetBucketLoggingOutput{
+								LoggingEnabled: &s3types.LoggingEnabled{
+									TargetBucket: aws.String(testLogBucket),
+									TargetObjectKeyFormat: &s3types.TargetObjectKeyFormat{
+										PartitionedPrefix: &s3types.PartitionedPrefix{},
+									},
+								},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							LoggingConfiguration: &v1alpha1.LoggingConfiguration{
+								TargetBucket: testLogBucket,
+								LogFormat:    aws.String(v1alpha1.LogFormatPartitionedPrefix),
+							},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := NewLoggingConfigurationClient(
+				tc.fields.backendStore,
+				s3clienthandler.NewHandler(
+					s3clienthandler.WithAssumeRoleArn(nil),
+					s3clienthandler.WithBackendStore(tc.fields.backendStore)),
+				logr.Discard())
+
+			got, err := c.observeBackend(context.Background(), tc.args.bucket, tc.args.backendName)
+			require.ErrorIs(t, err, tc.want.err, "unexpected error")
+			assert.Equal(t, tc.want.status, got, "unexpected status")
+		})
+	}
+}
+
+//nolint:maintidx // Function requires numerous checks.
+func TestLoggingConfigurationHandle(t *testing.T) {
+	t.Parallel()
+
+	bucketName := consts.TestBucket
+	beName := consts.S3Backend1
+
+	type fields struct {
+		backendStore *backendstore.BackendStore
+	}
+
+	type args struct {
+		bucket      *v1alpha1.Bucket
+		backendName string
+	}
+
+	type want struct {
+		err          error
+		specificDiff func(t *testing.T, bb *bucketBackends)
+	}
+
+	cases := map[string]struct {
+		fields fields
+		args   args
+		want   want
+	}{
+		"Unhealthy backend": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusUnhealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							LoggingConfiguration: &v1alpha1.LoggingConfiguration{TargetBucket: testLogBucket},
+						},
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				err: errUnhealthyBackend,
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					assert.Nil(t, bb.getLoggingConfigCondition(bucketName, beName), "unexpected logging config condition")
+				},
+			},
+		},
+		"Partitioned logging is enabled on backend": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketLoggingStub: func(ctx context.Context, in *s3.GetBucketLoggingInput, f ...func(*s3.Options)) (*s3.GetBucketLoggingOutput, error) {
+							return &s3.GetBucketLoggingOutput{}, nil
+						},
+						PutBucketLoggingStub: func(ctx context.Context, in *s3.PutBucketLoggingInput, f ...func(*s3.Options)) (*s3.PutBucketLoggingOutput, error) {
+							enabled := in.BucketLoggingStatus.LoggingEnabled
+							if aws.ToString(in.Bucket) != bucketName || enabled == nil ||
+								aws.ToString(enabled.TargetBucket) != testLogBucket || aws.ToString(enabled.TargetPrefix) != "logs/" ||
+								enabled.TargetObjectKeyFormat.PartitionedPrefix == nil ||
+								enabled.TargetObjectKeyFormat.PartitionedPrefix.PartitionDateSource != s3types.PartitionDateSourceEventTime {
+								return nil, errUnexpectedInput
+							}
+
+							return &s3.PutBucketLoggingOutput{}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							LoggingConfiguration: &v1alpha1.LoggingConfiguration{
+								TargetBucket:        testLogBucket,
+								TargetPrefix:        aws.String("logs/"),
+								LogFormat:           aws.String(v1alpha1.LogFormatPartitionedPrefix),
+								PartitionDateSource: aws.String(string(s3types.PartitionDateSourceEventTime)),
+							},
+						},
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					assert.True(t, bb.getLoggingConfigCondition(bucketName, beName).Equal(v1.Available()), "unexpected logging config condition")
+				},
+			},
+		},
+		"Logging is disabled on backend when removed from CR": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketLoggingStub: func(ctx context.Context, in *s3.GetBucketLoggingInput, f ...func(*s3.Options)) (*s3.GetBucketLoggingOutput, error) {
+							return &s3.GetBucketLoggingOutput{
+								LoggingEnabled: &s3types.LoggingEnabled{TargetBucket: aws.String(testLogBucket)},
+							}, nil
+						},
+						PutBucketLoggingStub: func(ctx context.Context, in *s3.PutBucketLoggingInput, f ...func(*s3.Options)) (*s3.PutBucketLoggingOutput, error) {
+							if aws.ToString(in.Bucket) != bucketName || in.BucketLoggingStatus.LoggingEnabled != nil {
+								return nil, errUnexpectedInput
+							}
+
+							return &s3.PutBucketLoggingOutput{}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					assert.Nil(t, bb.getLoggingConfigCondition(bucketName, beName), "unexpected logging config condition")
+				},
+			},
+		},
+		"Error disabling logging": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketLoggingStub: func(ctx context.Context, in *s3.GetBucketLoggingInput, f ...func(*s3.Options)) (*s3.GetBucketLoggingOutput, error) {
+							return &s3.GetBucketLoggingOutput{
+								LoggingEnabled: &s3types.LoggingEnabled{TargetBucket: aws.String(testLogBucket)},
+							}, nil
+						},
+						PutBucketLoggingStub: func(ctx context.Context, in *s3.PutBucketLoggingInput, f ...func(*s3.Options)) (*s3.PutBucketLoggingOutput, error) {
+							return nil, errExternal
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				err: errExternal,
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					condition := bb.getLoggingConfigCondition(bucketName, beName)
+					require.NotNil(t, condition, "missing logging config condition")
+					assert.Equal(t, v1.Deleting().Reason, condition.Reason, "unexpected logging config condition")
+				},
+			},
+		},
+		"Error enabling logging": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketLoggingStub: func(ctx context.Context, in *s3.GetBucketLoggingInput, f ...func(*s3.Options)) (*s3.GetBucketLoggingOutput, error) {
+							return &s3.GetBucketLoggingOutput{}, nil
+						},
+						PutBucketLoggingStub: func(ctx context.Context, in *s3.PutBucketLoggingInput, f ...func(*s3.Options)) (*s3.PutBucketLoggingOutput, error) {
+							return nil, errExternal
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							LoggingConfiguration: &v1alpha1.LoggingConfiguration{TargetBucket: testLogBucket},
+						},
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				err: errExternal,
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					condition := bb.getLoggingConfigCondition(bucketName, beName)
+					require.NotNil(t, condition, "missing logging config condition")
+					assert.Equal(t, v1.Unavailable().Reason, condition.Reason, "unexpected logging config condition")
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := NewLoggingConfigurationClient(
+				tc.fields.backendStore,
+				s3clienthandler.NewHandler(
+					s3clienthandler.WithAssumeRoleArn(nil),
+					s3clienthandler.WithBackendStore(tc.fields.backendStore)),
+				logr.Discard())
+
+			bb := newBucketBackends()
+			err := c.Handle(context.Background(), tc.args.bucket, tc.args.backendName, bb)
+			require.ErrorIs(t, err, tc.want.err, "unexpected error")
+			if tc.want.specificDiff != nil {
+				tc.want.specificDiff(t, bb)
+			}
+		})
+	}
+}
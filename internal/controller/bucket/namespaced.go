@@ -0,0 +1,139 @@
+package bucket
+
+import (
+	"context"
+	"slices"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	nsv1alpha1 "github.com/linode/provider-ceph/apis/namespaced/provider-ceph/v1alpha1"
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+)
+
+// namespacedExternal reconciles namespaced Buckets. A namespaced Bucket shares
+// its parameters and status with the cluster scoped Bucket, so the external
+// client of the cluster scoped Bucket operates on a cluster scoped view of the
+// namespaced Bucket. The view keeps the namespace of the Bucket, which is how
+// updateBucketCR and the backend selection tell both scopes apart.
+type namespacedExternal struct {
+	*external
+}
+
+func (c *namespacedExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	bucket, ok := mg.(*nsv1alpha1.Bucket)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBucket)
+	}
+
+	view := toClusterBucket(bucket)
+	defer fromClusterBucket(view, bucket)
+
+	return c.external.Observe(ctx, view)
+}
+
+func (c *namespacedExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	bucket, ok := mg.(*nsv1alpha1.Bucket)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBucket)
+	}
+
+	view := toClusterBucket(bucket)
+	defer fromClusterBucket(view, bucket)
+
+	return c.external.Create(ctx, view)
+}
+
+func (c *namespacedExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	bucket, ok := mg.(*nsv1alpha1.Bucket)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBucket)
+	}
+
+	view := toClusterBucket(bucket)
+	defer fromClusterBucket(view, bucket)
+
+	return c.external.Update(ctx, view)
+}
+
+func (c *namespacedExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	bucket, ok := mg.(*nsv1alpha1.Bucket)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotBucket)
+	}
+
+	view := toClusterBucket(bucket)
+	defer fromClusterBucket(view, bucket)
+
+	return c.external.Delete(ctx, view)
+}
+
+// updateNamespacedBucketCR applies the callback to the cluster scoped view of the
+// latest version of a namespaced Bucket and patches the namespaced Bucket with the
+// result. On success, bucket holds the view of the patched namespaced Bucket.
+func (c *external) updateNamespacedBucketCR(ctx context.Context, reader client.Reader, bucket *v1alpha1.Bucket, cb func(*v1alpha1.Bucket) UpdateRequired) error {
+	nsBucket := &nsv1alpha1.Bucket{}
+	if err := reader.Get(ctx, types.NamespacedName{Namespace: bucket.GetNamespace(), Name: bucket.GetName()}, nsBucket); err != nil {
+		return err
+	}
+
+	nsBucketCopy := nsBucket.DeepCopy()
+	toClusterBucket(nsBucket).DeepCopyInto(bucket)
+
+	updateRequired := cb(bucket)
+	fromClusterBucket(bucket, nsBucket)
+
+	var err error
+	switch updateRequired {
+	case NeedsStatusUpdate:
+		err = c.kubeClient.Status().Patch(ctx, nsBucket, client.MergeFrom(nsBucketCopy))
+	case NeedsObjectUpdate:
+		err = c.kubeClient.Patch(ctx, nsBucket, client.MergeFrom(nsBucketCopy))
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	toClusterBucket(nsBucket).DeepCopyInto(bucket)
+
+	return nil
+}
+
+// toClusterBucket returns a cluster scoped view of the namespaced Bucket.
+func toClusterBucket(bucket *nsv1alpha1.Bucket) *v1alpha1.Bucket {
+	view := &v1alpha1.Bucket{
+		ObjectMeta: *bucket.ObjectMeta.DeepCopy(),
+		Spec: v1alpha1.BucketSpec{
+			Providers:                      slices.Clone(bucket.Spec.Providers),
+			ForProvider:                    *bucket.Spec.ForProvider.DeepCopy(),
+			Placement:                      bucket.Spec.Placement.DeepCopy(),
+			ReplicaSync:                    bucket.Spec.ReplicaSync.DeepCopy(),
+			Disabled:                       bucket.Spec.Disabled,
+			LifecycleConfigurationDisabled: bucket.Spec.LifecycleConfigurationDisabled,
+			AutoPause:                      bucket.Spec.AutoPause,
+		},
+		Status: *bucket.Status.DeepCopy(),
+	}
+	view.Spec.ManagementPolicies = slices.Clone(bucket.Spec.ManagementPolicies)
+
+	return view
+}
+
+// fromClusterBucket applies the changes made to the cluster scoped view to the
+// namespaced Bucket.
+func fromClusterBucket(view *v1alpha1.Bucket, bucket *nsv1alpha1.Bucket) {
+	view.ObjectMeta.DeepCopyInto(&bucket.ObjectMeta)
+	bucket.Spec.Providers = slices.Clone(view.Spec.Providers)
+	view.Spec.ForProvider.DeepCopyInto(&bucket.Spec.ForProvider)
+	bucket.Spec.Placement = view.Spec.Placement.DeepCopy()
+	bucket.Spec.ReplicaSync = view.Spec.ReplicaSync.DeepCopy()
+	bucket.Spec.Disabled = view.Spec.Disabled
+	bucket.Spec.LifecycleConfigurationDisabled = view.Spec.LifecycleConfigurationDisabled
+	bucket.Spec.AutoPause = view.Spec.AutoPause
+	view.Status.DeepCopyInto(&bucket.Status)
+}
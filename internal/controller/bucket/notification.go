@@ -0,0 +1,249 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go/document"
+	"github.com/go-logr/logr"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/controller/s3clienthandler"
+	"github.com/linode/provider-ceph/internal/otel/traces"
+	"github.com/linode/provider-ceph/internal/rgw"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"go.opentelemetry.io/otel"
+)
+
+// NotificationConfigurationClient is the client for API methods and reconciling the NotificationConfiguration
+type NotificationConfigurationClient struct {
+	backendStore    *backendstore.BackendStore
+	s3ClientHandler *s3clienthandler.Handler
+	log             logr.Logger
+}
+
+func NewNotificationConfigurationClient(b *backendstore.BackendStore, h *s3clienthandler.Handler, l logr.Logger) *NotificationConfigurationClient {
+	return &NotificationConfigurationClient{backendStore: b, s3ClientHandler: h, log: l}
+}
+
+//nolint:dupl // NotificationConfiguration and LifecycleConfiguration are different feature.
+func (c *NotificationConfigurationClient) Observe(ctx context.Context, bucket *v1alpha1.Bucket, backendNames []string) (ResourceStatus, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "bucket.NotificationConfigurationClient.Observe")
+	defer span.End()
+	ctx, log := traces.InjectTraceAndLogger(ctx, c.log)
+
+	observationChan := make(chan ResourceStatus)
+	errChan := make(chan error)
+
+	for _, backendName := range backendNames {
+		beName := backendName
+		go func() {
+			if c.backendStore.GetBackendHealthStatus(beName) == apisv1alpha1.HealthStatusUnhealthy {
+				// If a backend is marked as unhealthy, we can ignore it for now by returning NoAction.
+				// The backend may be down for some time and we do not want to block Create/Update/Delete
+				// calls on other backends. By returning NoAction here, we would never pass the Observe
+				// phase until the backend becomes Healthy or Disabled.
+				observationChan <- NoAction
+
+				return
+			}
+
+			observation, err := c.observeBackend(ctx, bucket, beName)
+			if err != nil {
+				errChan <- err
+
+				return
+			}
+			observationChan <- observation
+		}()
+	}
+
+	for i := 0; i < len(backendNames); i++ {
+		select {
+		case <-ctx.Done():
+			log.Info("Context timeout during bucket notification configuration observation", consts.KeyBucketName, bucket.Name)
+			err := errors.Wrap(ctx.Err(), errObserveNotificationConfig)
+			traces.SetAndRecordError(span, err)
+
+			return NeedsUpdate, err
+		case observation := <-observationChan:
+			if observation == NeedsUpdate || observation == NeedsDeletion {
+				return observation, nil
+			}
+		case err := <-errChan:
+			err = errors.Wrap(err, errObserveNotificationConfig)
+			traces.SetAndRecordError(span, err)
+
+			return NeedsUpdate, err
+		}
+	}
+
+	return Updated, nil
+}
+
+func (c *NotificationConfigurationClient) observeBackend(ctx context.Context, bucket *v1alpha1.Bucket, backendName string) (ResourceStatus, error) {
+	ctx, log := traces.InjectTraceAndLogger(ctx, c.log)
+
+	log.V(1).Info("Observing subresource notification configuration on backend", consts.KeyBucketName, bucket.Name, consts.KeyBackendName, backendName)
+
+	s3Client, err := c.s3ClientHandler.GetS3Client(ctx, bucket, backendName)
+	if err != nil {
+		return NeedsUpdate, err
+	}
+	response, err := rgw.GetBucketNotificationConfiguration(ctx, s3Client, aws.String(bucket.Name))
+	if err != nil {
+		return NeedsUpdate, err
+	}
+
+	var external []s3types.TopicConfiguration
+	if response != nil {
+		external = response.TopicConfigurations
+	}
+
+	if bucket.Spec.ForProvider.NotificationConfiguration == nil {
+		// No notification config is specified, so it should not exist on any backend.
+		if len(external) == 0 {
+			log.V(1).Info("No notification configuration found on backend - no action required", consts.KeyBucketName, bucket.Name, consts.KeyBackendName, backendName)
+
+			return NoAction, nil
+		}
+		log.V(1).Info("Notification configuration found on backend - requires deletion", consts.KeyBucketName, bucket.Name, consts.KeyBackendName, backendName)
+
+		return NeedsDeletion, nil
+	}
+
+	topicARNs, err := c.getTopicARNs(ctx, bucket, backendName)
+	if err != nil {
+		return NeedsUpdate, err
+	}
+
+	local := rgw.GenerateTopicConfigurations(bucket.Spec.ForProvider.NotificationConfiguration.TopicConfigurations, topicARNs)
+	if !cmp.Equal(rgw.NormalizeTopicConfigurations(external), rgw.NormalizeTopicConfigurations(local), cmpopts.IgnoreTypes(document.NoSerde{}), cmpopts.EquateEmpty()) {
+		log.V(1).Info("Notification configuration requires update on backend", consts.KeyBucketName, bucket.Name, consts.KeyBackendName, backendName)
+
+		return NeedsUpdate, nil
+	}
+
+	return Updated, nil
+}
+
+// getTopicARNs returns the ARNs of the topics referred to by the notification
+// configuration of the bucket. The ARN of a topic contains the zonegroup of
+// the backend, so it is looked up on each backend.
+func (c *NotificationConfigurationClient) getTopicARNs(ctx context.Context, bucket *v1alpha1.Bucket, backendName string) (map[string]string, error) {
+	adminClient, err := c.s3ClientHandler.GetAdminClient(backendName)
+	if err != nil {
+		return nil, err
+	}
+
+	topicARNs := map[string]string{}
+	for _, name := range rgw.NotificationTopics(bucket.Spec.ForProvider.NotificationConfiguration) {
+		topic, err := rgw.GetTopic(ctx, adminClient, name)
+		if err != nil {
+			return nil, err
+		}
+		if topic == nil {
+			return nil, errors.Errorf(errMissingTopic, name)
+		}
+		topicARNs[name] = topic.ARN
+	}
+
+	return topicARNs, nil
+}
+
+func (c *NotificationConfigurationClient) Handle(ctx context.Context, b *v1alpha1.Bucket, backendName string, bb *bucketBackends) error {
+	ctx, span := otel.Tracer("").Start(ctx, "bucket.NotificationConfigurationClient.Handle")
+	defer span.End()
+
+	if c.backendStore.GetBackendHealthStatus(backendName) == apisv1alpha1.HealthStatusUnhealthy {
+		traces.SetAndRecordError(span, errUnhealthyBackend)
+
+		return errUnhealthyBackend
+	}
+
+	observation, err := c.observeBackend(ctx, b, backendName)
+	if err != nil {
+		err = errors.Wrap(err, errHandleNotificationConfig)
+		traces.SetAndRecordError(span, err)
+
+		return err
+	}
+
+	switch observation {
+	case NoAction:
+		return nil
+	case Updated:
+		// The notification config is updated, so we can consider this
+		// sub resource Available.
+		available := xpv1.Available()
+		bb.setNotificationConfigCondition(b.Name, backendName, &available)
+
+	case NeedsDeletion:
+		if err := c.delete(ctx, b, backendName); err != nil {
+			err = errors.Wrap(err, errHandleNotificationConfig)
+			deleting := xpv1.Deleting().WithMessage(err.Error())
+			bb.setNotificationConfigCondition(b.Name, backendName, &deleting)
+
+			traces.SetAndRecordError(span, err)
+
+			return err
+		}
+		bb.setNotificationConfigCondition(b.Name, backendName, nil)
+
+	case NeedsUpdate:
+		if err := c.createOrUpdate(ctx, b, backendName); err != nil {
+			err = errors.Wrap(err, errHandleNotificationConfig)
+			unavailable := xpv1.Unavailable().WithMessage(err.Error())
+			bb.setNotificationConfigCondition(b.Name, backendName, &unavailable)
+
+			traces.SetAndRecordError(span, err)
+
+			return err
+		}
+		available := xpv1.Available()
+		bb.setNotificationConfigCondition(b.Name, backendName, &available)
+	}
+
+	return nil
+}
+
+func (c *NotificationConfigurationClient) createOrUpdate(ctx context.Context, b *v1alpha1.Bucket, backendName string) error {
+	ctx, log := traces.InjectTraceAndLogger(ctx, c.log)
+
+	log.Info("Updating notification configuration", consts.KeyBucketName, b.Name, consts.KeyBackendName, backendName)
+	s3Client, err := c.s3ClientHandler.GetS3Client(ctx, b, backendName)
+	if err != nil {
+		return err
+	}
+
+	topicARNs, err := c.getTopicARNs(ctx, b, backendName)
+	if err != nil {
+		return err
+	}
+
+	_, err = rgw.PutBucketNotificationConfiguration(ctx, s3Client, rgw.GenerateNotificationConfigurationInput(b.Name, b.Spec.ForProvider.NotificationConfiguration, topicARNs))
+
+	return err
+}
+
+func (c *NotificationConfigurationClient) delete(ctx context.Context, b *v1alpha1.Bucket, backendName string) error {
+	ctx, log := traces.InjectTraceAndLogger(ctx, c.log)
+
+	log.Info("Deleting notification configuration", consts.KeyBucketName, b.Name, consts.KeyBackendName, backendName)
+	s3Client, err := c.s3ClientHandler.GetS3Client(ctx, b, backendName)
+	if err != nil {
+		return err
+	}
+
+	return rgw.DeleteBucketNotificationConfiguration(ctx, s3Client, aws.String(b.Name))
+}
@@ -0,0 +1,692 @@
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/backendstore/backendstorefakes"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/controller/s3clienthandler"
+	"github.com/linode/provider-ceph/internal/rgw/admin"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	testTopic    = "test-topic"
+	testTopicARN = "arn:aws:sns:zonegroup::test-topic"
+)
+
+//nolint:maintidx // Function requires numerous checks.
+func TestNotificationConfigObserveBackend(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		backendStore *backendstore.BackendStore
+	}
+
+	type args struct {
+		bucket      *v1alpha1.Bucket
+		backendName string
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+		errMsg string
+	}
+
+	cases := map[string]struct {
+		fields fields
+		args   args
+		want   want
+	}{
+		"External error getting notification config": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketNotificationConfigurationStub: func(ctx context.Context, in *s3.GetBucketNotificationConfigurationInput, f ...func(*s3.Options)) (*s3.GetBucketNotificationConfigurationOutput, error) {
+							return nil, errExternal
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							NotificationConfiguration: &v1alpha1.NotificationConfiguration{
+								TopicConfigurations: []v1alpha1.TopicConfiguration{
+									{ID: "uploads", Topic: testTopic, Events: []string{"s3:ObjectCreated:*"}},
+								},
+							},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    errExternal,
+			},
+		},
+		"Notification config not specified in CR and not found on backend so NoAction": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketNotificationConfigurationStub: func(ctx context.Context, in *s3.GetBucketNotificationConfigurationInput, f ...func(*s3.Options)) (*s3.GetBucketNotificationConfigurationOutput, error) {
+							return &s3.GetBucketNotificationConfigurationOutput{}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NoAction,
+			},
+		},
+		"Notification config not specified in CR but exists on backend so NeedsDeletion": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketNotificationConfigurationStub: func(ctx context.Context, in *s3.GetBucketNotificationConfigurationInput, f ...func(*s3.Options)) (*s3.GetBucketNotificationConfigurationOutput, error) {
+							return &s3.GetBucketNotificationConfigurationOutput{
+								TopicConfigurations: []s3types.TopicConfiguration{
+									{Id: aws.String("uploads"), TopicArn: aws.String(testTopicARN), Events: []s3types.Event{"s3:ObjectCreated:*"}},
+								},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsDeletion,
+			},
+		},
+		"Topic does not exist on backend": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketNotificationConfigurationStub: func(ctx context.Context, in *s3.GetBucketNotificationConfigurationInput, f ...func(*s3.Options)) (*s3.GetBucketNotificationConfigurationOutput, error) {
+							return &s3.GetBucketNotificationConfigurationOutput{}, nil
+						},
+					}
+					adminFake := backendstorefakes.FakeAdminClient{
+						GetTopicStub: func(ctx context.Context, in *admin.GetTopicInput) (*admin.Topic, error) {
+							return nil, &admin.Error{StatusCode: 404, Code: "NoSuchKey"}
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+					bs.SetBackendAdminClient(consts.S3Backend1, &adminFake)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							NotificationConfiguration: &v1alpha1.NotificationConfiguration{
+								TopicConfigurations: []v1alpha1.TopicConfiguration{
+									{ID: "uploads", Topic: testTopic, Events: []string{"s3:ObjectCreated:*"}},
+								},
+							},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsUpdate,
+				errMsg: "topic test-topic does not exist",
+			},
+		},
+		"External error getting topic": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketNotificationConfigurationStub: func(ctx context.Context, in *s3.GetBucketNotificationConfigurationInput, f ...func(*s3.Options)) (*s3.GetBucketNotificationConfigurationOutput, error) {
+							return &s3.GetBucketNotificationConfigurationOutput{}, nil
+						},
+					}
+					adminFake := backendstorefakes.FakeAdminClient{
+						GetTopicStub: func(ctx context.Context, in *admin.GetTopicInput) (*admin.Topic, error) {
+							return nil, errExternal
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+					bs.SetBackendAdminClient(consts.S3Backend1, &adminFake)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							NotificationConfiguration: &v1alpha1.NotificationConfiguration{
+								TopicConfigurations: []v1alpha1.TopicConfiguration{
+									{ID: "uploads", Topic: testTopic, Events: []string{"s3:ObjectCreated:*"}},
+								},
+							},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    errExternal,
+			},
+		},
+		"Topic arn on backend is of another topic so NeedsUpdate": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketNotificationConfigurationStub: func(ctx context.Context, in *s3.GetBucketNotificationConfigurationInput, f ...func(*s3.Options)) (*s3.GetBucketNotificationConfigurationOutput, error) {
+							return &s3.GetBucketNotificationConfigurationOutput{
+								TopicConfigurations: []s3types.TopicConfiguration{
+									{Id: aws.String("uploads"), TopicArn: aws.String("arn:aws:sns:zonegroup::other-topic"), Events: []s3types.Event{"s3:ObjectCreated:*"}},
+								},
+							}, nil
+						},
+					}
+					adminFake := backendstorefakes.FakeAdminClient{
+						GetTopicStub: func(ctx context.Context, in *admin.GetTopicInput) (*admin.Topic, error) {
+							return &admin.Topic{Name: testTopic, ARN: testTopicARN}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+					bs.SetBackendAdminClient(consts.S3Backend1, &adminFake)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							NotificationConfiguration: &v1alpha1.NotificationConfiguration{
+								TopicConfigurations: []v1alpha1.TopicConfiguration{
+									{ID: "uploads", Topic: testTopic, Events: []string{"s3:ObjectCreated:*"}},
+								},
+							},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"Filter missing on backend so NeedsUpdate": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketNotificationConfigurationStub: func(ctx context.Context, in *s3.GetBucketNotificationConfigurationInput, f ...func(*s3.Options)) (*s3.GetBucketNotificationConfigurationOutput, error) {
+							return &s3.GetBucketNotificationConfigurationOutput{
+								TopicConfigurations: []s3types.TopicConfiguration{
+									{Id: aws.String("uploads"), TopicArn: aws.String(testTopicARN), Events: []s3types.Event{"s3:ObjectCreated:*"}},
+								},
+							}, nil
+						},
+					}
+					adminFake := backendstorefakes.FakeAdminClient{
+						GetTopicStub: func(ctx context.Context, in *admin.GetTopicInput) (*admin.Topic, error) {
+							return &admin.Topic{Name: testTopic, ARN: testTopicARN}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+					bs.SetBackendAdminClient(consts.S3Backend1, &adminFake)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							NotificationConfiguration: &v1alpha1.NotificationConfiguration{
+								TopicConfigurations: []v1alpha1.TopicConfiguration{
+									{
+										ID:     "uploads",
+										Topic:  testTopic,
+										Events: []string{"s3:ObjectCreated:*"},
+										Filter: &v1alpha1.NotificationFilter{Prefix: aws.String("images/")},
+									},
+								},
+							},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"Events and filter rules reported in another order and case are Updated": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketNotificationConfigurationStub: func(ctx context.Context, in *s3.GetBucketNotificationConfigurationInput, f ...func(*s3.Options)) (*s3.GetBucketNotificationConfigurationOutput, error) {
+							return &s3.GetBucketNotificationConfigurationOutput{
+								TopicConfigurations: []s3types.TopicConfiguration{
+									{
+										Id:       aws.String("uploads"),
+										TopicArn: aws.String(testTopicARN),
+										Events:   []s3types.Event{"s3:ObjectRemoved:*", "s3:ObjectCreated:*"},
+										Filter: &s3types.NotificationConfigurationFilter{
+											Key: &s3types.S3KeyFilter{
+												FilterRules: []s3types.FilterRule{
+													{Name: "suffix", Value: aws.String(".jpg")},
+													{Name: "prefix", Value: aws.String("images/")},
+												},
+											},
+										},
+									},
+								},
+							}, nil
+						},
+					}
+					adminFake := backendstorefakes.FakeAdminClient{
+						GetTopicStub: func(ctx context.Context, in *admin.GetTopicInput) (*admin.Topic, error) {
+							return &admin.Topic{Name: testTopic, ARN: testTopicARN}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+					bs.SetBackendAdminClient(consts.S3Backend1, &adminFake)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							NotificationConfiguration: &v1alpha1.NotificationConfiguration{
+								TopicConfigurations: []v1alpha1.TopicConfiguration{
+									{
+										ID:     "uploads",
+										Topic:  testTopic,
+										Events: []string{"s3:ObjectCreated:*", "s3:ObjectRemoved:*"},
+										Filter: &v1alpha1.NotificationFilter{Prefix: aws.String("images/"), Suffix: aws.String(".jpg")},
+									},
+								},
+							},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := NewNotificationConfigurationClient(
+				tc.fields.backendStore,
+				s3clienthandler.NewHandler(
+					s3clienthandler.WithAssumeRoleArn(nil),
+					s3clienthandler.WithBackendStore(tc.fields.backendStore)),
+				logr.Discard())
+
+			got, err := c.observeBackend(context.Background(), tc.args.bucket, tc.args.backendName)
+			if tc.want.errMsg != "" {
+				require.ErrorContains(t, err, tc.want.errMsg, "unexpected error")
+			} else {
+				require.ErrorIs(t, err, tc.want.err, "unexpected error")
+			}
+			assert.Equal(t, tc.want.status, got, "unexpected status")
+		})
+	}
+}
+
+//nolint:maintidx // Function requires numerous checks.
+func TestNotificationConfigurationHandle(t *testing.T) {
+	t.Parallel()
+
+	bucketName := consts.TestBucket
+	beName := consts.S3Backend1
+
+	type fields struct {
+		backendStore *backendstore.BackendStore
+	}
+
+	type args struct {
+		bucket      *v1alpha1.Bucket
+		backendName string
+	}
+
+	type want struct {
+		err          error
+		specificDiff func(t *testing.T, bb *bucketBackends)
+	}
+
+	cases := map[string]struct {
+		fields fields
+		args   args
+		want   want
+	}{
+		"Unhealthy backend": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusUnhealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							NotificationConfiguration: &v1alpha1.NotificationConfiguration{
+								TopicConfigurations: []v1alpha1.TopicConfiguration{
+									{ID: "uploads", Topic: testTopic, Events: []string{"s3:ObjectCreated:*"}},
+								},
+							},
+						},
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				err: errUnhealthyBackend,
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					assert.Nil(t, bb.getNotificationConfigCondition(bucketName, beName), "unexpected notification config condition")
+				},
+			},
+		},
+		"Notification config is put on backend with the topic arn of the backend": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketNotificationConfigurationStub: func(ctx context.Context, in *s3.GetBucketNotificationConfigurationInput, f ...func(*s3.Options)) (*s3.GetBucketNotificationConfigurationOutput, error) {
+							return &s3.GetBucketNotificationConfigurationOutput{}, nil
+						},
+						PutBucketNotificationConfigurationStub: func(ctx context.Context, in *s3.PutBucketNotificationConfigurationInput, f ...func(*s3.Options)) (*s3.PutBucketNotificationConfigurationOutput, error) {
+							configs := in.NotificationConfiguration.TopicConfigurations
+							if aws.ToString(in.Bucket) != bucketName || len(configs) != 1 ||
+								aws.ToString(configs[0].TopicArn) != testTopicARN || aws.ToString(configs[0].Id) != "uploads" {
+								return nil, errUnexpectedInput
+							}
+
+							return &s3.PutBucketNotificationConfigurationOutput{}, nil
+						},
+					}
+					adminFake := backendstorefakes.FakeAdminClient{
+						GetTopicStub: func(ctx context.Context, in *admin.GetTopicInput) (*admin.Topic, error) {
+							if in.Name != testTopic {
+								return nil, errUnexpectedInput
+							}
+
+							return &admin.Topic{Name: testTopic, ARN: testTopicARN}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+					bs.SetBackendAdminClient(beName, &adminFake)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							NotificationConfiguration: &v1alpha1.NotificationConfiguration{
+								TopicConfigurations: []v1alpha1.TopicConfiguration{
+									{ID: "uploads", Topic: testTopic, Events: []string{"s3:ObjectCreated:*"}},
+								},
+							},
+						},
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					assert.True(t, bb.getNotificationConfigCondition(bucketName, beName).Equal(v1.Available()), "unexpected notification config condition")
+				},
+			},
+		},
+		"Notification config is replaced by an empty one when removed from CR": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketNotificationConfigurationStub: func(ctx context.Context, in *s3.GetBucketNotificationConfigurationInput, f ...func(*s3.Options)) (*s3.GetBucketNotificationConfigurationOutput, error) {
+							return &s3.GetBucketNotificationConfigurationOutput{
+								TopicConfigurations: []s3types.TopicConfiguration{
+									{Id: aws.String("uploads"), TopicArn: aws.String(testTopicARN), Events: []s3types.Event{"s3:ObjectCreated:*"}},
+								},
+							}, nil
+						},
+						PutBucketNotificationConfigurationStub: func(ctx context.Context, in *s3.PutBucketNotificationConfigurationInput, f ...func(*s3.Options)) (*s3.PutBucketNotificationConfigurationOutput, error) {
+							if aws.ToString(in.Bucket) != bucketName || len(in.NotificationConfiguration.TopicConfigurations) != 0 {
+								return nil, errUnexpectedInput
+							}
+
+							return &s3.PutBucketNotificationConfigurationOutput{}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					assert.Nil(t, bb.getNotificationConfigCondition(bucketName, beName), "unexpected notification config condition")
+				},
+			},
+		},
+		"Error removing notification config": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketNotificationConfigurationStub: func(ctx context.Context, in *s3.GetBucketNotificationConfigurationInput, f ...func(*s3.Options)) (*s3.GetBucketNotificationConfigurationOutput, error) {
+							return &s3.GetBucketNotificationConfigurationOutput{
+								TopicConfigurations: []s3types.TopicConfiguration{
+									{Id: aws.String("uploads"), TopicArn: aws.String(testTopicARN), Events: []s3types.Event{"s3:ObjectCreated:*"}},
+								},
+							}, nil
+						},
+						PutBucketNotificationConfigurationStub: func(ctx context.Context, in *s3.PutBucketNotificationConfigurationInput, f ...func(*s3.Options)) (*s3.PutBucketNotificationConfigurationOutput, error) {
+							return nil, errExternal
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				err: errExternal,
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					condition := bb.getNotificationConfigCondition(bucketName, beName)
+					require.NotNil(t, condition, "missing notification config condition")
+					assert.Equal(t, v1.Deleting().Reason, condition.Reason, "unexpected notification config condition")
+				},
+			},
+		},
+		"Error putting notification config": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketNotificationConfigurationStub: func(ctx context.Context, in *s3.GetBucketNotificationConfigurationInput, f ...func(*s3.Options)) (*s3.GetBucketNotificationConfigurationOutput, error) {
+							return &s3.GetBucketNotificationConfigurationOutput{}, nil
+						},
+						PutBucketNotificationConfigurationStub: func(ctx context.Context, in *s3.PutBucketNotificationConfigurationInput, f ...func(*s3.Options)) (*s3.PutBucketNotificationConfigurationOutput, error) {
+							return nil, errExternal
+						},
+					}
+					adminFake := backendstorefakes.FakeAdminClient{
+						GetTopicStub: func(ctx context.Context, in *admin.GetTopicInput) (*admin.Topic, error) {
+							return &admin.Topic{Name: testTopic, ARN: testTopicARN}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+					bs.SetBackendAdminClient(beName, &adminFake)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							NotificationConfiguration: &v1alpha1.NotificationConfiguration{
+								TopicConfigurations: []v1alpha1.TopicConfiguration{
+									{ID: "uploads", Topic: testTopic, Events: []string{"s3:ObjectCreated:*"}},
+								},
+							},
+						},
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				err: errExternal,
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					condition := bb.getNotificationConfigCondition(bucketName, beName)
+					require.NotNil(t, condition, "missing notification config condition")
+					assert.Equal(t, v1.Unavailable().Reason, condition.Reason, "unexpected notification config condition")
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := NewNotificationConfigurationClient(
+				tc.fields.backendStore,
+				s3clienthandler.NewHandler(
+					s3clienthandler.WithAssumeRoleArn(nil),
+					s3clienthandler.WithBackendStore(tc.fields.backendStore)),
+				logr.Discard())
+
+			bb := newBucketBackends()
+			err := c.Handle(context.Background(), tc.args.bucket, tc.args.backendName, bb)
+			require.ErrorIs(t, err, tc.want.err, "unexpected error")
+			if tc.want.specificDiff != nil {
+				tc.want.specificDiff(t, bb)
+			}
+		})
+	}
+}
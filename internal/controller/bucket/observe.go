@@ -82,7 +82,40 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		}, nil
 	}
 
-	providerNames := getBucketProvidersFilterDisabledLabel(bucket, c.backendStore.GetAllBackendNames())
+	backendNames := c.getBucketBackendNames(bucket)
+
+	// The bucket is placed on fewer backends than required by its placement policy,
+	// it must be updated if there are backends on which it can be placed.
+	if c.needsPlacement(bucket) {
+		candidates, err := c.getPlacementCandidates(bucket, backendNames)
+		if err != nil {
+			traces.SetAndRecordError(span, err)
+
+			return managed.ExternalObservation{}, err
+		}
+		if len(candidates) != 0 {
+			return managed.ExternalObservation{
+				ResourceExists:   true,
+				ResourceUpToDate: false,
+			}, nil
+		}
+	}
+
+	// Failover replicas of the bucket must be added or removed.
+	changes, err := c.getFailoverChanges(bucket, backendNames)
+	if err != nil {
+		traces.SetAndRecordError(span, err)
+
+		return managed.ExternalObservation{}, err
+	}
+	if !changes.empty() {
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: false,
+		}, nil
+	}
+
+	providerNames := getBucketProvidersFilterDisabledLabel(bucket, backendNames)
 	if len(providerNames) == 0 {
 		err := errors.New(errAllS3BackendsDisabled)
 		traces.SetAndRecordError(span, err)
@@ -155,6 +188,14 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		resourceUpToDate = false
 	}
 
+	connectionDetails, err := c.getConnectionDetails(ctx, bucket, providerNames)
+	if err != nil {
+		traces.SetAndRecordError(span, err)
+
+		return managed.ExternalObservation{}, err
+	}
+	bucket.Status.AtProvider.WebsiteURL = string(connectionDetails[consts.KeyWebsiteURL])
+
 	return managed.ExternalObservation{
 		// Return false when the external resource does not exist. This lets
 		// the managed resource reconciler know that it needs to call Create to
@@ -168,6 +209,6 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 		// Return any details that may be required to connect to the external
 		// resource. These will be stored as the connection secret.
-		ConnectionDetails: managed.ConnectionDetails{},
+		ConnectionDetails: connectionDetails,
 	}, nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
 	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
@@ -296,9 +297,12 @@ func TestObserve(t *testing.T) {
 			},
 			want: want{
 				o: managed.ExternalObservation{
-					ResourceExists:    true,
-					ResourceUpToDate:  true,
-					ConnectionDetails: managed.ConnectionDetails{},
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						consts.KeyEndpoint: []byte("https://" + consts.S3Backend1 + ".example.com"),
+						consts.KeyBucket:   []byte("bucket-check-external-error"),
+					},
 				},
 			},
 		},
@@ -350,9 +354,12 @@ func TestObserve(t *testing.T) {
 			},
 			want: want{
 				o: managed.ExternalObservation{
-					ResourceExists:    true,
-					ResourceUpToDate:  false,
-					ConnectionDetails: managed.ConnectionDetails{},
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						consts.KeyEndpoint: []byte("https://" + consts.S3Backend1 + ".example.com"),
+						consts.KeyBucket:   []byte("bucket-check-external-not-exists"),
+					},
 				},
 			},
 		},
@@ -404,9 +411,75 @@ func TestObserve(t *testing.T) {
 			},
 			want: want{
 				o: managed.ExternalObservation{
-					ResourceExists:    true,
-					ResourceUpToDate:  true,
-					ConnectionDetails: managed.ConnectionDetails{},
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						consts.KeyEndpoint: []byte("https://" + consts.S3Backend1 + ".example.com"),
+						consts.KeyBucket:   []byte("bucket-check-external-ok"),
+					},
+				},
+			},
+		},
+		"Bucket check on external - website url": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						HeadBucketStub: func(ctx context.Context, hbi *s3.HeadBucketInput, f ...func(*s3.Options)) (*s3.HeadBucketOutput, error) {
+							return &s3.HeadBucketOutput{}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				mg: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "bucket-check-external-website",
+					},
+					Spec: v1alpha1.BucketSpec{
+						Providers: []string{consts.S3Backend1},
+						ForProvider: v1alpha1.BucketParameters{
+							WebsiteConfiguration: &v1alpha1.WebsiteConfiguration{
+								IndexDocument: &v1alpha1.IndexDocument{Suffix: "index.html"},
+							},
+						},
+						ResourceSpec: v1.ResourceSpec{
+							ProviderConfigReference: &v1.Reference{
+								Name: consts.S3Backend1,
+							},
+						},
+					},
+					Status: v1alpha1.BucketStatus{
+						AtProvider: v1alpha1.BucketObservation{
+							Backends: v1alpha1.Backends{
+								consts.S3Backend1: &v1alpha1.BackendInfo{
+									BucketCondition: v1.Available(),
+								},
+							},
+						},
+						ResourceStatus: v1.ResourceStatus{
+							ConditionedStatus: v1.ConditionedStatus{
+								Conditions: []v1.Condition{
+									v1.Available(),
+								},
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						consts.KeyEndpoint:   []byte("https://" + consts.S3Backend1 + ".example.com"),
+						consts.KeyBucket:     []byte("bucket-check-external-website"),
+						consts.KeyWebsiteURL: []byte("https://bucket-check-external-website.s3-website.example.com"),
+					},
 				},
 			},
 		},
@@ -466,7 +539,21 @@ func TestObserve(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			e := external{backendStore: tc.fields.backendStore, autoPauseBucket: tc.fields.autoPauseBucket, log: logr.Discard()}
+			s := runtime.NewScheme()
+			s.AddKnownTypes(apisv1alpha1.SchemeGroupVersion, &apisv1alpha1.ProviderConfig{}, &apisv1alpha1.ProviderConfigList{})
+			cl := fake.NewClientBuilder().
+				WithScheme(s).
+				WithObjects(&apisv1alpha1.ProviderConfig{
+					ObjectMeta: metav1.ObjectMeta{Name: consts.S3Backend1},
+					Spec: apisv1alpha1.ProviderConfigSpec{
+						HostBase:        consts.S3Backend1 + ".example.com",
+						WebsiteEndpoint: aws.String("s3-website.example.com"),
+						UseHTTPS:        true,
+					},
+				}).
+				Build()
+
+			e := external{kubeClient: cl, backendStore: tc.fields.backendStore, autoPauseBucket: tc.fields.autoPauseBucket, log: logr.Discard()}
 			got, err := e.Observe(context.Background(), tc.args.mg)
 			require.ErrorIs(t, err, tc.want.err, "unexpected error")
 			assert.Equal(t, got, tc.want.o, "unexpected result")
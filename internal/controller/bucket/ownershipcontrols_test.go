@@ -0,0 +1,505 @@
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/backendstore/backendstorefakes"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/controller/s3clienthandler"
+	"github.com/linode/provider-ceph/internal/rgw"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+//nolint:maintidx // Function requires numerous checks.
+func TestOwnershipControlsObserveBackend(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		backendStore *backendstore.BackendStore
+	}
+
+	type args struct {
+		bucket      *v1alpha1.Bucket
+		backendName string
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		fields fields
+		args   args
+		want   want
+	}{
+		"External error getting ownership controls": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketOwnershipControlsStub: func(ctx context.Context, in *s3.GetBucketOwnershipControlsInput, f ...func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+							return nil, errExternal
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							ObjectOwnership: aws.String(string(s3types.ObjectOwnershipBucketOwnerEnforced)),
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    errExternal,
+			},
+		},
+		"Object ownership not specified in CR and OwnershipControlsNotFoundError on backend so NoAction": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketOwnershipControlsStub: func(ctx context.Context, in *s3.GetBucketOwnershipControlsInput, f ...func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+							return nil, &smithy.GenericAPIError{Code: rgw.OwnershipControlsNotFoundErrCode}
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NoAction,
+			},
+		},
+		"Object ownership not specified in CR and no rules on backend so NoAction": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketOwnershipControlsStub: func(ctx context.Context, in *s3.GetBucketOwnershipControlsInput, f ...func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+							return &s3.GetBucketOwnershipControlsOutput{
+								OwnershipControls: &s3types.OwnershipControls{},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NoAction,
+			},
+		},
+		"Object ownership not specified in CR but set on backend so NeedsDeletion": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketOwnershipControlsStub: func(ctx context.Context, in *s3.GetBucketOwnershipControlsInput, f ...func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+							return &s3.GetBucketOwnershipControlsOutput{
+								OwnershipControls: &s3types.OwnershipControls{
+									Rules: []s3types.OwnershipControlsRule{{ObjectOwnership: s3types.ObjectOwnershipObjectWriter}},
+								},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsDeletion,
+			},
+		},
+		"Object ownership differs on backend so NeedsUpdate": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketOwnershipControlsStub: func(ctx context.Context, in *s3.GetBucketOwnershipControlsInput, f ...func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+							return &s3.GetBucketOwnershipControlsOutput{
+								OwnershipControls: &s3types.OwnershipControls{
+									Rules: []s3types.OwnershipControlsRule{{ObjectOwnership: s3types.ObjectOwnershipObjectWriter}},
+								},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							ObjectOwnership: aws.String(string(s3types.ObjectOwnershipBucketOwnerEnforced)),
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"Object ownership is up to date": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketOwnershipControlsStub: func(ctx context.Context, in *s3.GetBucketOwnershipControlsInput, f ...func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+							return &s3.GetBucketOwnershipControlsOutput{
+								OwnershipControls: &s3types.OwnershipControls{
+									Rules: []s3types.OwnershipControlsRule{{ObjectOwnership: s3types.ObjectOwnershipBucketOwnerEnforced}},
+								},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							ObjectOwnership: aws.String(string(s3types.ObjectOwnershipBucketOwnerEnforced)),
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := NewOwnershipControlsClient(
+				tc.fields.backendStore,
+				s3clienthandler.NewHandler(
+					s3clienthandler.WithAssumeRoleArn(nil),
+					s3clienthandler.WithBackendStore(tc.fields.backendStore)),
+				logr.Discard())
+
+			got, err := c.observeBackend(context.Background(), tc.args.bucket, tc.args.backendName)
+			require.ErrorIs(t, err, tc.want.err, "unexpected error")
+			assert.Equal(t, tc.want.status, got, "unexpected status")
+		})
+	}
+}
+
+//nolint:maintidx // Function requires numerous checks.
+func TestOwnershipControlsHandle(t *testing.T) {
+	t.Parallel()
+
+	bucketName := consts.TestBucket
+	beName := consts.S3Backend1
+
+	type fields struct {
+		backendStore *backendstore.BackendStore
+	}
+
+	type args struct {
+		bucket      *v1alpha1.Bucket
+		backendName string
+	}
+
+	type want struct {
+		err          error
+		specificDiff func(t *testing.T, bb *bucketBackends)
+	}
+
+	cases := map[string]struct {
+		fields fields
+		args   args
+		want   want
+	}{
+		"Unhealthy backend": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusUnhealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							ObjectOwnership: aws.String(string(s3types.ObjectOwnershipBucketOwnerEnforced)),
+						},
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				err: errUnhealthyBackend,
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					assert.Nil(t, bb.getOwnershipControlsCondition(bucketName, beName), "unexpected ownership controls condition")
+				},
+			},
+		},
+		"Object ownership is put on backend as a single rule": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketOwnershipControlsStub: func(ctx context.Context, in *s3.GetBucketOwnershipControlsInput, f ...func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+							return nil, &smithy.GenericAPIError{Code: rgw.OwnershipControlsNotFoundErrCode}
+						},
+						PutBucketOwnershipControlsStub: func(ctx context.Context, in *s3.PutBucketOwnershipControlsInput, f ...func(*s3.Options)) (*s3.PutBucketOwnershipControlsOutput, error) {
+							rules := in.OwnershipControls.Rules
+							if aws.ToString(in.Bucket) != bucketName || len(rules) != 1 || rules[0].ObjectOwnership != s3types.ObjectOwnershipBucketOwnerPreferred {
+								return nil, errUnexpectedInput
+							}
+
+							return &s3.PutBucketOwnershipControlsOutput{}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							ObjectOwnership: aws.String(string(s3types.ObjectOwnershipBucketOwnerPreferred)),
+						},
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					assert.True(t, bb.getOwnershipControlsCondition(bucketName, beName).Equal(v1.Available()), "unexpected ownership controls condition")
+				},
+			},
+		},
+		"Ownership controls are deleted from backend when removed from CR": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketOwnershipControlsStub: func(ctx context.Context, in *s3.GetBucketOwnershipControlsInput, f ...func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+							return &s3.GetBucketOwnershipControlsOutput{
+								OwnershipControls: &s3types.OwnershipControls{
+									Rules: []s3types.OwnershipControlsRule{{ObjectOwnership: s3types.ObjectOwnershipObjectWriter}},
+								},
+							}, nil
+						},
+						DeleteBucketOwnershipControlsStub: func(ctx context.Context, in *s3.DeleteBucketOwnershipControlsInput, f ...func(*s3.Options)) (*s3.DeleteBucketOwnershipControlsOutput, error) {
+							if aws.ToString(in.Bucket) != bucketName {
+								return nil, errUnexpectedInput
+							}
+
+							return &s3.DeleteBucketOwnershipControlsOutput{}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					assert.Nil(t, bb.getOwnershipControlsCondition(bucketName, beName), "unexpected ownership controls condition")
+				},
+			},
+		},
+		"Error deleting ownership controls": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketOwnershipControlsStub: func(ctx context.Context, in *s3.GetBucketOwnershipControlsInput, f ...func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+							return &s3.GetBucketOwnershipControlsOutput{
+								OwnershipControls: &s3types.OwnershipControls{
+									Rules: []s3types.OwnershipControlsRule{{ObjectOwnership: s3types.ObjectOwnershipObjectWriter}},
+								},
+							}, nil
+						},
+						DeleteBucketOwnershipControlsStub: func(ctx context.Context, in *s3.DeleteBucketOwnershipControlsInput, f ...func(*s3.Options)) (*s3.DeleteBucketOwnershipControlsOutput, error) {
+							return nil, errExternal
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				err: errExternal,
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					condition := bb.getOwnershipControlsCondition(bucketName, beName)
+					require.NotNil(t, condition, "missing ownership controls condition")
+					assert.Equal(t, v1.Deleting().Reason, condition.Reason, "unexpected ownership controls condition")
+				},
+			},
+		},
+		"Error putting ownership controls": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketOwnershipControlsStub: func(ctx context.Context, in *s3.GetBucketOwnershipControlsInput, f ...func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+							return nil, &smithy.GenericAPIError{Code: rgw.OwnershipControlsNotFoundErrCode}
+						},
+						PutBucketOwnershipControlsStub: func(ctx context.Context, in *s3.PutBucketOwnershipControlsInput, f ...func(*s3.Options)) (*s3.PutBucketOwnershipControlsOutput, error) {
+							return nil, errExternal
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							ObjectOwnership: aws.String(string(s3types.ObjectOwnershipBucketOwnerEnforced)),
+						},
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				err: errExternal,
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					condition := bb.getOwnershipControlsCondition(bucketName, beName)
+					require.NotNil(t, condition, "missing ownership controls condition")
+					assert.Equal(t, v1.Unavailable().Reason, condition.Reason, "unexpected ownership controls condition")
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := NewOwnershipControlsClient(
+				tc.fields.backendStore,
+				s3clienthandler.NewHandler(
+					s3clienthandler.WithAssumeRoleArn(nil),
+					s3clienthandler.WithBackendStore(tc.fields.backendStore)),
+				logr.Discard())
+
+			bb := newBucketBackends()
+			err := c.Handle(context.Background(), tc.args.bucket, tc.args.backendName, bb)
+			require.ErrorIs(t, err, tc.want.err, "unexpected error")
+			if tc.want.specificDiff != nil {
+				tc.want.specificDiff(t, bb)
+			}
+		})
+	}
+}
@@ -0,0 +1,248 @@
+package bucket
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"go.opentelemetry.io/otel"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	nsv1alpha1 "github.com/linode/provider-ceph/apis/namespaced/provider-ceph/v1alpha1"
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/otel/traces"
+	"github.com/linode/provider-ceph/internal/utils"
+)
+
+const bucketListKind = "BucketList"
+
+// isPlacedByPolicy returns true if the backends of the bucket are chosen by its
+// placement policy rather than specified as providers.
+func isPlacedByPolicy(bucket *v1alpha1.Bucket) bool {
+	return bucket.Spec.Placement != nil && len(bucket.Spec.Providers) == 0
+}
+
+// needsPlacement returns true if the bucket is placed on fewer backends than
+// required by its placement policy. Backends which are being drained or have
+// been removed are not counted, so that the bucket is placed on other backends
+// in their stead.
+func (c *external) needsPlacement(bucket *v1alpha1.Bucket) bool {
+	return isPlacedByPolicy(bucket) && uint(len(c.getActivePlacedBackends(bucket))) < bucket.Spec.Placement.Replicas
+}
+
+// getActivePlacedBackends returns the backends chosen by the placement policy of
+// the bucket which are in the backend store and are not being drained.
+func (c *external) getActivePlacedBackends(bucket *v1alpha1.Bucket) []string {
+	return slices.DeleteFunc(slices.Clone(bucket.Status.AtProvider.PlacedBackends), func(backendName string) bool {
+		return !c.backendStore.BackendExists(backendName) ||
+			c.backendStore.GetBackendMode(backendName) == apisv1alpha1.BackendModeDraining
+	})
+}
+
+// getPlacementCandidates returns the backends on which the bucket may be placed
+// but is not yet, sorted by name. A candidate is healthy, matches the selector of
+// the placement policy, is not cordoned and has not been disabled by label on
+// the Bucket CR.
+func (c *external) getPlacementCandidates(bucket *v1alpha1.Bucket, backendNames []string) ([]string, error) {
+	selector := labels.Everything()
+	if bucket.Spec.Placement.Selector != nil {
+		var err error
+		selector, err = metav1.LabelSelectorAsSelector(bucket.Spec.Placement.Selector)
+		if err != nil {
+			return nil, errors.Wrap(err, errPlacementSelector)
+		}
+	}
+
+	candidates := make([]string, 0, len(backendNames))
+	for _, backendName := range backendNames {
+		if slices.Contains(bucket.Status.AtProvider.PlacedBackends, backendName) {
+			continue
+		}
+		if status, ok := bucket.Labels[utils.GetBackendLabel(backendName)]; ok && status != consts.TrueStr {
+			continue
+		}
+		if c.backendStore.GetBackendHealthStatus(backendName) == apisv1alpha1.HealthStatusUnhealthy {
+			continue
+		}
+		if c.backendStore.IsBackendCordoned(backendName) {
+			continue
+		}
+		if !selector.Matches(labels.Set(c.backendStore.GetBackendLabels(backendName))) {
+			continue
+		}
+
+		candidates = append(candidates, backendName)
+	}
+	slices.Sort(candidates)
+
+	return candidates, nil
+}
+
+// placeBucket adds backends to the placement of the bucket until it is placed on
+// as many backends as required by its placement policy, or no candidates remain.
+// The placement is persisted in the Bucket CR status so that it is stable across
+// reconciles.
+func (c *external) placeBucket(ctx context.Context, bucket *v1alpha1.Bucket, backendNames []string) error {
+	if !c.needsPlacement(bucket) {
+		return nil
+	}
+
+	ctx, span := otel.Tracer("").Start(ctx, "bucket.external.placeBucket")
+	defer span.End()
+	ctx, log := traces.InjectTraceAndLogger(ctx, c.log)
+
+	candidates, err := c.getPlacementCandidates(bucket, backendNames)
+	if err != nil {
+		traces.SetAndRecordError(span, err)
+
+		return err
+	}
+
+	placed := slices.Clone(bucket.Status.AtProvider.PlacedBackends)
+	missing := int(bucket.Spec.Placement.Replicas) - len(c.getActivePlacedBackends(bucket))
+
+	// Backends on which the bucket already exists are preferred, so that adding
+	// a placement policy to an existing Bucket CR does not move the bucket.
+	for _, backendName := range candidates {
+		if missing == 0 {
+			break
+		}
+		if _, ok := bucket.Status.AtProvider.Backends[backendName]; ok {
+			placed = append(placed, backendName)
+			missing--
+		}
+	}
+	candidates = slices.DeleteFunc(candidates, func(backendName string) bool {
+		return slices.Contains(placed, backendName)
+	})
+
+	if missing > 0 && len(candidates) > 0 {
+		ordered, err := c.orderPlacementCandidates(ctx, bucket, candidates)
+		if err != nil {
+			traces.SetAndRecordError(span, err)
+
+			return err
+		}
+		placed = append(placed, c.choosePlacementCandidates(bucket, placed, ordered, missing)...)
+	}
+
+	if len(placed) == 0 {
+		err := errors.New(errNoPlacementCandidates)
+		traces.SetAndRecordError(span, err)
+
+		return err
+	}
+	if len(placed) == len(bucket.Status.AtProvider.PlacedBackends) {
+		return nil
+	}
+
+	log.Info("Placing bucket on backends", consts.KeyBucketName, bucket.Name, "backends", placed)
+
+	bucket.Status.AtProvider.PlacedBackends = placed
+	if err := c.updateBucketCR(ctx, bucket, func(bucketLatest *v1alpha1.Bucket) UpdateRequired {
+		bucketLatest.Status.AtProvider.PlacedBackends = placed
+
+		return NeedsStatusUpdate
+	}); err != nil {
+		err = errors.Wrap(err, errUpdateBucketCR)
+		traces.SetAndRecordError(span, err)
+
+		return err
+	}
+
+	return nil
+}
+
+// orderPlacementCandidates orders the candidates by preference according to the
+// strategy of the placement policy.
+func (c *external) orderPlacementCandidates(ctx context.Context, bucket *v1alpha1.Bucket, candidates []string) ([]string, error) {
+	if bucket.Spec.Placement.Strategy == v1alpha1.PlacementStrategyLeastUsed {
+		counts, err := c.countBucketsPerBackend(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		ordered := slices.Clone(candidates)
+		slices.SortStableFunc(ordered, func(a, b string) int {
+			return cmp.Compare(counts[a], counts[b])
+		})
+
+		return ordered, nil
+	}
+
+	// The RoundRobin and SpreadByLabel strategies both rotate the candidates
+	// so that consecutive buckets start on a different backend.
+	var offset int
+	if c.placementCounter != nil {
+		offset = int(c.placementCounter.Add(1)-1) % len(candidates)
+	}
+
+	return append(slices.Clone(candidates[offset:]), candidates[:offset]...), nil
+}
+
+// choosePlacementCandidates returns up to n of the ordered candidates. The
+// SpreadByLabel strategy prefers candidates whose value of the spread label is
+// shared by the fewest placed backends, other strategies follow the order.
+func (c *external) choosePlacementCandidates(bucket *v1alpha1.Bucket, placed, ordered []string, n int) []string {
+	if bucket.Spec.Placement.Strategy != v1alpha1.PlacementStrategySpreadByLabel {
+		return ordered[:min(n, len(ordered))]
+	}
+
+	key := bucket.Spec.Placement.SpreadLabelKey
+	valueOf := func(backendName string) string {
+		return c.backendStore.GetBackendLabels(backendName)[key]
+	}
+
+	spread := map[string]int{}
+	for _, backendName := range placed {
+		spread[valueOf(backendName)]++
+	}
+
+	remaining := slices.Clone(ordered)
+	chosen := make([]string, 0, n)
+	for len(chosen) < n && len(remaining) > 0 {
+		best := 0
+		for i := range remaining {
+			if spread[valueOf(remaining[i])] < spread[valueOf(remaining[best])] {
+				best = i
+			}
+		}
+
+		spread[valueOf(remaining[best])]++
+		chosen = append(chosen, remaining[best])
+		remaining = slices.Delete(remaining, best, best+1)
+	}
+
+	return chosen
+}
+
+// countBucketsPerBackend returns the number of Bucket CRs of either scope which
+// are labelled as placed on each backend. Only the metadata of the Buckets is
+// listed, from the API server rather than from the cache of the kube client, so
+// that paused Buckets, which are not cached, are counted too.
+func (c *external) countBucketsPerBackend(ctx context.Context) (map[string]int, error) {
+	counts := map[string]int{}
+	for _, gv := range []schema.GroupVersion{v1alpha1.SchemeGroupVersion, nsv1alpha1.SchemeGroupVersion} {
+		list := &metav1.PartialObjectMetadataList{}
+		list.SetGroupVersionKind(gv.WithKind(bucketListKind))
+		if err := c.kubeReader.List(ctx, list); err != nil {
+			return nil, errors.Wrap(err, errListBuckets)
+		}
+
+		for i := range list.Items {
+			for label, value := range list.Items[i].Labels {
+				if backendName, ok := strings.CutPrefix(label, v1alpha1.BackendLabelPrefix); ok && value == consts.TrueStr {
+					counts[backendName]++
+				}
+			}
+		}
+	}
+
+	return counts, nil
+}
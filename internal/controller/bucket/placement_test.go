@@ -0,0 +1,309 @@
+package bucket
+
+import (
+	"context"
+	"slices"
+	"sync/atomic"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	nsv1alpha1 "github.com/linode/provider-ceph/apis/namespaced/provider-ceph/v1alpha1"
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/backendstore/backendstorefakes"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/utils"
+)
+
+const (
+	labelZone = "topology.kubernetes.io/zone"
+	labelTier = "tier"
+)
+
+// pausedBucketFilter hides paused Buckets from lists of the client, as the cache
+// of the manager does.
+func pausedBucketFilter(cl client.WithWatch) client.WithWatch {
+	return interceptor.NewClient(cl, interceptor.Funcs{
+		List: func(ctx context.Context, cl client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+			if err := cl.List(ctx, list, opts...); err != nil {
+				return err
+			}
+			if l, ok := list.(*metav1.PartialObjectMetadataList); ok {
+				l.Items = slices.DeleteFunc(l.Items, func(o metav1.PartialObjectMetadata) bool {
+					return o.Labels[meta.AnnotationKeyReconciliationPaused] == consts.TrueStr
+				})
+			}
+
+			return nil
+		},
+	})
+}
+
+//nolint:maintidx // Requires many scenarios for full coverage.
+func TestPlaceBucket(t *testing.T) {
+	t.Parallel()
+
+	// Backends 1 and 2 are in zone a, backends 3 and 4 in zone b.
+	// Backend 4 is unhealthy and backend 2 is archive tier.
+	backends := map[string]struct {
+		labels map[string]string
+		health apisv1alpha1.HealthStatus
+	}{
+		consts.S3Backend1: {labels: map[string]string{labelZone: "a", labelTier: "hot"}, health: apisv1alpha1.HealthStatusHealthy},
+		consts.S3Backend2: {labels: map[string]string{labelZone: "a", labelTier: "archive"}, health: apisv1alpha1.HealthStatusHealthy},
+		consts.S3Backend3: {labels: map[string]string{labelZone: "b", labelTier: "hot"}, health: apisv1alpha1.HealthStatusHealthy},
+		"s3-backend-4":    {labels: map[string]string{labelZone: "b", labelTier: "hot"}, health: apisv1alpha1.HealthStatusUnhealthy},
+	}
+
+	newBucket := func(placement *v1alpha1.PlacementPolicy, placed ...string) *v1alpha1.Bucket {
+		return &v1alpha1.Bucket{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "bucket",
+			},
+			Spec: v1alpha1.BucketSpec{
+				Placement: placement,
+			},
+			Status: v1alpha1.BucketStatus{
+				AtProvider: v1alpha1.BucketObservation{
+					PlacedBackends: placed,
+				},
+			},
+		}
+	}
+
+	type args struct {
+		bucket  *v1alpha1.Bucket
+		counter uint64
+		others  []client.Object
+		modes   map[string]apisv1alpha1.BackendMode
+	}
+
+	type want struct {
+		placed []string
+		err    error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"Round robin places bucket on first backends": {
+			args: args{
+				bucket: newBucket(&v1alpha1.PlacementPolicy{Replicas: 2, Strategy: v1alpha1.PlacementStrategyRoundRobin}),
+			},
+			want: want{
+				placed: []string{consts.S3Backend1, consts.S3Backend2},
+			},
+		},
+		"Round robin rotates backends of consecutive buckets": {
+			args: args{
+				bucket:  newBucket(&v1alpha1.PlacementPolicy{Replicas: 2, Strategy: v1alpha1.PlacementStrategyRoundRobin}),
+				counter: 2,
+			},
+			want: want{
+				placed: []string{consts.S3Backend3, consts.S3Backend1},
+			},
+		},
+		"Selector restricts backends": {
+			args: args{
+				bucket: newBucket(&v1alpha1.PlacementPolicy{
+					Replicas: 3,
+					Strategy: v1alpha1.PlacementStrategyRoundRobin,
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{labelTier: "hot"}},
+				}),
+			},
+			want: want{
+				placed: []string{consts.S3Backend1, consts.S3Backend3},
+			},
+		},
+		"Cordoned backends are not chosen": {
+			args: args{
+				bucket: newBucket(&v1alpha1.PlacementPolicy{Replicas: 2, Strategy: v1alpha1.PlacementStrategyRoundRobin}),
+				modes:  map[string]apisv1alpha1.BackendMode{consts.S3Backend1: apisv1alpha1.BackendModeCordoned},
+			},
+			want: want{
+				placed: []string{consts.S3Backend2, consts.S3Backend3},
+			},
+		},
+		"Draining backend is replaced by another backend": {
+			args: args{
+				bucket: newBucket(&v1alpha1.PlacementPolicy{Replicas: 1, Strategy: v1alpha1.PlacementStrategyRoundRobin}, consts.S3Backend1),
+				modes:  map[string]apisv1alpha1.BackendMode{consts.S3Backend1: apisv1alpha1.BackendModeDraining},
+			},
+			want: want{
+				placed: []string{consts.S3Backend1, consts.S3Backend2},
+			},
+		},
+		"Spread by label places replicas in different zones": {
+			args: args{
+				bucket: newBucket(&v1alpha1.PlacementPolicy{Replicas: 2, Strategy: v1alpha1.PlacementStrategySpreadByLabel, SpreadLabelKey: labelZone}),
+			},
+			want: want{
+				placed: []string{consts.S3Backend1, consts.S3Backend3},
+			},
+		},
+		"Spread by label tops up placement in least used zone": {
+			args: args{
+				bucket: newBucket(&v1alpha1.PlacementPolicy{Replicas: 2, Strategy: v1alpha1.PlacementStrategySpreadByLabel, SpreadLabelKey: labelZone}, consts.S3Backend3),
+			},
+			want: want{
+				placed: []string{consts.S3Backend3, consts.S3Backend1},
+			},
+		},
+		"Least used places bucket on backends with fewest buckets": {
+			args: args{
+				bucket: newBucket(&v1alpha1.PlacementPolicy{Replicas: 1, Strategy: v1alpha1.PlacementStrategyLeastUsed}),
+				others: []client.Object{
+					&v1alpha1.Bucket{ObjectMeta: metav1.ObjectMeta{
+						Name:   "other-1",
+						Labels: map[string]string{utils.GetBackendLabel(consts.S3Backend1): consts.TrueStr},
+					}},
+					&nsv1alpha1.Bucket{ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      "other-2",
+						Labels:    map[string]string{utils.GetBackendLabel(consts.S3Backend2): consts.TrueStr},
+					}},
+				},
+			},
+			want: want{
+				placed: []string{consts.S3Backend3},
+			},
+		},
+		"Least used counts paused buckets": {
+			args: args{
+				bucket: newBucket(&v1alpha1.PlacementPolicy{Replicas: 1, Strategy: v1alpha1.PlacementStrategyLeastUsed}),
+				others: []client.Object{
+					&v1alpha1.Bucket{ObjectMeta: metav1.ObjectMeta{
+						Name:   "other-1",
+						Labels: map[string]string{utils.GetBackendLabel(consts.S3Backend1): consts.TrueStr},
+					}},
+					&v1alpha1.Bucket{ObjectMeta: metav1.ObjectMeta{
+						Name:   "other-2",
+						Labels: map[string]string{utils.GetBackendLabel(consts.S3Backend2): consts.TrueStr},
+					}},
+					&v1alpha1.Bucket{ObjectMeta: metav1.ObjectMeta{
+						Name: "paused",
+						Labels: map[string]string{
+							utils.GetBackendLabel(consts.S3Backend3): consts.TrueStr,
+							meta.AnnotationKeyReconciliationPaused:   consts.TrueStr,
+						},
+					}},
+				},
+			},
+			want: want{
+				placed: []string{consts.S3Backend1},
+			},
+		},
+		"Removed backend is replaced by another backend": {
+			args: args{
+				bucket: newBucket(&v1alpha1.PlacementPolicy{Replicas: 1, Strategy: v1alpha1.PlacementStrategyRoundRobin}, "s3-backend-5"),
+			},
+			want: want{
+				placed: []string{"s3-backend-5", consts.S3Backend1},
+			},
+		},
+		"Backends on which bucket exists are preferred": {
+			args: args{
+				bucket: func() *v1alpha1.Bucket {
+					b := newBucket(&v1alpha1.PlacementPolicy{Replicas: 1, Strategy: v1alpha1.PlacementStrategyRoundRobin})
+					b.Status.AtProvider.Backends = v1alpha1.Backends{consts.S3Backend3: &v1alpha1.BackendInfo{}}
+
+					return b
+				}(),
+			},
+			want: want{
+				placed: []string{consts.S3Backend3},
+			},
+		},
+		"Backends disabled by label are not placed": {
+			args: args{
+				bucket: func() *v1alpha1.Bucket {
+					b := newBucket(&v1alpha1.PlacementPolicy{Replicas: 1, Strategy: v1alpha1.PlacementStrategyRoundRobin})
+					b.Labels = map[string]string{utils.GetBackendLabel(consts.S3Backend1): consts.FalseStr}
+
+					return b
+				}(),
+			},
+			want: want{
+				placed: []string{consts.S3Backend2},
+			},
+		},
+		"Placed bucket is not moved": {
+			args: args{
+				bucket: newBucket(&v1alpha1.PlacementPolicy{Replicas: 1, Strategy: v1alpha1.PlacementStrategyRoundRobin}, consts.S3Backend2),
+			},
+			want: want{
+				placed: []string{consts.S3Backend2},
+			},
+		},
+		"No backend matches placement policy": {
+			args: args{
+				bucket: newBucket(&v1alpha1.PlacementPolicy{
+					Replicas: 1,
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{labelTier: "cold"}},
+				}),
+			},
+			want: want{
+				err: errors.New(errNoPlacementCandidates),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			bs := backendstore.NewBackendStore()
+			for backendName, backend := range backends {
+				bs.AddOrUpdateBackend(backendName, &backendstorefakes.FakeS3Client{}, nil, backend.health)
+				bs.SetBackendLabels(backendName, backend.labels)
+				bs.SetBackendMode(backendName, tc.args.modes[backendName])
+			}
+
+			s := runtime.NewScheme()
+			s.AddKnownTypes(v1alpha1.SchemeGroupVersion, &v1alpha1.Bucket{}, &v1alpha1.BucketList{})
+			s.AddKnownTypes(nsv1alpha1.SchemeGroupVersion, &nsv1alpha1.Bucket{}, &nsv1alpha1.BucketList{})
+
+			cl := fake.NewClientBuilder().
+				WithScheme(s).
+				WithObjects(append(tc.args.others, tc.args.bucket)...).
+				WithStatusSubresource(tc.args.bucket).
+				Build()
+
+			counter := &atomic.Uint64{}
+			counter.Store(tc.args.counter)
+
+			e := external{
+				kubeClient:       pausedBucketFilter(cl),
+				kubeReader:       cl,
+				backendStore:     bs,
+				log:              logr.Discard(),
+				placementCounter: counter,
+			}
+
+			err := e.placeBucket(context.Background(), tc.args.bucket, bs.GetAllBackendNames())
+			if tc.want.err != nil {
+				require.EqualError(t, err, tc.want.err.Error(), "unexpected error")
+
+				return
+			}
+			require.NoError(t, err, "unexpected error")
+
+			bucket := &v1alpha1.Bucket{}
+			require.NoError(t, cl.Get(context.Background(), types.NamespacedName{Name: tc.args.bucket.Name}, bucket))
+			assert.Equal(t, tc.want.placed, bucket.Status.AtProvider.PlacedBackends, "unexpected placement")
+			assert.Equal(t, tc.want.placed, getBucketProvidersFilterDisabledLabel(bucket, bs.GetAllBackendNames()), "unexpected providers")
+		})
+	}
+}
@@ -108,7 +108,12 @@ func (p *PolicyClient) observeBackend(ctx context.Context, bucket *v1alpha1.Buck
 		external = *response.Policy
 	}
 
-	if bucket.Spec.ForProvider.Policy == "" {
+	local, err := rgw.GenerateBucketPolicy(&bucket.Spec.ForProvider)
+	if err != nil {
+		return NeedsUpdate, err
+	}
+
+	if local == "" {
 		// No policy config is specified.
 		// In that case, it should not exist on any backend.
 		if external == "" {
@@ -122,8 +127,9 @@ func (p *PolicyClient) observeBackend(ctx context.Context, bucket *v1alpha1.Buck
 		}
 	}
 
-	local := bucket.Spec.ForProvider.Policy
-	if local != external {
+	// The policies are compared after normalization, as the order of statements
+	// and single values versus lists of one value are not significant.
+	if !rgw.BucketPoliciesEqual(local, external) {
 		log.Info("Bucket policy requires update on backend", consts.KeyBucketName, bucket.Name, consts.KeyBackendName, backendName)
 
 		return NeedsUpdate, nil
@@ -284,6 +284,120 @@ func TestPolicyObserveBackend(t *testing.T) {
 				status: NeedsUpdate,
 			},
 		},
+		"ok - reordered bucket policy is updated": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketPolicyStub: func(ctx context.Context, in *s3.GetBucketPolicyInput, f ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+							return &s3.GetBucketPolicyOutput{Policy: aws.String(`{"Statement": {"Resource": "arn:aws:s3:::bucket/*", "Action": "s3:GetObject", "Principal": "*", "Effect": "Allow"}, "Version": "2012-10-17"}`)}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							Policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::bucket/*"]}]}`,
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"ok - structured bucket policy is updated": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketPolicyStub: func(ctx context.Context, in *s3.GetBucketPolicyInput, f ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+							return &s3.GetBucketPolicyOutput{Policy: aws.String(`{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Principal": {"AWS": "arn:aws:iam:::user/alice"}, "Action": "s3:*", "Resource": "arn:aws:s3:::bucket/*"}]}`)}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							BucketPolicy: &v1alpha1.BucketPolicy{
+								Statements: []v1alpha1.BucketPolicyStatement{
+									{
+										Effect:    "Allow",
+										Principal: v1alpha1.BucketPolicyPrincipal{AWS: []string{"arn:aws:iam:::user/alice"}},
+										Actions:   []string{"s3:*"},
+										Resources: []string{"arn:aws:s3:::bucket/*"},
+									},
+								},
+							},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"structured bucket policy differs from bucket policy in backend": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketPolicyStub: func(ctx context.Context, in *s3.GetBucketPolicyInput, f ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
+							return &s3.GetBucketPolicyOutput{Policy: aws.String(`{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Principal": "*", "Action": "s3:*", "Resource": "arn:aws:s3:::bucket/*"}]}`)}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							BucketPolicy: &v1alpha1.BucketPolicy{
+								Statements: []v1alpha1.BucketPolicyStatement{
+									{
+										Effect:    "Allow",
+										Principal: v1alpha1.BucketPolicyPrincipal{AWS: []string{"arn:aws:iam:::user/alice"}},
+										Actions:   []string{"s3:*"},
+										Resources: []string{"arn:aws:s3:::bucket/*"},
+									},
+								},
+							},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -0,0 +1,215 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/smithy-go/document"
+	"github.com/go-logr/logr"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/controller/s3clienthandler"
+	"github.com/linode/provider-ceph/internal/otel/traces"
+	"github.com/linode/provider-ceph/internal/rgw"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"go.opentelemetry.io/otel"
+)
+
+// PublicAccessBlockClient is the client for API methods and reconciling the
+// public access block configuration of a bucket.
+type PublicAccessBlockClient struct {
+	backendStore    *backendstore.BackendStore
+	s3ClientHandler *s3clienthandler.Handler
+	// blockByDefault specifies whether all public access is blocked for
+	// buckets which do not specify a public access block configuration.
+	blockByDefault bool
+	log            logr.Logger
+}
+
+func NewPublicAccessBlockClient(b *backendstore.BackendStore, h *s3clienthandler.Handler, blockByDefault bool, l logr.Logger) *PublicAccessBlockClient {
+	return &PublicAccessBlockClient{backendStore: b, s3ClientHandler: h, blockByDefault: blockByDefault, log: l}
+}
+
+//nolint:dupl // PublicAccessBlock and LifecycleConfiguration are different feature.
+func (p *PublicAccessBlockClient) Observe(ctx context.Context, bucket *v1alpha1.Bucket, backendNames []string) (ResourceStatus, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "bucket.PublicAccessBlockClient.Observe")
+	defer span.End()
+	ctx, log := traces.InjectTraceAndLogger(ctx, p.log)
+
+	observationChan := make(chan ResourceStatus)
+	errChan := make(chan error)
+
+	for _, backendName := range backendNames {
+		beName := backendName
+		go func() {
+			if p.backendStore.GetBackendHealthStatus(beName) == apisv1alpha1.HealthStatusUnhealthy {
+				// If a backend is marked as unhealthy, we can ignore it for now by returning NoAction.
+				// The backend may be down for some time and we do not want to block Create/Update/Delete
+				// calls on other backends. By returning NoAction here, we would never pass the Observe
+				// phase until the backend becomes Healthy or Disabled.
+				observationChan <- NoAction
+
+				return
+			}
+
+			observation, err := p.observeBackend(ctx, bucket, beName)
+			if err != nil {
+				errChan <- err
+
+				return
+			}
+			observationChan <- observation
+		}()
+	}
+
+	for i := 0; i < len(backendNames); i++ {
+		select {
+		case <-ctx.Done():
+			log.Info("Context timeout during bucket public access block observation", consts.KeyBucketName, bucket.Name)
+			err := errors.Wrap(ctx.Err(), errObservePublicAccessBlock)
+			traces.SetAndRecordError(span, err)
+
+			return NeedsUpdate, err
+		case observation := <-observationChan:
+			if observation == NeedsUpdate || observation == NeedsDeletion {
+				return observation, nil
+			}
+		case err := <-errChan:
+			err = errors.Wrap(err, errObservePublicAccessBlock)
+			traces.SetAndRecordError(span, err)
+
+			return NeedsUpdate, err
+		}
+	}
+
+	return Updated, nil
+}
+
+func (p *PublicAccessBlockClient) observeBackend(ctx context.Context, bucket *v1alpha1.Bucket, backendName string) (ResourceStatus, error) {
+	ctx, log := traces.InjectTraceAndLogger(ctx, p.log)
+
+	log.V(1).Info("Observing subresource public access block on backend", consts.KeyBucketName, bucket.Name, consts.KeyBackendName, backendName)
+
+	s3Client, err := p.s3ClientHandler.GetS3Client(ctx, bucket, backendName)
+	if err != nil {
+		return NeedsUpdate, err
+	}
+	response, err := rgw.GetPublicAccessBlock(ctx, s3Client, aws.String(bucket.Name))
+	if err != nil {
+		return NeedsUpdate, err
+	}
+
+	external := rgw.PublicAccessBlockConfigurationFromOutput(response)
+
+	local := rgw.GeneratePublicAccessBlockConfiguration(bucket.Spec.ForProvider.PublicAccessBlockConfiguration, p.blockByDefault)
+	if local == nil {
+		// No public access block configuration is specified, so none should exist on any backend.
+		if external == nil {
+			log.V(1).Info("No public access block configuration found on backend - no action required", consts.KeyBucketName, bucket.Name, consts.KeyBackendName, backendName)
+
+			return NoAction, nil
+		}
+		log.V(1).Info("Public access block configuration found on backend - requires deletion", consts.KeyBucketName, bucket.Name, consts.KeyBackendName, backendName)
+
+		return NeedsDeletion, nil
+	}
+
+	if !cmp.Equal(external, local, cmpopts.IgnoreTypes(document.NoSerde{})) {
+		log.V(1).Info("Public access block configuration requires update on backend", consts.KeyBucketName, bucket.Name, consts.KeyBackendName, backendName)
+
+		return NeedsUpdate, nil
+	}
+
+	return Updated, nil
+}
+
+func (p *PublicAccessBlockClient) Handle(ctx context.Context, b *v1alpha1.Bucket, backendName string, bb *bucketBackends) error {
+	ctx, span := otel.Tracer("").Start(ctx, "bucket.PublicAccessBlockClient.Handle")
+	defer span.End()
+
+	if p.backendStore.GetBackendHealthStatus(backendName) == apisv1alpha1.HealthStatusUnhealthy {
+		traces.SetAndRecordError(span, errUnhealthyBackend)
+
+		return errUnhealthyBackend
+	}
+
+	observation, err := p.observeBackend(ctx, b, backendName)
+	if err != nil {
+		err = errors.Wrap(err, errHandlePublicAccessBlock)
+		traces.SetAndRecordError(span, err)
+
+		return err
+	}
+
+	switch observation {
+	case NoAction:
+		return nil
+	case Updated:
+		// The public access block configuration is up to date, so we can consider this
+		// sub resource Available.
+		available := xpv1.Available()
+		bb.setPublicAccessBlockCondition(b.Name, backendName, &available)
+
+	case NeedsDeletion:
+		if err := p.delete(ctx, b, backendName); err != nil {
+			err = errors.Wrap(err, errHandlePublicAccessBlock)
+			deleting := xpv1.Deleting().WithMessage(err.Error())
+			bb.setPublicAccessBlockCondition(b.Name, backendName, &deleting)
+
+			traces.SetAndRecordError(span, err)
+
+			return err
+		}
+		bb.setPublicAccessBlockCondition(b.Name, backendName, nil)
+
+	case NeedsUpdate:
+		if err := p.createOrUpdate(ctx, b, backendName); err != nil {
+			err = errors.Wrap(err, errHandlePublicAccessBlock)
+			unavailable := xpv1.Unavailable().WithMessage(err.Error())
+			bb.setPublicAccessBlockCondition(b.Name, backendName, &unavailable)
+
+			traces.SetAndRecordError(span, err)
+
+			return err
+		}
+		available := xpv1.Available()
+		bb.setPublicAccessBlockCondition(b.Name, backendName, &available)
+	}
+
+	return nil
+}
+
+func (p *PublicAccessBlockClient) createOrUpdate(ctx context.Context, b *v1alpha1.Bucket, backendName string) error {
+	ctx, log := traces.InjectTraceAndLogger(ctx, p.log)
+
+	log.Info("Updating public access block configuration", consts.KeyBucketName, b.Name, consts.KeyBackendName, backendName)
+	s3Client, err := p.s3ClientHandler.GetS3Client(ctx, b, backendName)
+	if err != nil {
+		return err
+	}
+
+	_, err = rgw.PutPublicAccessBlock(ctx, s3Client, aws.String(b.Name), rgw.GeneratePublicAccessBlockConfiguration(b.Spec.ForProvider.PublicAccessBlockConfiguration, p.blockByDefault))
+
+	return err
+}
+
+func (p *PublicAccessBlockClient) delete(ctx context.Context, b *v1alpha1.Bucket, backendName string) error {
+	ctx, log := traces.InjectTraceAndLogger(ctx, p.log)
+
+	log.Info("Deleting public access block configuration", consts.KeyBucketName, b.Name, consts.KeyBackendName, backendName)
+	s3Client, err := p.s3ClientHandler.GetS3Client(ctx, b, backendName)
+	if err != nil {
+		return err
+	}
+
+	return rgw.DeletePublicAccessBlock(ctx, s3Client, aws.String(b.Name))
+}
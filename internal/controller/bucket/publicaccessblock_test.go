@@ -0,0 +1,536 @@
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/backendstore/backendstorefakes"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/controller/s3clienthandler"
+	"github.com/linode/provider-ceph/internal/rgw"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+//nolint:maintidx // Function requires numerous checks.
+func TestPublicAccessBlockObserveBackend(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		backendStore   *backendstore.BackendStore
+		blockByDefault bool
+	}
+
+	type args struct {
+		bucket      *v1alpha1.Bucket
+		backendName string
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		fields fields
+		args   args
+		want   want
+	}{
+		"External error getting public access block": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetPublicAccessBlockStub: func(ctx context.Context, in *s3.GetPublicAccessBlockInput, f ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error) {
+							return nil, errExternal
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							PublicAccessBlockConfiguration: &v1alpha1.PublicAccessBlockConfiguration{BlockPublicAcls: aws.Bool(true)},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    errExternal,
+			},
+		},
+		"Not specified in CR, not blocked by default and not found on backend so NoAction": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetPublicAccessBlockStub: func(ctx context.Context, in *s3.GetPublicAccessBlockInput, f ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error) {
+							return nil, &smithy.GenericAPIError{Code: rgw.PublicAccessBlockNotFoundErrCode}
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NoAction,
+			},
+		},
+		"Not specified in CR and not blocked by default but exists on backend so NeedsDeletion": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetPublicAccessBlockStub: func(ctx context.Context, in *s3.GetPublicAccessBlockInput, f ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error) {
+							return &s3.GetPublicAccessBlockOutput{
+								PublicAccessBlockConfiguration: &s3types.PublicAccessBlockConfiguration{BlockPublicAcls: aws.Bool(true)},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsDeletion,
+			},
+		},
+		"Not specified in CR but blocked by default and not found on backend so NeedsUpdate": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetPublicAccessBlockStub: func(ctx context.Context, in *s3.GetPublicAccessBlockInput, f ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error) {
+							return nil, &smithy.GenericAPIError{Code: rgw.PublicAccessBlockNotFoundErrCode}
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+				blockByDefault: true,
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"Not specified in CR but blocked by default and all public access blocked on backend so Updated": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetPublicAccessBlockStub: func(ctx context.Context, in *s3.GetPublicAccessBlockInput, f ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error) {
+							return &s3.GetPublicAccessBlockOutput{
+								PublicAccessBlockConfiguration: &s3types.PublicAccessBlockConfiguration{
+									BlockPublicAcls:       aws.Bool(true),
+									IgnorePublicAcls:      aws.Bool(true),
+									BlockPublicPolicy:     aws.Bool(true),
+									RestrictPublicBuckets: aws.Bool(true),
+								},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+				blockByDefault: true,
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"Opted out of default block but all public access blocked on backend so NeedsUpdate": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetPublicAccessBlockStub: func(ctx context.Context, in *s3.GetPublicAccessBlockInput, f ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error) {
+							return &s3.GetPublicAccessBlockOutput{
+								PublicAccessBlockConfiguration: &s3types.PublicAccessBlockConfiguration{
+									BlockPublicAcls:       aws.Bool(true),
+									IgnorePublicAcls:      aws.Bool(true),
+									BlockPublicPolicy:     aws.Bool(true),
+									RestrictPublicBuckets: aws.Bool(true),
+								},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+				blockByDefault: true,
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							PublicAccessBlockConfiguration: &v1alpha1.PublicAccessBlockConfiguration{},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"Fields omitted by backend are unset in CR so Updated": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetPublicAccessBlockStub: func(ctx context.Context, in *s3.GetPublicAccessBlockInput, f ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error) {
+							return &s3.GetPublicAccessBlockOutput{
+								PublicAccessBlockConfiguration: &s3types.PublicAccessBlockConfiguration{BlockPublicPolicy: aws.Bool(true)},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							PublicAccessBlockConfiguration: &v1alpha1.PublicAccessBlockConfiguration{
+								BlockPublicAcls:   aws.Bool(false),
+								BlockPublicPolicy: aws.Bool(true),
+							},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := NewPublicAccessBlockClient(
+				tc.fields.backendStore,
+				s3clienthandler.NewHandler(
+					s3clienthandler.WithAssumeRoleArn(nil),
+					s3clienthandler.WithBackendStore(tc.fields.backendStore)),
+				tc.fields.blockByDefault,
+				logr.Discard())
+
+			got, err := c.observeBackend(context.Background(), tc.args.bucket, tc.args.backendName)
+			require.ErrorIs(t, err, tc.want.err, "unexpected error")
+			assert.Equal(t, tc.want.status, got, "unexpected status")
+		})
+	}
+}
+
+//nolint:maintidx // Function requires numerous checks.
+func TestPublicAccessBlockHandle(t *testing.T) {
+	t.Parallel()
+
+	bucketName := consts.TestBucket
+	beName := consts.S3Backend1
+
+	type fields struct {
+		backendStore   *backendstore.BackendStore
+		blockByDefault bool
+	}
+
+	type args struct {
+		bucket      *v1alpha1.Bucket
+		backendName string
+	}
+
+	type want struct {
+		err          error
+		specificDiff func(t *testing.T, bb *bucketBackends)
+	}
+
+	cases := map[string]struct {
+		fields fields
+		args   args
+		want   want
+	}{
+		"Unhealthy backend": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusUnhealthy)
+
+					return bs
+				}(),
+				blockByDefault: true,
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				err: errUnhealthyBackend,
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					assert.Nil(t, bb.getPublicAccessBlockCondition(bucketName, beName), "unexpected public access block condition")
+				},
+			},
+		},
+		"All public access is blocked by default on backend": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetPublicAccessBlockStub: func(ctx context.Context, in *s3.GetPublicAccessBlockInput, f ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error) {
+							return nil, &smithy.GenericAPIError{Code: rgw.PublicAccessBlockNotFoundErrCode}
+						},
+						PutPublicAccessBlockStub: func(ctx context.Context, in *s3.PutPublicAccessBlockInput, f ...func(*s3.Options)) (*s3.PutPublicAccessBlockOutput, error) {
+							config := in.PublicAccessBlockConfiguration
+							if aws.ToString(in.Bucket) != bucketName ||
+								!aws.ToBool(config.BlockPublicAcls) || !aws.ToBool(config.IgnorePublicAcls) ||
+								!aws.ToBool(config.BlockPublicPolicy) || !aws.ToBool(config.RestrictPublicBuckets) {
+								return nil, errUnexpectedInput
+							}
+
+							return &s3.PutPublicAccessBlockOutput{}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+				blockByDefault: true,
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					assert.True(t, bb.getPublicAccessBlockCondition(bucketName, beName).Equal(v1.Available()), "unexpected public access block condition")
+				},
+			},
+		},
+		"Public access block is deleted from backend when removed from CR": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetPublicAccessBlockStub: func(ctx context.Context, in *s3.GetPublicAccessBlockInput, f ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error) {
+							return &s3.GetPublicAccessBlockOutput{
+								PublicAccessBlockConfiguration: &s3types.PublicAccessBlockConfiguration{BlockPublicAcls: aws.Bool(true)},
+							}, nil
+						},
+						DeletePublicAccessBlockStub: func(ctx context.Context, in *s3.DeletePublicAccessBlockInput, f ...func(*s3.Options)) (*s3.DeletePublicAccessBlockOutput, error) {
+							if aws.ToString(in.Bucket) != bucketName {
+								return nil, errUnexpectedInput
+							}
+
+							return &s3.DeletePublicAccessBlockOutput{}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					assert.Nil(t, bb.getPublicAccessBlockCondition(bucketName, beName), "unexpected public access block condition")
+				},
+			},
+		},
+		"Error deleting public access block": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetPublicAccessBlockStub: func(ctx context.Context, in *s3.GetPublicAccessBlockInput, f ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error) {
+							return &s3.GetPublicAccessBlockOutput{
+								PublicAccessBlockConfiguration: &s3types.PublicAccessBlockConfiguration{BlockPublicAcls: aws.Bool(true)},
+							}, nil
+						},
+						DeletePublicAccessBlockStub: func(ctx context.Context, in *s3.DeletePublicAccessBlockInput, f ...func(*s3.Options)) (*s3.DeletePublicAccessBlockOutput, error) {
+							return nil, errExternal
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				err: errExternal,
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					condition := bb.getPublicAccessBlockCondition(bucketName, beName)
+					require.NotNil(t, condition, "missing public access block condition")
+					assert.Equal(t, v1.Deleting().Reason, condition.Reason, "unexpected public access block condition")
+				},
+			},
+		},
+		"Error putting public access block": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetPublicAccessBlockStub: func(ctx context.Context, in *s3.GetPublicAccessBlockInput, f ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error) {
+							return nil, &smithy.GenericAPIError{Code: rgw.PublicAccessBlockNotFoundErrCode}
+						},
+						PutPublicAccessBlockStub: func(ctx context.Context, in *s3.PutPublicAccessBlockInput, f ...func(*s3.Options)) (*s3.PutPublicAccessBlockOutput, error) {
+							return nil, errExternal
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							PublicAccessBlockConfiguration: &v1alpha1.PublicAccessBlockConfiguration{BlockPublicAcls: aws.Bool(true)},
+						},
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				err: errExternal,
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					condition := bb.getPublicAccessBlockCondition(bucketName, beName)
+					require.NotNil(t, condition, "missing public access block condition")
+					assert.Equal(t, v1.Unavailable().Reason, condition.Reason, "unexpected public access block condition")
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := NewPublicAccessBlockClient(
+				tc.fields.backendStore,
+				s3clienthandler.NewHandler(
+					s3clienthandler.WithAssumeRoleArn(nil),
+					s3clienthandler.WithBackendStore(tc.fields.backendStore)),
+				tc.fields.blockByDefault,
+				logr.Discard())
+
+			bb := newBucketBackends()
+			err := c.Handle(context.Background(), tc.args.bucket, tc.args.backendName, bb)
+			require.ErrorIs(t, err, tc.want.err, "unexpected error")
+			if tc.want.specificDiff != nil {
+				tc.want.specificDiff(t, bb)
+			}
+		})
+	}
+}
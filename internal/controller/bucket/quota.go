@@ -0,0 +1,226 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/controller/s3clienthandler"
+	"github.com/linode/provider-ceph/internal/otel/traces"
+	"github.com/linode/provider-ceph/internal/rgw"
+	"github.com/linode/provider-ceph/internal/rgw/admin"
+
+	"go.opentelemetry.io/otel"
+)
+
+// QuotaClient is the client for API methods and reconciling the bucket Quota.
+// Quotas are not part of the S3 API, so the RGW Admin Ops API is used instead.
+type QuotaClient struct {
+	backendStore    *backendstore.BackendStore
+	s3ClientHandler *s3clienthandler.Handler
+	log             logr.Logger
+}
+
+func NewQuotaClient(b *backendstore.BackendStore, h *s3clienthandler.Handler, l logr.Logger) *QuotaClient {
+	return &QuotaClient{backendStore: b, s3ClientHandler: h, log: l}
+}
+
+//nolint:dupl // Quota and VersioningConfiguration are different feature.
+func (q *QuotaClient) Observe(ctx context.Context, bucket *v1alpha1.Bucket, backendNames []string) (ResourceStatus, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "bucket.QuotaClient.Observe")
+	defer span.End()
+	ctx, log := traces.InjectTraceAndLogger(ctx, q.log)
+
+	observationChan := make(chan ResourceStatus)
+	errChan := make(chan error)
+
+	for _, backendName := range backendNames {
+		beName := backendName
+		go func() {
+			if q.backendStore.GetBackendHealthStatus(beName) == apisv1alpha1.HealthStatusUnhealthy {
+				// If a backend is marked as unhealthy, we can ignore it for now by returning NoAction.
+				// The backend may be down for some time and we do not want to block Create/Update/Delete
+				// calls on other backends. By returning NoAction here, we would never pass the Observe
+				// phase until the backend becomes Healthy or Disabled.
+				observationChan <- NoAction
+
+				return
+			}
+
+			observation, err := q.observeBackend(ctx, bucket, beName)
+			if err != nil {
+				errChan <- err
+
+				return
+			}
+			observationChan <- observation
+		}()
+	}
+
+	for i := 0; i < len(backendNames); i++ {
+		select {
+		case <-ctx.Done():
+			log.Info("Context timeout during bucket quota observation", consts.KeyBucketName, bucket.Name)
+			err := errors.Wrap(ctx.Err(), errObserveQuota)
+			traces.SetAndRecordError(span, err)
+
+			return NeedsUpdate, err
+		case observation := <-observationChan:
+			if observation == NeedsUpdate || observation == NeedsDeletion {
+				return observation, nil
+			}
+		case err := <-errChan:
+			err = errors.Wrap(err, errObserveQuota)
+			traces.SetAndRecordError(span, err)
+
+			return NeedsUpdate, err
+		}
+	}
+
+	return Updated, nil
+}
+
+func (q *QuotaClient) observeBackend(ctx context.Context, bucket *v1alpha1.Bucket, backendName string) (ResourceStatus, error) {
+	ctx, log := traces.InjectTraceAndLogger(ctx, q.log)
+
+	log.V(1).Info("Observing subresource quota on backend", consts.KeyBucketName, bucket.Name, consts.KeyBackendName, backendName)
+
+	if bucket.Spec.ForProvider.Quota == nil && !isQuotaInStatus(bucket, backendName) {
+		// No quota was defined by the user in the Bucket CR Spec and no quota was
+		// previously applied on this backend, so there is nothing to disable. Returning
+		// early means that the Admin Ops API is only required for buckets with quotas.
+		return NoAction, nil
+	}
+
+	adminClient, err := q.s3ClientHandler.GetAdminClient(backendName)
+	if err != nil {
+		return NeedsUpdate, err
+	}
+
+	response, err := rgw.GetBucketInfo(ctx, adminClient, bucket.Name)
+	if err != nil {
+		return NeedsUpdate, err
+	}
+
+	if bucket.Spec.ForProvider.Quota == nil {
+		// No quota was defined by the user in the Bucket CR Spec. This should
+		// result in the quota being disabled on the backend.
+		if response == nil || !response.BucketQuota.Enabled {
+			log.V(1).Info("Quota is not enabled for bucket on backend - no action required", consts.KeyBucketName, bucket.Name, consts.KeyBackendName, backendName)
+
+			return NoAction, nil
+		}
+
+		log.V(1).Info("Quota is enabled for bucket on backend - requires disabling", consts.KeyBucketName, bucket.Name, consts.KeyBackendName, backendName)
+
+		return NeedsDeletion, nil
+	}
+
+	if response == nil {
+		return NeedsUpdate, nil
+	}
+
+	if !rgw.IsBucketQuotaUpToDate(response.BucketQuota, rgw.GenerateBucketQuota(bucket.Spec.ForProvider.Quota)) {
+		log.Info("Quota requires update on backend", consts.KeyBucketName, bucket.Name, consts.KeyBackendName, backendName)
+
+		return NeedsUpdate, nil
+	}
+
+	return Updated, nil
+}
+
+func (q *QuotaClient) Handle(ctx context.Context, b *v1alpha1.Bucket, backendName string, bb *bucketBackends) error {
+	ctx, span := otel.Tracer("").Start(ctx, "bucket.QuotaClient.Handle")
+	defer span.End()
+
+	if q.backendStore.GetBackendHealthStatus(backendName) == apisv1alpha1.HealthStatusUnhealthy {
+		traces.SetAndRecordError(span, errUnhealthyBackend)
+
+		return errUnhealthyBackend
+	}
+
+	observation, err := q.observeBackend(ctx, b, backendName)
+	if err != nil {
+		err = errors.Wrap(err, errHandleQuota)
+		traces.SetAndRecordError(span, err)
+
+		return err
+	}
+
+	switch observation {
+	case NoAction:
+		return nil
+	case Updated:
+		// The quota is updated, so we can consider this
+		// sub resource Available.
+		available := xpv1.Available()
+		bb.setQuotaCondition(b.Name, backendName, &available)
+
+	case NeedsDeletion:
+		// Quotas cannot be deleted, so a disabled quota without limits is set instead.
+		if err := q.setQuota(ctx, b.Name, backendName, rgw.GenerateBucketQuota(nil)); err != nil {
+			err = errors.Wrap(err, errHandleQuota)
+			deleting := xpv1.Deleting().WithMessage(err.Error())
+			bb.setQuotaCondition(b.Name, backendName, &deleting)
+
+			traces.SetAndRecordError(span, err)
+
+			return err
+		}
+		bb.setQuotaCondition(b.Name, backendName, nil)
+
+	case NeedsUpdate:
+		if err := q.setQuota(ctx, b.Name, backendName, rgw.GenerateBucketQuota(b.Spec.ForProvider.Quota)); err != nil {
+			err = errors.Wrap(err, errHandleQuota)
+			unavailable := xpv1.Unavailable().WithMessage(err.Error())
+			bb.setQuotaCondition(b.Name, backendName, &unavailable)
+
+			traces.SetAndRecordError(span, err)
+
+			return err
+		}
+		available := xpv1.Available()
+		bb.setQuotaCondition(b.Name, backendName, &available)
+	}
+
+	return nil
+}
+
+func (q *QuotaClient) setQuota(ctx context.Context, bucketName, backendName string, quota admin.Quota) error {
+	ctx, log := traces.InjectTraceAndLogger(ctx, q.log)
+
+	log.Info("Updating quota", consts.KeyBucketName, bucketName, consts.KeyBackendName, backendName)
+	adminClient, err := q.s3ClientHandler.GetAdminClient(backendName)
+	if err != nil {
+		return err
+	}
+
+	// The Admin Ops API requires the bucket owner to set a bucket quota.
+	info, err := rgw.GetBucketInfo(ctx, adminClient, bucketName)
+	if err != nil {
+		return err
+	}
+	if info == nil {
+		return errBucketNotFoundForQuota
+	}
+
+	return rgw.SetBucketQuota(ctx, adminClient, &admin.SetBucketQuotaInput{
+		UID:    info.Owner,
+		Bucket: bucketName,
+		Quota:  quota,
+	})
+}
+
+// isQuotaInStatus returns true if the Bucket CR status reports a quota on the backend.
+func isQuotaInStatus(bucket *v1alpha1.Bucket, backendName string) bool {
+	backend, ok := bucket.Status.AtProvider.Backends[backendName]
+
+	return ok && backend != nil && backend.QuotaCondition != nil
+}
@@ -0,0 +1,602 @@
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/backendstore/backendstorefakes"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/controller/s3clienthandler"
+	"github.com/linode/provider-ceph/internal/rgw/admin"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestQuotaObserveBackend(t *testing.T) {
+	t.Parallel()
+
+	available := v1.Available()
+
+	type fields struct {
+		backendStore *backendstore.BackendStore
+	}
+
+	type args struct {
+		bucket      *v1alpha1.Bucket
+		backendName string
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		fields fields
+		args   args
+		want   want
+	}{
+		"Quota not specified in CR and never applied so Admin Ops API is not called": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					// Backends without quotas do not need the Admin Ops API,
+					// so any call is an error.
+					fake := backendstorefakes.FakeAdminClient{
+						GetBucketInfoStub: func(ctx context.Context, in *admin.GetBucketInfoInput) (*admin.Bucket, error) {
+							return nil, errExternal
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &backendstorefakes.FakeS3Client{}, nil, apisv1alpha1.HealthStatusHealthy)
+					bs.SetBackendAdminClient(consts.S3Backend1, &fake)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NoAction,
+			},
+		},
+		"Quota not specified in CR and never applied on backend without admin client": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &backendstorefakes.FakeS3Client{}, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NoAction,
+			},
+		},
+		"External error getting bucket info": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeAdminClient{
+						GetBucketInfoStub: func(ctx context.Context, in *admin.GetBucketInfoInput) (*admin.Bucket, error) {
+							return nil, errExternal
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &backendstorefakes.FakeS3Client{}, nil, apisv1alpha1.HealthStatusHealthy)
+					bs.SetBackendAdminClient(consts.S3Backend1, &fake)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							Quota: &v1alpha1.BucketQuota{MaxObjects: aws.Int64(10)},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    errExternal,
+			},
+		},
+		"Quota removed from CR but still enabled on backend so NeedsDeletion": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeAdminClient{
+						GetBucketInfoStub: func(ctx context.Context, in *admin.GetBucketInfoInput) (*admin.Bucket, error) {
+							return &admin.Bucket{
+								Bucket:      in.Bucket,
+								BucketQuota: admin.Quota{Enabled: true, MaxObjects: 10, MaxSize: admin.QuotaUnlimited},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &backendstorefakes.FakeS3Client{}, nil, apisv1alpha1.HealthStatusHealthy)
+					bs.SetBackendAdminClient(consts.S3Backend1, &fake)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Status: v1alpha1.BucketStatus{
+						AtProvider: v1alpha1.BucketObservation{
+							Backends: v1alpha1.Backends{
+								consts.S3Backend1: &v1alpha1.BackendInfo{QuotaCondition: &available},
+							},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsDeletion,
+			},
+		},
+		"Quota removed from CR and bucket no longer exists on backend so NoAction": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeAdminClient{
+						GetBucketInfoStub: func(ctx context.Context, in *admin.GetBucketInfoInput) (*admin.Bucket, error) {
+							return nil, &admin.Error{StatusCode: 404, Code: admin.CodeNoSuchBucket}
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &backendstorefakes.FakeS3Client{}, nil, apisv1alpha1.HealthStatusHealthy)
+					bs.SetBackendAdminClient(consts.S3Backend1, &fake)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Status: v1alpha1.BucketStatus{
+						AtProvider: v1alpha1.BucketObservation{
+							Backends: v1alpha1.Backends{
+								consts.S3Backend1: &v1alpha1.BackendInfo{QuotaCondition: &available},
+							},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NoAction,
+			},
+		},
+		"Quota specified in CR but bucket does not exist on backend so NeedsUpdate": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeAdminClient{
+						GetBucketInfoStub: func(ctx context.Context, in *admin.GetBucketInfoInput) (*admin.Bucket, error) {
+							return nil, &admin.Error{StatusCode: 404, Code: admin.CodeNoSuchBucket}
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &backendstorefakes.FakeS3Client{}, nil, apisv1alpha1.HealthStatusHealthy)
+					bs.SetBackendAdminClient(consts.S3Backend1, &fake)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							Quota: &v1alpha1.BucketQuota{MaxObjects: aws.Int64(10)},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"Quota specified in CR and unset limits are unlimited on backend so is Updated": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeAdminClient{
+						GetBucketInfoStub: func(ctx context.Context, in *admin.GetBucketInfoInput) (*admin.Bucket, error) {
+							return &admin.Bucket{
+								Bucket:      in.Bucket,
+								BucketQuota: admin.Quota{Enabled: true, MaxObjects: 10, MaxSize: admin.QuotaUnlimited},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &backendstorefakes.FakeS3Client{}, nil, apisv1alpha1.HealthStatusHealthy)
+					bs.SetBackendAdminClient(consts.S3Backend1, &fake)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							Quota: &v1alpha1.BucketQuota{MaxObjects: aws.Int64(10)},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"Quota specified in CR but disabled on backend so NeedsUpdate": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeAdminClient{
+						GetBucketInfoStub: func(ctx context.Context, in *admin.GetBucketInfoInput) (*admin.Bucket, error) {
+							return &admin.Bucket{
+								Bucket:      in.Bucket,
+								BucketQuota: admin.Quota{MaxObjects: 10, MaxSize: admin.QuotaUnlimited},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &backendstorefakes.FakeS3Client{}, nil, apisv1alpha1.HealthStatusHealthy)
+					bs.SetBackendAdminClient(consts.S3Backend1, &fake)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							Quota: &v1alpha1.BucketQuota{MaxObjects: aws.Int64(10)},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := NewQuotaClient(
+				tc.fields.backendStore,
+				s3clienthandler.NewHandler(
+					s3clienthandler.WithAssumeRoleArn(nil),
+					s3clienthandler.WithBackendStore(tc.fields.backendStore)),
+				logr.Discard())
+
+			got, err := c.observeBackend(context.Background(), tc.args.bucket, tc.args.backendName)
+			require.ErrorIs(t, err, tc.want.err, "unexpected error")
+			assert.Equal(t, tc.want.status, got, "unexpected status")
+		})
+	}
+}
+
+//nolint:maintidx // Function requires numerous checks.
+func TestQuotaHandle(t *testing.T) {
+	t.Parallel()
+
+	bucketName := consts.TestBucket
+	beName := consts.S3Backend1
+	available := v1.Available()
+	errUnexpectedQuota := errors.New("unexpected quota input")
+
+	type fields struct {
+		backendStore *backendstore.BackendStore
+	}
+
+	type args struct {
+		bucket      *v1alpha1.Bucket
+		backendName string
+	}
+
+	type want struct {
+		err          error
+		specificDiff func(t *testing.T, bb *bucketBackends)
+	}
+
+	cases := map[string]struct {
+		fields fields
+		args   args
+		want   want
+	}{
+		"Unhealthy backend": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &backendstorefakes.FakeS3Client{}, nil, apisv1alpha1.HealthStatusUnhealthy)
+					bs.SetBackendAdminClient(beName, &backendstorefakes.FakeAdminClient{})
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							Quota: &v1alpha1.BucketQuota{MaxObjects: aws.Int64(10)},
+						},
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				err: errUnhealthyBackend,
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					assert.Nil(t, bb.getQuotaCondition(bucketName, beName), "unexpected quota condition")
+				},
+			},
+		},
+		"Quota is set for the owner of the bucket": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeAdminClient{
+						GetBucketInfoStub: func(ctx context.Context, in *admin.GetBucketInfoInput) (*admin.Bucket, error) {
+							return &admin.Bucket{
+								Bucket:      in.Bucket,
+								Owner:       "owner",
+								BucketQuota: admin.Quota{MaxObjects: admin.QuotaUnlimited, MaxSize: admin.QuotaUnlimited},
+							}, nil
+						},
+						// The Admin Ops API requires the owner of the bucket.
+						SetBucketQuotaStub: func(ctx context.Context, in *admin.SetBucketQuotaInput) error {
+							if in.UID != "owner" || in.Bucket != bucketName ||
+								in.Quota != (admin.Quota{Enabled: true, MaxObjects: 10, MaxSize: admin.QuotaUnlimited}) {
+								return errUnexpectedQuota
+							}
+
+							return nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &backendstorefakes.FakeS3Client{}, nil, apisv1alpha1.HealthStatusHealthy)
+					bs.SetBackendAdminClient(beName, &fake)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							Quota: &v1alpha1.BucketQuota{MaxObjects: aws.Int64(10)},
+						},
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					assert.True(t, bb.getQuotaCondition(bucketName, beName).Equal(v1.Available()), "unexpected quota condition")
+				},
+			},
+		},
+		"Quota removed from CR is disabled without limits as quotas cannot be deleted": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeAdminClient{
+						GetBucketInfoStub: func(ctx context.Context, in *admin.GetBucketInfoInput) (*admin.Bucket, error) {
+							return &admin.Bucket{
+								Bucket:      in.Bucket,
+								Owner:       "owner",
+								BucketQuota: admin.Quota{Enabled: true, MaxObjects: 10, MaxSize: admin.QuotaUnlimited},
+							}, nil
+						},
+						SetBucketQuotaStub: func(ctx context.Context, in *admin.SetBucketQuotaInput) error {
+							if in.Quota != (admin.Quota{MaxObjects: admin.QuotaUnlimited, MaxSize: admin.QuotaUnlimited}) {
+								return errUnexpectedQuota
+							}
+
+							return nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &backendstorefakes.FakeS3Client{}, nil, apisv1alpha1.HealthStatusHealthy)
+					bs.SetBackendAdminClient(beName, &fake)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+					Status: v1alpha1.BucketStatus{
+						AtProvider: v1alpha1.BucketObservation{
+							Backends: v1alpha1.Backends{
+								beName: &v1alpha1.BackendInfo{QuotaCondition: &available},
+							},
+						},
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					assert.Nil(t, bb.getQuotaCondition(bucketName, beName), "quota condition should be removed")
+				},
+			},
+		},
+		"Bucket owner cannot be determined": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					calls := 0
+					fake := backendstorefakes.FakeAdminClient{
+						// The bucket is deleted between the observation and
+						// the lookup of its owner.
+						GetBucketInfoStub: func(ctx context.Context, in *admin.GetBucketInfoInput) (*admin.Bucket, error) {
+							calls++
+							if calls > 1 {
+								return nil, &admin.Error{StatusCode: 404, Code: admin.CodeNoSuchBucket}
+							}
+
+							return &admin.Bucket{Bucket: in.Bucket, Owner: "owner"}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &backendstorefakes.FakeS3Client{}, nil, apisv1alpha1.HealthStatusHealthy)
+					bs.SetBackendAdminClient(beName, &fake)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							Quota: &v1alpha1.BucketQuota{MaxObjects: aws.Int64(10)},
+						},
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				err: errBucketNotFoundForQuota,
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					condition := bb.getQuotaCondition(bucketName, beName)
+					require.NotNil(t, condition, "missing quota condition")
+					assert.Equal(t, v1.Unavailable().Reason, condition.Reason, "unexpected quota condition")
+				},
+			},
+		},
+		"Error setting quota": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeAdminClient{
+						GetBucketInfoStub: func(ctx context.Context, in *admin.GetBucketInfoInput) (*admin.Bucket, error) {
+							return &admin.Bucket{
+								Bucket:      in.Bucket,
+								Owner:       "owner",
+								BucketQuota: admin.Quota{MaxObjects: admin.QuotaUnlimited, MaxSize: admin.QuotaUnlimited},
+							}, nil
+						},
+						SetBucketQuotaStub: func(ctx context.Context, in *admin.SetBucketQuotaInput) error {
+							return errExternal
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &backendstorefakes.FakeS3Client{}, nil, apisv1alpha1.HealthStatusHealthy)
+					bs.SetBackendAdminClient(beName, &fake)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							Quota: &v1alpha1.BucketQuota{MaxObjects: aws.Int64(10)},
+						},
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				err: errExternal,
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					condition := bb.getQuotaCondition(bucketName, beName)
+					require.NotNil(t, condition, "missing quota condition")
+					assert.Equal(t, v1.Unavailable().Reason, condition.Reason, "unexpected quota condition")
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := NewQuotaClient(
+				tc.fields.backendStore,
+				s3clienthandler.NewHandler(
+					s3clienthandler.WithAssumeRoleArn(nil),
+					s3clienthandler.WithBackendStore(tc.fields.backendStore)),
+				logr.Discard())
+
+			bb := newBucketBackends()
+			err := c.Handle(context.Background(), tc.args.bucket, tc.args.backendName, bb)
+			require.ErrorIs(t, err, tc.want.err, "unexpected error")
+			if tc.want.specificDiff != nil {
+				tc.want.specificDiff(t, bb)
+			}
+		})
+	}
+}
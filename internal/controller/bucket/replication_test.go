@@ -0,0 +1,611 @@
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/backendstore/backendstorefakes"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/controller/s3clienthandler"
+	"github.com/linode/provider-ceph/internal/rgw"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+//nolint:maintidx // Function requires numerous checks.
+func TestReplicationConfigObserveBackend(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		backendStore *backendstore.BackendStore
+	}
+
+	type args struct {
+		bucket      *v1alpha1.Bucket
+		backendName string
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		fields fields
+		args   args
+		want   want
+	}{
+		"External error getting replication config": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketReplicationStub: func(ctx context.Context, in *s3.GetBucketReplicationInput, f ...func(*s3.Options)) (*s3.GetBucketReplicationOutput, error) {
+							return nil, errExternal
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							ReplicationConfiguration: &v1alpha1.ReplicationConfiguration{
+								Rules: []v1alpha1.ReplicationRule{
+									{ID: "all", Status: "Enabled", Destination: v1alpha1.ReplicationDestination{Bucket: "destination"}},
+								},
+							},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    errExternal,
+			},
+		},
+		"Replication config not specified in CR and not found on backend so NoAction": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketReplicationStub: func(ctx context.Context, in *s3.GetBucketReplicationInput, f ...func(*s3.Options)) (*s3.GetBucketReplicationOutput, error) {
+							return nil, &smithy.GenericAPIError{Code: rgw.ReplicationNotFoundErrCode}
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NoAction,
+			},
+		},
+		"Replication config not specified in CR but exists on backend so NeedsDeletion": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketReplicationStub: func(ctx context.Context, in *s3.GetBucketReplicationInput, f ...func(*s3.Options)) (*s3.GetBucketReplicationOutput, error) {
+							return &s3.GetBucketReplicationOutput{
+								ReplicationConfiguration: &s3types.ReplicationConfiguration{
+									Rules: []s3types.ReplicationRule{
+										{ID: aws.String("all"), Status: s3types.ReplicationRuleStatusEnabled, Destination: &s3types.Destination{Bucket: aws.String("arn:aws:s3:::destination")}},
+									},
+								},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsDeletion,
+			},
+		},
+		"Destination storage class differs on backend so NeedsUpdate": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketReplicationStub: func(ctx context.Context, in *s3.GetBucketReplicationInput, f ...func(*s3.Options)) (*s3.GetBucketReplicationOutput, error) {
+							return &s3.GetBucketReplicationOutput{
+								ReplicationConfiguration: &s3types.ReplicationConfiguration{
+									Rules: []s3types.ReplicationRule{
+										{
+											ID:     aws.String("all"),
+											Status: s3types.ReplicationRuleStatusEnabled,
+											Destination: &s3types.Destination{
+												Bucket:       aws.String("arn:aws:s3:::destination"),
+												StorageClass: s3types.StorageClassStandard,
+											},
+										},
+									},
+								},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							ReplicationConfiguration: &v1alpha1.ReplicationConfiguration{
+								Rules: []v1alpha1.ReplicationRule{
+									{
+										ID:     "all",
+										Status: "Enabled",
+										Destination: v1alpha1.ReplicationDestination{
+											Bucket:       "destination",
+											StorageClass: aws.String(string(s3types.StorageClassStandardIa)),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"Delete marker replication enabled in CR but not on backend so NeedsUpdate": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketReplicationStub: func(ctx context.Context, in *s3.GetBucketReplicationInput, f ...func(*s3.Options)) (*s3.GetBucketReplicationOutput, error) {
+							return &s3.GetBucketReplicationOutput{
+								ReplicationConfiguration: &s3types.ReplicationConfiguration{
+									Rules: []s3types.ReplicationRule{
+										{ID: aws.String("all"), Status: s3types.ReplicationRuleStatusEnabled, Destination: &s3types.Destination{Bucket: aws.String("arn:aws:s3:::destination")}},
+									},
+								},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							ReplicationConfiguration: &v1alpha1.ReplicationConfiguration{
+								Rules: []v1alpha1.ReplicationRule{
+									{
+										ID:                      "all",
+										Status:                  "Enabled",
+										Destination:             v1alpha1.ReplicationDestination{Bucket: "destination"},
+										DeleteMarkerReplication: &v1alpha1.DeleteMarkerReplication{Status: "Enabled"},
+									},
+								},
+							},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"Rules reported with defaults omitted and in another order are Updated": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketReplicationStub: func(ctx context.Context, in *s3.GetBucketReplicationInput, f ...func(*s3.Options)) (*s3.GetBucketReplicationOutput, error) {
+							return &s3.GetBucketReplicationOutput{
+								ReplicationConfiguration: &s3types.ReplicationConfiguration{
+									Rules: []s3types.ReplicationRule{
+										{
+											ID:     aws.String("images"),
+											Status: s3types.ReplicationRuleStatusEnabled,
+											Filter: &s3types.ReplicationRuleFilterMemberAnd{
+												Value: s3types.ReplicationRuleAndOperator{
+													Prefix: aws.String("images/"),
+													Tags: []s3types.Tag{
+														{Key: aws.String("team"), Value: aws.String("storage")},
+														{Key: aws.String("env"), Value: aws.String("prod")},
+													},
+												},
+											},
+											Destination:             &s3types.Destination{Bucket: aws.String("arn:aws:s3:::destination")},
+											DeleteMarkerReplication: &s3types.DeleteMarkerReplication{Status: s3types.DeleteMarkerReplicationStatusEnabled},
+										},
+										{
+											ID:          aws.String("all"),
+											Status:      s3types.ReplicationRuleStatusEnabled,
+											Destination: &s3types.Destination{Bucket: aws.String("destination")},
+										},
+									},
+								},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							ReplicationConfiguration: &v1alpha1.ReplicationConfiguration{
+								Rules: []v1alpha1.ReplicationRule{
+									{
+										ID:          "all",
+										Status:      "Enabled",
+										Destination: v1alpha1.ReplicationDestination{Bucket: "destination"},
+									},
+									{
+										ID:     "images",
+										Status: "Enabled",
+										Filter: &v1alpha1.ReplicationRuleFilter{
+											And: &v1alpha1.ReplicationRuleAndOperator{
+												Prefix: aws.String("images/"),
+												Tags:   []v1alpha1.Tag{{Key: "env", Value: "prod"}, {Key: "team", Value: "storage"}},
+											},
+										},
+										Destination:             v1alpha1.ReplicationDestination{Bucket: "destination"},
+										DeleteMarkerReplication: &v1alpha1.DeleteMarkerReplication{Status: "Enabled"},
+									},
+								},
+							},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := NewReplicationConfigurationClient(
+				tc.fields.backendStore,
+				s3clienthandler.NewHandler(
+					s3clienthandler.WithAssumeRoleArn(nil),
+					s3clienthandler.WithBackendStore(tc.fields.backendStore)),
+				logr.Discard())
+
+			got, err := c.observeBackend(context.Background(), tc.args.bucket, tc.args.backendName)
+			require.ErrorIs(t, err, tc.want.err, "unexpected error")
+			assert.Equal(t, tc.want.status, got, "unexpected status")
+		})
+	}
+}
+
+//nolint:maintidx // Function requires numerous checks.
+func TestReplicationConfigurationHandle(t *testing.T) {
+	t.Parallel()
+
+	bucketName := consts.TestBucket
+	beName := consts.S3Backend1
+
+	type fields struct {
+		backendStore *backendstore.BackendStore
+	}
+
+	type args struct {
+		bucket      *v1alpha1.Bucket
+		backendName string
+	}
+
+	type want struct {
+		err          error
+		specificDiff func(t *testing.T, bb *bucketBackends)
+	}
+
+	cases := map[string]struct {
+		fields fields
+		args   args
+		want   want
+	}{
+		"Unhealthy backend": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusUnhealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							ReplicationConfiguration: &v1alpha1.ReplicationConfiguration{
+								Rules: []v1alpha1.ReplicationRule{
+									{ID: "all", Status: "Enabled", Destination: v1alpha1.ReplicationDestination{Bucket: "destination"}},
+								},
+							},
+						},
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				err: errUnhealthyBackend,
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					assert.Nil(t, bb.getReplicationConfigCondition(bucketName, beName), "unexpected replication config condition")
+				},
+			},
+		},
+		"Replication config is put on backend with destination arn and default filter": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketReplicationStub: func(ctx context.Context, in *s3.GetBucketReplicationInput, f ...func(*s3.Options)) (*s3.GetBucketReplicationOutput, error) {
+							return nil, &smithy.GenericAPIError{Code: rgw.ReplicationNotFoundErrCode}
+						},
+						PutBucketReplicationStub: func(ctx context.Context, in *s3.PutBucketReplicationInput, f ...func(*s3.Options)) (*s3.PutBucketReplicationOutput, error) {
+							rules := in.ReplicationConfiguration.Rules
+							if aws.ToString(in.Bucket) != bucketName || len(rules) != 1 ||
+								aws.ToString(rules[0].Destination.Bucket) != "arn:aws:s3:::destination" ||
+								rules[0].DeleteMarkerReplication.Status != s3types.DeleteMarkerReplicationStatusDisabled {
+								return nil, errUnexpectedInput
+							}
+							if filter, ok := rules[0].Filter.(*s3types.ReplicationRuleFilterMemberPrefix); !ok || filter.Value != "" {
+								return nil, errUnexpectedInput
+							}
+
+							return &s3.PutBucketReplicationOutput{}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							ReplicationConfiguration: &v1alpha1.ReplicationConfiguration{
+								Rules: []v1alpha1.ReplicationRule{
+									{ID: "all", Status: "Enabled", Destination: v1alpha1.ReplicationDestination{Bucket: "destination"}},
+								},
+							},
+						},
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					assert.True(t, bb.getReplicationConfigCondition(bucketName, beName).Equal(v1.Available()), "unexpected replication config condition")
+				},
+			},
+		},
+		"Replication config is deleted from backend when removed from CR": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketReplicationStub: func(ctx context.Context, in *s3.GetBucketReplicationInput, f ...func(*s3.Options)) (*s3.GetBucketReplicationOutput, error) {
+							return &s3.GetBucketReplicationOutput{
+								ReplicationConfiguration: &s3types.ReplicationConfiguration{
+									Rules: []s3types.ReplicationRule{
+										{ID: aws.String("all"), Status: s3types.ReplicationRuleStatusEnabled, Destination: &s3types.Destination{Bucket: aws.String("arn:aws:s3:::destination")}},
+									},
+								},
+							}, nil
+						},
+						DeleteBucketReplicationStub: func(ctx context.Context, in *s3.DeleteBucketReplicationInput, f ...func(*s3.Options)) (*s3.DeleteBucketReplicationOutput, error) {
+							if aws.ToString(in.Bucket) != bucketName {
+								return nil, errUnexpectedInput
+							}
+
+							return &s3.DeleteBucketReplicationOutput{}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					assert.Nil(t, bb.getReplicationConfigCondition(bucketName, beName), "unexpected replication config condition")
+				},
+			},
+		},
+		"Error deleting replication config": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketReplicationStub: func(ctx context.Context, in *s3.GetBucketReplicationInput, f ...func(*s3.Options)) (*s3.GetBucketReplicationOutput, error) {
+							return &s3.GetBucketReplicationOutput{
+								ReplicationConfiguration: &s3types.ReplicationConfiguration{
+									Rules: []s3types.ReplicationRule{
+										{ID: aws.String("all"), Status: s3types.ReplicationRuleStatusEnabled, Destination: &s3types.Destination{Bucket: aws.String("arn:aws:s3:::destination")}},
+									},
+								},
+							}, nil
+						},
+						DeleteBucketReplicationStub: func(ctx context.Context, in *s3.DeleteBucketReplicationInput, f ...func(*s3.Options)) (*s3.DeleteBucketReplicationOutput, error) {
+							return nil, errExternal
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				err: errExternal,
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					condition := bb.getReplicationConfigCondition(bucketName, beName)
+					require.NotNil(t, condition, "missing replication config condition")
+					assert.Equal(t, v1.Deleting().Reason, condition.Reason, "unexpected replication config condition")
+				},
+			},
+		},
+		"Error putting replication config": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketReplicationStub: func(ctx context.Context, in *s3.GetBucketReplicationInput, f ...func(*s3.Options)) (*s3.GetBucketReplicationOutput, error) {
+							return nil, &smithy.GenericAPIError{Code: rgw.ReplicationNotFoundErrCode}
+						},
+						PutBucketReplicationStub: func(ctx context.Context, in *s3.PutBucketReplicationInput, f ...func(*s3.Options)) (*s3.PutBucketReplicationOutput, error) {
+							return nil, errExternal
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							ReplicationConfiguration: &v1alpha1.ReplicationConfiguration{
+								Rules: []v1alpha1.ReplicationRule{
+									{ID: "all", Status: "Enabled", Destination: v1alpha1.ReplicationDestination{Bucket: "destination"}},
+								},
+							},
+						},
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				err: errExternal,
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					condition := bb.getReplicationConfigCondition(bucketName, beName)
+					require.NotNil(t, condition, "missing replication config condition")
+					assert.Equal(t, v1.Unavailable().Reason, condition.Reason, "unexpected replication config condition")
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := NewReplicationConfigurationClient(
+				tc.fields.backendStore,
+				s3clienthandler.NewHandler(
+					s3clienthandler.WithAssumeRoleArn(nil),
+					s3clienthandler.WithBackendStore(tc.fields.backendStore)),
+				logr.Discard())
+
+			bb := newBucketBackends()
+			err := c.Handle(context.Background(), tc.args.bucket, tc.args.backendName, bb)
+			require.ErrorIs(t, err, tc.want.err, "unexpected error")
+			if tc.want.specificDiff != nil {
+				tc.want.specificDiff(t, bb)
+			}
+		})
+	}
+}
@@ -0,0 +1,640 @@
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/backendstore/backendstorefakes"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/controller/s3clienthandler"
+	"github.com/linode/provider-ceph/internal/rgw"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+//nolint:maintidx // Function requires numerous checks.
+func TestSSEConfigObserveBackend(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		backendStore *backendstore.BackendStore
+	}
+
+	type args struct {
+		bucket      *v1alpha1.Bucket
+		backendName string
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		fields fields
+		args   args
+		want   want
+	}{
+		"External error getting server-side encryption config": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketEncryptionStub: func(ctx context.Context, in *s3.GetBucketEncryptionInput, f ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+							return nil, errExternal
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							ServerSideEncryptionConfiguration: &v1alpha1.ServerSideEncryptionConfiguration{
+								Rules: []v1alpha1.ServerSideEncryptionRule{
+									{ApplyServerSideEncryptionByDefault: v1alpha1.ServerSideEncryptionByDefault{SSEAlgorithm: string(s3types.ServerSideEncryptionAes256)}},
+								},
+							},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    errExternal,
+			},
+		},
+		"Server-side encryption config not specified in CR and not found on backend so NoAction": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketEncryptionStub: func(ctx context.Context, in *s3.GetBucketEncryptionInput, f ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+							return nil, &smithy.GenericAPIError{Code: rgw.SSENotFoundErrCode}
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NoAction,
+			},
+		},
+		"Server-side encryption config not specified in CR but exists on backend so NeedsDeletion": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketEncryptionStub: func(ctx context.Context, in *s3.GetBucketEncryptionInput, f ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+							return &s3.GetBucketEncryptionOutput{
+								ServerSideEncryptionConfiguration: &s3types.ServerSideEncryptionConfiguration{
+									Rules: []s3types.ServerSideEncryptionRule{
+										{ApplyServerSideEncryptionByDefault: &s3types.ServerSideEncryptionByDefault{SSEAlgorithm: s3types.ServerSideEncryptionAes256}},
+									},
+								},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsDeletion,
+			},
+		},
+		"Algorithm differs on backend so NeedsUpdate": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketEncryptionStub: func(ctx context.Context, in *s3.GetBucketEncryptionInput, f ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+							return &s3.GetBucketEncryptionOutput{
+								ServerSideEncryptionConfiguration: &s3types.ServerSideEncryptionConfiguration{
+									Rules: []s3types.ServerSideEncryptionRule{
+										{ApplyServerSideEncryptionByDefault: &s3types.ServerSideEncryptionByDefault{SSEAlgorithm: s3types.ServerSideEncryptionAes256}},
+									},
+								},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							ServerSideEncryptionConfiguration: &v1alpha1.ServerSideEncryptionConfiguration{
+								Rules: []v1alpha1.ServerSideEncryptionRule{
+									{
+										ApplyServerSideEncryptionByDefault: v1alpha1.ServerSideEncryptionByDefault{
+											SSEAlgorithm:   string(s3types.ServerSideEncryptionAwsKms),
+											KMSMasterKeyID: aws.String("key"),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"KMS master key differs on backend so NeedsUpdate": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketEncryptionStub: func(ctx context.Context, in *s3.GetBucketEncryptionInput, f ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+							return &s3.GetBucketEncryptionOutput{
+								ServerSideEncryptionConfiguration: &s3types.ServerSideEncryptionConfiguration{
+									Rules: []s3types.ServerSideEncryptionRule{
+										{
+											ApplyServerSideEncryptionByDefault: &s3types.ServerSideEncryptionByDefault{
+												SSEAlgorithm:   s3types.ServerSideEncryptionAwsKms,
+												KMSMasterKeyID: aws.String("old-key"),
+											},
+										},
+									},
+								},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							ServerSideEncryptionConfiguration: &v1alpha1.ServerSideEncryptionConfiguration{
+								Rules: []v1alpha1.ServerSideEncryptionRule{
+									{
+										ApplyServerSideEncryptionByDefault: v1alpha1.ServerSideEncryptionByDefault{
+											SSEAlgorithm:   string(s3types.ServerSideEncryptionAwsKms),
+											KMSMasterKeyID: aws.String("key"),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"Bucket key enabled in CR but not on backend so NeedsUpdate": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketEncryptionStub: func(ctx context.Context, in *s3.GetBucketEncryptionInput, f ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+							return &s3.GetBucketEncryptionOutput{
+								ServerSideEncryptionConfiguration: &s3types.ServerSideEncryptionConfiguration{
+									Rules: []s3types.ServerSideEncryptionRule{
+										{
+											ApplyServerSideEncryptionByDefault: &s3types.ServerSideEncryptionByDefault{
+												SSEAlgorithm:   s3types.ServerSideEncryptionAwsKms,
+												KMSMasterKeyID: aws.String("key"),
+											},
+										},
+									},
+								},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							ServerSideEncryptionConfiguration: &v1alpha1.ServerSideEncryptionConfiguration{
+								Rules: []v1alpha1.ServerSideEncryptionRule{
+									{
+										ApplyServerSideEncryptionByDefault: v1alpha1.ServerSideEncryptionByDefault{
+											SSEAlgorithm:   string(s3types.ServerSideEncryptionAwsKms),
+											KMSMasterKeyID: aws.String("key"),
+										},
+										BucketKeyEnabled: aws.Bool(true),
+									},
+								},
+							},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"Bucket key disabled in CR and not reported by backend so is Updated": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketEncryptionStub: func(ctx context.Context, in *s3.GetBucketEncryptionInput, f ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+							return &s3.GetBucketEncryptionOutput{
+								ServerSideEncryptionConfiguration: &s3types.ServerSideEncryptionConfiguration{
+									Rules: []s3types.ServerSideEncryptionRule{
+										{
+											ApplyServerSideEncryptionByDefault: &s3types.ServerSideEncryptionByDefault{
+												SSEAlgorithm:   s3types.ServerSideEncryptionAwsKms,
+												KMSMasterKeyID: aws.String("key"),
+											},
+										},
+									},
+								},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							ServerSideEncryptionConfiguration: &v1alpha1.ServerSideEncryptionConfiguration{
+								Rules: []v1alpha1.ServerSideEncryptionRule{
+									{
+										ApplyServerSideEncryptionByDefault: v1alpha1.ServerSideEncryptionByDefault{
+											SSEAlgorithm:   string(s3types.ServerSideEncryptionAwsKms),
+											KMSMasterKeyID: aws.String("key"),
+										},
+										BucketKeyEnabled: aws.Bool(false),
+									},
+								},
+							},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := NewServerSideEncryptionConfigurationClient(
+				tc.fields.backendStore,
+				s3clienthandler.NewHandler(
+					s3clienthandler.WithAssumeRoleArn(nil),
+					s3clienthandler.WithBackendStore(tc.fields.backendStore)),
+				logr.Discard())
+
+			got, err := c.observeBackend(context.Background(), tc.args.bucket, tc.args.backendName)
+			require.ErrorIs(t, err, tc.want.err, "unexpected error")
+			assert.Equal(t, tc.want.status, got, "unexpected status")
+		})
+	}
+}
+
+//nolint:maintidx // Function requires numerous checks.
+func TestSSEConfigurationHandle(t *testing.T) {
+	t.Parallel()
+
+	bucketName := consts.TestBucket
+	beName := consts.S3Backend1
+
+	type fields struct {
+		backendStore *backendstore.BackendStore
+	}
+
+	type args struct {
+		bucket      *v1alpha1.Bucket
+		backendName string
+	}
+
+	type want struct {
+		err          error
+		specificDiff func(t *testing.T, bb *bucketBackends)
+	}
+
+	cases := map[string]struct {
+		fields fields
+		args   args
+		want   want
+	}{
+		"Unhealthy backend": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusUnhealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							ServerSideEncryptionConfiguration: &v1alpha1.ServerSideEncryptionConfiguration{
+								Rules: []v1alpha1.ServerSideEncryptionRule{
+									{ApplyServerSideEncryptionByDefault: v1alpha1.ServerSideEncryptionByDefault{SSEAlgorithm: string(s3types.ServerSideEncryptionAes256)}},
+								},
+							},
+						},
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				err: errUnhealthyBackend,
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					assert.Nil(t, bb.getSSEConfigCondition(bucketName, beName), "unexpected server-side encryption config condition")
+				},
+			},
+		},
+		"KMS server-side encryption config is put on backend": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketEncryptionStub: func(ctx context.Context, in *s3.GetBucketEncryptionInput, f ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+							return nil, &smithy.GenericAPIError{Code: rgw.SSENotFoundErrCode}
+						},
+						PutBucketEncryptionStub: func(ctx context.Context, in *s3.PutBucketEncryptionInput, f ...func(*s3.Options)) (*s3.PutBucketEncryptionOutput, error) {
+							rules := in.ServerSideEncryptionConfiguration.Rules
+							if aws.ToString(in.Bucket) != bucketName || len(rules) != 1 ||
+								rules[0].ApplyServerSideEncryptionByDefault.SSEAlgorithm != s3types.ServerSideEncryptionAwsKms ||
+								aws.ToString(rules[0].ApplyServerSideEncryptionByDefault.KMSMasterKeyID) != "key" ||
+								!aws.ToBool(rules[0].BucketKeyEnabled) {
+								return nil, errUnexpectedInput
+							}
+
+							return &s3.PutBucketEncryptionOutput{}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							ServerSideEncryptionConfiguration: &v1alpha1.ServerSideEncryptionConfiguration{
+								Rules: []v1alpha1.ServerSideEncryptionRule{
+									{
+										ApplyServerSideEncryptionByDefault: v1alpha1.ServerSideEncryptionByDefault{
+											SSEAlgorithm:   string(s3types.ServerSideEncryptionAwsKms),
+											KMSMasterKeyID: aws.String("key"),
+										},
+										BucketKeyEnabled: aws.Bool(true),
+									},
+								},
+							},
+						},
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					assert.True(t, bb.getSSEConfigCondition(bucketName, beName).Equal(v1.Available()), "unexpected server-side encryption config condition")
+				},
+			},
+		},
+		"Server-side encryption config is deleted from backend when removed from CR": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketEncryptionStub: func(ctx context.Context, in *s3.GetBucketEncryptionInput, f ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+							return &s3.GetBucketEncryptionOutput{
+								ServerSideEncryptionConfiguration: &s3types.ServerSideEncryptionConfiguration{
+									Rules: []s3types.ServerSideEncryptionRule{
+										{ApplyServerSideEncryptionByDefault: &s3types.ServerSideEncryptionByDefault{SSEAlgorithm: s3types.ServerSideEncryptionAes256}},
+									},
+								},
+							}, nil
+						},
+						DeleteBucketEncryptionStub: func(ctx context.Context, in *s3.DeleteBucketEncryptionInput, f ...func(*s3.Options)) (*s3.DeleteBucketEncryptionOutput, error) {
+							if aws.ToString(in.Bucket) != bucketName {
+								return nil, errUnexpectedInput
+							}
+
+							return &s3.DeleteBucketEncryptionOutput{}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					assert.Nil(t, bb.getSSEConfigCondition(bucketName, beName), "unexpected server-side encryption config condition")
+				},
+			},
+		},
+		"Error deleting server-side encryption config": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketEncryptionStub: func(ctx context.Context, in *s3.GetBucketEncryptionInput, f ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+							return &s3.GetBucketEncryptionOutput{
+								ServerSideEncryptionConfiguration: &s3types.ServerSideEncryptionConfiguration{
+									Rules: []s3types.ServerSideEncryptionRule{
+										{ApplyServerSideEncryptionByDefault: &s3types.ServerSideEncryptionByDefault{SSEAlgorithm: s3types.ServerSideEncryptionAes256}},
+									},
+								},
+							}, nil
+						},
+						DeleteBucketEncryptionStub: func(ctx context.Context, in *s3.DeleteBucketEncryptionInput, f ...func(*s3.Options)) (*s3.DeleteBucketEncryptionOutput, error) {
+							return nil, errExternal
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				err: errExternal,
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					condition := bb.getSSEConfigCondition(bucketName, beName)
+					require.NotNil(t, condition, "missing server-side encryption config condition")
+					assert.Equal(t, v1.Deleting().Reason, condition.Reason, "unexpected server-side encryption config condition")
+				},
+			},
+		},
+		"Error putting server-side encryption config": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketEncryptionStub: func(ctx context.Context, in *s3.GetBucketEncryptionInput, f ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+							return nil, &smithy.GenericAPIError{Code: rgw.SSENotFoundErrCode}
+						},
+						PutBucketEncryptionStub: func(ctx context.Context, in *s3.PutBucketEncryptionInput, f ...func(*s3.Options)) (*s3.PutBucketEncryptionOutput, error) {
+							return nil, errExternal
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							ServerSideEncryptionConfiguration: &v1alpha1.ServerSideEncryptionConfiguration{
+								Rules: []v1alpha1.ServerSideEncryptionRule{
+									{ApplyServerSideEncryptionByDefault: v1alpha1.ServerSideEncryptionByDefault{SSEAlgorithm: string(s3types.ServerSideEncryptionAes256)}},
+								},
+							},
+						},
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				err: errExternal,
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					condition := bb.getSSEConfigCondition(bucketName, beName)
+					require.NotNil(t, condition, "missing server-side encryption config condition")
+					assert.Equal(t, v1.Unavailable().Reason, condition.Reason, "unexpected server-side encryption config condition")
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := NewServerSideEncryptionConfigurationClient(
+				tc.fields.backendStore,
+				s3clienthandler.NewHandler(
+					s3clienthandler.WithAssumeRoleArn(nil),
+					s3clienthandler.WithBackendStore(tc.fields.backendStore)),
+				logr.Discard())
+
+			bb := newBucketBackends()
+			err := c.Handle(context.Background(), tc.args.bucket, tc.args.backendName, bb)
+			require.ErrorIs(t, err, tc.want.err, "unexpected error")
+			if tc.want.specificDiff != nil {
+				tc.want.specificDiff(t, bb)
+			}
+		})
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/statemetrics"
 
+	nsv1alpha1 "github.com/linode/provider-ceph/apis/namespaced/provider-ceph/v1alpha1"
 	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
 	"github.com/linode/provider-ceph/internal/features"
 )
@@ -47,6 +48,12 @@ func SetupGated(mgr ctrl.Manager, o controller.Options, c *Connector) error {
 		}
 	}, v1alpha1.BucketGroupVersionKind)
 
+	o.Gate.Register(func() {
+		if err := SetupNamespaced(mgr, o, c); err != nil {
+			panic(err)
+		}
+	}, nsv1alpha1.BucketGroupVersionKind)
+
 	return nil
 }
 
@@ -54,6 +61,42 @@ func SetupGated(mgr ctrl.Manager, o controller.Options, c *Connector) error {
 func Setup(mgr ctrl.Manager, o controller.Options, c *Connector) error {
 	name := managed.ControllerName(v1alpha1.BucketGroupKind)
 
+	if err := mgr.Add(statemetrics.NewMRStateRecorder(
+		mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.BucketList{}, o.MetricOptions.PollStateMetricInterval)); err != nil {
+		return err
+	}
+
+	r := managed.NewReconciler(mgr, resource.ManagedKind(v1alpha1.BucketGroupVersionKind), reconcilerOptions(mgr, o, c, name)...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.Bucket{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// SetupNamespaced adds a controller that reconciles namespaced Bucket managed resources.
+func SetupNamespaced(mgr ctrl.Manager, o controller.Options, c *Connector) error {
+	name := managed.ControllerName(nsv1alpha1.BucketGroupKind)
+
+	if err := mgr.Add(statemetrics.NewMRStateRecorder(
+		mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &nsv1alpha1.BucketList{}, o.MetricOptions.PollStateMetricInterval)); err != nil {
+		return err
+	}
+
+	r := managed.NewReconciler(mgr, resource.ManagedKind(nsv1alpha1.BucketGroupVersionKind), reconcilerOptions(mgr, o, c, name)...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&nsv1alpha1.Bucket{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// reconcilerOptions returns the options of the managed reconcilers of both Bucket scopes.
+func reconcilerOptions(mgr ctrl.Manager, o controller.Options, c *Connector, name string) []managed.ReconcilerOption {
 	opts := []managed.ReconcilerOption{
 		managed.WithCriticalAnnotationUpdater(managed.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
 		managed.WithTimeout(c.operationTimeout + time.Second),
@@ -69,17 +112,5 @@ func Setup(mgr ctrl.Manager, o controller.Options, c *Connector) error {
 		opts = append(opts, managed.WithManagementPolicies())
 	}
 
-	if err := mgr.Add(statemetrics.NewMRStateRecorder(
-		mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.BucketList{}, o.MetricOptions.PollStateMetricInterval)); err != nil {
-		return err
-	}
-
-	r := managed.NewReconciler(mgr, resource.ManagedKind(v1alpha1.BucketGroupVersionKind), opts...)
-
-	return ctrl.NewControllerManagedBy(mgr).
-		Named(name).
-		WithOptions(o.ForControllerRuntime()).
-		WithEventFilter(resource.DesiredStateChanged()).
-		For(&v1alpha1.Bucket{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+	return opts
 }
@@ -51,6 +51,36 @@ func NewSubresourceClients(b *backendstore.BackendStore, h *s3clienthandler.Hand
 	if !config.ObjectLockConfigurationClientDisabled {
 		subresourceClients = append(subresourceClients, NewObjectLockConfigurationClient(b, h, l.WithValues("object-lock-configuration-client", managed.ControllerName(v1alpha1.BucketGroupKind))))
 	}
+	if !config.QuotaClientDisabled {
+		subresourceClients = append(subresourceClients, NewQuotaClient(b, h, l.WithValues("quota-client", managed.ControllerName(v1alpha1.BucketGroupKind))))
+	}
+	if !config.CORSConfigurationClientDisabled {
+		subresourceClients = append(subresourceClients, NewCORSConfigurationClient(b, h, l.WithValues("cors-configuration-client", managed.ControllerName(v1alpha1.BucketGroupKind))))
+	}
+	if !config.TaggingClientDisabled {
+		subresourceClients = append(subresourceClients, NewTaggingClient(b, h, config.TagLabelPrefix, l.WithValues("tagging-client", managed.ControllerName(v1alpha1.BucketGroupKind))))
+	}
+	if !config.ServerSideEncryptionConfigurationClientDisabled {
+		subresourceClients = append(subresourceClients, NewServerSideEncryptionConfigurationClient(b, h, l.WithValues("server-side-encryption-configuration-client", managed.ControllerName(v1alpha1.BucketGroupKind))))
+	}
+	if !config.NotificationConfigurationClientDisabled {
+		subresourceClients = append(subresourceClients, NewNotificationConfigurationClient(b, h, l.WithValues("notification-configuration-client", managed.ControllerName(v1alpha1.BucketGroupKind))))
+	}
+	if !config.ReplicationConfigurationClientDisabled {
+		subresourceClients = append(subresourceClients, NewReplicationConfigurationClient(b, h, l.WithValues("replication-configuration-client", managed.ControllerName(v1alpha1.BucketGroupKind))))
+	}
+	if !config.WebsiteConfigurationClientDisabled {
+		subresourceClients = append(subresourceClients, NewWebsiteConfigurationClient(b, h, l.WithValues("website-configuration-client", managed.ControllerName(v1alpha1.BucketGroupKind))))
+	}
+	if !config.OwnershipControlsClientDisabled {
+		subresourceClients = append(subresourceClients, NewOwnershipControlsClient(b, h, l.WithValues("ownership-controls-client", managed.ControllerName(v1alpha1.BucketGroupKind))))
+	}
+	if !config.PublicAccessBlockClientDisabled {
+		subresourceClients = append(subresourceClients, NewPublicAccessBlockClient(b, h, config.DefaultPublicAccessBlock, l.WithValues("public-access-block-client", managed.ControllerName(v1alpha1.BucketGroupKind))))
+	}
+	if !config.LoggingConfigurationClientDisabled {
+		subresourceClients = append(subresourceClients, NewLoggingConfigurationClient(b, h, l.WithValues("logging-configuration-client", managed.ControllerName(v1alpha1.BucketGroupKind))))
+	}
 
 	return subresourceClients
 }
@@ -70,9 +100,25 @@ const (
 )
 
 type SubresourceClientConfig struct {
-	LifecycleConfigurationClientDisabled  bool
-	ACLClientDisabled                     bool
-	PolicyClientDisabled                  bool
-	VersioningConfigurationClientDisabled bool
-	ObjectLockConfigurationClientDisabled bool
+	LifecycleConfigurationClientDisabled            bool
+	ACLClientDisabled                               bool
+	PolicyClientDisabled                            bool
+	VersioningConfigurationClientDisabled           bool
+	ObjectLockConfigurationClientDisabled           bool
+	QuotaClientDisabled                             bool
+	CORSConfigurationClientDisabled                 bool
+	TaggingClientDisabled                           bool
+	ServerSideEncryptionConfigurationClientDisabled bool
+	NotificationConfigurationClientDisabled         bool
+	ReplicationConfigurationClientDisabled          bool
+	WebsiteConfigurationClientDisabled              bool
+	OwnershipControlsClientDisabled                 bool
+	PublicAccessBlockClientDisabled                 bool
+	LoggingConfigurationClientDisabled              bool
+	// TagLabelPrefix is the prefix of the keys of the Bucket labels which are
+	// merged into the tags of the bucket. Labels are not merged when empty.
+	TagLabelPrefix string
+	// DefaultPublicAccessBlock specifies whether all public access is blocked
+	// for Buckets which do not specify a public access block configuration.
+	DefaultPublicAccessBlock bool
 }
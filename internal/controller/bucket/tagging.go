@@ -0,0 +1,218 @@
+package bucket
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go/document"
+	"github.com/go-logr/logr"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/controller/s3clienthandler"
+	"github.com/linode/provider-ceph/internal/otel/traces"
+	"github.com/linode/provider-ceph/internal/rgw"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"go.opentelemetry.io/otel"
+)
+
+// TaggingClient is the client for API methods and reconciling the tags of a bucket.
+type TaggingClient struct {
+	backendStore    *backendstore.BackendStore
+	s3ClientHandler *s3clienthandler.Handler
+	// labelPrefix is the prefix of the keys of the Bucket labels which are
+	// merged into the tags. Labels are ignored when it is empty.
+	labelPrefix string
+	log         logr.Logger
+}
+
+func NewTaggingClient(b *backendstore.BackendStore, h *s3clienthandler.Handler, labelPrefix string, l logr.Logger) *TaggingClient {
+	return &TaggingClient{backendStore: b, s3ClientHandler: h, labelPrefix: labelPrefix, log: l}
+}
+
+//nolint:dupl // Tagging and LifecycleConfiguration are different feature.
+func (t *TaggingClient) Observe(ctx context.Context, bucket *v1alpha1.Bucket, backendNames []string) (ResourceStatus, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "bucket.TaggingClient.Observe")
+	defer span.End()
+	ctx, log := traces.InjectTraceAndLogger(ctx, t.log)
+
+	observationChan := make(chan ResourceStatus)
+	errChan := make(chan error)
+
+	for _, backendName := range backendNames {
+		beName := backendName
+		go func() {
+			if t.backendStore.GetBackendHealthStatus(beName) == apisv1alpha1.HealthStatusUnhealthy {
+				// If a backend is marked as unhealthy, we can ignore it for now by returning NoAction.
+				// The backend may be down for some time and we do not want to block Create/Update/Delete
+				// calls on other backends. By returning NoAction here, we would never pass the Observe
+				// phase until the backend becomes Healthy or Disabled.
+				observationChan <- NoAction
+
+				return
+			}
+
+			observation, err := t.observeBackend(ctx, bucket, beName)
+			if err != nil {
+				errChan <- err
+
+				return
+			}
+			observationChan <- observation
+		}()
+	}
+
+	for i := 0; i < len(backendNames); i++ {
+		select {
+		case <-ctx.Done():
+			log.Info("Context timeout during bucket tagging observation", consts.KeyBucketName, bucket.Name)
+			err := errors.Wrap(ctx.Err(), errObserveTagging)
+			traces.SetAndRecordError(span, err)
+
+			return NeedsUpdate, err
+		case observation := <-observationChan:
+			if observation == NeedsUpdate || observation == NeedsDeletion {
+				return observation, nil
+			}
+		case err := <-errChan:
+			err = errors.Wrap(err, errObserveTagging)
+			traces.SetAndRecordError(span, err)
+
+			return NeedsUpdate, err
+		}
+	}
+
+	return Updated, nil
+}
+
+func (t *TaggingClient) observeBackend(ctx context.Context, bucket *v1alpha1.Bucket, backendName string) (ResourceStatus, error) {
+	ctx, log := traces.InjectTraceAndLogger(ctx, t.log)
+
+	log.V(1).Info("Observing subresource tagging on backend", consts.KeyBucketName, bucket.Name, consts.KeyBackendName, backendName)
+
+	s3Client, err := t.s3ClientHandler.GetS3Client(ctx, bucket, backendName)
+	if err != nil {
+		return NeedsUpdate, err
+	}
+	response, err := rgw.GetBucketTagging(ctx, s3Client, aws.String(bucket.Name))
+	if err != nil {
+		return NeedsUpdate, err
+	}
+
+	var external []s3types.Tag
+	if response != nil {
+		external = rgw.SortTagSet(response.TagSet)
+	}
+
+	local := rgw.GenerateBucketTags(bucket, t.labelPrefix)
+	if len(local) == 0 {
+		// No tags are specified, so none should exist on any backend.
+		if len(external) == 0 {
+			log.V(1).Info("No tags found on backend - no action required", consts.KeyBucketName, bucket.Name, consts.KeyBackendName, backendName)
+
+			return NoAction, nil
+		}
+		log.V(1).Info("Tags found on backend - requires deletion", consts.KeyBucketName, bucket.Name, consts.KeyBackendName, backendName)
+
+		return NeedsDeletion, nil
+	}
+
+	if !cmp.Equal(external, local, cmpopts.IgnoreTypes(document.NoSerde{})) {
+		log.V(1).Info("Tags require update on backend", consts.KeyBucketName, bucket.Name, consts.KeyBackendName, backendName)
+
+		return NeedsUpdate, nil
+	}
+
+	return Updated, nil
+}
+
+func (t *TaggingClient) Handle(ctx context.Context, b *v1alpha1.Bucket, backendName string, bb *bucketBackends) error {
+	ctx, span := otel.Tracer("").Start(ctx, "bucket.TaggingClient.Handle")
+	defer span.End()
+
+	if t.backendStore.GetBackendHealthStatus(backendName) == apisv1alpha1.HealthStatusUnhealthy {
+		traces.SetAndRecordError(span, errUnhealthyBackend)
+
+		return errUnhealthyBackend
+	}
+
+	observation, err := t.observeBackend(ctx, b, backendName)
+	if err != nil {
+		err = errors.Wrap(err, errHandleTagging)
+		traces.SetAndRecordError(span, err)
+
+		return err
+	}
+
+	switch observation {
+	case NoAction:
+		return nil
+	case Updated:
+		// The tags are up to date, so we can consider this
+		// sub resource Available.
+		available := xpv1.Available()
+		bb.setTaggingCondition(b.Name, backendName, &available)
+
+	case NeedsDeletion:
+		if err := t.delete(ctx, b, backendName); err != nil {
+			err = errors.Wrap(err, errHandleTagging)
+			deleting := xpv1.Deleting().WithMessage(err.Error())
+			bb.setTaggingCondition(b.Name, backendName, &deleting)
+
+			traces.SetAndRecordError(span, err)
+
+			return err
+		}
+		bb.setTaggingCondition(b.Name, backendName, nil)
+
+	case NeedsUpdate:
+		if err := t.createOrUpdate(ctx, b, backendName); err != nil {
+			err = errors.Wrap(err, errHandleTagging)
+			unavailable := xpv1.Unavailable().WithMessage(err.Error())
+			bb.setTaggingCondition(b.Name, backendName, &unavailable)
+
+			traces.SetAndRecordError(span, err)
+
+			return err
+		}
+		available := xpv1.Available()
+		bb.setTaggingCondition(b.Name, backendName, &available)
+	}
+
+	return nil
+}
+
+func (t *TaggingClient) createOrUpdate(ctx context.Context, b *v1alpha1.Bucket, backendName string) error {
+	ctx, log := traces.InjectTraceAndLogger(ctx, t.log)
+
+	log.Info("Updating tags", consts.KeyBucketName, b.Name, consts.KeyBackendName, backendName)
+	s3Client, err := t.s3ClientHandler.GetS3Client(ctx, b, backendName)
+	if err != nil {
+		return err
+	}
+
+	_, err = rgw.PutBucketTagging(ctx, s3Client, aws.String(b.Name), rgw.GenerateBucketTags(b, t.labelPrefix))
+
+	return err
+}
+
+func (t *TaggingClient) delete(ctx context.Context, b *v1alpha1.Bucket, backendName string) error {
+	ctx, log := traces.InjectTraceAndLogger(ctx, t.log)
+
+	log.Info("Deleting tags", consts.KeyBucketName, b.Name, consts.KeyBackendName, backendName)
+	s3Client, err := t.s3ClientHandler.GetS3Client(ctx, b, backendName)
+	if err != nil {
+		return err
+	}
+
+	return rgw.DeleteBucketTagging(ctx, s3Client, aws.String(b.Name))
+}
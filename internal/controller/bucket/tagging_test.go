@@ -0,0 +1,541 @@
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/backendstore/backendstorefakes"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/controller/s3clienthandler"
+	"github.com/linode/provider-ceph/internal/rgw"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const testTagLabelPrefix = "tags.example.com/"
+
+//nolint:maintidx // Function requires numerous checks.
+func TestTaggingObserveBackend(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		backendStore *backendstore.BackendStore
+	}
+
+	type args struct {
+		bucket      *v1alpha1.Bucket
+		backendName string
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		fields fields
+		args   args
+		want   want
+	}{
+		"External error getting tags": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketTaggingStub: func(ctx context.Context, in *s3.GetBucketTaggingInput, f ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error) {
+							return nil, errExternal
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							Tags: []v1alpha1.Tag{{Key: "team", Value: "storage"}},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    errExternal,
+			},
+		},
+		"No tags specified and NoSuchTagSet on backend so NoAction": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketTaggingStub: func(ctx context.Context, in *s3.GetBucketTaggingInput, f ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error) {
+							return nil, &smithy.GenericAPIError{Code: rgw.NoSuchTagSetErrCode}
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NoAction,
+			},
+		},
+		"Labels without the tag prefix are ignored and tags exist on backend so NeedsDeletion": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketTaggingStub: func(ctx context.Context, in *s3.GetBucketTaggingInput, f ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error) {
+							return &s3.GetBucketTaggingOutput{
+								TagSet: []s3types.Tag{
+									{Key: aws.String("team"), Value: aws.String("storage")},
+								},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+						Labels: map[string]string{
+							"team":                     "storage",
+							testTagLabelPrefix:         "empty-key",
+							"other.example.com/region": "eu",
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsDeletion,
+			},
+		},
+		"Tag value differs on backend so NeedsUpdate": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketTaggingStub: func(ctx context.Context, in *s3.GetBucketTaggingInput, f ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error) {
+							return &s3.GetBucketTaggingOutput{
+								TagSet: []s3types.Tag{
+									{Key: aws.String("team"), Value: aws.String("platform")},
+								},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							Tags: []v1alpha1.Tag{{Key: "team", Value: "storage"}},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"Tag from prefixed label missing on backend so NeedsUpdate": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketTaggingStub: func(ctx context.Context, in *s3.GetBucketTaggingInput, f ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error) {
+							return &s3.GetBucketTaggingOutput{
+								TagSet: []s3types.Tag{
+									{Key: aws.String("team"), Value: aws.String("storage")},
+								},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+						Labels: map[string]string{
+							testTagLabelPrefix + "environment": "production",
+						},
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							Tags: []v1alpha1.Tag{{Key: "team", Value: "storage"}},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"Tags from spec and labels are up to date regardless of order": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketTaggingStub: func(ctx context.Context, in *s3.GetBucketTaggingInput, f ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error) {
+							return &s3.GetBucketTaggingOutput{
+								TagSet: []s3types.Tag{
+									{Key: aws.String("team"), Value: aws.String("storage")},
+									{Key: aws.String("environment"), Value: aws.String("production")},
+									{Key: aws.String("cost-center"), Value: aws.String("42")},
+								},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+						Labels: map[string]string{
+							testTagLabelPrefix + "environment": "production",
+						},
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							Tags: []v1alpha1.Tag{
+								{Key: "team", Value: "storage"},
+								{Key: "cost-center", Value: "42"},
+							},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := NewTaggingClient(
+				tc.fields.backendStore,
+				s3clienthandler.NewHandler(
+					s3clienthandler.WithAssumeRoleArn(nil),
+					s3clienthandler.WithBackendStore(tc.fields.backendStore)),
+				testTagLabelPrefix,
+				logr.Discard())
+
+			got, err := c.observeBackend(context.Background(), tc.args.bucket, tc.args.backendName)
+			require.ErrorIs(t, err, tc.want.err, "unexpected error")
+			assert.Equal(t, tc.want.status, got, "unexpected status")
+		})
+	}
+}
+
+//nolint:maintidx // Function requires numerous checks.
+func TestTaggingHandle(t *testing.T) {
+	t.Parallel()
+
+	bucketName := consts.TestBucket
+	beName := consts.S3Backend1
+
+	type fields struct {
+		backendStore *backendstore.BackendStore
+	}
+
+	type args struct {
+		bucket      *v1alpha1.Bucket
+		backendName string
+	}
+
+	type want struct {
+		err          error
+		specificDiff func(t *testing.T, bb *bucketBackends)
+	}
+
+	cases := map[string]struct {
+		fields fields
+		args   args
+		want   want
+	}{
+		"Unhealthy backend": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusUnhealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							Tags: []v1alpha1.Tag{{Key: "team", Value: "storage"}},
+						},
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				err: errUnhealthyBackend,
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					assert.Nil(t, bb.getTaggingCondition(bucketName, beName), "unexpected tagging condition")
+				},
+			},
+		},
+		"Spec tags take precedence over labels when put on backend": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketTaggingStub: func(ctx context.Context, in *s3.GetBucketTaggingInput, f ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error) {
+							return nil, &smithy.GenericAPIError{Code: rgw.NoSuchTagSetErrCode}
+						},
+						PutBucketTaggingStub: func(ctx context.Context, in *s3.PutBucketTaggingInput, f ...func(*s3.Options)) (*s3.PutBucketTaggingOutput, error) {
+							want := []s3types.Tag{
+								{Key: aws.String("environment"), Value: aws.String("production")},
+								{Key: aws.String("team"), Value: aws.String("storage")},
+							}
+							if aws.ToString(in.Bucket) != bucketName || !cmp.Equal(in.Tagging.TagSet, want, cmpopts.IgnoreUnexported(s3types.Tag{})) {
+								return nil, errUnexpectedInput
+							}
+
+							return &s3.PutBucketTaggingOutput{}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+						Labels: map[string]string{
+							testTagLabelPrefix + "team":        "platform",
+							testTagLabelPrefix + "environment": "production",
+						},
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							Tags: []v1alpha1.Tag{{Key: "team", Value: "storage"}},
+						},
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					assert.True(t, bb.getTaggingCondition(bucketName, beName).Equal(v1.Available()), "unexpected tagging condition")
+				},
+			},
+		},
+		"Tags are deleted from backend when removed from CR": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketTaggingStub: func(ctx context.Context, in *s3.GetBucketTaggingInput, f ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error) {
+							return &s3.GetBucketTaggingOutput{
+								TagSet: []s3types.Tag{
+									{Key: aws.String("team"), Value: aws.String("storage")},
+								},
+							}, nil
+						},
+						DeleteBucketTaggingStub: func(ctx context.Context, in *s3.DeleteBucketTaggingInput, f ...func(*s3.Options)) (*s3.DeleteBucketTaggingOutput, error) {
+							if aws.ToString(in.Bucket) != bucketName {
+								return nil, errUnexpectedInput
+							}
+
+							return &s3.DeleteBucketTaggingOutput{}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					assert.Nil(t, bb.getTaggingCondition(bucketName, beName), "unexpected tagging condition")
+				},
+			},
+		},
+		"Error deleting tags": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketTaggingStub: func(ctx context.Context, in *s3.GetBucketTaggingInput, f ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error) {
+							return &s3.GetBucketTaggingOutput{
+								TagSet: []s3types.Tag{
+									{Key: aws.String("team"), Value: aws.String("storage")},
+								},
+							}, nil
+						},
+						DeleteBucketTaggingStub: func(ctx context.Context, in *s3.DeleteBucketTaggingInput, f ...func(*s3.Options)) (*s3.DeleteBucketTaggingOutput, error) {
+							return nil, errExternal
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				err: errExternal,
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					condition := bb.getTaggingCondition(bucketName, beName)
+					require.NotNil(t, condition, "missing tagging condition")
+					assert.Equal(t, v1.Deleting().Reason, condition.Reason, "unexpected tagging condition")
+				},
+			},
+		},
+		"Error putting tags": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketTaggingStub: func(ctx context.Context, in *s3.GetBucketTaggingInput, f ...func(*s3.Options)) (*s3.GetBucketTaggingOutput, error) {
+							return nil, &smithy.GenericAPIError{Code: rgw.NoSuchTagSetErrCode}
+						},
+						PutBucketTaggingStub: func(ctx context.Context, in *s3.PutBucketTaggingInput, f ...func(*s3.Options)) (*s3.PutBucketTaggingOutput, error) {
+							return nil, errExternal
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							Tags: []v1alpha1.Tag{{Key: "team", Value: "storage"}},
+						},
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				err: errExternal,
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					condition := bb.getTaggingCondition(bucketName, beName)
+					require.NotNil(t, condition, "missing tagging condition")
+					assert.Equal(t, v1.Unavailable().Reason, condition.Reason, "unexpected tagging condition")
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := NewTaggingClient(
+				tc.fields.backendStore,
+				s3clienthandler.NewHandler(
+					s3clienthandler.WithAssumeRoleArn(nil),
+					s3clienthandler.WithBackendStore(tc.fields.backendStore)),
+				testTagLabelPrefix,
+				logr.Discard())
+
+			bb := newBucketBackends()
+			err := c.Handle(context.Background(), tc.args.bucket, tc.args.backendName, bb)
+			require.ErrorIs(t, err, tc.want.err, "unexpected error")
+			if tc.want.specificDiff != nil {
+				tc.want.specificDiff(t, bb)
+			}
+		})
+	}
+}
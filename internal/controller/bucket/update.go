@@ -48,8 +48,9 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, err
 	}
 
-	// allBackendNames is a list of the names of all backends from backend store.
-	allBackendNames := c.backendStore.GetAllBackendNames()
+	// allBackendNames is a list of the names of all backends from backend store
+	// which may be used by the bucket.
+	allBackendNames := c.getBucketBackendNames(bucket)
 	if len(allBackendNames) == 0 {
 		err := errors.New(errNoS3BackendsStored)
 		traces.SetAndRecordError(span, err)
@@ -57,10 +58,23 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, err
 	}
 
+	// Choose the backends of the bucket if it is placed by its placement policy
+	// and is not yet placed on enough backends.
+	if err := c.placeBucket(ctx, bucket, allBackendNames); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	// Add or remove failover replicas of the bucket for backends which have
+	// been unhealthy for longer than the failover period.
+	if err := c.failoverBucket(ctx, bucket, allBackendNames); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
 	// backendsToUpdateOnNames is a list of names of all backends on which this S3 bucket
 	// is to be updated. This will either be:
 	// 1. The list of bucket.Spec.Providers, if specified.
-	// 2. Otherwise, the allBackendNames list.
+	// 2. The backends chosen by bucket.Spec.Placement, if specified.
+	// 3. Otherwise, the allBackendNames list.
 	// In either case, the list will exclude any backends which have been specified as
 	// disabled on the Bucket CR. A backend is specified as disabled for a given bucket
 	// if it has been given the backend label (eg 'provider-ceph.backends.backend-a: "false"').
@@ -170,7 +184,8 @@ func (c *external) updateOnBackend(ctx context.Context, beName string, bucket *v
 			return err
 		}
 		if !bucketExists {
-			if !c.recreateMissingBucket {
+			// Failover replicas are always created, regardless of recreateMissingBucket.
+			if _, ok := bucket.Status.AtProvider.FailoverBackends[beName]; !ok && !c.recreateMissingBucket {
 				bb.deleteBackend(bucket.Name, beName)
 
 				return nil
@@ -6,6 +6,7 @@ import (
 	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
@@ -545,6 +546,7 @@ func TestUpdate(t *testing.T) {
 				minReplicas:        1,
 				log:                logr.Discard(),
 				subresourceClients: NewSubresourceClients(tc.fields.backendStore, s3ClientHandler, SubresourceClientConfig{}, logr.Discard()),
+				operationTimeout:   time.Second * 5,
 			}
 
 			got, err := e.Update(context.Background(), tc.args.mg)
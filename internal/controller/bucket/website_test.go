@@ -0,0 +1,573 @@
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/backendstore/backendstorefakes"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/controller/s3clienthandler"
+	"github.com/linode/provider-ceph/internal/rgw"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+//nolint:maintidx // Function requires numerous checks.
+func TestWebsiteConfigObserveBackend(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		backendStore *backendstore.BackendStore
+	}
+
+	type args struct {
+		bucket      *v1alpha1.Bucket
+		backendName string
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		fields fields
+		args   args
+		want   want
+	}{
+		"External error getting website config": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketWebsiteStub: func(ctx context.Context, in *s3.GetBucketWebsiteInput, f ...func(*s3.Options)) (*s3.GetBucketWebsiteOutput, error) {
+							return nil, errExternal
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							WebsiteConfiguration: &v1alpha1.WebsiteConfiguration{
+								IndexDocument: &v1alpha1.IndexDocument{Suffix: "index.html"},
+							},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    errExternal,
+			},
+		},
+		"Website config not specified in CR and NoSuchWebsiteConfiguration on backend so NoAction": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketWebsiteStub: func(ctx context.Context, in *s3.GetBucketWebsiteInput, f ...func(*s3.Options)) (*s3.GetBucketWebsiteOutput, error) {
+							return nil, &smithy.GenericAPIError{Code: rgw.WebsiteNotFoundErrCode}
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NoAction,
+			},
+		},
+		"Website config not specified in CR and empty on backend so NoAction": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketWebsiteStub: func(ctx context.Context, in *s3.GetBucketWebsiteInput, f ...func(*s3.Options)) (*s3.GetBucketWebsiteOutput, error) {
+							return &s3.GetBucketWebsiteOutput{RoutingRules: []s3types.RoutingRule{}}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NoAction,
+			},
+		},
+		"Website config not specified in CR but redirect exists on backend so NeedsDeletion": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketWebsiteStub: func(ctx context.Context, in *s3.GetBucketWebsiteInput, f ...func(*s3.Options)) (*s3.GetBucketWebsiteOutput, error) {
+							return &s3.GetBucketWebsiteOutput{
+								RedirectAllRequestsTo: &s3types.RedirectAllRequestsTo{HostName: aws.String("example.com")},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsDeletion,
+			},
+		},
+		"Routing rule missing on backend so NeedsUpdate": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketWebsiteStub: func(ctx context.Context, in *s3.GetBucketWebsiteInput, f ...func(*s3.Options)) (*s3.GetBucketWebsiteOutput, error) {
+							return &s3.GetBucketWebsiteOutput{
+								IndexDocument: &s3types.IndexDocument{Suffix: aws.String("index.html")},
+								ErrorDocument: &s3types.ErrorDocument{Key: aws.String("error.html")},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							WebsiteConfiguration: &v1alpha1.WebsiteConfiguration{
+								IndexDocument: &v1alpha1.IndexDocument{Suffix: "index.html"},
+								ErrorDocument: &v1alpha1.ErrorDocument{Key: "error.html"},
+								RoutingRules: []v1alpha1.RoutingRule{
+									{
+										Condition: &v1alpha1.RoutingRuleCondition{KeyPrefixEquals: aws.String("docs/")},
+										Redirect:  v1alpha1.RoutingRuleRedirect{ReplaceKeyPrefixWith: aws.String("documents/")},
+									},
+								},
+							},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"Redirect protocol differs on backend so NeedsUpdate": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketWebsiteStub: func(ctx context.Context, in *s3.GetBucketWebsiteInput, f ...func(*s3.Options)) (*s3.GetBucketWebsiteOutput, error) {
+							return &s3.GetBucketWebsiteOutput{
+								RedirectAllRequestsTo: &s3types.RedirectAllRequestsTo{
+									HostName: aws.String("example.com"),
+									Protocol: s3types.ProtocolHttp,
+								},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							WebsiteConfiguration: &v1alpha1.WebsiteConfiguration{
+								RedirectAllRequestsTo: &v1alpha1.RedirectAllRequestsTo{
+									HostName: "example.com",
+									Protocol: aws.String(string(s3types.ProtocolHttps)),
+								},
+							},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"Documents and routing rules are up to date": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketWebsiteStub: func(ctx context.Context, in *s3.GetBucketWebsiteInput, f ...func(*s3.Options)) (*s3.GetBucketWebsiteOutput, error) {
+							return &s3.GetBucketWebsiteOutput{
+								IndexDocument: &s3types.IndexDocument{Suffix: aws.String("index.html")},
+								ErrorDocument: &s3types.ErrorDocument{Key: aws.String("error.html")},
+								RoutingRules: []s3types.RoutingRule{
+									{
+										Condition: &s3types.Condition{KeyPrefixEquals: aws.String("docs/")},
+										Redirect:  &s3types.Redirect{ReplaceKeyPrefixWith: aws.String("documents/")},
+									},
+								},
+							}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.TestBucket,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							WebsiteConfiguration: &v1alpha1.WebsiteConfiguration{
+								IndexDocument: &v1alpha1.IndexDocument{Suffix: "index.html"},
+								ErrorDocument: &v1alpha1.ErrorDocument{Key: "error.html"},
+								RoutingRules: []v1alpha1.RoutingRule{
+									{
+										Condition: &v1alpha1.RoutingRuleCondition{KeyPrefixEquals: aws.String("docs/")},
+										Redirect:  v1alpha1.RoutingRuleRedirect{ReplaceKeyPrefixWith: aws.String("documents/")},
+									},
+								},
+							},
+						},
+					},
+				},
+				backendName: consts.S3Backend1,
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := NewWebsiteConfigurationClient(
+				tc.fields.backendStore,
+				s3clienthandler.NewHandler(
+					s3clienthandler.WithAssumeRoleArn(nil),
+					s3clienthandler.WithBackendStore(tc.fields.backendStore)),
+				logr.Discard())
+
+			got, err := c.observeBackend(context.Background(), tc.args.bucket, tc.args.backendName)
+			require.ErrorIs(t, err, tc.want.err, "unexpected error")
+			assert.Equal(t, tc.want.status, got, "unexpected status")
+		})
+	}
+}
+
+//nolint:maintidx // Function requires numerous checks.
+func TestWebsiteConfigurationHandle(t *testing.T) {
+	t.Parallel()
+
+	bucketName := consts.TestBucket
+	beName := consts.S3Backend1
+
+	type fields struct {
+		backendStore *backendstore.BackendStore
+	}
+
+	type args struct {
+		bucket      *v1alpha1.Bucket
+		backendName string
+	}
+
+	type want struct {
+		err          error
+		specificDiff func(t *testing.T, bb *bucketBackends)
+	}
+
+	cases := map[string]struct {
+		fields fields
+		args   args
+		want   want
+	}{
+		"Unhealthy backend": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusUnhealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							WebsiteConfiguration: &v1alpha1.WebsiteConfiguration{
+								IndexDocument: &v1alpha1.IndexDocument{Suffix: "index.html"},
+							},
+						},
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				err: errUnhealthyBackend,
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					assert.Nil(t, bb.getWebsiteConfigCondition(bucketName, beName), "unexpected website config condition")
+				},
+			},
+		},
+		"Redirect of all requests is put on backend": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketWebsiteStub: func(ctx context.Context, in *s3.GetBucketWebsiteInput, f ...func(*s3.Options)) (*s3.GetBucketWebsiteOutput, error) {
+							return nil, &smithy.GenericAPIError{Code: rgw.WebsiteNotFoundErrCode}
+						},
+						PutBucketWebsiteStub: func(ctx context.Context, in *s3.PutBucketWebsiteInput, f ...func(*s3.Options)) (*s3.PutBucketWebsiteOutput, error) {
+							redirect := in.WebsiteConfiguration.RedirectAllRequestsTo
+							if aws.ToString(in.Bucket) != bucketName || in.WebsiteConfiguration.IndexDocument != nil || redirect == nil ||
+								aws.ToString(redirect.HostName) != "example.com" || redirect.Protocol != s3types.ProtocolHttps {
+								return nil, errUnexpectedInput
+							}
+
+							return &s3.PutBucketWebsiteOutput{}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							WebsiteConfiguration: &v1alpha1.WebsiteConfiguration{
+								RedirectAllRequestsTo: &v1alpha1.RedirectAllRequestsTo{
+									HostName: "example.com",
+									Protocol: aws.String(string(s3types.ProtocolHttps)),
+								},
+							},
+						},
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					assert.True(t, bb.getWebsiteConfigCondition(bucketName, beName).Equal(v1.Available()), "unexpected website config condition")
+				},
+			},
+		},
+		"Website config is deleted from backend when removed from CR": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketWebsiteStub: func(ctx context.Context, in *s3.GetBucketWebsiteInput, f ...func(*s3.Options)) (*s3.GetBucketWebsiteOutput, error) {
+							return &s3.GetBucketWebsiteOutput{
+								IndexDocument: &s3types.IndexDocument{Suffix: aws.String("index.html")},
+							}, nil
+						},
+						DeleteBucketWebsiteStub: func(ctx context.Context, in *s3.DeleteBucketWebsiteInput, f ...func(*s3.Options)) (*s3.DeleteBucketWebsiteOutput, error) {
+							if aws.ToString(in.Bucket) != bucketName {
+								return nil, errUnexpectedInput
+							}
+
+							return &s3.DeleteBucketWebsiteOutput{}, nil
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					assert.Nil(t, bb.getWebsiteConfigCondition(bucketName, beName), "unexpected website config condition")
+				},
+			},
+		},
+		"Error deleting website config": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketWebsiteStub: func(ctx context.Context, in *s3.GetBucketWebsiteInput, f ...func(*s3.Options)) (*s3.GetBucketWebsiteOutput, error) {
+							return &s3.GetBucketWebsiteOutput{
+								IndexDocument: &s3types.IndexDocument{Suffix: aws.String("index.html")},
+							}, nil
+						},
+						DeleteBucketWebsiteStub: func(ctx context.Context, in *s3.DeleteBucketWebsiteInput, f ...func(*s3.Options)) (*s3.DeleteBucketWebsiteOutput, error) {
+							return nil, errExternal
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				err: errExternal,
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					condition := bb.getWebsiteConfigCondition(bucketName, beName)
+					require.NotNil(t, condition, "missing website config condition")
+					assert.Equal(t, v1.Deleting().Reason, condition.Reason, "unexpected website config condition")
+				},
+			},
+		},
+		"Error putting website config": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := backendstorefakes.FakeS3Client{
+						GetBucketWebsiteStub: func(ctx context.Context, in *s3.GetBucketWebsiteInput, f ...func(*s3.Options)) (*s3.GetBucketWebsiteOutput, error) {
+							return nil, &smithy.GenericAPIError{Code: rgw.WebsiteNotFoundErrCode}
+						},
+						PutBucketWebsiteStub: func(ctx context.Context, in *s3.PutBucketWebsiteInput, f ...func(*s3.Options)) (*s3.PutBucketWebsiteOutput, error) {
+							return nil, errExternal
+						},
+					}
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(beName, &fake, nil, apisv1alpha1.HealthStatusHealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				bucket: &v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bucketName,
+					},
+					Spec: v1alpha1.BucketSpec{
+						ForProvider: v1alpha1.BucketParameters{
+							WebsiteConfiguration: &v1alpha1.WebsiteConfiguration{
+								IndexDocument: &v1alpha1.IndexDocument{Suffix: "index.html"},
+							},
+						},
+					},
+				},
+				backendName: beName,
+			},
+			want: want{
+				err: errExternal,
+				specificDiff: func(t *testing.T, bb *bucketBackends) {
+					t.Helper()
+					condition := bb.getWebsiteConfigCondition(bucketName, beName)
+					require.NotNil(t, condition, "missing website config condition")
+					assert.Equal(t, v1.Unavailable().Reason, condition.Reason, "unexpected website config condition")
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := NewWebsiteConfigurationClient(
+				tc.fields.backendStore,
+				s3clienthandler.NewHandler(
+					s3clienthandler.WithAssumeRoleArn(nil),
+					s3clienthandler.WithBackendStore(tc.fields.backendStore)),
+				logr.Discard())
+
+			bb := newBucketBackends()
+			err := c.Handle(context.Background(), tc.args.bucket, tc.args.backendName, bb)
+			require.ErrorIs(t, err, tc.want.err, "unexpected error")
+			if tc.want.specificDiff != nil {
+				tc.want.specificDiff(t, bb)
+			}
+		})
+	}
+}
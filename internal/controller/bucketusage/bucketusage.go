@@ -0,0 +1,138 @@
+package bucketusage
+
+import (
+	"context"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	nsv1alpha1 "github.com/linode/provider-ceph/apis/namespaced/provider-ceph/v1alpha1"
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+)
+
+const controllerName = "bucket-usage-controller"
+
+// Controller periodically measures the usage of each Bucket on its backends.
+// It runs independently of the Bucket controller, so that usage is measured
+// on its own interval and also for paused Buckets.
+type Controller struct {
+	kubeClient client.Client
+	// kubeReader reads Buckets from the API server, as paused Buckets are
+	// not cached by the manager.
+	kubeReader client.Reader
+	// bucketCache watches the metadata of all Buckets, including paused
+	// Buckets.
+	bucketCache  cache.Cache
+	backendStore *backendstore.BackendStore
+	metrics      *Metrics
+	log          logr.Logger
+	interval     time.Duration
+	now          func() time.Time
+}
+
+func NewController(options ...func(*Controller)) *Controller {
+	r := &Controller{
+		metrics: NewMetrics(),
+		now:     time.Now,
+	}
+	for _, o := range options {
+		o(r)
+	}
+
+	return r
+}
+
+func WithKubeClient(k client.Client) func(*Controller) {
+	return func(r *Controller) {
+		r.kubeClient = k
+	}
+}
+
+func WithKubeReader(k client.Reader) func(*Controller) {
+	return func(r *Controller) {
+		r.kubeReader = k
+	}
+}
+
+// WithBucketCache sets the cache watching the metadata of Buckets. It must not
+// filter out paused Buckets.
+func WithBucketCache(c cache.Cache) func(*Controller) {
+	return func(r *Controller) {
+		r.bucketCache = c
+	}
+}
+
+func WithLogger(l logr.Logger) func(*Controller) {
+	return func(r *Controller) {
+		r.log = l.WithValues(v1alpha1.BucketGroupKind, managed.ControllerName(controllerName))
+	}
+}
+
+func WithBackendStore(b *backendstore.BackendStore) func(*Controller) {
+	return func(r *Controller) {
+		r.backendStore = b
+	}
+}
+
+func WithMetrics(m *Metrics) func(*Controller) {
+	return func(r *Controller) {
+		r.metrics = m
+	}
+}
+
+func WithInterval(t time.Duration) func(*Controller) {
+	return func(r *Controller) {
+		r.interval = t
+	}
+}
+
+func (c *Controller) SetupWithManager(mgr ctrl.Manager) error {
+	const maxReconciles = 5
+
+	// Status updates do not change the generation of a Bucket, so the
+	// controller is not triggered by its own updates. Buckets are instead
+	// requeued once their usage is due to be measured again.
+	if err := ctrl.NewControllerManagedBy(mgr).
+		Named(controllerName).
+		WatchesRawSource(c.bucketSource(v1alpha1.BucketGroupVersionKind)).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: maxReconciles,
+		}.ForControllerRuntime()).
+		Complete(c); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(controllerName + "-namespaced").
+		WatchesRawSource(c.bucketSource(nsv1alpha1.BucketGroupVersionKind)).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: maxReconciles,
+		}.ForControllerRuntime()).
+		Complete(reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+			return c.reconcile(ctx, req, &nsv1alpha1.Bucket{})
+		}))
+}
+
+// bucketSource returns a source of the Buckets of the kind, which are watched
+// by their metadata in the bucket cache, as the cache of the manager does not
+// hold paused Buckets.
+func (c *Controller) bucketSource(gvk schema.GroupVersionKind) source.Source {
+	bucket := &metav1.PartialObjectMetadata{}
+	bucket.SetGroupVersionKind(gvk)
+
+	return source.Kind(c.bucketCache, bucket,
+		&handler.TypedEnqueueRequestForObject[*metav1.PartialObjectMetadata]{},
+		predicate.TypedGenerationChangedPredicate[*metav1.PartialObjectMetadata]{})
+}
@@ -0,0 +1,214 @@
+package bucketusage
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"go.opentelemetry.io/otel"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	nsv1alpha1 "github.com/linode/provider-ceph/apis/namespaced/provider-ceph/v1alpha1"
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/otel/traces"
+	"github.com/linode/provider-ceph/internal/rgw"
+)
+
+const (
+	errGetBucket         = "failed to get Bucket"
+	errUpdateBucketUsage = "failed to update Bucket usage"
+)
+
+func (c *Controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return c.reconcile(ctx, req, &v1alpha1.Bucket{})
+}
+
+// reconcile measures the usage of a Bucket of either scope.
+func (c *Controller) reconcile(ctx context.Context, req ctrl.Request, bucket client.Object) (ctrl.Result, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "bucketusage.Controller.Reconcile")
+	defer span.End()
+	ctx, log := traces.InjectTraceAndLogger(ctx, c.log)
+
+	if err := c.kubeReader.Get(ctx, req.NamespacedName, bucket); err != nil {
+		if kerrors.IsNotFound(err) {
+			// Bucket has been deleted so there is nothing to measure and no need to requeue.
+			c.metrics.deleteBucket(req.Namespace, req.Name)
+
+			return ctrl.Result{}, nil
+		}
+		err = errors.Wrap(err, errGetBucket)
+		traces.SetAndRecordError(span, err)
+
+		return ctrl.Result{}, err
+	}
+
+	if meta.WasDeleted(bucket) {
+		c.metrics.deleteBucket(bucket.GetNamespace(), bucket.GetName())
+
+		return ctrl.Result{}, nil
+	}
+
+	status := bucketStatus(bucket)
+
+	if wait := c.untilDue(status); wait > 0 {
+		// Export the usage from the status so that the gauges are populated
+		// after a restart without measuring the usage ahead of time.
+		c.metrics.setBucketUsage(bucket.GetNamespace(), bucket.GetName(), status.AtProvider.Usage)
+
+		return ctrl.Result{RequeueAfter: wait}, nil
+	}
+
+	log.V(1).Info("Measuring bucket usage", consts.KeyBucketName, bucket.GetName())
+
+	usage := c.measure(ctx, bucket.GetName(), status)
+	c.metrics.setBucketUsage(bucket.GetNamespace(), bucket.GetName(), usage)
+
+	if err := c.updateUsage(ctx, bucket, usage); err != nil {
+		err = errors.Wrap(err, errUpdateBucketUsage)
+		traces.SetAndRecordError(span, err)
+
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: c.interval}, nil
+}
+
+// untilDue returns the time until the usage of the bucket must be measured
+// again, based on the oldest measurement of a backend on which the bucket
+// is available. A measurement is due immediately if any backend is missing one,
+// or if the status holds the usage of a backend on which the bucket is no
+// longer available, so that it is removed.
+func (c *Controller) untilDue(status *v1alpha1.BucketStatus) time.Duration {
+	backends := availableBackends(status)
+	for backendName := range status.AtProvider.Usage {
+		if _, ok := backends[backendName]; !ok {
+			return 0
+		}
+	}
+
+	var oldest *metav1.Time
+	for backendName := range backends {
+		u, ok := status.AtProvider.Usage[backendName]
+		if !ok || u == nil {
+			return 0
+		}
+		if oldest == nil || u.LastMeasured.Before(oldest) {
+			oldest = &u.LastMeasured
+		}
+	}
+
+	if oldest == nil {
+		// The bucket is not available on any backend yet.
+		return c.interval
+	}
+
+	return c.interval - c.now().Sub(oldest.Time)
+}
+
+// measure returns the usage of the bucket on each backend on which it is
+// available. The previous measurement is kept for backends which cannot be
+// measured, so that LastMeasured reflects the age of the data.
+func (c *Controller) measure(ctx context.Context, bucketName string, status *v1alpha1.BucketStatus) v1alpha1.BackendUsages {
+	ctx, log := traces.InjectTraceAndLogger(ctx, c.log)
+
+	backends := availableBackends(status)
+
+	usage := make(v1alpha1.BackendUsages)
+	for backendName := range backends {
+		if u, ok := status.AtProvider.Usage[backendName]; ok && u != nil {
+			usage[backendName] = u.DeepCopy()
+		}
+	}
+
+	mu := sync.Mutex{}
+	wg := sync.WaitGroup{}
+
+	for backendName := range backends {
+		if c.backendStore.GetBackendHealthStatus(backendName) == apisv1alpha1.HealthStatusUnhealthy {
+			continue
+		}
+
+		adminClient := c.backendStore.GetBackendAdminClient(backendName)
+		if adminClient == nil {
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			stats, err := rgw.GetBucketStats(ctx, adminClient, bucketName)
+			if err != nil {
+				log.Info("Failed to measure bucket usage on backend", consts.KeyBucketName, bucketName, consts.KeyBackendName, backendName, "error", err.Error())
+
+				return
+			}
+			if stats == nil {
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			usage[backendName] = &v1alpha1.BackendUsage{
+				ObjectCount:  stats.NumObjects,
+				BytesUsed:    stats.Size,
+				LastMeasured: metav1.NewTime(c.now()),
+			}
+		}()
+	}
+	wg.Wait()
+
+	return usage
+}
+
+// updateUsage persists the usage in the status of the latest version of the bucket.
+// The status is updated rather than merge patched, so that the whole usage is
+// replaced and the usage of backends which no longer hold the bucket is removed.
+func (c *Controller) updateUsage(ctx context.Context, bucket client.Object, usage v1alpha1.BackendUsages) error {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if err := c.kubeReader.Get(ctx, client.ObjectKeyFromObject(bucket), bucket); err != nil {
+			return err
+		}
+
+		bucketStatus(bucket).AtProvider.Usage = usage
+
+		return c.kubeClient.Status().Update(ctx, bucket)
+	})
+
+	return resource.Ignore(kerrors.IsNotFound, err)
+}
+
+// bucketStatus returns the status of a Bucket of either scope, which is of the same type.
+func bucketStatus(bucket client.Object) *v1alpha1.BucketStatus {
+	switch b := bucket.(type) {
+	case *v1alpha1.Bucket:
+		return &b.Status
+	case *nsv1alpha1.Bucket:
+		return &b.Status
+	default:
+		return &v1alpha1.BucketStatus{}
+	}
+}
+
+// availableBackends returns the set of backends on which the bucket is available.
+func availableBackends(status *v1alpha1.BucketStatus) map[string]struct{} {
+	backends := make(map[string]struct{})
+	for backendName, backend := range status.AtProvider.Backends {
+		if backend != nil && backend.BucketCondition.Equal(xpv1.Available()) {
+			backends[backendName] = struct{}{}
+		}
+	}
+
+	return backends
+}
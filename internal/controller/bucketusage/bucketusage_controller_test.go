@@ -0,0 +1,283 @@
+package bucketusage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/backendstore/backendstorefakes"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/rgw/admin"
+)
+
+var (
+	testNow      = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	testInterval = 5 * time.Minute
+	errExternal  = errors.New("external error")
+)
+
+func withStats(numObjects, size int64) *backendstorefakes.FakeAdminClient {
+	return &backendstorefakes.FakeAdminClient{
+		GetBucketInfoStub: func(ctx context.Context, in *admin.GetBucketInfoInput) (*admin.Bucket, error) {
+			return &admin.Bucket{
+				Bucket: in.Bucket,
+				Usage: map[string]admin.BucketUsage{
+					"rgw.main": {Size: size, NumObjects: numObjects},
+				},
+			}, nil
+		},
+	}
+}
+
+func usageBucket(backends []string, usage v1alpha1.BackendUsages) *v1alpha1.Bucket {
+	bucket := &v1alpha1.Bucket{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: consts.TestBucket,
+		},
+		Status: v1alpha1.BucketStatus{
+			AtProvider: v1alpha1.BucketObservation{
+				Backends: v1alpha1.Backends{},
+				Usage:    usage,
+			},
+		},
+	}
+	for _, backendName := range backends {
+		bucket.Status.AtProvider.Backends[backendName] = &v1alpha1.BackendInfo{BucketCondition: xpv1.Available()}
+	}
+
+	return bucket
+}
+
+// pausedBucketFilter returns a client which does not find paused Buckets, like
+// the client of the manager whose cache does not hold paused Buckets.
+func pausedBucketFilter(cl client.WithWatch) client.WithWatch {
+	return interceptor.NewClient(cl, interceptor.Funcs{
+		Get: func(ctx context.Context, cl client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+			if err := cl.Get(ctx, key, obj, opts...); err != nil {
+				return err
+			}
+			if obj.GetLabels()[meta.AnnotationKeyReconciliationPaused] == "true" {
+				return kerrors.NewNotFound(schema.GroupResource{}, key.Name)
+			}
+
+			return nil
+		},
+	})
+}
+
+func TestReconcile(t *testing.T) {
+	t.Parallel()
+
+	measuredAt := func(d time.Duration) metav1.Time {
+		return metav1.NewTime(testNow.Add(-d))
+	}
+
+	type backend struct {
+		name   string
+		client *backendstorefakes.FakeAdminClient
+		health apisv1alpha1.HealthStatus
+	}
+
+	type want struct {
+		result       ctrl.Result
+		usage        v1alpha1.BackendUsages
+		objectGauges map[string]float64
+		adminCalls   int
+	}
+
+	cases := map[string]struct {
+		bucket   *v1alpha1.Bucket
+		backends []backend
+		want     want
+	}{
+		"Usage of paused bucket is measured": {
+			bucket: func() *v1alpha1.Bucket {
+				bucket := usageBucket([]string{consts.S3Backend1}, nil)
+				bucket.Labels = map[string]string{meta.AnnotationKeyReconciliationPaused: "true"}
+
+				return bucket
+			}(),
+			backends: []backend{{name: consts.S3Backend1, client: withStats(2, 20), health: apisv1alpha1.HealthStatusHealthy}},
+			want: want{
+				result: ctrl.Result{RequeueAfter: testInterval},
+				usage: v1alpha1.BackendUsages{
+					consts.S3Backend1: &v1alpha1.BackendUsage{ObjectCount: 2, BytesUsed: 20, LastMeasured: metav1.NewTime(testNow)},
+				},
+				objectGauges: map[string]float64{consts.S3Backend1: 2},
+				adminCalls:   1,
+			},
+		},
+		"Bucket does not exist": {
+			backends: []backend{{name: consts.S3Backend1, client: withStats(1, 1), health: apisv1alpha1.HealthStatusHealthy}},
+			want: want{
+				result: ctrl.Result{},
+			},
+		},
+		"Usage is not due so it is not measured": {
+			bucket: usageBucket([]string{consts.S3Backend1}, v1alpha1.BackendUsages{
+				consts.S3Backend1: &v1alpha1.BackendUsage{ObjectCount: 1, BytesUsed: 10, LastMeasured: measuredAt(time.Minute)},
+			}),
+			backends: []backend{{name: consts.S3Backend1, client: withStats(2, 20), health: apisv1alpha1.HealthStatusHealthy}},
+			want: want{
+				result: ctrl.Result{RequeueAfter: testInterval - time.Minute},
+				usage: v1alpha1.BackendUsages{
+					consts.S3Backend1: &v1alpha1.BackendUsage{ObjectCount: 1, BytesUsed: 10, LastMeasured: measuredAt(time.Minute)},
+				},
+				objectGauges: map[string]float64{consts.S3Backend1: 1},
+			},
+		},
+		"Usage is measured on all available backends": {
+			bucket: usageBucket([]string{consts.S3Backend1, consts.S3Backend2}, nil),
+			backends: []backend{
+				{name: consts.S3Backend1, client: withStats(2, 20), health: apisv1alpha1.HealthStatusHealthy},
+				{name: consts.S3Backend2, client: withStats(3, 30), health: apisv1alpha1.HealthStatusHealthy},
+			},
+			want: want{
+				result: ctrl.Result{RequeueAfter: testInterval},
+				usage: v1alpha1.BackendUsages{
+					consts.S3Backend1: &v1alpha1.BackendUsage{ObjectCount: 2, BytesUsed: 20, LastMeasured: metav1.NewTime(testNow)},
+					consts.S3Backend2: &v1alpha1.BackendUsage{ObjectCount: 3, BytesUsed: 30, LastMeasured: metav1.NewTime(testNow)},
+				},
+				objectGauges: map[string]float64{consts.S3Backend1: 2, consts.S3Backend2: 3},
+				adminCalls:   2,
+			},
+		},
+		"Previous usage is kept for unhealthy and failing backends": {
+			bucket: usageBucket([]string{consts.S3Backend1, consts.S3Backend2}, v1alpha1.BackendUsages{
+				consts.S3Backend1: &v1alpha1.BackendUsage{ObjectCount: 1, BytesUsed: 10, LastMeasured: measuredAt(time.Hour)},
+				consts.S3Backend2: &v1alpha1.BackendUsage{ObjectCount: 1, BytesUsed: 10, LastMeasured: measuredAt(time.Hour)},
+			}),
+			backends: []backend{
+				{name: consts.S3Backend1, client: withStats(2, 20), health: apisv1alpha1.HealthStatusUnhealthy},
+				{
+					name: consts.S3Backend2,
+					client: &backendstorefakes.FakeAdminClient{
+						GetBucketInfoStub: func(ctx context.Context, in *admin.GetBucketInfoInput) (*admin.Bucket, error) {
+							return nil, errExternal
+						},
+					},
+					health: apisv1alpha1.HealthStatusHealthy,
+				},
+			},
+			want: want{
+				result: ctrl.Result{RequeueAfter: testInterval},
+				usage: v1alpha1.BackendUsages{
+					consts.S3Backend1: &v1alpha1.BackendUsage{ObjectCount: 1, BytesUsed: 10, LastMeasured: measuredAt(time.Hour)},
+					consts.S3Backend2: &v1alpha1.BackendUsage{ObjectCount: 1, BytesUsed: 10, LastMeasured: measuredAt(time.Hour)},
+				},
+				objectGauges: map[string]float64{consts.S3Backend1: 1, consts.S3Backend2: 1},
+				adminCalls:   1,
+			},
+		},
+		"Usage of backends without the bucket is removed": {
+			bucket: usageBucket([]string{consts.S3Backend1}, v1alpha1.BackendUsages{
+				consts.S3Backend2: &v1alpha1.BackendUsage{ObjectCount: 1, BytesUsed: 10, LastMeasured: measuredAt(time.Hour)},
+			}),
+			backends: []backend{
+				{name: consts.S3Backend1, client: withStats(2, 20), health: apisv1alpha1.HealthStatusHealthy},
+				{name: consts.S3Backend2, client: withStats(3, 30), health: apisv1alpha1.HealthStatusHealthy},
+			},
+			want: want{
+				result: ctrl.Result{RequeueAfter: testInterval},
+				usage: v1alpha1.BackendUsages{
+					consts.S3Backend1: &v1alpha1.BackendUsage{ObjectCount: 2, BytesUsed: 20, LastMeasured: metav1.NewTime(testNow)},
+				},
+				objectGauges: map[string]float64{consts.S3Backend1: 2},
+				adminCalls:   1,
+			},
+		},
+		"Recent usage of a backend which no longer holds the bucket is removed": {
+			bucket: usageBucket(nil, v1alpha1.BackendUsages{
+				consts.S3Backend1: &v1alpha1.BackendUsage{ObjectCount: 1, BytesUsed: 10, LastMeasured: measuredAt(time.Minute)},
+			}),
+			backends: []backend{
+				{name: consts.S3Backend1, client: withStats(2, 20), health: apisv1alpha1.HealthStatusHealthy},
+			},
+			want: want{
+				result: ctrl.Result{RequeueAfter: testInterval},
+				usage:  v1alpha1.BackendUsages{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s := runtime.NewScheme()
+			require.NoError(t, v1alpha1.SchemeBuilder.AddToScheme(s))
+
+			cb := fake.NewClientBuilder().WithScheme(s)
+			if tc.bucket != nil {
+				cb = cb.WithObjects(tc.bucket).WithStatusSubresource(tc.bucket)
+			}
+			cl := cb.Build()
+
+			bs := backendstore.NewBackendStore()
+			for _, b := range tc.backends {
+				bs.AddOrUpdateBackend(b.name, &backendstorefakes.FakeS3Client{}, nil, b.health)
+				bs.SetBackendAdminClient(b.name, b.client)
+			}
+
+			m := NewMetrics()
+			c := NewController(
+				WithKubeClient(pausedBucketFilter(cl)),
+				WithKubeReader(cl),
+				WithBackendStore(bs),
+				WithMetrics(m),
+				WithInterval(testInterval),
+				WithLogger(logr.Discard()))
+			c.now = func() time.Time { return testNow }
+
+			got, err := c.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: consts.TestBucket}})
+			require.NoError(t, err)
+			assert.Equal(t, tc.want.result, got, "unexpected result")
+
+			adminCalls := 0
+			for _, b := range tc.backends {
+				adminCalls += b.client.GetBucketInfoCallCount()
+			}
+			assert.Equal(t, tc.want.adminCalls, adminCalls, "unexpected number of admin ops calls")
+
+			assert.Equal(t, len(tc.want.objectGauges), testutil.CollectAndCount(m.objects), "unexpected number of gauges")
+			for backendName, value := range tc.want.objectGauges {
+				assert.InDelta(t, value, testutil.ToFloat64(m.objects.WithLabelValues("", consts.TestBucket, backendName)), 0, "unexpected gauge for %s", backendName)
+			}
+
+			if tc.bucket == nil {
+				return
+			}
+
+			bucket := &v1alpha1.Bucket{}
+			require.NoError(t, cl.Get(context.Background(), client.ObjectKeyFromObject(tc.bucket), bucket))
+			require.Len(t, bucket.Status.AtProvider.Usage, len(tc.want.usage), "unexpected usage")
+			for backendName, want := range tc.want.usage {
+				u := bucket.Status.AtProvider.Usage[backendName]
+				require.NotNil(t, u, "missing usage for %s", backendName)
+				assert.Equal(t, want.ObjectCount, u.ObjectCount, "unexpected object count for %s", backendName)
+				assert.Equal(t, want.BytesUsed, u.BytesUsed, "unexpected bytes used for %s", backendName)
+				assert.True(t, want.LastMeasured.Equal(&u.LastMeasured), "unexpected last measured for %s", backendName)
+			}
+		})
+	}
+}
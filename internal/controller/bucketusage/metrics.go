@@ -0,0 +1,72 @@
+package bucketusage
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+)
+
+const (
+	metricNamespace = "provider_ceph"
+	metricSubsystem = "bucket"
+
+	labelNamespace = "namespace"
+	labelBucket    = "bucket"
+	labelBackend   = "backend"
+)
+
+// Metrics exports the usage of Buckets as Prometheus gauges.
+type Metrics struct {
+	objects *prometheus.GaugeVec
+	bytes   *prometheus.GaugeVec
+}
+
+// NewMetrics returns Metrics which must be registered with a Prometheus registry.
+func NewMetrics() *Metrics {
+	return &Metrics{
+		objects: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricNamespace,
+			Subsystem: metricSubsystem,
+			Name:      "usage_objects",
+			Help:      "The number of objects in the bucket on the backend.",
+		}, []string{labelNamespace, labelBucket, labelBackend}),
+		bytes: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricNamespace,
+			Subsystem: metricSubsystem,
+			Name:      "usage_bytes",
+			Help:      "The total size in bytes of the objects in the bucket on the backend.",
+		}, []string{labelNamespace, labelBucket, labelBackend}),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
+	m.objects.Describe(ch)
+	m.bytes.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
+	m.objects.Collect(ch)
+	m.bytes.Collect(ch)
+}
+
+// setBucketUsage replaces the gauges of the bucket with the given usage.
+// The namespace of a cluster scoped bucket is empty.
+func (m *Metrics) setBucketUsage(namespace, bucketName string, usage v1alpha1.BackendUsages) {
+	m.deleteBucket(namespace, bucketName)
+
+	for backendName, u := range usage {
+		if u == nil {
+			continue
+		}
+		m.objects.WithLabelValues(namespace, bucketName, backendName).Set(float64(u.ObjectCount))
+		m.bytes.WithLabelValues(namespace, bucketName, backendName).Set(float64(u.BytesUsed))
+	}
+}
+
+// deleteBucket removes the gauges of the bucket on all backends.
+func (m *Metrics) deleteBucket(namespace, bucketName string) {
+	m.objects.DeletePartialMatch(prometheus.Labels{labelNamespace: namespace, labelBucket: bucketName})
+	m.bytes.DeletePartialMatch(prometheus.Labels{labelNamespace: namespace, labelBucket: bucketName})
+}
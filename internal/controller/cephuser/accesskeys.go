@@ -0,0 +1,264 @@
+package cephuser
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/rgw"
+	"github.com/linode/provider-ceph/internal/rgw/admin"
+	"github.com/linode/provider-ceph/internal/utils"
+	"github.com/linode/provider-ceph/internal/utils/randomstring"
+)
+
+const (
+	accessKeyLength = 20
+	secretKeyLength = 40
+
+	defaultKeyExpiryGracePeriod = time.Hour
+)
+
+var (
+	accessKeyCharset = randomstring.NewCharset("ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789")
+	secretKeyCharset = randomstring.NewCharset("0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ")
+)
+
+// getActiveAccessKey returns the active access key of the user, or nil if
+// the user has no active access key.
+func getActiveAccessKey(user *v1alpha1.CephUser) *v1alpha1.AccessKey {
+	keys := user.Status.AtProvider.AccessKeys
+	for i := len(keys) - 1; i >= 0; i-- {
+		if keys[i].ExpiresAt == nil {
+			return &keys[i]
+		}
+	}
+
+	return nil
+}
+
+func isRotationDue(user *v1alpha1.CephUser, key *v1alpha1.AccessKey, now time.Time) bool {
+	period := user.Spec.ForProvider.KeyRotationPeriod
+	if period == nil || period.Duration <= 0 {
+		return false
+	}
+
+	return !now.Before(key.CreatedAt.Add(period.Duration))
+}
+
+func isExpired(key v1alpha1.AccessKey, now time.Time) bool {
+	return key.ExpiresAt != nil && !now.Before(key.ExpiresAt.Time)
+}
+
+func getKeyExpiryGracePeriod(user *v1alpha1.CephUser) time.Duration {
+	if user.Spec.ForProvider.KeyExpiryGracePeriod == nil {
+		return defaultKeyExpiryGracePeriod
+	}
+
+	return user.Spec.ForProvider.KeyExpiryGracePeriod.Duration
+}
+
+// findSecretKey returns the secret key of the access key from the first
+// backend that owns the access key.
+func findSecretKey(accessKey string, externalUsers map[string]*admin.User) string {
+	for _, externalUser := range externalUsers {
+		if externalUser == nil {
+			continue
+		}
+		if secretKey := externalUser.GetSecretKey(accessKey); secretKey != "" {
+			return secretKey
+		}
+	}
+
+	return ""
+}
+
+// areAccessKeysUpToDate returns false if a new access key must be created,
+// the active access key is missing on a backend, or an expired access key
+// has not been revoked yet.
+func areAccessKeysUpToDate(user *v1alpha1.CephUser, externalUsers map[string]*admin.User, now time.Time) bool {
+	active := getActiveAccessKey(user)
+	if active == nil || isRotationDue(user, active, now) {
+		return false
+	}
+
+	for _, externalUser := range externalUsers {
+		if externalUser != nil && !externalUser.HasKey(active.AccessKeyID) {
+			return false
+		}
+	}
+
+	for _, key := range user.Status.AtProvider.AccessKeys {
+		if isExpired(key, now) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ensureAccessKeys creates a new access key if the user has none or the active
+// access key is due for rotation, propagates the active access key to all
+// backends and revokes expired access keys. The same key pair is used on all
+// backends so that the connection secret is valid for each of them.
+//
+//nolint:gocognit,cyclop // Function requires numerous checks.
+func (c *external) ensureAccessKeys(ctx context.Context, user *v1alpha1.CephUser, externalUsers map[string]*admin.User) (string, error) {
+	now := c.now()
+
+	active := getActiveAccessKey(user)
+	secretKey := ""
+	if active != nil {
+		secretKey = findSecretKey(active.AccessKeyID, externalUsers)
+	}
+
+	// A new access key is required if the user has none, if the active access
+	// key is due for rotation or if its secret key is no longer known by any backend.
+	if active == nil || secretKey == "" || isRotationDue(user, active, now) {
+		accessKeyID, newSecretKey, err := c.generateKeyPair()
+		if err != nil {
+			return "", err
+		}
+
+		if active != nil {
+			expiresAt := metav1.NewTime(now.Add(getKeyExpiryGracePeriod(user)))
+			active.ExpiresAt = &expiresAt
+		}
+
+		user.Status.AtProvider.AccessKeys = append(user.Status.AtProvider.AccessKeys, v1alpha1.AccessKey{
+			AccessKeyID: accessKeyID,
+			CreatedAt:   metav1.NewTime(now),
+		})
+		active = &user.Status.AtProvider.AccessKeys[len(user.Status.AtProvider.AccessKeys)-1]
+		secretKey = newSecretKey
+	}
+
+	uid := rgw.CephUserToUID(user)
+
+	g := new(errgroup.Group)
+	for backendName, externalUser := range externalUsers {
+		cl, err := c.s3ClientHandler.GetAdminClient(backendName)
+		if err != nil {
+			return "", err
+		}
+
+		g.Go(func() error {
+			if !externalUser.HasKey(active.AccessKeyID) {
+				if err := rgw.CreateKey(ctx, cl, &admin.CreateKeyInput{
+					UID:       uid,
+					AccessKey: active.AccessKeyID,
+					SecretKey: secretKey,
+				}); err != nil {
+					return err
+				}
+			}
+
+			for _, key := range user.Status.AtProvider.AccessKeys {
+				if !isExpired(key, now) || !externalUser.HasKey(key.AccessKeyID) {
+					continue
+				}
+				if err := rgw.RemoveKey(ctx, cl, uid, key.AccessKeyID); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return "", err
+	}
+
+	// Expired access keys have now been revoked on all backends.
+	keys := make([]v1alpha1.AccessKey, 0, len(user.Status.AtProvider.AccessKeys))
+	for _, key := range user.Status.AtProvider.AccessKeys {
+		if !isExpired(key, now) {
+			keys = append(keys, key)
+		}
+	}
+	user.Status.AtProvider.AccessKeys = keys
+
+	return secretKey, nil
+}
+
+func (c *external) generateKeyPair() (string, string, error) {
+	accessKey, err := c.randomStringGenerator.Generate("", accessKeyLength, accessKeyCharset)
+	if err != nil {
+		return "", "", errors.Wrap(err, errGenerateKey)
+	}
+
+	secretKey, err := c.randomStringGenerator.Generate("", secretKeyLength, secretKeyCharset)
+	if err != nil {
+		return "", "", errors.Wrap(err, errGenerateKey)
+	}
+
+	return accessKey, secretKey, nil
+}
+
+// getConnectionDetails returns the active access key of the user together
+// with the endpoint of the first backend (in alphabetical order) on which
+// the user exists.
+func (c *external) getConnectionDetails(ctx context.Context, user *v1alpha1.CephUser, secretKey string, backendNames []string) (managed.ConnectionDetails, error) {
+	active := getActiveAccessKey(user)
+	if active == nil || secretKey == "" || len(backendNames) == 0 {
+		return managed.ConnectionDetails{}, nil
+	}
+
+	sorted := append([]string{}, backendNames...)
+	sort.Strings(sorted)
+
+	pc, err := utils.GetProviderConfig(ctx, c.kubeClient, sorted[0])
+	if err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	return managed.ConnectionDetails{
+		consts.KeyAccessKey: []byte(active.AccessKeyID),
+		consts.KeySecretKey: []byte(secretKey),
+		consts.KeyEndpoint:  []byte(utils.ResolveHostBase(pc.GetSpec().HostBase, pc.GetSpec().UseHTTPS)),
+	}, nil
+}
+
+// externalUsers is a concurrency-safe store of the users observed on each backend.
+type externalUsers struct {
+	mu    sync.Mutex
+	users map[string]*admin.User
+}
+
+func newExternalUsers() *externalUsers {
+	return &externalUsers{users: map[string]*admin.User{}}
+}
+
+func (e *externalUsers) set(backendName string, user *admin.User) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.users[backendName] = user
+}
+
+// existing returns the users which exist on their backend and the names of these backends.
+func (e *externalUsers) existing() (map[string]*admin.User, []string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	users := map[string]*admin.User{}
+	names := []string{}
+	for backendName, user := range e.users {
+		if user == nil {
+			continue
+		}
+		users[backendName] = user
+		names = append(names, backendName)
+	}
+
+	return users, names
+}
@@ -0,0 +1,167 @@
+package cephuser
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/backendstore/backendstorefakes"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/rgw/admin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestEnsureAccessKeys(t *testing.T) {
+	t.Parallel()
+
+	expiredAt := metav1.NewTime(testNow.Add(-time.Minute))
+
+	type want struct {
+		err        error
+		assertions func(t *testing.T, user *v1alpha1.CephUser, secretKey string, fakes map[string]*backendstorefakes.FakeAdminClient)
+	}
+
+	cases := map[string]struct {
+		user          *v1alpha1.CephUser
+		externalUsers map[string]*admin.User
+		createKeyErr  error
+		want          want
+	}{
+		"Access key is created with the same key pair on all backends": {
+			user: newTestUser(),
+			externalUsers: map[string]*admin.User{
+				consts.S3Backend1: {UserID: testUser},
+				consts.S3Backend2: {UserID: testUser},
+			},
+			want: want{
+				assertions: func(t *testing.T, user *v1alpha1.CephUser, secretKey string, fakes map[string]*backendstorefakes.FakeAdminClient) {
+					t.Helper()
+
+					require.Len(t, user.Status.AtProvider.AccessKeys, 1, "unexpected number of access keys")
+					active := user.Status.AtProvider.AccessKeys[0]
+					assert.Len(t, active.AccessKeyID, accessKeyLength, "unexpected access key length")
+					assert.Len(t, secretKey, secretKeyLength, "unexpected secret key length")
+					assert.Equal(t, testNow, active.CreatedAt.Time, "unexpected creation time")
+
+					for _, be := range []string{consts.S3Backend1, consts.S3Backend2} {
+						require.Equal(t, 1, fakes[be].CreateKeyCallCount(), "unexpected number of CreateKey calls on %s", be)
+						_, in := fakes[be].CreateKeyArgsForCall(0)
+						assert.Equal(t, active.AccessKeyID, in.AccessKey, "unexpected access key on %s", be)
+						assert.Equal(t, secretKey, in.SecretKey, "unexpected secret key on %s", be)
+					}
+				},
+			},
+		},
+		"Access key is rotated and superseded key expires after grace period": {
+			user: func() *v1alpha1.CephUser {
+				user := withActiveKey(newTestUser(), testNow.Add(-2*time.Hour))
+				user.Spec.ForProvider.KeyRotationPeriod = &metav1.Duration{Duration: time.Hour}
+				user.Spec.ForProvider.KeyExpiryGracePeriod = &metav1.Duration{Duration: 10 * time.Minute}
+
+				return user
+			}(),
+			externalUsers: map[string]*admin.User{
+				consts.S3Backend1: {UserID: testUser, Keys: []admin.UserKey{{AccessKey: "AK1", SecretKey: "SK1"}}},
+			},
+			want: want{
+				assertions: func(t *testing.T, user *v1alpha1.CephUser, secretKey string, fakes map[string]*backendstorefakes.FakeAdminClient) {
+					t.Helper()
+
+					require.Len(t, user.Status.AtProvider.AccessKeys, 2, "unexpected number of access keys")
+					old := user.Status.AtProvider.AccessKeys[0]
+					require.NotNil(t, old.ExpiresAt, "superseded access key should expire")
+					assert.Equal(t, testNow.Add(10*time.Minute), old.ExpiresAt.Time, "unexpected expiry time")
+
+					assert.NotEqual(t, "SK1", secretKey, "a new secret key is expected")
+					assert.Equal(t, 1, fakes[consts.S3Backend1].CreateKeyCallCount(), "new access key should be created")
+					assert.Equal(t, 0, fakes[consts.S3Backend1].RemoveKeyCallCount(), "superseded access key should not be revoked yet")
+				},
+			},
+		},
+		"Expired access key is revoked": {
+			user: func() *v1alpha1.CephUser {
+				user := newTestUser()
+				user.Status.AtProvider.AccessKeys = []v1alpha1.AccessKey{
+					{AccessKeyID: "AK0", ExpiresAt: &expiredAt},
+				}
+
+				return withActiveKey(user, testNow)
+			}(),
+			externalUsers: map[string]*admin.User{
+				consts.S3Backend1: {UserID: testUser, Keys: []admin.UserKey{{AccessKey: "AK0", SecretKey: "SK0"}, {AccessKey: "AK1", SecretKey: "SK1"}}},
+				consts.S3Backend2: {UserID: testUser, Keys: []admin.UserKey{{AccessKey: "AK1", SecretKey: "SK1"}}},
+			},
+			want: want{
+				assertions: func(t *testing.T, user *v1alpha1.CephUser, secretKey string, fakes map[string]*backendstorefakes.FakeAdminClient) {
+					t.Helper()
+
+					assert.Equal(t, "SK1", secretKey, "unexpected secret key")
+					require.Len(t, user.Status.AtProvider.AccessKeys, 1, "expired access key should be removed from status")
+					assert.Equal(t, "AK1", user.Status.AtProvider.AccessKeys[0].AccessKeyID, "unexpected active access key")
+
+					require.Equal(t, 1, fakes[consts.S3Backend1].RemoveKeyCallCount(), "expired access key should be revoked")
+					_, in := fakes[consts.S3Backend1].RemoveKeyArgsForCall(0)
+					assert.Equal(t, "AK0", in.AccessKey, "unexpected revoked access key")
+					assert.Equal(t, 0, fakes[consts.S3Backend2].RemoveKeyCallCount(), "no access key to revoke on backend")
+				},
+			},
+		},
+		"Active access key is propagated to backend missing it": {
+			user: withActiveKey(newTestUser(), testNow),
+			externalUsers: map[string]*admin.User{
+				consts.S3Backend1: {UserID: testUser, Keys: []admin.UserKey{{AccessKey: "AK1", SecretKey: "SK1"}}},
+				consts.S3Backend2: {UserID: testUser},
+			},
+			want: want{
+				assertions: func(t *testing.T, user *v1alpha1.CephUser, secretKey string, fakes map[string]*backendstorefakes.FakeAdminClient) {
+					t.Helper()
+
+					assert.Equal(t, "SK1", secretKey, "unexpected secret key")
+					assert.Len(t, user.Status.AtProvider.AccessKeys, 1, "unexpected number of access keys")
+					assert.Equal(t, 0, fakes[consts.S3Backend1].CreateKeyCallCount(), "access key already exists on backend")
+					require.Equal(t, 1, fakes[consts.S3Backend2].CreateKeyCallCount(), "access key should be created on backend")
+					_, in := fakes[consts.S3Backend2].CreateKeyArgsForCall(0)
+					assert.Equal(t, "AK1", in.AccessKey, "unexpected access key")
+					assert.Equal(t, "SK1", in.SecretKey, "unexpected secret key")
+				},
+			},
+		},
+		"Create access key fails": {
+			user: newTestUser(),
+			externalUsers: map[string]*admin.User{
+				consts.S3Backend1: {UserID: testUser},
+			},
+			createKeyErr: errExternal,
+			want: want{
+				err: errExternal,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			fakes := map[string]*backendstorefakes.FakeAdminClient{}
+			bs := backendstore.NewBackendStore()
+			for be := range tc.externalUsers {
+				fake := &backendstorefakes.FakeAdminClient{}
+				fake.CreateKeyReturns(nil, tc.createKeyErr)
+				fakes[be] = fake
+				bs.AddOrUpdateBackend(be, nil, nil, apisv1alpha1.HealthStatusHealthy)
+				bs.SetBackendAdminClient(be, fake)
+			}
+
+			secretKey, err := newExternal(bs).ensureAccessKeys(context.Background(), tc.user, tc.externalUsers)
+			require.ErrorIs(t, err, tc.want.err, "unexpected err")
+			if tc.want.assertions != nil {
+				tc.want.assertions(t, tc.user, secretKey, fakes)
+			}
+		})
+	}
+}
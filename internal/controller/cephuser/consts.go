@@ -0,0 +1,25 @@
+package cephuser
+
+import "github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+var errUnhealthyBackend = errors.New("backend marked as unhealthy in backendstore")
+
+const (
+	// k8s error messages.
+	errNotCephUser  = "managed resource is not a CephUser custom resource"
+	errTrackPCUsage = "failed to track ProviderConfig usage"
+	errGetPC        = "failed to get ProviderConfig"
+
+	// Backend store error messages.
+	errNoS3BackendsStored = "no s3 backends stored in backendstore"
+	errNoHealthyBackends  = "no healthy s3 backends available for this user"
+
+	// User error messages.
+	errObserveUser = "failed to observe user"
+	errCreateUser  = "failed to create user"
+	errUpdateUser  = "failed to update user"
+	errDeleteUser  = "failed to delete user"
+
+	// Access key error messages.
+	errGenerateKey = "failed to generate access key"
+)
@@ -0,0 +1,50 @@
+package cephuser
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	"github.com/linode/provider-ceph/internal/otel/traces"
+)
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "cephuser.external.Create")
+	defer span.End()
+
+	user, ok := mg.(*v1alpha1.CephUser)
+	if !ok {
+		err := errors.New(errNotCephUser)
+		traces.SetAndRecordError(span, err)
+
+		return managed.ExternalCreation{}, err
+	}
+
+	user.SetConditions(xpv1.Creating())
+
+	if !c.backendStore.BackendsAreStored() {
+		err := errors.New(errNoS3BackendsStored)
+		traces.SetAndRecordError(span, err)
+
+		return managed.ExternalCreation{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.operationTimeout)
+	defer cancel()
+
+	connectionDetails, err := c.ensureUserOnBackends(ctx, user)
+	if err != nil {
+		err = errors.Wrap(err, errCreateUser)
+		traces.SetAndRecordError(span, err)
+
+		return managed.ExternalCreation{}, err
+	}
+
+	return managed.ExternalCreation{ConnectionDetails: connectionDetails}, nil
+}
@@ -0,0 +1,77 @@
+package cephuser
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"golang.org/x/sync/errgroup"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/otel/traces"
+	"github.com/linode/provider-ceph/internal/rgw"
+)
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "cephuser.external.Delete")
+	defer span.End()
+	ctx, log := traces.InjectTraceAndLogger(ctx, c.log)
+
+	user, ok := mg.(*v1alpha1.CephUser)
+	if !ok {
+		err := errors.New(errNotCephUser)
+		traces.SetAndRecordError(span, err)
+
+		return managed.ExternalDelete{}, err
+	}
+
+	if !c.backendStore.BackendsAreStored() {
+		err := errors.New(errNoS3BackendsStored)
+		traces.SetAndRecordError(span, err)
+
+		return managed.ExternalDelete{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.operationTimeout)
+	defer cancel()
+
+	uid := rgw.CephUserToUID(user)
+	ub := newUserBackends()
+
+	g := new(errgroup.Group)
+	for _, backendName := range getUserProviders(user, c.backendStore.GetBackendNamesForNamespace("")) {
+		log.Info("Deleting user on backend", consts.KeyBackendName, backendName)
+		beName := backendName
+		g.Go(func() error {
+			cl, err := c.s3ClientHandler.GetAdminClient(beName)
+			if err != nil {
+				ub.setUserCondition(beName, xpv1.Deleting().WithMessage(err.Error()))
+
+				return err
+			}
+
+			if err := rgw.RemoveUser(ctx, cl, uid); err != nil {
+				ub.setUserCondition(beName, xpv1.Deleting().WithMessage(err.Error()))
+
+				return err
+			}
+
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		user.Status.AtProvider.Backends = ub.getBackends()
+		err = errors.Wrap(err, errDeleteUser)
+		traces.SetAndRecordError(span, err)
+
+		return managed.ExternalDelete{}, err
+	}
+
+	return managed.ExternalDelete{}, nil
+}
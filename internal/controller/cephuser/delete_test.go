@@ -0,0 +1,79 @@
+package cephuser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/backendstore/backendstorefakes"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDelete(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		providers []string
+		removeErr error
+		wantCalls map[string]int
+		wantErr   error
+	}{
+		"User is removed from all backends": {
+			wantCalls: map[string]int{consts.S3Backend1: 1, consts.S3Backend2: 1},
+		},
+		"User is removed only from specified providers": {
+			providers: []string{consts.S3Backend2},
+			wantCalls: map[string]int{consts.S3Backend1: 0, consts.S3Backend2: 1},
+		},
+		"Missing user is ignored": {
+			removeErr: errNoSuchUser,
+			wantCalls: map[string]int{consts.S3Backend1: 1, consts.S3Backend2: 1},
+		},
+		"Remove fails": {
+			removeErr: errExternal,
+			wantCalls: map[string]int{consts.S3Backend1: 1, consts.S3Backend2: 1},
+			wantErr:   errExternal,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			fakes := map[string]*backendstorefakes.FakeAdminClient{}
+			bs := backendstore.NewBackendStore()
+			for _, be := range []string{consts.S3Backend1, consts.S3Backend2} {
+				fake := &backendstorefakes.FakeAdminClient{}
+				fake.RemoveUserReturns(tc.removeErr)
+				fakes[be] = fake
+				bs.AddOrUpdateBackend(be, nil, nil, apisv1alpha1.HealthStatusHealthy)
+				bs.SetBackendAdminClient(be, fake)
+			}
+
+			user := newTestUser()
+			user.Spec.Providers = tc.providers
+
+			_, err := newExternal(bs).Delete(context.Background(), user)
+			require.ErrorIs(t, err, tc.wantErr, "unexpected err")
+			for be, calls := range tc.wantCalls {
+				assert.Equal(t, calls, fakes[be].RemoveUserCallCount(), "unexpected number of calls on %s", be)
+			}
+		})
+	}
+}
+
+func TestGetUserProviders(t *testing.T) {
+	t.Parallel()
+
+	all := []string{consts.S3Backend1, consts.S3Backend2}
+
+	user := &v1alpha1.CephUser{}
+	assert.Equal(t, all, getUserProviders(user, all), "all backends expected when providers are unset")
+
+	user.Spec.Providers = []string{consts.S3Backend1}
+	assert.Equal(t, []string{consts.S3Backend1}, getUserProviders(user, all), "only specified providers expected")
+}
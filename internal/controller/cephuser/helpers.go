@@ -0,0 +1,138 @@
+package cephuser
+
+import (
+	"context"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/rgw"
+	"github.com/linode/provider-ceph/internal/rgw/admin"
+)
+
+// getUserProviders returns the names of the backends on which the user
+// should exist. If the CephUser does not specify any providers, the user
+// is expected on all backends.
+func getUserProviders(user *v1alpha1.CephUser, allBackendNames []string) []string {
+	if len(user.Spec.Providers) != 0 {
+		return user.Spec.Providers
+	}
+
+	return allBackendNames
+}
+
+// userBackends is a concurrency-safe store of user conditions per backend.
+type userBackends struct {
+	mu       sync.RWMutex
+	backends v1alpha1.UserBackends
+}
+
+func newUserBackends() *userBackends {
+	return &userBackends{
+		backends: make(v1alpha1.UserBackends),
+	}
+}
+
+func (u *userBackends) setUserCondition(backendName string, c xpv1.Condition) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.backends[backendName] = &v1alpha1.UserBackendInfo{UserCondition: c}
+}
+
+// getBackends returns a copy of the stored backends.
+func (u *userBackends) getBackends() v1alpha1.UserBackends {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	backends := make(v1alpha1.UserBackends, len(u.backends))
+	for k, v := range u.backends {
+		backends[k] = v.DeepCopy()
+	}
+
+	return backends
+}
+
+// ensureUser creates the user on the backend if it does not exist, or
+// modifies it if it is not up to date. The resulting user is returned.
+func (c *external) ensureUser(ctx context.Context, user *v1alpha1.CephUser, backendName string) (*admin.User, error) {
+	if c.backendStore.GetBackendHealthStatus(backendName) == apisv1alpha1.HealthStatusUnhealthy {
+		return nil, errUnhealthyBackend
+	}
+
+	cl, err := c.s3ClientHandler.GetAdminClient(backendName)
+	if err != nil {
+		return nil, err
+	}
+
+	uid := rgw.CephUserToUID(user)
+	externalUser, err := rgw.GetUser(ctx, cl, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case externalUser == nil:
+		externalUser, err = rgw.CreateUser(ctx, cl, rgw.CephUserToCreateUserInput(user))
+	case !rgw.IsUserUpToDate(user, externalUser):
+		externalUser, err = rgw.ModifyUser(ctx, cl, rgw.CephUserToModifyUserInput(user))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if externalUser == nil {
+		// The response carried no user info, assume a user without keys.
+		externalUser = &admin.User{UserID: uid}
+	}
+
+	return externalUser, nil
+}
+
+// ensureUserOnBackends calls ensureUser for each of the user's backends
+// concurrently and records the outcome per backend in the CephUser status.
+// Once the user exists on all backends, its access keys are reconciled and
+// the resulting connection details are returned.
+func (c *external) ensureUserOnBackends(ctx context.Context, user *v1alpha1.CephUser) (managed.ConnectionDetails, error) {
+	ub := newUserBackends()
+	ensured := newExternalUsers()
+
+	g := new(errgroup.Group)
+	for _, backendName := range getUserProviders(user, c.backendStore.GetBackendNamesForNamespace("")) {
+		g.Go(func() error {
+			externalUser, err := c.ensureUser(ctx, user, backendName)
+			if err != nil {
+				ub.setUserCondition(backendName, xpv1.Unavailable().WithMessage(err.Error()))
+
+				return err
+			}
+			ub.setUserCondition(backendName, xpv1.Available())
+			ensured.set(backendName, externalUser)
+
+			return nil
+		})
+	}
+
+	err := g.Wait()
+
+	user.Status.AtProvider.UID = rgw.CephUserToUID(user)
+	user.Status.AtProvider.Backends = ub.getBackends()
+
+	if err != nil {
+		return nil, err
+	}
+
+	externalUsers, backendNames := ensured.existing()
+
+	secretKey, err := c.ensureAccessKeys(ctx, user, externalUsers)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.getConnectionDetails(ctx, user, secretKey, backendNames)
+}
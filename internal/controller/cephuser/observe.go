@@ -0,0 +1,130 @@
+package cephuser
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"golang.org/x/sync/errgroup"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/otel/traces"
+	"github.com/linode/provider-ceph/internal/rgw"
+)
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "cephuser.external.Observe")
+	defer span.End()
+	ctx, log := traces.InjectTraceAndLogger(ctx, c.log)
+
+	user, ok := mg.(*v1alpha1.CephUser)
+	if !ok {
+		err := errors.New(errNotCephUser)
+		traces.SetAndRecordError(span, err)
+
+		return managed.ExternalObservation{}, err
+	}
+
+	if !c.backendStore.BackendsAreStored() {
+		err := errors.New(errNoS3BackendsStored)
+		traces.SetAndRecordError(span, err)
+
+		return managed.ExternalObservation{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.operationTimeout)
+	defer cancel()
+
+	uid := rgw.CephUserToUID(user)
+	observed := newExternalUsers()
+
+	g := new(errgroup.Group)
+	for _, backendName := range getUserProviders(user, c.backendStore.GetBackendNamesForNamespace("")) {
+		if c.backendStore.GetBackendHealthStatus(backendName) == apisv1alpha1.HealthStatusUnhealthy {
+			log.Info("Backend is marked unhealthy, skipping observation of user", consts.KeyBackendName, backendName)
+
+			continue
+		}
+
+		cl, err := c.s3ClientHandler.GetAdminClient(backendName)
+		if err != nil {
+			traces.SetAndRecordError(span, err)
+
+			return managed.ExternalObservation{}, errors.Wrap(err, errObserveUser)
+		}
+
+		g.Go(func() error {
+			externalUser, err := rgw.GetUser(ctx, cl, uid)
+			if err != nil {
+				return err
+			}
+			observed.set(backendName, externalUser)
+
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		err = errors.Wrap(err, errObserveUser)
+		traces.SetAndRecordError(span, err)
+
+		return managed.ExternalObservation{}, err
+	}
+
+	if len(observed.users) == 0 {
+		err := errors.New(errNoHealthyBackends)
+		traces.SetAndRecordError(span, err)
+
+		return managed.ExternalObservation{}, err
+	}
+
+	existingUsers, existingBackendNames := observed.existing()
+	if len(existingUsers) == 0 {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	userUpToDate := len(existingUsers) == len(observed.users)
+	backends := v1alpha1.UserBackends{}
+	for backendName, externalUser := range existingUsers {
+		backends[backendName] = &v1alpha1.UserBackendInfo{UserCondition: xpv1.Available()}
+
+		if !rgw.IsUserUpToDate(user, externalUser) {
+			log.Info("User is not up to date on backend", consts.KeyBackendName, backendName)
+			userUpToDate = false
+		}
+	}
+
+	if !areAccessKeysUpToDate(user, existingUsers, c.now()) {
+		log.Info("User access keys are not up to date")
+		userUpToDate = false
+	}
+
+	user.Status.AtProvider.UID = uid
+	user.Status.AtProvider.Backends = backends
+	user.SetConditions(xpv1.Available())
+
+	secretKey := ""
+	if active := getActiveAccessKey(user); active != nil {
+		secretKey = findSecretKey(active.AccessKeyID, existingUsers)
+	}
+
+	connectionDetails, err := c.getConnectionDetails(ctx, user, secretKey, existingBackendNames)
+	if err != nil {
+		err = errors.Wrap(err, errObserveUser)
+		traces.SetAndRecordError(span, err)
+
+		return managed.ExternalObservation{}, err
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  userUpToDate,
+		ConnectionDetails: connectionDetails,
+	}, nil
+}
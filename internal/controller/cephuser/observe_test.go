@@ -0,0 +1,365 @@
+package cephuser
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/go-logr/logr"
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/backendstore/backendstorefakes"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/controller/s3clienthandler"
+	"github.com/linode/provider-ceph/internal/rgw/admin"
+	"github.com/linode/provider-ceph/internal/utils/randomstring"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testUser = "test-user"
+
+var (
+	errExternal   = errors.New("external error")
+	errNoSuchUser = &admin.Error{StatusCode: 404, Code: admin.CodeNoSuchUser}
+)
+
+func newTestUser() *v1alpha1.CephUser {
+	return &v1alpha1.CephUser{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testUser,
+		},
+		Spec: v1alpha1.CephUserSpec{
+			ForProvider: v1alpha1.CephUserParameters{
+				DisplayName: "Test User",
+				MaxBuckets:  aws.Int32(10),
+			},
+		},
+	}
+}
+
+var testNow = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func newExternal(bs *backendstore.BackendStore) *external {
+	s := runtime.NewScheme()
+	s.AddKnownTypes(apisv1alpha1.SchemeGroupVersion, &apisv1alpha1.ProviderConfig{}, &apisv1alpha1.ProviderConfigList{})
+
+	pcs := []client.Object{}
+	for _, be := range []string{consts.S3Backend1, consts.S3Backend2} {
+		pcs = append(pcs, &apisv1alpha1.ProviderConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: be},
+			Spec:       apisv1alpha1.ProviderConfigSpec{HostBase: be + ".example.com"},
+		})
+	}
+
+	return &external{
+		kubeClient:   fake.NewClientBuilder().WithScheme(s).WithObjects(pcs...).Build(),
+		backendStore: bs,
+		s3ClientHandler: s3clienthandler.NewHandler(
+			s3clienthandler.WithBackendStore(bs)),
+		log:                   logr.Discard(),
+		operationTimeout:      time.Second * 5,
+		now:                   func() time.Time { return testNow },
+		randomStringGenerator: &randomstring.StandardGenerator{},
+	}
+}
+
+// withActiveKey adds an active access key created at the given time to the user status.
+func withActiveKey(user *v1alpha1.CephUser, createdAt time.Time) *v1alpha1.CephUser {
+	user.Status.AtProvider.AccessKeys = append(user.Status.AtProvider.AccessKeys, v1alpha1.AccessKey{
+		AccessKeyID: "AK1",
+		CreatedAt:   metav1.NewTime(createdAt),
+	})
+
+	return user
+}
+
+func TestObserve(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		backendStore *backendstore.BackendStore
+	}
+
+	type args struct {
+		mg resource.Managed
+	}
+
+	type want struct {
+		o          managed.ExternalObservation
+		statusDiff func(t *testing.T, mg resource.Managed)
+		err        error
+	}
+
+	cases := map[string]struct {
+		fields fields
+		args   args
+		want   want
+	}{
+		"Invalid managed resource": {
+			fields: fields{
+				backendStore: backendstore.NewBackendStore(),
+			},
+			args: args{
+				mg: &v1alpha1.Bucket{},
+			},
+			want: want{
+				err: errors.New(errNotCephUser),
+			},
+		},
+		"No backends stored": {
+			fields: fields{
+				backendStore: backendstore.NewBackendStore(),
+			},
+			args: args{
+				mg: newTestUser(),
+			},
+			want: want{
+				err: errors.New(errNoS3BackendsStored),
+			},
+		},
+		"All backends unhealthy": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, nil, nil, apisv1alpha1.HealthStatusUnhealthy)
+					bs.SetBackendAdminClient(consts.S3Backend1, &backendstorefakes.FakeAdminClient{})
+
+					return bs
+				}(),
+			},
+			args: args{
+				mg: newTestUser(),
+			},
+			want: want{
+				err: errors.New(errNoHealthyBackends),
+			},
+		},
+		"Admin client error": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := &backendstorefakes.FakeAdminClient{}
+					fake.GetUserReturns(nil, errExternal)
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, nil, nil, apisv1alpha1.HealthStatusHealthy)
+					bs.SetBackendAdminClient(consts.S3Backend1, fake)
+
+					return bs
+				}(),
+			},
+			args: args{
+				mg: newTestUser(),
+			},
+			want: want{
+				err: errExternal,
+			},
+		},
+		"User does not exist on any backend": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := &backendstorefakes.FakeAdminClient{}
+					fake.GetUserReturns(nil, errNoSuchUser)
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, nil, nil, apisv1alpha1.HealthStatusHealthy)
+					bs.SetBackendAdminClient(consts.S3Backend1, fake)
+					bs.AddOrUpdateBackend(consts.S3Backend2, nil, nil, apisv1alpha1.HealthStatusHealthy)
+					bs.SetBackendAdminClient(consts.S3Backend2, fake)
+
+					return bs
+				}(),
+			},
+			args: args{
+				mg: newTestUser(),
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"User missing on one backend": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := &backendstorefakes.FakeAdminClient{}
+					fake.GetUserReturns(&admin.User{UserID: testUser, DisplayName: "Test User", MaxBuckets: 10}, nil)
+					missing := &backendstorefakes.FakeAdminClient{}
+					missing.GetUserReturns(nil, errNoSuchUser)
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, nil, nil, apisv1alpha1.HealthStatusHealthy)
+					bs.SetBackendAdminClient(consts.S3Backend1, fake)
+					bs.AddOrUpdateBackend(consts.S3Backend2, nil, nil, apisv1alpha1.HealthStatusHealthy)
+					bs.SetBackendAdminClient(consts.S3Backend2, missing)
+
+					return bs
+				}(),
+			},
+			args: args{
+				mg: newTestUser(),
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+				statusDiff: func(t *testing.T, mg resource.Managed) {
+					t.Helper()
+					user, _ := mg.(*v1alpha1.CephUser)
+
+					assert.Len(t, user.Status.AtProvider.Backends, 1, "unexpected number of backends in status")
+					assert.True(t,
+						user.Status.AtProvider.Backends[consts.S3Backend1].UserCondition.Equal(v1.Available()),
+						"user condition on backend is not available")
+				},
+			},
+		},
+		"User is not up to date": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := &backendstorefakes.FakeAdminClient{}
+					fake.GetUserReturns(&admin.User{UserID: testUser, DisplayName: "Test User", MaxBuckets: 1000}, nil)
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, nil, nil, apisv1alpha1.HealthStatusHealthy)
+					bs.SetBackendAdminClient(consts.S3Backend1, fake)
+
+					return bs
+				}(),
+			},
+			args: args{
+				mg: newTestUser(),
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"User without access key is not up to date": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := &backendstorefakes.FakeAdminClient{}
+					fake.GetUserReturns(&admin.User{UserID: testUser, DisplayName: "Test User", MaxBuckets: 10}, nil)
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, nil, nil, apisv1alpha1.HealthStatusHealthy)
+					bs.SetBackendAdminClient(consts.S3Backend1, fake)
+
+					return bs
+				}(),
+			},
+			args: args{
+				mg: newTestUser(),
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+			},
+		},
+		"Access key is due for rotation": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := &backendstorefakes.FakeAdminClient{}
+					fake.GetUserReturns(&admin.User{
+						UserID:      testUser,
+						DisplayName: "Test User",
+						MaxBuckets:  10,
+						Keys:        []admin.UserKey{{AccessKey: "AK1", SecretKey: "SK1"}},
+					}, nil)
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, nil, nil, apisv1alpha1.HealthStatusHealthy)
+					bs.SetBackendAdminClient(consts.S3Backend1, fake)
+
+					return bs
+				}(),
+			},
+			args: args{
+				mg: func() *v1alpha1.CephUser {
+					user := withActiveKey(newTestUser(), testNow.Add(-2*time.Hour))
+					user.Spec.ForProvider.KeyRotationPeriod = &metav1.Duration{Duration: time.Hour}
+
+					return user
+				}(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						consts.KeyAccessKey: []byte("AK1"),
+						consts.KeySecretKey: []byte("SK1"),
+						consts.KeyEndpoint:  []byte("http://" + consts.S3Backend1 + ".example.com"),
+					},
+				},
+			},
+		},
+		"User is up to date and unhealthy backend is skipped": {
+			fields: fields{
+				backendStore: func() *backendstore.BackendStore {
+					fake := &backendstorefakes.FakeAdminClient{}
+					fake.GetUserReturns(&admin.User{
+						UserID:      testUser,
+						DisplayName: "Test User",
+						MaxBuckets:  10,
+						Keys:        []admin.UserKey{{AccessKey: "AK1", SecretKey: "SK1"}},
+					}, nil)
+					unhealthy := &backendstorefakes.FakeAdminClient{}
+					unhealthy.GetUserReturns(nil, errExternal)
+
+					bs := backendstore.NewBackendStore()
+					bs.AddOrUpdateBackend(consts.S3Backend1, nil, nil, apisv1alpha1.HealthStatusHealthy)
+					bs.SetBackendAdminClient(consts.S3Backend1, fake)
+					bs.AddOrUpdateBackend(consts.S3Backend2, nil, nil, apisv1alpha1.HealthStatusUnhealthy)
+					bs.SetBackendAdminClient(consts.S3Backend2, unhealthy)
+
+					return bs
+				}(),
+			},
+			args: args{
+				mg: withActiveKey(newTestUser(), testNow),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						consts.KeyAccessKey: []byte("AK1"),
+						consts.KeySecretKey: []byte("SK1"),
+						consts.KeyEndpoint:  []byte("http://" + consts.S3Backend1 + ".example.com"),
+					},
+				},
+				statusDiff: func(t *testing.T, mg resource.Managed) {
+					t.Helper()
+					user, _ := mg.(*v1alpha1.CephUser)
+
+					assert.Equal(t, testUser, user.Status.AtProvider.UID, "unexpected uid")
+					assert.True(t,
+						user.Status.Conditions[0].Equal(v1.Available()),
+						"user cr condition is not available")
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := newExternal(tc.fields.backendStore).Observe(context.Background(), tc.args.mg)
+			if tc.want.err != nil {
+				require.ErrorContains(t, err, tc.want.err.Error(), "unexpected err")
+			} else {
+				require.NoError(t, err, "unexpected err")
+			}
+			assert.Equal(t, tc.want.o, got, "unexpected observation")
+			if tc.want.statusDiff != nil {
+				tc.want.statusDiff(t, tc.args.mg)
+			}
+		})
+	}
+}
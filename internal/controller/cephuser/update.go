@@ -0,0 +1,47 @@
+package cephuser
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	"github.com/linode/provider-ceph/internal/otel/traces"
+)
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "cephuser.external.Update")
+	defer span.End()
+
+	user, ok := mg.(*v1alpha1.CephUser)
+	if !ok {
+		err := errors.New(errNotCephUser)
+		traces.SetAndRecordError(span, err)
+
+		return managed.ExternalUpdate{}, err
+	}
+
+	if !c.backendStore.BackendsAreStored() {
+		err := errors.New(errNoS3BackendsStored)
+		traces.SetAndRecordError(span, err)
+
+		return managed.ExternalUpdate{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.operationTimeout)
+	defer cancel()
+
+	connectionDetails, err := c.ensureUserOnBackends(ctx, user)
+	if err != nil {
+		err = errors.Wrap(err, errUpdateUser)
+		traces.SetAndRecordError(span, err)
+
+		return managed.ExternalUpdate{}, err
+	}
+
+	return managed.ExternalUpdate{ConnectionDetails: connectionDetails}, nil
+}
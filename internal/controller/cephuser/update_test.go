@@ -0,0 +1,163 @@
+package cephuser
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/backendstore/backendstorefakes"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/rgw/admin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdate(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		fakes map[string]*backendstorefakes.FakeAdminClient
+		// unhealthy backends are added with an unhealthy status.
+		unhealthy []string
+	}
+
+	type want struct {
+		statusDiff func(t *testing.T, mg resource.Managed, fakes map[string]*backendstorefakes.FakeAdminClient)
+		err        error
+	}
+
+	cases := map[string]struct {
+		fields fields
+		mg     *v1alpha1.CephUser
+		want   want
+	}{
+		"Missing user is created and outdated user is modified": {
+			fields: fields{
+				fakes: map[string]*backendstorefakes.FakeAdminClient{
+					consts.S3Backend1: func() *backendstorefakes.FakeAdminClient {
+						fake := &backendstorefakes.FakeAdminClient{}
+						fake.GetUserReturns(nil, errNoSuchUser)
+
+						return fake
+					}(),
+					consts.S3Backend2: func() *backendstorefakes.FakeAdminClient {
+						fake := &backendstorefakes.FakeAdminClient{}
+						fake.GetUserReturns(&admin.User{UserID: testUser, DisplayName: "Old Name"}, nil)
+
+						return fake
+					}(),
+				},
+			},
+			mg: newTestUser(),
+			want: want{
+				statusDiff: func(t *testing.T, mg resource.Managed, fakes map[string]*backendstorefakes.FakeAdminClient) {
+					t.Helper()
+					user, _ := mg.(*v1alpha1.CephUser)
+
+					assert.Equal(t, 1, fakes[consts.S3Backend1].CreateUserCallCount(), "user should be created")
+					assert.Equal(t, 0, fakes[consts.S3Backend1].ModifyUserCallCount(), "user should not be modified")
+					assert.Equal(t, 0, fakes[consts.S3Backend2].CreateUserCallCount(), "user should not be created")
+					assert.Equal(t, 1, fakes[consts.S3Backend2].ModifyUserCallCount(), "user should be modified")
+
+					_, in := fakes[consts.S3Backend2].ModifyUserArgsForCall(0)
+					assert.Equal(t, "Test User", in.DisplayName, "unexpected display name")
+
+					for _, be := range []string{consts.S3Backend1, consts.S3Backend2} {
+						assert.True(t,
+							user.Status.AtProvider.Backends[be].UserCondition.Equal(v1.Available()),
+							"user condition on backend is not available")
+					}
+				},
+			},
+		},
+		"Up to date user is left untouched": {
+			fields: fields{
+				fakes: map[string]*backendstorefakes.FakeAdminClient{
+					consts.S3Backend1: func() *backendstorefakes.FakeAdminClient {
+						fake := &backendstorefakes.FakeAdminClient{}
+						fake.GetUserReturns(&admin.User{UserID: testUser, DisplayName: "Test User", MaxBuckets: 10}, nil)
+
+						return fake
+					}(),
+				},
+			},
+			mg: newTestUser(),
+			want: want{
+				statusDiff: func(t *testing.T, _ resource.Managed, fakes map[string]*backendstorefakes.FakeAdminClient) {
+					t.Helper()
+
+					assert.Equal(t, 0, fakes[consts.S3Backend1].CreateUserCallCount(), "user should not be created")
+					assert.Equal(t, 0, fakes[consts.S3Backend1].ModifyUserCallCount(), "user should not be modified")
+				},
+			},
+		},
+		"Modify fails on one backend": {
+			fields: fields{
+				fakes: map[string]*backendstorefakes.FakeAdminClient{
+					consts.S3Backend1: func() *backendstorefakes.FakeAdminClient {
+						fake := &backendstorefakes.FakeAdminClient{}
+						fake.GetUserReturns(&admin.User{UserID: testUser, DisplayName: "Old Name"}, nil)
+						fake.ModifyUserReturns(nil, errExternal)
+
+						return fake
+					}(),
+				},
+			},
+			mg: newTestUser(),
+			want: want{
+				err: errExternal,
+				statusDiff: func(t *testing.T, mg resource.Managed, _ map[string]*backendstorefakes.FakeAdminClient) {
+					t.Helper()
+					user, _ := mg.(*v1alpha1.CephUser)
+
+					assert.Equal(t,
+						v1.ReasonUnavailable,
+						user.Status.AtProvider.Backends[consts.S3Backend1].UserCondition.Reason,
+						"user condition on backend is not unavailable")
+				},
+			},
+		},
+		"Unhealthy backend is not updated": {
+			fields: fields{
+				fakes: map[string]*backendstorefakes.FakeAdminClient{
+					consts.S3Backend1: {},
+				},
+				unhealthy: []string{consts.S3Backend1},
+			},
+			mg: newTestUser(),
+			want: want{
+				err: errUnhealthyBackend,
+				statusDiff: func(t *testing.T, _ resource.Managed, fakes map[string]*backendstorefakes.FakeAdminClient) {
+					t.Helper()
+
+					assert.Equal(t, 0, fakes[consts.S3Backend1].GetUserCallCount(), "unhealthy backend should not be called")
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			bs := backendstore.NewBackendStore()
+			for be, fake := range tc.fields.fakes {
+				bs.AddOrUpdateBackend(be, nil, nil, apisv1alpha1.HealthStatusHealthy)
+				bs.SetBackendAdminClient(be, fake)
+			}
+			for _, be := range tc.fields.unhealthy {
+				bs.SetBackendHealthStatus(be, apisv1alpha1.HealthStatusUnhealthy)
+			}
+
+			_, err := newExternal(bs).Update(context.Background(), tc.mg)
+			require.ErrorIs(t, err, tc.want.err, "unexpected err")
+			if tc.want.statusDiff != nil {
+				tc.want.statusDiff(t, tc.mg, tc.fields.fakes)
+			}
+		})
+	}
+}
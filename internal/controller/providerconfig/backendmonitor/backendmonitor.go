@@ -1,17 +1,37 @@
 package backendmonitor
 
 import (
+	"context"
+	"reflect"
+	"sync"
 	"time"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/providerconfig"
 	"github.com/go-logr/logr"
+	apisnsv1alpha1 "github.com/linode/provider-ceph/apis/namespaced/v1alpha1"
 	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
 	"github.com/linode/provider-ceph/internal/backendstore"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
-const controllerName = "backend-store-controller"
+const (
+	controllerName = "backend-store-controller"
+
+	// secretRefIndex is the field index of the "namespace/name" of the Secret
+	// referenced by the credentials of a ProviderConfig.
+	secretRefIndex = "spec.credentials.secretRef"
+)
 
 type Controller struct {
 	kubeClient      client.Client
@@ -19,10 +39,23 @@ type Controller struct {
 	log             logr.Logger
 	s3Timeout       time.Duration
 	requeueInterval time.Duration
+	// webIdentityCredentials are the web identity credentials of each
+	// backend, which are kept across reconciles so that they are only
+	// refreshed when they are about to expire.
+	webIdentityCredentials map[string]webIdentityCredentials
+	webIdentityMu          sync.Mutex
+	// staticCredentials are the static credentials in use by each backend,
+	// which are kept so that a backend keeps using them when new credentials
+	// are rejected during a rotation.
+	staticCredentials map[string]staticCredentials
+	staticMu          sync.Mutex
 }
 
 func NewController(options ...func(*Controller)) *Controller {
-	r := &Controller{}
+	r := &Controller{
+		webIdentityCredentials: make(map[string]webIdentityCredentials),
+		staticCredentials:      make(map[string]staticCredentials),
+	}
 	for _, o := range options {
 		o(r)
 	}
@@ -61,8 +94,112 @@ func WithRequeueInterval(t time.Duration) func(*Controller) {
 }
 
 func (c *Controller) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	for _, obj := range []client.Object{&apisv1alpha1.ProviderConfig{}, &apisnsv1alpha1.ProviderConfig{}, &apisnsv1alpha1.ClusterProviderConfig{}} {
+		if err := mgr.GetFieldIndexer().IndexField(context.Background(), obj, secretRefIndex, secretRefIndexValue); err != nil {
+			return err
+		}
+	}
+
+	if err := ctrl.NewControllerManagedBy(mgr).
 		Named(controllerName).
 		For(&apisv1alpha1.ProviderConfig{}).
-		Complete(c)
+		Watches(&corev1.Secret{}, c.enqueueForSecret(func() client.ObjectList { return &apisv1alpha1.ProviderConfigList{} }), builder.WithPredicates(secretDataChanged())).
+		Complete(c); err != nil {
+		return err
+	}
+
+	// The namespaced ProviderConfig and the ClusterProviderConfig are
+	// reconciled by separate controllers sharing the same backend store.
+	if err := ctrl.NewControllerManagedBy(mgr).
+		Named(controllerName+"-namespaced").
+		For(&apisnsv1alpha1.ProviderConfig{}).
+		Watches(&corev1.Secret{}, c.enqueueForSecret(func() client.ObjectList { return &apisnsv1alpha1.ProviderConfigList{} }), builder.WithPredicates(secretDataChanged())).
+		Complete(c.forKind(func() apisv1alpha1.ProviderConfigObject { return &apisnsv1alpha1.ProviderConfig{} })); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(controllerName+"-cluster").
+		For(&apisnsv1alpha1.ClusterProviderConfig{}).
+		Watches(&corev1.Secret{}, c.enqueueForSecret(func() client.ObjectList { return &apisnsv1alpha1.ClusterProviderConfigList{} }), builder.WithPredicates(secretDataChanged())).
+		Complete(c.forKind(func() apisv1alpha1.ProviderConfigObject { return &apisnsv1alpha1.ClusterProviderConfig{} }))
+}
+
+// forKind returns a reconciler for the ProviderConfig kind created by newProviderConfig.
+func (c *Controller) forKind(newProviderConfig func() apisv1alpha1.ProviderConfigObject) reconcile.Reconciler {
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		return c.reconcile(ctx, req, newProviderConfig())
+	})
+}
+
+// enqueueForSecret returns an event handler which enqueues the ProviderConfigs
+// of the kind listed by newList that reference a Secret whenever the Secret
+// changes, so that rotated credentials are used immediately rather than on
+// the next requeue.
+func (c *Controller) enqueueForSecret(newList func() client.ObjectList) handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, secret client.Object) []reconcile.Request {
+		return c.providerConfigsForSecret(ctx, newList(), secret)
+	})
+}
+
+// providerConfigsForSecret returns a request for each ProviderConfig of the
+// list's kind with the Secret credentials source that references the Secret.
+// The ProviderConfigs are looked up with the secretRefIndex field index.
+func (c *Controller) providerConfigsForSecret(ctx context.Context, list client.ObjectList, secret client.Object) []reconcile.Request {
+	key := types.NamespacedName{Namespace: secret.GetNamespace(), Name: secret.GetName()}.String()
+	if err := c.kubeClient.List(ctx, list, client.MatchingFields{secretRefIndex: key}); err != nil {
+		c.log.Info("Failed to list ProviderConfigs referencing Secret", "namespace", secret.GetNamespace(), "name", secret.GetName(), "error", err.Error())
+
+		return nil
+	}
+
+	var requests []reconcile.Request
+	_ = meta.EachListItem(list, func(obj runtime.Object) error {
+		if pc, ok := obj.(client.Object); ok {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(pc)})
+		}
+
+		return nil
+	})
+
+	return requests
+}
+
+// secretRefIndexValue returns the "namespace/name" of the Secret the
+// credentials of the ProviderConfig are read from, if any. A namespaced
+// ProviderConfig may only reference a Secret in its own namespace.
+func secretRefIndexValue(obj client.Object) []string {
+	pc, ok := obj.(apisv1alpha1.ProviderConfigObject)
+	if !ok {
+		return nil
+	}
+
+	creds := pc.GetSpec().Credentials
+	if creds.Source != xpv1.CredentialsSourceSecret || creds.SecretRef == nil {
+		return nil
+	}
+
+	secretNamespace := creds.SecretRef.Namespace
+	if pc.GetNamespace() != "" {
+		secretNamespace = pc.GetNamespace()
+	}
+
+	return []string{types.NamespacedName{Namespace: secretNamespace, Name: creds.SecretRef.Name}.String()}
+}
+
+// secretDataChanged returns a predicate which filters out updates of Secrets
+// which do not change their data, such as changes of their labels or
+// annotations, as they do not change the credentials of any ProviderConfig.
+func secretDataChanged() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldSecret, oldOK := e.ObjectOld.(*corev1.Secret)
+			newSecret, newOK := e.ObjectNew.(*corev1.Secret)
+			if !oldOK || !newOK {
+				return true
+			}
+
+			return !reflect.DeepEqual(oldSecret.Data, newSecret.Data) || !reflect.DeepEqual(oldSecret.StringData, newSecret.StringData)
+		},
+	}
 }
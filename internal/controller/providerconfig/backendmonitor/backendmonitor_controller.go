@@ -31,6 +31,7 @@ import (
 
 	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
 	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
 	"github.com/linode/provider-ceph/internal/consts"
 	"github.com/linode/provider-ceph/internal/otel/traces"
 	"github.com/linode/provider-ceph/internal/rgw"
@@ -44,27 +45,48 @@ const (
 	errGetSecret                = "failed to get Secret"
 	errCleanup                  = "failed to perform cleanup"
 	errDeleteLCValidationBucket = "failed to delete lifecycle configuration validation bucket"
+	errNoWebIdentity            = "spec.credentials.webIdentity is required for the WebIdentity credentials source"
+	errWebIdentityCredentials   = "failed to retrieve web identity credentials"
 )
 
 func (c *Controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return c.reconcile(ctx, req, &apisv1alpha1.ProviderConfig{})
+}
+
+func (c *Controller) reconcile(ctx context.Context, req ctrl.Request, providerConfig apisv1alpha1.ProviderConfigObject) (ctrl.Result, error) {
 	ctx, span := otel.Tracer("").Start(ctx, "backendmonitor.Controller.Reconcile")
 	defer span.End()
 	ctx, log := traces.InjectTraceAndLogger(ctx, c.log)
 
-	log.V(1).Info("Reconciling backend store", "name", req.Name)
-	providerConfig := &apisv1alpha1.ProviderConfig{}
+	backendName := backendstore.BackendName(req.Namespace, req.Name)
+
+	log.V(1).Info("Reconciling backend store", "name", backendName)
+
+	shadowed, err := utils.IsShadowed(ctx, c.kubeClient, providerConfig, req.Name)
+	if err != nil {
+		err = errors.Wrap(err, errGetProviderConfig)
+		traces.SetAndRecordError(span, err)
+
+		return ctrl.Result{}, err
+	}
+	if shadowed {
+		return c.reconcileShadowed(ctx, req, providerConfig)
+	}
+
 	if err := c.kubeClient.Get(ctx, req.NamespacedName, providerConfig); err != nil {
 		if kerrors.IsNotFound(err) {
 			// ProviderConfig has been deleted, perform cleanup.
-			if err := c.cleanup(ctx, req); err != nil {
+			if err := c.cleanup(ctx, backendName); err != nil {
 				err = errors.Wrap(err, errCleanup)
 				traces.SetAndRecordError(span, err)
 
 				return ctrl.Result{}, err
 			}
 
-			log.Info("Removing s3 backend as from backend store", "name", req.Name)
-			c.backendStore.DeleteBackend(req.Name)
+			log.Info("Removing s3 backend as from backend store", "name", backendName)
+			c.backendStore.DeleteBackend(backendName)
+			c.deleteWebIdentityCredentials(backendName)
+			c.deleteStaticCredentials(backendName)
 
 			// The ProviderConfig no longer exists so there is no need to requeue the reconcile key.
 			return ctrl.Result{}, nil
@@ -75,38 +97,121 @@ func (c *Controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, err
 	}
 
-	if err := c.addOrUpdateBackend(ctx, providerConfig); err != nil {
+	if err := checkCredentialsSource(providerConfig); err != nil {
+		traces.SetAndRecordError(span, err)
+		if err := c.setCredentialsStatus(ctx, providerConfig, v1alpha1.UnsupportedCredentialsSource(err), ""); err != nil {
+			traces.SetAndRecordError(span, err)
+
+			return ctrl.Result{}, err
+		}
+
+		// The ProviderConfig is reconciled again when its spec changes.
+		return ctrl.Result{}, nil
+	}
+
+	clients, err := c.addOrUpdateBackend(ctx, backendName, providerConfig)
+	if err != nil {
+		traces.SetAndRecordError(span, err)
+		if err := c.setCredentialsStatus(ctx, providerConfig, v1alpha1.CredentialsError(err), ""); err != nil {
+			log.Info("Failed to set credentials condition", "name", backendName, "error", err.Error())
+		}
+
+		return ctrl.Result{}, err
+	}
+
+	condition := v1alpha1.CredentialsLoaded()
+	if clients.rejectedErr != nil {
+		log.Info("New credentials were rejected by the backend, using the previous credentials", "name", backendName, "error", clients.rejectedErr.Error())
+		condition = v1alpha1.CredentialsRejected(clients.rejectedErr)
+	}
+
+	if err := c.setCredentialsStatus(ctx, providerConfig, condition, clients.credentialsVersion); err != nil {
 		traces.SetAndRecordError(span, err)
 
 		return ctrl.Result{}, err
 	}
 
-	// Requeue the reconcile key after the interval. We do this because we need to
-	// ensure that if a ProviderConfig's referenced Secret is updated, we also update
-	// the client in the backend store with the new credentials.
+	// Requeue the reconcile key after the interval. Changes of referenced
+	// Secrets trigger a reconcile immediately, but credentials read from the
+	// environment or the filesystem, and new credentials which were rejected
+	// by the backend, are only checked again after the interval.
 	return ctrl.Result{RequeueAfter: c.requeueInterval}, nil
 }
 
-func (c *Controller) addOrUpdateBackend(ctx context.Context, pc *apisv1alpha1.ProviderConfig) error {
-	secret, err := c.getProviderConfigSecret(ctx, pc.Spec.Credentials.SecretRef.Namespace, pc.Spec.Credentials.SecretRef.Name)
-	if err != nil {
-		return err
+// reconcileShadowed marks a ClusterProviderConfig shadowed by a ProviderConfig
+// with the same name as not ready. The backend belongs to the ProviderConfig,
+// so it is neither updated nor removed. The ClusterProviderConfig is checked
+// again after the interval in case the ProviderConfig is deleted.
+func (c *Controller) reconcileShadowed(ctx context.Context, req ctrl.Request, providerConfig apisv1alpha1.ProviderConfigObject) (ctrl.Result, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "backendmonitor.Controller.reconcileShadowed")
+	defer span.End()
+	ctx, log := traces.InjectTraceAndLogger(ctx, c.log)
+
+	if err := c.kubeClient.Get(ctx, req.NamespacedName, providerConfig); err != nil {
+		if kerrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		err = errors.Wrap(err, errGetProviderConfig)
+		traces.SetAndRecordError(span, err)
+
+		return ctrl.Result{}, err
 	}
 
-	s3Client, err := rgw.NewS3Client(ctx, secret.Data, &pc.Spec, c.s3Timeout, nil)
-	if err != nil {
-		return errors.Wrap(err, errCreateS3Client)
+	log.Info("ClusterProviderConfig is shadowed by a ProviderConfig with the same name", "name", req.Name)
+	if err := c.setCredentialsStatus(ctx, providerConfig, v1alpha1.Shadowed(), ""); err != nil {
+		traces.SetAndRecordError(span, err)
+
+		return ctrl.Result{}, err
 	}
 
-	stsClient, err := rgw.NewSTSClient(ctx, secret.Data, &pc.Spec, c.s3Timeout)
+	return ctrl.Result{RequeueAfter: c.requeueInterval}, nil
+}
+
+func (c *Controller) addOrUpdateBackend(ctx context.Context, backendName string, pc apisv1alpha1.ProviderConfigObject) (*backendClients, error) {
+	spec := pc.GetSpec()
+
+	clients, err := c.newBackendClients(ctx, backendName, pc)
 	if err != nil {
-		return errors.Wrap(err, errCreateSTSClient)
+		return nil, err
 	}
 
-	readyCondition := pc.Status.GetCondition(v1.TypeReady)
-	c.backendStore.AddOrUpdateBackend(pc.Name, s3Client, stsClient, utils.MapConditionToHealthStatus(readyCondition))
+	readyCondition := pc.GetCondition(v1.TypeReady)
+	c.backendStore.AddOrUpdateBackend(backendName, clients.s3Client, clients.stsClient, utils.MapConditionToHealthStatus(readyCondition))
+	c.backendStore.SetBackendAdminClient(backendName, clients.adminClient)
+	c.backendStore.SetBackendLabels(backendName, pc.GetLabels())
+	c.backendStore.SetBackendMode(backendName, spec.Mode)
+	c.backendStore.SetBackendCapabilities(backendName, spec.Capabilities)
+	c.backendStore.SetBackendAssumeRole(backendName, spec.AssumeRole)
+	c.backendStore.SetBackendCredentialsVersion(backendName, clients.credentialsVersion)
+	_, legacy := pc.(*apisv1alpha1.ProviderConfig)
+	c.backendStore.SetBackendLegacy(backendName, legacy)
+
+	return clients, nil
+}
 
-	return nil
+// backendClients are the clients of a backend created from the credentials of
+// its ProviderConfig.
+type backendClients struct {
+	s3Client    backendstore.S3Client
+	stsClient   backendstore.STSClient
+	adminClient backendstore.AdminClient
+	// credentialsVersion changes whenever the ProviderConfig is recreated,
+	// its spec changes or its credentials change. Status updates of the
+	// ProviderConfig do not change the version.
+	credentialsVersion string
+	// rejectedErr is set when new credentials were rejected by the backend
+	// and the clients were created from the previous credentials instead.
+	rejectedErr error
+}
+
+// newBackendClients creates the clients of the backend from the credentials
+// source of the ProviderConfig.
+func (c *Controller) newBackendClients(ctx context.Context, backendName string, pc apisv1alpha1.ProviderConfigObject) (*backendClients, error) {
+	if pc.GetSpec().Credentials.Source == apisv1alpha1.CredentialsSourceWebIdentity {
+		return c.newWebIdentityClients(ctx, backendName, pc)
+	}
+
+	return c.newStaticClients(ctx, backendName, pc)
 }
 
 func (c *Controller) getProviderConfigSecret(ctx context.Context, secretNamespace, secretName string) (*corev1.Secret, error) {
@@ -121,17 +226,17 @@ func (c *Controller) getProviderConfigSecret(ctx context.Context, secretNamespac
 
 // cleanup deletes the lifecycle configuration validation bucket from the backend.
 // This function is only called when a ProviderConfig has been deleted.
-func (c *Controller) cleanup(ctx context.Context, req ctrl.Request) error {
+func (c *Controller) cleanup(ctx context.Context, backendName string) error {
 	ctx, log := traces.InjectTraceAndLogger(ctx, c.log)
 
-	backendClient := c.backendStore.GetBackendS3Client(req.Name)
+	backendClient := c.backendStore.GetBackendS3Client(backendName)
 	if backendClient == nil {
-		log.Info("Backend client not found during validation bucket cleanup - aborting cleanup", consts.KeyBackendName, req.Name)
+		log.Info("Backend client not found during validation bucket cleanup - aborting cleanup", consts.KeyBackendName, backendName)
 
 		return nil
 	}
 
-	log.Info("Deleting lifecycle configuration validation bucket", consts.KeyBucketName, v1alpha1.LifecycleConfigValidationBucketName, consts.KeyBackendName, req.Name)
+	log.Info("Deleting lifecycle configuration validation bucket", consts.KeyBucketName, v1alpha1.LifecycleConfigValidationBucketName, consts.KeyBackendName, backendName)
 	if err := rgw.DeleteBucket(ctx, backendClient, aws.String(v1alpha1.LifecycleConfigValidationBucketName), true); err != nil {
 		return errors.Wrap(err, errDeleteLCValidationBucket)
 	}
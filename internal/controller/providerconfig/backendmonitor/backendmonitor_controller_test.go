@@ -0,0 +1,60 @@
+package backendmonitor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	apisnsv1alpha1 "github.com/linode/provider-ceph/apis/namespaced/v1alpha1"
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/backendstore/backendstorefakes"
+	"github.com/linode/provider-ceph/internal/consts"
+)
+
+func TestReconcileShadowedClusterProviderConfig(t *testing.T) {
+	t.Parallel()
+
+	s := runtime.NewScheme()
+	require.NoError(t, apisv1alpha1.SchemeBuilder.AddToScheme(s))
+	require.NoError(t, apisnsv1alpha1.SchemeBuilder.AddToScheme(s))
+
+	pc := &apisv1alpha1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: consts.S3Backend1}}
+	cpc := &apisnsv1alpha1.ClusterProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: consts.S3Backend1}}
+	kube := fake.NewClientBuilder().WithScheme(s).WithObjects(pc, cpc).WithStatusSubresource(cpc).Build()
+
+	// The backend was added by the reconciler of the ProviderConfig.
+	s3Client := &backendstorefakes.FakeS3Client{}
+	bs := backendstore.NewBackendStore()
+	bs.AddOrUpdateBackend(consts.S3Backend1, s3Client, nil, apisv1alpha1.HealthStatusHealthy)
+
+	c := NewController(WithKubeClient(kube), WithBackendStore(bs), WithRequeueInterval(time.Minute))
+	ctx := context.Background()
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: consts.S3Backend1}}
+
+	res, err := c.reconcile(ctx, req, &apisnsv1alpha1.ClusterProviderConfig{})
+	require.NoError(t, err)
+	assert.Equal(t, ctrl.Result{RequeueAfter: time.Minute}, res, "shadowed cluster provider config should be checked again")
+	assert.Same(t, s3Client, bs.GetBackendS3Client(consts.S3Backend1), "backend of the provider config should not be updated")
+
+	got := &apisnsv1alpha1.ClusterProviderConfig{}
+	require.NoError(t, kube.Get(ctx, client.ObjectKeyFromObject(cpc), got))
+	assert.Equal(t, v1alpha1.ReasonShadowed, got.GetCondition(xpv1.TypeReady).Reason, "cluster provider config should be marked as shadowed")
+
+	// Deleting the shadowed ClusterProviderConfig keeps the backend of the ProviderConfig.
+	require.NoError(t, kube.Delete(ctx, got))
+	_, err = c.reconcile(ctx, req, &apisnsv1alpha1.ClusterProviderConfig{})
+	require.NoError(t, err)
+	assert.True(t, bs.BackendExists(consts.S3Backend1), "backend of the provider config should not be removed")
+}
@@ -0,0 +1,235 @@
+package backendmonitor
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/rgw"
+	"github.com/linode/provider-ceph/internal/utils"
+)
+
+const (
+	errNoSecretRef       = "spec.credentials.secretRef is required for the Secret credentials source"
+	errNoSecretRefKey    = "spec.credentials.secretRef.key is required for S3cfg credentials"
+	errNoEnv             = "spec.credentials.env is required for S3cfg credentials of the Environment credentials source"
+	errNoFs              = "spec.credentials.fs is required for the Filesystem credentials source"
+	errReadCredentials   = "failed to read credentials file"
+	errUpdateCredentials = "failed to update credentials condition"
+
+	// Default names of the environment variables holding Keys credentials.
+	envAccessKey = "AWS_ACCESS_KEY_ID"
+	envSecretKey = "AWS_SECRET_ACCESS_KEY"
+)
+
+// checkCredentialsSource returns an error if the credentials source of the
+// ProviderConfig is not supported. The Environment, Filesystem and WebIdentity
+// sources read the environment and filesystem of the provider, so they are
+// only supported by cluster scoped ProviderConfigs.
+func checkCredentialsSource(pc apisv1alpha1.ProviderConfigObject) error {
+	switch source := pc.GetSpec().Credentials.Source; source {
+	case xpv1.CredentialsSourceSecret:
+		return nil
+	case xpv1.CredentialsSourceEnvironment, xpv1.CredentialsSourceFilesystem, apisv1alpha1.CredentialsSourceWebIdentity:
+		if pc.GetNamespace() != "" {
+			return errors.New(fmt.Sprintf("credentials source %s is not supported by namespaced ProviderConfigs, only Secret is", source))
+		}
+
+		return nil
+	default:
+		return errors.New(fmt.Sprintf("credentials source %s is not supported, must be one of Secret, Environment, Filesystem or WebIdentity", source))
+	}
+}
+
+// getStaticCredentials returns the access key and the secret key of the
+// Secret, Environment or Filesystem credentials source of the ProviderConfig,
+// keyed by consts.KeyAccessKey and consts.KeySecretKey, along with their
+// credentials version.
+func (c *Controller) getStaticCredentials(ctx context.Context, pc apisv1alpha1.ProviderConfigObject) (map[string][]byte, string, error) {
+	creds := pc.GetSpec().Credentials
+
+	switch creds.Source {
+	case xpv1.CredentialsSourceEnvironment:
+		data, err := getEnvironmentCredentials(creds)
+
+		return data, contentVersion(pc, data), err
+	case xpv1.CredentialsSourceFilesystem:
+		data, err := getFilesystemCredentials(creds)
+
+		return data, contentVersion(pc, data), err
+	default:
+		return c.getSecretCredentials(ctx, pc)
+	}
+}
+
+func (c *Controller) getSecretCredentials(ctx context.Context, pc apisv1alpha1.ProviderConfigObject) (map[string][]byte, string, error) {
+	creds := pc.GetSpec().Credentials
+	if creds.SecretRef == nil {
+		return nil, "", errors.New(errNoSecretRef)
+	}
+
+	// A namespaced ProviderConfig may only use a Secret in its own namespace.
+	secretNamespace := creds.SecretRef.Namespace
+	if pc.GetNamespace() != "" {
+		secretNamespace = pc.GetNamespace()
+	}
+
+	secret, err := c.getProviderConfigSecret(ctx, secretNamespace, creds.SecretRef.Name)
+	if err != nil {
+		return nil, "", err
+	}
+
+	version := fmt.Sprintf("%s/%d/%s/%s", pc.GetUID(), pc.GetGeneration(), secret.GetUID(), secret.GetResourceVersion())
+
+	if creds.Format == apisv1alpha1.CredentialsFormatS3cfg {
+		if creds.SecretRef.Key == "" {
+			return nil, "", errors.New(errNoSecretRefKey)
+		}
+		data, err := rgw.ParseS3cfg(secret.Data[creds.SecretRef.Key])
+
+		return data, version, err
+	}
+
+	accessKey, secretKey := credentialKeys(creds, consts.KeyAccessKey, consts.KeySecretKey)
+	data, err := keysCredentials(accessKey, secretKey, func(name string) ([]byte, error) { return secret.Data[name], nil })
+
+	return data, version, err
+}
+
+func getEnvironmentCredentials(creds apisv1alpha1.ProviderCredentials) (map[string][]byte, error) {
+	if creds.Format == apisv1alpha1.CredentialsFormatS3cfg {
+		if creds.Env == nil {
+			return nil, errors.New(errNoEnv)
+		}
+
+		return rgw.ParseS3cfg([]byte(os.Getenv(creds.Env.Name)))
+	}
+
+	accessKey, secretKey := credentialKeys(creds, envAccessKey, envSecretKey)
+
+	return keysCredentials(accessKey, secretKey, func(name string) ([]byte, error) { return []byte(os.Getenv(name)), nil })
+}
+
+// getFilesystemCredentials reads the s3cfg file at fs.path, or the files of
+// the directory at fs.path holding the access key and the secret key, such as
+// a mounted Secret.
+func getFilesystemCredentials(creds apisv1alpha1.ProviderCredentials) (map[string][]byte, error) {
+	if creds.Fs == nil {
+		return nil, errors.New(errNoFs)
+	}
+
+	if creds.Format == apisv1alpha1.CredentialsFormatS3cfg {
+		cfg, err := utils.ReadFile(creds.Fs.Path)
+		if err != nil {
+			return nil, errors.Wrap(err, errReadCredentials)
+		}
+
+		return rgw.ParseS3cfg(cfg)
+	}
+
+	accessKey, secretKey := credentialKeys(creds, consts.KeyAccessKey, consts.KeySecretKey)
+
+	return keysCredentials(accessKey, secretKey, func(name string) ([]byte, error) {
+		value, err := utils.ReadFile(filepath.Join(creds.Fs.Path, name))
+		if err != nil {
+			return nil, errors.Wrap(err, errReadCredentials)
+		}
+
+		return bytes.TrimSpace(value), nil
+	})
+}
+
+// keysCredentials returns the access key and the secret key looked up by
+// name, keyed by consts.KeyAccessKey and consts.KeySecretKey. Both keys must
+// be set.
+func keysCredentials(accessKey, secretKey string, lookup func(name string) ([]byte, error)) (map[string][]byte, error) {
+	data := map[string][]byte{}
+	for _, kv := range [][2]string{{consts.KeyAccessKey, accessKey}, {consts.KeySecretKey, secretKey}} {
+		key, name := kv[0], kv[1]
+		value, err := lookup(name)
+		if err != nil {
+			return nil, err
+		}
+		if len(value) == 0 {
+			return nil, errors.New(fmt.Sprintf("credentials key %s is not set", name))
+		}
+		data[key] = value
+	}
+
+	return data, nil
+}
+
+// credentialKeys returns the names of the access key and the secret key of
+// Keys credentials, or the given defaults.
+func credentialKeys(creds apisv1alpha1.ProviderCredentials, defaultAccessKey, defaultSecretKey string) (accessKey, secretKey string) {
+	accessKey, secretKey = defaultAccessKey, defaultSecretKey
+	if creds.Keys != nil && creds.Keys.AccessKey != "" {
+		accessKey = creds.Keys.AccessKey
+	}
+	if creds.Keys != nil && creds.Keys.SecretKey != "" {
+		secretKey = creds.Keys.SecretKey
+	}
+
+	return accessKey, secretKey
+}
+
+// contentVersion returns a credentials version which changes whenever the
+// ProviderConfig is recreated, its spec changes or the credentials change.
+// Only a prefix of the hash of the credentials is used, as the version is
+// reported in the status of the ProviderConfig.
+func contentVersion(pc apisv1alpha1.ProviderConfigObject, data map[string][]byte) string {
+	h := sha256.New()
+	h.Write(data[consts.KeyAccessKey])
+	h.Write([]byte{0})
+	h.Write(data[consts.KeySecretKey])
+
+	return fmt.Sprintf("%s/%d/%x", pc.GetUID(), pc.GetGeneration(), h.Sum(nil)[:8])
+}
+
+// setCredentialsStatus sets the condition of the ProviderConfig, usually its
+// credentials condition, and, unless version is empty, the version of the
+// credentials in use by its backend. The status is only updated if it changes.
+func (c *Controller) setCredentialsStatus(ctx context.Context, pc apisv1alpha1.ProviderConfigObject, condition xpv1.Condition, version string) error {
+	if pc.GetCondition(condition.Type).Equal(condition) && credentialsVersionUpToDate(pc.GetStatus(), version) {
+		return nil
+	}
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if err := c.kubeClient.Get(ctx, client.ObjectKeyFromObject(pc), pc); err != nil {
+			return err
+		}
+		pc.SetConditions(condition)
+		setCredentialsVersion(pc.GetStatus(), version)
+
+		return c.kubeClient.Status().Update(ctx, pc)
+	})
+
+	return errors.Wrap(err, errUpdateCredentials)
+}
+
+func credentialsVersionUpToDate(status *apisv1alpha1.ProviderConfigStatus, version string) bool {
+	return version == "" || (status.Credentials != nil && status.Credentials.Version == version)
+}
+
+// setCredentialsVersion sets the version of the credentials in use and
+// increments their generation whenever the version changes.
+func setCredentialsVersion(status *apisv1alpha1.ProviderConfigStatus, version string) {
+	if credentialsVersionUpToDate(status, version) {
+		return
+	}
+	if status.Credentials == nil {
+		status.Credentials = &apisv1alpha1.CredentialsStatus{}
+	}
+	status.Credentials.Generation++
+	status.Credentials.Version = version
+}
@@ -0,0 +1,276 @@
+package backendmonitor
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	apisnsv1alpha1 "github.com/linode/provider-ceph/apis/namespaced/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/consts"
+)
+
+func TestCheckCredentialsSource(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		pc      apisv1alpha1.ProviderConfigObject
+		wantErr bool
+	}{
+		"Secret is supported": {
+			pc: &apisv1alpha1.ProviderConfig{Spec: apisv1alpha1.ProviderConfigSpec{
+				Credentials: apisv1alpha1.ProviderCredentials{Source: xpv1.CredentialsSourceSecret},
+			}},
+		},
+		"Environment is supported": {
+			pc: &apisv1alpha1.ProviderConfig{Spec: apisv1alpha1.ProviderConfigSpec{
+				Credentials: apisv1alpha1.ProviderCredentials{Source: xpv1.CredentialsSourceEnvironment},
+			}},
+		},
+		"WebIdentity is supported": {
+			pc: &apisv1alpha1.ProviderConfig{Spec: apisv1alpha1.ProviderConfigSpec{
+				Credentials: apisv1alpha1.ProviderCredentials{Source: apisv1alpha1.CredentialsSourceWebIdentity},
+			}},
+		},
+		"None is not supported": {
+			pc: &apisv1alpha1.ProviderConfig{Spec: apisv1alpha1.ProviderConfigSpec{
+				Credentials: apisv1alpha1.ProviderCredentials{Source: xpv1.CredentialsSourceNone},
+			}},
+			wantErr: true,
+		},
+		"InjectedIdentity is not supported": {
+			pc: &apisv1alpha1.ProviderConfig{Spec: apisv1alpha1.ProviderConfigSpec{
+				Credentials: apisv1alpha1.ProviderCredentials{Source: xpv1.CredentialsSourceInjectedIdentity},
+			}},
+			wantErr: true,
+		},
+		"Filesystem is not supported by namespaced ProviderConfigs": {
+			pc: &apisnsv1alpha1.ProviderConfig{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "tenant"},
+				Spec: apisv1alpha1.ProviderConfigSpec{
+					Credentials: apisv1alpha1.ProviderCredentials{Source: xpv1.CredentialsSourceFilesystem},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := checkCredentialsSource(tc.pc)
+			if tc.wantErr {
+				assert.Error(t, err, "expected source to be rejected")
+			} else {
+				assert.NoError(t, err, "unexpected error")
+			}
+		})
+	}
+}
+
+func TestGetStaticCredentials(t *testing.T) {
+	t.Parallel()
+
+	s3cfg := "[default]\naccess_key = cfg-access\nsecret_key = cfg-secret\n"
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "access_key"), []byte("fs-access\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "secret_key"), []byte("fs-secret\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "s3cfg"), []byte(s3cfg), 0o600))
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "crossplane-system", Name: "creds"},
+		Data: map[string][]byte{
+			"access_key": []byte("access"),
+			"secret_key": []byte("secret"),
+			"AK":         []byte("custom-access"),
+			"SK":         []byte("custom-secret"),
+			"s3cfg":      []byte(s3cfg),
+		},
+	}
+	secretRef := func(key string) xpv1.CommonCredentialSelectors {
+		return xpv1.CommonCredentialSelectors{SecretRef: &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: "creds"},
+			Key:             key,
+		}}
+	}
+
+	cases := map[string]struct {
+		creds    apisv1alpha1.ProviderCredentials
+		wantData map[string][]byte
+		wantErr  bool
+	}{
+		"Secret with default keys": {
+			creds: apisv1alpha1.ProviderCredentials{Source: xpv1.CredentialsSourceSecret, CommonCredentialSelectors: secretRef("credentials")},
+			wantData: map[string][]byte{
+				consts.KeyAccessKey: []byte("access"),
+				consts.KeySecretKey: []byte("secret"),
+			},
+		},
+		"Secret with custom keys": {
+			creds: apisv1alpha1.ProviderCredentials{
+				Source:                    xpv1.CredentialsSourceSecret,
+				CommonCredentialSelectors: secretRef(""),
+				Keys:                      &apisv1alpha1.CredentialKeys{AccessKey: "AK", SecretKey: "SK"},
+			},
+			wantData: map[string][]byte{
+				consts.KeyAccessKey: []byte("custom-access"),
+				consts.KeySecretKey: []byte("custom-secret"),
+			},
+		},
+		"Secret missing custom key": {
+			creds: apisv1alpha1.ProviderCredentials{
+				Source:                    xpv1.CredentialsSourceSecret,
+				CommonCredentialSelectors: secretRef(""),
+				Keys:                      &apisv1alpha1.CredentialKeys{AccessKey: "missing"},
+			},
+			wantErr: true,
+		},
+		"Secret with s3cfg": {
+			creds: apisv1alpha1.ProviderCredentials{
+				Source:                    xpv1.CredentialsSourceSecret,
+				CommonCredentialSelectors: secretRef("s3cfg"),
+				Format:                    apisv1alpha1.CredentialsFormatS3cfg,
+			},
+			wantData: map[string][]byte{
+				consts.KeyAccessKey: []byte("cfg-access"),
+				consts.KeySecretKey: []byte("cfg-secret"),
+			},
+		},
+		"Secret without secretRef": {
+			creds:   apisv1alpha1.ProviderCredentials{Source: xpv1.CredentialsSourceSecret},
+			wantErr: true,
+		},
+		"Filesystem directory with default keys": {
+			creds: apisv1alpha1.ProviderCredentials{
+				Source:                    xpv1.CredentialsSourceFilesystem,
+				CommonCredentialSelectors: xpv1.CommonCredentialSelectors{Fs: &xpv1.FsSelector{Path: dir}},
+			},
+			wantData: map[string][]byte{
+				consts.KeyAccessKey: []byte("fs-access"),
+				consts.KeySecretKey: []byte("fs-secret"),
+			},
+		},
+		"Filesystem s3cfg file": {
+			creds: apisv1alpha1.ProviderCredentials{
+				Source:                    xpv1.CredentialsSourceFilesystem,
+				CommonCredentialSelectors: xpv1.CommonCredentialSelectors{Fs: &xpv1.FsSelector{Path: filepath.Join(dir, "s3cfg")}},
+				Format:                    apisv1alpha1.CredentialsFormatS3cfg,
+			},
+			wantData: map[string][]byte{
+				consts.KeyAccessKey: []byte("cfg-access"),
+				consts.KeySecretKey: []byte("cfg-secret"),
+			},
+		},
+		"Filesystem missing file": {
+			creds: apisv1alpha1.ProviderCredentials{
+				Source:                    xpv1.CredentialsSourceFilesystem,
+				CommonCredentialSelectors: xpv1.CommonCredentialSelectors{Fs: &xpv1.FsSelector{Path: filepath.Join(dir, "missing")}},
+				Format:                    apisv1alpha1.CredentialsFormatS3cfg,
+			},
+			wantErr: true,
+		},
+		"Filesystem without fs": {
+			creds:   apisv1alpha1.ProviderCredentials{Source: xpv1.CredentialsSourceFilesystem},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := NewController(WithKubeClient(fake.NewClientBuilder().WithObjects(secret).Build()))
+			pc := &apisv1alpha1.ProviderConfig{Spec: apisv1alpha1.ProviderConfigSpec{Credentials: tc.creds}}
+
+			data, version, err := c.getStaticCredentials(context.Background(), pc)
+			if tc.wantErr {
+				assert.Error(t, err, "expected credentials to be rejected")
+
+				return
+			}
+			require.NoError(t, err, "unexpected error")
+			assert.Equal(t, tc.wantData, data, "unexpected credentials")
+			assert.NotEmpty(t, version, "expected a credentials version")
+		})
+	}
+}
+
+//nolint:paralleltest // t.Setenv cannot be used in parallel tests.
+func TestGetEnvironmentCredentials(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "env-access")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "env-secret")
+	t.Setenv("CEPH_ACCESS", "custom-access")
+	t.Setenv("CEPH_S3CFG", "[default]\naccess_key = cfg-access\nsecret_key = cfg-secret\n")
+
+	cases := map[string]struct {
+		creds    apisv1alpha1.ProviderCredentials
+		wantData map[string][]byte
+		wantErr  bool
+	}{
+		"Default variables": {
+			creds: apisv1alpha1.ProviderCredentials{Source: xpv1.CredentialsSourceEnvironment},
+			wantData: map[string][]byte{
+				consts.KeyAccessKey: []byte("env-access"),
+				consts.KeySecretKey: []byte("env-secret"),
+			},
+		},
+		"Custom variable": {
+			creds: apisv1alpha1.ProviderCredentials{
+				Source: xpv1.CredentialsSourceEnvironment,
+				Keys:   &apisv1alpha1.CredentialKeys{AccessKey: "CEPH_ACCESS"},
+			},
+			wantData: map[string][]byte{
+				consts.KeyAccessKey: []byte("custom-access"),
+				consts.KeySecretKey: []byte("env-secret"),
+			},
+		},
+		"Unset variable": {
+			creds: apisv1alpha1.ProviderCredentials{
+				Source: xpv1.CredentialsSourceEnvironment,
+				Keys:   &apisv1alpha1.CredentialKeys{SecretKey: "CEPH_UNSET"},
+			},
+			wantErr: true,
+		},
+		"s3cfg variable": {
+			creds: apisv1alpha1.ProviderCredentials{
+				Source:                    xpv1.CredentialsSourceEnvironment,
+				CommonCredentialSelectors: xpv1.CommonCredentialSelectors{Env: &xpv1.EnvSelector{Name: "CEPH_S3CFG"}},
+				Format:                    apisv1alpha1.CredentialsFormatS3cfg,
+			},
+			wantData: map[string][]byte{
+				consts.KeyAccessKey: []byte("cfg-access"),
+				consts.KeySecretKey: []byte("cfg-secret"),
+			},
+		},
+		"s3cfg without env": {
+			creds: apisv1alpha1.ProviderCredentials{
+				Source: xpv1.CredentialsSourceEnvironment,
+				Format: apisv1alpha1.CredentialsFormatS3cfg,
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			data, err := getEnvironmentCredentials(tc.creds)
+			if tc.wantErr {
+				assert.Error(t, err, "expected credentials to be rejected")
+
+				return
+			}
+			require.NoError(t, err, "unexpected error")
+			assert.Equal(t, tc.wantData, data, "unexpected credentials")
+		})
+	}
+}
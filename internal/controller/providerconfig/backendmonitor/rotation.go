@@ -0,0 +1,95 @@
+package backendmonitor
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/rgw"
+)
+
+// staticCredentials are the static credentials in use by a backend.
+type staticCredentials struct {
+	// version is the credentials version of the credentials.
+	version string
+	data    map[string][]byte
+}
+
+// newStaticClients creates the clients of the backend from the access key and
+// secret key of the Secret, Environment or Filesystem credentials source.
+//
+// When the keys change, such as when a Secret is rotated, the new keys are
+// verified against the backend first. If the backend rejects them, for example
+// because the Secret was updated before the new key was known to every RGW,
+// the clients are created from the previous keys instead until the new keys
+// are accepted.
+func (c *Controller) newStaticClients(ctx context.Context, backendName string, pc apisv1alpha1.ProviderConfigObject) (*backendClients, error) {
+	data, version, err := c.getStaticCredentials(ctx, pc)
+	if err != nil {
+		return nil, err
+	}
+
+	clients, err := c.newClientsFromCredentials(ctx, data, version, pc.GetSpec())
+	if err != nil {
+		return nil, err
+	}
+
+	c.staticMu.Lock()
+	previous, ok := c.staticCredentials[backendName]
+	c.staticMu.Unlock()
+
+	if ok && !credentialsEqual(previous.data, data) {
+		if err := rgw.VerifyCredentials(ctx, clients.s3Client); err != nil {
+			fallback, ferr := c.newClientsFromCredentials(ctx, previous.data, previous.version, pc.GetSpec())
+			if ferr != nil {
+				return nil, ferr
+			}
+			fallback.rejectedErr = err
+
+			return fallback, nil
+		}
+	}
+
+	c.staticMu.Lock()
+	c.staticCredentials[backendName] = staticCredentials{version: version, data: data}
+	c.staticMu.Unlock()
+
+	return clients, nil
+}
+
+func (c *Controller) newClientsFromCredentials(ctx context.Context, data map[string][]byte, version string, spec *apisv1alpha1.ProviderConfigSpec) (*backendClients, error) {
+	s3Client, err := rgw.NewS3Client(ctx, data, spec, c.s3Timeout, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateS3Client)
+	}
+
+	stsClient, err := rgw.NewSTSClient(ctx, data, spec, c.s3Timeout)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSTSClient)
+	}
+
+	return &backendClients{
+		s3Client:           s3Client,
+		stsClient:          stsClient,
+		adminClient:        rgw.NewAdminClient(data, spec, c.s3Timeout),
+		credentialsVersion: version,
+	}, nil
+}
+
+// credentialsEqual returns true if both credentials have the same access key
+// and secret key.
+func credentialsEqual(a, b map[string][]byte) bool {
+	return bytes.Equal(a[consts.KeyAccessKey], b[consts.KeyAccessKey]) &&
+		bytes.Equal(a[consts.KeySecretKey], b[consts.KeySecretKey])
+}
+
+// deleteStaticCredentials deletes the static credentials of a deleted backend.
+func (c *Controller) deleteStaticCredentials(backendName string) {
+	c.staticMu.Lock()
+	defer c.staticMu.Unlock()
+
+	delete(c.staticCredentials, backendName)
+}
@@ -0,0 +1,286 @@
+package backendmonitor
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	apisnsv1alpha1 "github.com/linode/provider-ceph/apis/namespaced/v1alpha1"
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/consts"
+)
+
+func TestProviderConfigsForSecret(t *testing.T) {
+	t.Parallel()
+
+	secretCreds := func(namespace, name string) apisv1alpha1.ProviderConfigSpec {
+		return apisv1alpha1.ProviderConfigSpec{Credentials: apisv1alpha1.ProviderCredentials{
+			Source: xpv1.CredentialsSourceSecret,
+			CommonCredentialSelectors: xpv1.CommonCredentialSelectors{SecretRef: &xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Namespace: namespace, Name: name},
+			}},
+		}}
+	}
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "tenant", Name: "creds"}}
+
+	cases := map[string]struct {
+		objects []client.Object
+		newList func() client.ObjectList
+		want    []reconcile.Request
+	}{
+		"ProviderConfig referencing the Secret": {
+			objects: []client.Object{
+				&apisv1alpha1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: "match"}, Spec: secretCreds("tenant", "creds")},
+				&apisv1alpha1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: "other-name"}, Spec: secretCreds("tenant", "other")},
+				&apisv1alpha1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: "other-namespace"}, Spec: secretCreds("other", "creds")},
+				&apisv1alpha1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: "environment"}, Spec: apisv1alpha1.ProviderConfigSpec{
+					Credentials: apisv1alpha1.ProviderCredentials{Source: xpv1.CredentialsSourceEnvironment},
+				}},
+			},
+			newList: func() client.ObjectList { return &apisv1alpha1.ProviderConfigList{} },
+			want:    []reconcile.Request{{NamespacedName: types.NamespacedName{Name: "match"}}},
+		},
+		"Namespaced ProviderConfig only references Secrets in its namespace": {
+			objects: []client.Object{
+				// The namespace of the secretRef is ignored.
+				&apisnsv1alpha1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Namespace: "tenant", Name: "match"}, Spec: secretCreds("other", "creds")},
+				&apisnsv1alpha1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "other-namespace"}, Spec: secretCreds("tenant", "creds")},
+			},
+			newList: func() client.ObjectList { return &apisnsv1alpha1.ProviderConfigList{} },
+			want:    []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: "tenant", Name: "match"}}},
+		},
+		"ClusterProviderConfig referencing the Secret": {
+			objects: []client.Object{
+				&apisnsv1alpha1.ClusterProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: "match"}, Spec: secretCreds("tenant", "creds")},
+			},
+			newList: func() client.ObjectList { return &apisnsv1alpha1.ClusterProviderConfigList{} },
+			want:    []reconcile.Request{{NamespacedName: types.NamespacedName{Name: "match"}}},
+		},
+		"No ProviderConfig referencing the Secret": {
+			objects: []client.Object{
+				&apisv1alpha1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: "other-name"}, Spec: secretCreds("tenant", "other")},
+			},
+			newList: func() client.ObjectList { return &apisv1alpha1.ProviderConfigList{} },
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			scheme := runtime.NewScheme()
+			scheme.AddKnownTypes(apisv1alpha1.SchemeGroupVersion,
+				&apisv1alpha1.ProviderConfig{},
+				&apisv1alpha1.ProviderConfigList{})
+			scheme.AddKnownTypes(apisnsv1alpha1.SchemeGroupVersion,
+				&apisnsv1alpha1.ProviderConfig{},
+				&apisnsv1alpha1.ProviderConfigList{},
+				&apisnsv1alpha1.ClusterProviderConfig{},
+				&apisnsv1alpha1.ClusterProviderConfigList{})
+
+			kubeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(tc.objects...).
+				WithIndex(&apisv1alpha1.ProviderConfig{}, secretRefIndex, secretRefIndexValue).
+				WithIndex(&apisnsv1alpha1.ProviderConfig{}, secretRefIndex, secretRefIndexValue).
+				WithIndex(&apisnsv1alpha1.ClusterProviderConfig{}, secretRefIndex, secretRefIndexValue).
+				Build()
+			c := NewController(WithKubeClient(kubeClient))
+
+			got := c.providerConfigsForSecret(context.Background(), tc.newList(), secret)
+			assert.Equal(t, tc.want, got, "unexpected requests")
+		})
+	}
+}
+
+func TestSecretDataChanged(t *testing.T) {
+	t.Parallel()
+
+	secret := func(labels map[string]string, data map[string][]byte) *corev1.Secret {
+		return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "tenant", Name: "creds", Labels: labels}, Data: data}
+	}
+
+	cases := map[string]struct {
+		oldSecret *corev1.Secret
+		newSecret *corev1.Secret
+		want      bool
+	}{
+		"Data changed": {
+			oldSecret: secret(nil, map[string][]byte{consts.KeyAccessKey: []byte("old")}),
+			newSecret: secret(nil, map[string][]byte{consts.KeyAccessKey: []byte("new")}),
+			want:      true,
+		},
+		"Key added": {
+			oldSecret: secret(nil, map[string][]byte{consts.KeyAccessKey: []byte("old")}),
+			newSecret: secret(nil, map[string][]byte{consts.KeyAccessKey: []byte("old"), consts.KeySecretKey: []byte("new")}),
+			want:      true,
+		},
+		"Only labels changed": {
+			oldSecret: secret(nil, map[string][]byte{consts.KeyAccessKey: []byte("old")}),
+			newSecret: secret(map[string]string{"label": "value"}, map[string][]byte{consts.KeyAccessKey: []byte("old")}),
+			want:      false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := secretDataChanged().Update(event.UpdateEvent{ObjectOld: tc.oldSecret, ObjectNew: tc.newSecret})
+			assert.Equal(t, tc.want, got, "unexpected predicate result")
+		})
+	}
+}
+
+// fakeRGW responds to S3 requests signed with a rejected access key with an
+// InvalidAccessKeyId error, and to all other requests with a NoSuchBucket error.
+type fakeRGW struct {
+	rejected map[string]bool
+	mu       sync.Mutex
+}
+
+func (f *fakeRGW) reject(accessKey string, rejected bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.rejected[accessKey] = rejected
+}
+
+func (f *fakeRGW) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// The Authorization header is "AWS4-HMAC-SHA256 Credential=<access key>/...".
+	_, credential, _ := strings.Cut(r.Header.Get("Authorization"), "Credential=")
+	accessKey, _, _ := strings.Cut(credential, "/")
+
+	w.Header().Set("Content-Type", "application/xml")
+	if f.rejected[accessKey] {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte("<Error><Code>InvalidAccessKeyId</Code></Error>"))
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNotFound)
+	_, _ = w.Write([]byte("<Error><Code>NoSuchBucket</Code></Error>"))
+}
+
+func TestNewStaticClientsRotation(t *testing.T) {
+	t.Parallel()
+
+	rgwServer := &fakeRGW{rejected: map[string]bool{}}
+	server := httptest.NewServer(rgwServer)
+	t.Cleanup(server.Close)
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "crossplane-system", Name: "creds"},
+		Data: map[string][]byte{
+			consts.KeyAccessKey: []byte("old-access"),
+			consts.KeySecretKey: []byte("old-secret"),
+		},
+	}
+	pc := &apisv1alpha1.ProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "pc"},
+		Spec: apisv1alpha1.ProviderConfigSpec{
+			HostBase: server.URL,
+			Credentials: apisv1alpha1.ProviderCredentials{
+				Source: xpv1.CredentialsSourceSecret,
+				CommonCredentialSelectors: xpv1.CommonCredentialSelectors{SecretRef: &xpv1.SecretKeySelector{
+					SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: "creds"},
+				}},
+			},
+		},
+	}
+
+	kube := fake.NewClientBuilder().WithObjects(secret).Build()
+	c := NewController(WithKubeClient(kube), WithS3Timeout(5*time.Second))
+	ctx := context.Background()
+
+	initial, err := c.newStaticClients(ctx, "pc", pc)
+	require.NoError(t, err, "unexpected error")
+	require.NoError(t, initial.rejectedErr, "initial credentials are not verified")
+
+	// The Secret is rotated before the backend accepts the new key.
+	rgwServer.reject("new-access", true)
+	secret.Data[consts.KeyAccessKey] = []byte("new-access")
+	secret.Data[consts.KeySecretKey] = []byte("new-secret")
+	require.NoError(t, kube.Update(ctx, secret), "unexpected error")
+
+	fallback, err := c.newStaticClients(ctx, "pc", pc)
+	require.NoError(t, err, "unexpected error")
+	assert.Error(t, fallback.rejectedErr, "new credentials should be rejected")
+	assert.Equal(t, initial.credentialsVersion, fallback.credentialsVersion, "previous credentials should be in use")
+
+	// Once the backend accepts the new key, it is used.
+	rgwServer.reject("new-access", false)
+
+	rotated, err := c.newStaticClients(ctx, "pc", pc)
+	require.NoError(t, err, "unexpected error")
+	assert.NoError(t, rotated.rejectedErr, "new credentials should be accepted")
+	assert.NotEqual(t, initial.credentialsVersion, rotated.credentialsVersion, "new credentials should be in use")
+
+	// The previous key is no longer used once the new key was accepted.
+	rgwServer.reject("new-access", true)
+	secret.Data[consts.KeyAccessKey] = []byte("newer-access")
+	require.NoError(t, kube.Update(ctx, secret), "unexpected error")
+
+	latest, err := c.newStaticClients(ctx, "pc", pc)
+	require.NoError(t, err, "unexpected error")
+	assert.NoError(t, latest.rejectedErr, "newer credentials should be accepted")
+}
+
+func TestSetCredentialsStatus(t *testing.T) {
+	t.Parallel()
+
+	pc := &apisv1alpha1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: "pc"}}
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypes(apisv1alpha1.SchemeGroupVersion,
+		&apisv1alpha1.ProviderConfig{},
+		&apisv1alpha1.ProviderConfigList{})
+	kube := fake.NewClientBuilder().WithScheme(scheme).WithObjects(pc).WithStatusSubresource(pc).Build()
+	c := NewController(WithKubeClient(kube))
+	ctx := context.Background()
+
+	steps := []struct {
+		condition      xpv1.Condition
+		version        string
+		wantGeneration int64
+		wantVersion    string
+	}{
+		{condition: v1alpha1.CredentialsLoaded(), version: "1", wantGeneration: 1, wantVersion: "1"},
+		{condition: v1alpha1.CredentialsLoaded(), version: "1", wantGeneration: 1, wantVersion: "1"},
+		// Errors do not change the credentials in use.
+		{condition: v1alpha1.CredentialsError(assert.AnError), wantGeneration: 1, wantVersion: "1"},
+		{condition: v1alpha1.CredentialsLoaded(), version: "2", wantGeneration: 2, wantVersion: "2"},
+	}
+
+	for i, step := range steps {
+		require.NoError(t, c.setCredentialsStatus(ctx, pc, step.condition, step.version), "unexpected error in step %d", i)
+
+		got := &apisv1alpha1.ProviderConfig{}
+		require.NoError(t, kube.Get(ctx, client.ObjectKeyFromObject(pc), got), "unexpected error in step %d", i)
+		require.NotNil(t, got.Status.Credentials, "expected credentials status in step %d", i)
+		assert.Equal(t, step.wantGeneration, got.Status.Credentials.Generation, "unexpected generation in step %d", i)
+		assert.Equal(t, step.wantVersion, got.Status.Credentials.Version, "unexpected version in step %d", i)
+		assert.Equal(t, step.condition.Reason, got.GetCondition(v1alpha1.TypeCredentials).Reason, "unexpected condition in step %d", i)
+	}
+}
@@ -0,0 +1,96 @@
+package backendmonitor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/rgw"
+)
+
+// webIdentityCredentials are the web identity credentials of a backend.
+type webIdentityCredentials struct {
+	// version is the credentials version of the backend for which the
+	// credentials were created.
+	version string
+	creds   aws.CredentialsProvider
+}
+
+// newWebIdentityClients creates the clients of the backend from temporary
+// credentials obtained by exchanging a projected ServiceAccount token with
+// the STS service of the backend.
+func (c *Controller) newWebIdentityClients(ctx context.Context, backendName string, pc apisv1alpha1.ProviderConfigObject) (*backendClients, error) {
+	spec := pc.GetSpec()
+	if spec.Credentials.WebIdentity == nil {
+		return nil, errors.New(errNoWebIdentity)
+	}
+
+	version := fmt.Sprintf("%s/%d", pc.GetUID(), pc.GetGeneration())
+	creds, err := c.getWebIdentityCredentials(ctx, backendName, version, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	s3Client, err := rgw.NewS3ClientWithCredentials(ctx, creds, spec, c.s3Timeout)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateS3Client)
+	}
+
+	stsClient, err := rgw.NewSTSClientWithCredentials(ctx, creds, spec, c.s3Timeout)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSTSClient)
+	}
+
+	// Temporary credentials do not carry RGW admin capabilities, so the
+	// backend has no admin client.
+	return &backendClients{
+		s3Client:           s3Client,
+		stsClient:          stsClient,
+		credentialsVersion: version,
+	}, nil
+}
+
+// getWebIdentityCredentials returns the web identity credentials of the
+// backend, which are only created again when the version changes. The
+// credentials are retrieved before they are returned, so that an invalid
+// token or role fails the reconcile of the ProviderConfig.
+func (c *Controller) getWebIdentityCredentials(ctx context.Context, backendName, version string, spec *apisv1alpha1.ProviderConfigSpec) (aws.CredentialsProvider, error) {
+	c.webIdentityMu.Lock()
+	existing, ok := c.webIdentityCredentials[backendName]
+	c.webIdentityMu.Unlock()
+
+	if !ok || existing.version != version {
+		// The token is exchanged for credentials without a signature.
+		stsClient, err := rgw.NewSTSClientWithCredentials(ctx, aws.AnonymousCredentials{}, spec, c.s3Timeout)
+		if err != nil {
+			return nil, errors.Wrap(err, errCreateSTSClient)
+		}
+
+		existing = webIdentityCredentials{
+			version: version,
+			creds:   rgw.NewWebIdentityCredentials(stsClient, spec.Credentials.WebIdentity.RoleARN, spec.Credentials.WebIdentity.TokenPath),
+		}
+
+		c.webIdentityMu.Lock()
+		c.webIdentityCredentials[backendName] = existing
+		c.webIdentityMu.Unlock()
+	}
+
+	if _, err := existing.creds.Retrieve(ctx); err != nil {
+		return nil, errors.Wrap(err, errWebIdentityCredentials)
+	}
+
+	return existing.creds, nil
+}
+
+// deleteWebIdentityCredentials deletes the web identity credentials of a
+// deleted backend.
+func (c *Controller) deleteWebIdentityCredentials(backendName string) {
+	c.webIdentityMu.Lock()
+	defer c.webIdentityMu.Unlock()
+
+	delete(c.webIdentityCredentials, backendName)
+}
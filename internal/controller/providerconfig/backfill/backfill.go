@@ -0,0 +1,104 @@
+package backfill
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/providerconfig"
+	"github.com/go-logr/logr"
+	"golang.org/x/time/rate"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+)
+
+const controllerName = "backfill-controller"
+
+// Controller backfills the existing buckets onto each backend added to the
+// backend store, ie it triggers the reconciliation of every Bucket which should
+// be placed on the backend but is not yet, unpausing it if it is auto-paused.
+// Buckets are backfilled at a limited rate and the progress is reported in the
+// status of the ProviderConfig of the backend.
+type Controller struct {
+	kubeClientUncached client.Client
+	kubeClientCached   client.Client
+	backendStore       *backendstore.BackendStore
+	limiter            *rate.Limiter
+	log                logr.Logger
+	autoPauseBucket    bool
+}
+
+func NewController(options ...func(*Controller)) *Controller {
+	r := &Controller{
+		limiter: rate.NewLimiter(rate.Inf, 1),
+	}
+	for _, o := range options {
+		o(r)
+	}
+
+	return r
+}
+
+func WithKubeClientUncached(k client.Client) func(*Controller) {
+	return func(r *Controller) {
+		r.kubeClientUncached = k
+	}
+}
+
+func WithKubeClientCached(k client.Client) func(*Controller) {
+	return func(r *Controller) {
+		r.kubeClientCached = k
+	}
+}
+
+func WithLogger(l logr.Logger) func(*Controller) {
+	return func(r *Controller) {
+		r.log = l.WithValues(apisv1alpha1.ProviderConfigGroupKind, providerconfig.ControllerName(controllerName))
+	}
+}
+
+func WithBackendStore(b *backendstore.BackendStore) func(*Controller) {
+	return func(r *Controller) {
+		r.backendStore = b
+	}
+}
+
+func WithAutoPause(autoPause *bool) func(*Controller) {
+	return func(r *Controller) {
+		r.autoPauseBucket = *autoPause
+	}
+}
+
+// WithRate limits the number of Buckets backfilled per second across all backends.
+func WithRate(bucketsPerSecond float64) func(*Controller) {
+	return func(r *Controller) {
+		r.limiter = rate.NewLimiter(rate.Limit(bucketsPerSecond), 1)
+	}
+}
+
+func (c *Controller) SetupWithManager(mgr ctrl.Manager) error {
+	// Backends rather than ProviderConfigs are reconciled, as a backend is
+	// only added to the backend store once its ProviderConfig has been
+	// reconciled by the backend monitor controller. Backfills are not run
+	// concurrently, so that they share the rate limit in turn.
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(controllerName).
+		WatchesRawSource(source.Func(func(_ context.Context, q workqueue.TypedRateLimitingInterface[reconcile.Request]) error {
+			c.backendStore.OnBackendAdded(func(backendName string) {
+				namespace, name := backendstore.SplitBackendName(backendName)
+				q.Add(reconcile.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}})
+			})
+
+			return nil
+		})).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: 1,
+		}.ForControllerRuntime()).
+		Complete(c)
+}
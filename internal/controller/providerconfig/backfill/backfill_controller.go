@@ -0,0 +1,272 @@
+package backfill
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"go.opentelemetry.io/otel"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	nsv1alpha1 "github.com/linode/provider-ceph/apis/namespaced/provider-ceph/v1alpha1"
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/otel/traces"
+	"github.com/linode/provider-ceph/internal/utils"
+)
+
+const (
+	errGetProviderConfig    = "failed to get ProviderConfig"
+	errListBuckets          = "failed to list Buckets"
+	errUpdateBackfillStatus = "failed to update backfill status of ProviderConfig"
+
+	// statusBatchSize is the number of Buckets backfilled between updates of
+	// the progress in the status of the ProviderConfig.
+	statusBatchSize = 50
+
+	// retryAfter is the delay before the Buckets which could not be
+	// backfilled are retried.
+	retryAfter = time.Minute
+)
+
+// bucket is the part of a Bucket of either scope which determines whether it is
+// backfilled onto a backend.
+type bucket struct {
+	client.Object
+	providers []string
+	placement *v1alpha1.PlacementPolicy
+	disabled  bool
+	autoPause bool
+	backends  v1alpha1.Backends
+}
+
+func (c *Controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "backfill.Controller.Reconcile")
+	defer span.End()
+	ctx, log := traces.InjectTraceAndLogger(ctx, c.log)
+
+	backendName := backendstore.BackendName(req.Namespace, req.Name)
+	if !c.backendStore.BackendExists(backendName) {
+		// The backend has been removed since it was added, so there is nothing to backfill.
+		return ctrl.Result{}, nil
+	}
+
+	pc, err := utils.GetProviderConfig(ctx, c.kubeClientCached, backendName)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		err = errors.Wrap(err, errGetProviderConfig)
+		traces.SetAndRecordError(span, err)
+
+		return ctrl.Result{}, err
+	}
+
+	prev := pc.GetStatus().Backfill
+	if prev != nil && prev.CompletionTime != nil {
+		log.V(1).Info("Backend has already been backfilled", consts.KeyBackendName, backendName)
+
+		return ctrl.Result{}, nil
+	}
+
+	if pc.GetSpec().Mode.IsCordoned() {
+		// No new buckets are placed on a cordoned backend, so the backfill
+		// is postponed until the backend is active.
+		log.V(1).Info("Backend is cordoned - postponing backfill", consts.KeyBackendName, backendName)
+
+		return ctrl.Result{RequeueAfter: retryAfter}, nil
+	}
+
+	buckets, err := c.listBuckets(ctx, backendName)
+	if err != nil {
+		err = errors.Wrap(err, errListBuckets)
+		traces.SetAndRecordError(span, err)
+
+		return ctrl.Result{}, err
+	}
+
+	status := &apisv1alpha1.BackfillStatus{StartTime: &metav1.Time{Time: time.Now()}}
+	if prev != nil && prev.StartTime != nil {
+		status.StartTime = prev.StartTime
+	}
+
+	pending := []bucket{}
+	for _, b := range buckets {
+		if !c.shouldBePlaced(b, backendName) {
+			continue
+		}
+		status.Total++
+
+		if isBackfilled(b, backendName) {
+			status.Backfilled++
+
+			continue
+		}
+		pending = append(pending, b)
+	}
+
+	log.Info("Backfilling buckets onto backend", consts.KeyBackendName, backendName, "buckets", len(pending))
+
+	if err := c.updateBackfillStatus(ctx, pc, status); err != nil {
+		err = errors.Wrap(err, errUpdateBackfillStatus)
+		traces.SetAndRecordError(span, err)
+
+		return ctrl.Result{}, err
+	}
+
+	for i, b := range pending {
+		if err := c.limiter.Wait(ctx); err != nil {
+			traces.SetAndRecordError(span, err)
+
+			return ctrl.Result{}, err
+		}
+
+		if err := c.backfillBucket(ctx, b, backendName); err != nil {
+			log.Info("Failed to backfill bucket onto backend", consts.KeyBucketName, b.GetName(), consts.KeyBackendName, backendName, "error", err.Error())
+		} else {
+			status.Backfilled++
+		}
+
+		if (i+1)%statusBatchSize == 0 && i+1 < len(pending) {
+			if err := c.updateBackfillStatus(ctx, pc, status); err != nil {
+				log.Info("Failed to update backfill progress of backend", consts.KeyBackendName, backendName, "error", err.Error())
+			}
+		}
+	}
+
+	if status.Backfilled == status.Total {
+		status.CompletionTime = &metav1.Time{Time: time.Now()}
+	}
+
+	if err := c.updateBackfillStatus(ctx, pc, status); err != nil {
+		err = errors.Wrap(err, errUpdateBackfillStatus)
+		traces.SetAndRecordError(span, err)
+
+		return ctrl.Result{}, err
+	}
+
+	if status.CompletionTime == nil {
+		return ctrl.Result{RequeueAfter: retryAfter}, nil
+	}
+
+	log.Info("Backfilled buckets onto backend", consts.KeyBackendName, backendName, "buckets", status.Total)
+
+	return ctrl.Result{}, nil
+}
+
+// shouldBePlaced returns true if the bucket should be placed on the backend, ie it
+// is placed on all backends available to it, it has not been disabled by label
+// on the backend and it is reconciled.
+func (c *Controller) shouldBePlaced(b bucket, backendName string) bool {
+	if meta.WasDeleted(b) || b.disabled || len(b.providers) != 0 || b.placement != nil {
+		return false
+	}
+
+	bucketLabels := b.GetLabels()
+	if status, ok := bucketLabels[utils.GetBackendLabel(backendName)]; ok && status != consts.TrueStr {
+		return false
+	}
+
+	// Buckets paused by the user rather than by auto pause are not reconciled.
+	paused := bucketLabels[meta.AnnotationKeyReconciliationPaused] == consts.TrueStr
+
+	return !paused || c.autoPauseBucket || b.autoPause
+}
+
+// isBackfilled returns true if the bucket is available on the backend or its
+// reconciliation has already been triggered for the backend.
+func isBackfilled(b bucket, backendName string) bool {
+	if backend, ok := b.backends[backendName]; ok && backend != nil && backend.BucketCondition.Equal(xpv1.Available()) {
+		return true
+	}
+
+	return b.GetAnnotations()[v1alpha1.BackfillAnnotation] == backendName
+}
+
+// backfillBucket triggers the reconciliation of the bucket by annotating it with
+// the backend and unpausing it.
+func (c *Controller) backfillBucket(ctx context.Context, b bucket, backendName string) error {
+	patch := client.MergeFrom(b.DeepCopyObject().(client.Object)) //nolint:forcetypeassert // Type is guaranteed.
+
+	meta.AddAnnotations(b, map[string]string{v1alpha1.BackfillAnnotation: backendName})
+	if bucketLabels := b.GetLabels(); bucketLabels[meta.AnnotationKeyReconciliationPaused] == consts.TrueStr {
+		bucketLabels[meta.AnnotationKeyReconciliationPaused] = ""
+	}
+
+	return resource.Ignore(kerrors.IsNotFound, c.kubeClientCached.Patch(ctx, b.Object, patch))
+}
+
+// listBuckets lists the Buckets which may be placed on the backend. Cluster scoped
+// Buckets can only be placed on backends of cluster scoped ProviderConfigs, whereas
+// namespaced Buckets can be placed on backends of ClusterProviderConfigs and of
+// ProviderConfigs in their namespace. Paused Buckets are not cached, so the Buckets
+// are listed from the API server.
+func (c *Controller) listBuckets(ctx context.Context, backendName string) ([]bucket, error) {
+	buckets := []bucket{}
+
+	namespace, _ := backendstore.SplitBackendName(backendName)
+	if namespace == "" {
+		list := &v1alpha1.BucketList{}
+		if err := c.kubeClientUncached.List(ctx, list); err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			b := &list.Items[i]
+			buckets = append(buckets, bucket{
+				Object:    b,
+				providers: b.Spec.Providers,
+				placement: b.Spec.Placement,
+				disabled:  b.Spec.Disabled,
+				autoPause: b.Spec.AutoPause,
+				backends:  b.Status.AtProvider.Backends,
+			})
+		}
+	}
+
+	// An empty namespace lists the namespaced Buckets in all namespaces.
+	nsList := &nsv1alpha1.BucketList{}
+	if err := c.kubeClientUncached.List(ctx, nsList, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+	for i := range nsList.Items {
+		b := &nsList.Items[i]
+		buckets = append(buckets, bucket{
+			Object:    b,
+			providers: b.Spec.Providers,
+			placement: b.Spec.Placement,
+			disabled:  b.Spec.Disabled,
+			autoPause: b.Spec.AutoPause,
+			backends:  b.Status.AtProvider.Backends,
+		})
+	}
+
+	return buckets, nil
+}
+
+// updateBackfillStatus persists the backfill status in the latest version of the ProviderConfig.
+func (c *Controller) updateBackfillStatus(ctx context.Context, pc apisv1alpha1.ProviderConfigObject, status *apisv1alpha1.BackfillStatus) error {
+	status.Progress = fmt.Sprintf("%d of %d buckets backfilled", status.Backfilled, status.Total)
+
+	err := retry.OnError(retry.DefaultRetry, resource.IsAPIError, func() error {
+		if err := c.kubeClientCached.Get(ctx, client.ObjectKeyFromObject(pc), pc); err != nil {
+			return err
+		}
+
+		pcCopy, _ := pc.DeepCopyObject().(client.Object)
+		pc.GetStatus().Backfill = status.DeepCopy()
+
+		return c.kubeClientCached.Status().Patch(ctx, pc, client.MergeFrom(pcCopy))
+	})
+
+	return resource.Ignore(kerrors.IsNotFound, err)
+}
@@ -0,0 +1,317 @@
+package backfill
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	nsv1alpha1 "github.com/linode/provider-ceph/apis/namespaced/provider-ceph/v1alpha1"
+	apisnsv1alpha1 "github.com/linode/provider-ceph/apis/namespaced/v1alpha1"
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/backendstore/backendstorefakes"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/utils"
+)
+
+//nolint:maintidx // Function requires numerous checks.
+func TestReconcile(t *testing.T) {
+	t.Parallel()
+	backendName := "new-backend"
+	namespacedBackendName := backendstore.BackendName("team-a", backendName)
+
+	paused := map[string]string{meta.AnnotationKeyReconciliationPaused: consts.TrueStr}
+	available := v1alpha1.Backends{backendName: &v1alpha1.BackendInfo{BucketCondition: xpv1.Available()}}
+
+	type fields struct {
+		backends  []string
+		objects   []client.Object
+		autoPause bool
+	}
+
+	type want struct {
+		res ctrl.Result
+		// backfilled are the names of the Buckets annotated with the backend.
+		backfilled []string
+		// unpaused are the names of the Buckets which have been unpaused.
+		unpaused []string
+		status   *apisv1alpha1.BackfillStatus
+	}
+
+	cases := map[string]struct {
+		fields fields
+		req    ctrl.Request
+		want   want
+	}{
+		"Backend has been removed": {
+			fields: fields{
+				objects: []client.Object{
+					&apisv1alpha1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: backendName}},
+					&v1alpha1.Bucket{ObjectMeta: metav1.ObjectMeta{Name: "bucket"}},
+				},
+			},
+			req: ctrl.Request{NamespacedName: types.NamespacedName{Name: backendName}},
+			want: want{
+				backfilled: []string{},
+				unpaused:   []string{},
+			},
+		},
+		"Backend has already been backfilled": {
+			fields: fields{
+				backends: []string{backendName},
+				objects: []client.Object{
+					&apisv1alpha1.ProviderConfig{
+						ObjectMeta: metav1.ObjectMeta{Name: backendName},
+						Status: apisv1alpha1.ProviderConfigStatus{
+							Backfill: &apisv1alpha1.BackfillStatus{
+								Total:          0,
+								Progress:       "0 of 0 buckets backfilled",
+								StartTime:      &metav1.Time{Time: time.Now()},
+								CompletionTime: &metav1.Time{Time: time.Now()},
+							},
+						},
+					},
+					&v1alpha1.Bucket{ObjectMeta: metav1.ObjectMeta{Name: "bucket"}},
+				},
+			},
+			req: ctrl.Request{NamespacedName: types.NamespacedName{Name: backendName}},
+			want: want{
+				backfilled: []string{},
+				unpaused:   []string{},
+				status: &apisv1alpha1.BackfillStatus{
+					Total:    0,
+					Progress: "0 of 0 buckets backfilled",
+				},
+			},
+		},
+		"Backfill of a cordoned backend is postponed": {
+			fields: fields{
+				backends: []string{backendName},
+				objects: []client.Object{
+					&apisv1alpha1.ProviderConfig{
+						ObjectMeta: metav1.ObjectMeta{Name: backendName},
+						Spec:       apisv1alpha1.ProviderConfigSpec{Mode: apisv1alpha1.BackendModeCordoned},
+					},
+					&v1alpha1.Bucket{ObjectMeta: metav1.ObjectMeta{Name: "bucket"}},
+				},
+			},
+			req: ctrl.Request{NamespacedName: types.NamespacedName{Name: backendName}},
+			want: want{
+				res:        ctrl.Result{RequeueAfter: retryAfter},
+				backfilled: []string{},
+				unpaused:   []string{},
+			},
+		},
+		"Buckets which should be placed on the backend are backfilled": {
+			fields: fields{
+				backends: []string{backendName},
+				objects: []client.Object{
+					&apisv1alpha1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: backendName}},
+					&v1alpha1.Bucket{ObjectMeta: metav1.ObjectMeta{Name: "bucket"}},
+					&v1alpha1.Bucket{
+						ObjectMeta: metav1.ObjectMeta{Name: "available-bucket"},
+						Status:     v1alpha1.BucketStatus{AtProvider: v1alpha1.BucketObservation{Backends: available}},
+					},
+					&v1alpha1.Bucket{
+						ObjectMeta: metav1.ObjectMeta{Name: "providers-bucket"},
+						Spec:       v1alpha1.BucketSpec{Providers: []string{"other-backend"}},
+					},
+					&v1alpha1.Bucket{
+						ObjectMeta: metav1.ObjectMeta{Name: "placement-bucket"},
+						Spec:       v1alpha1.BucketSpec{Placement: &v1alpha1.PlacementPolicy{}},
+					},
+					&v1alpha1.Bucket{
+						ObjectMeta: metav1.ObjectMeta{Name: "disabled-bucket"},
+						Spec:       v1alpha1.BucketSpec{Disabled: true},
+					},
+					&v1alpha1.Bucket{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:   "disabled-on-backend-bucket",
+							Labels: map[string]string{utils.GetBackendLabel(backendName): "false"},
+						},
+					},
+					&v1alpha1.Bucket{
+						ObjectMeta: metav1.ObjectMeta{Name: "user-paused-bucket", Labels: paused},
+					},
+					&v1alpha1.Bucket{
+						ObjectMeta: metav1.ObjectMeta{Name: "auto-paused-bucket", Labels: paused},
+						Spec:       v1alpha1.BucketSpec{AutoPause: true},
+					},
+					&nsv1alpha1.Bucket{ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "namespaced-bucket"}},
+				},
+			},
+			req: ctrl.Request{NamespacedName: types.NamespacedName{Name: backendName}},
+			want: want{
+				backfilled: []string{"auto-paused-bucket", "bucket", "namespaced-bucket"},
+				unpaused:   []string{"auto-paused-bucket"},
+				status: &apisv1alpha1.BackfillStatus{
+					Total:      4,
+					Backfilled: 4,
+					Progress:   "4 of 4 buckets backfilled",
+				},
+			},
+		},
+		"Paused Buckets are backfilled with auto pause enabled": {
+			fields: fields{
+				backends:  []string{backendName},
+				autoPause: true,
+				objects: []client.Object{
+					&apisv1alpha1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: backendName}},
+					&v1alpha1.Bucket{
+						ObjectMeta: metav1.ObjectMeta{Name: "paused-bucket", Labels: paused},
+					},
+				},
+			},
+			req: ctrl.Request{NamespacedName: types.NamespacedName{Name: backendName}},
+			want: want{
+				backfilled: []string{"paused-bucket"},
+				unpaused:   []string{"paused-bucket"},
+				status: &apisv1alpha1.BackfillStatus{
+					Total:      1,
+					Backfilled: 1,
+					Progress:   "1 of 1 buckets backfilled",
+				},
+			},
+		},
+		"Buckets annotated with the backend are not backfilled again": {
+			fields: fields{
+				backends: []string{backendName},
+				objects: []client.Object{
+					&apisv1alpha1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: backendName}},
+					&v1alpha1.Bucket{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:        "bucket",
+							Labels:      paused,
+							Annotations: map[string]string{v1alpha1.BackfillAnnotation: backendName},
+						},
+						Spec: v1alpha1.BucketSpec{AutoPause: true},
+					},
+				},
+			},
+			req: ctrl.Request{NamespacedName: types.NamespacedName{Name: backendName}},
+			want: want{
+				backfilled: []string{"bucket"},
+				unpaused:   []string{},
+				status: &apisv1alpha1.BackfillStatus{
+					Total:      1,
+					Backfilled: 1,
+					Progress:   "1 of 1 buckets backfilled",
+				},
+			},
+		},
+		"Only namespaced Buckets in the namespace of a ProviderConfig are backfilled": {
+			fields: fields{
+				backends: []string{namespacedBackendName},
+				objects: []client.Object{
+					&apisnsv1alpha1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: backendName}},
+					&v1alpha1.Bucket{ObjectMeta: metav1.ObjectMeta{Name: "cluster-bucket"}},
+					&nsv1alpha1.Bucket{ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "bucket"}},
+					&nsv1alpha1.Bucket{ObjectMeta: metav1.ObjectMeta{Namespace: "team-b", Name: "other-bucket"}},
+				},
+			},
+			req: ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "team-a", Name: backendName}},
+			want: want{
+				backfilled: []string{"bucket"},
+				unpaused:   []string{},
+				status: &apisv1alpha1.BackfillStatus{
+					Total:      1,
+					Backfilled: 1,
+					Progress:   "1 of 1 buckets backfilled",
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			scheme := runtime.NewScheme()
+			scheme.AddKnownTypes(apisv1alpha1.SchemeGroupVersion,
+				&apisv1alpha1.ProviderConfig{},
+				&apisv1alpha1.ProviderConfigList{})
+			scheme.AddKnownTypes(apisnsv1alpha1.SchemeGroupVersion,
+				&apisnsv1alpha1.ProviderConfig{},
+				&apisnsv1alpha1.ProviderConfigList{})
+			scheme.AddKnownTypes(v1alpha1.SchemeGroupVersion,
+				&v1alpha1.Bucket{},
+				&v1alpha1.BucketList{})
+			scheme.AddKnownTypes(nsv1alpha1.SchemeGroupVersion,
+				&nsv1alpha1.Bucket{},
+				&nsv1alpha1.BucketList{})
+
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(tc.fields.objects...).
+				WithStatusSubresource(tc.fields.objects...).
+				Build()
+
+			bs := backendstore.NewBackendStore()
+			for _, b := range tc.fields.backends {
+				bs.AddOrUpdateBackend(b, &backendstorefakes.FakeS3Client{}, nil, apisv1alpha1.HealthStatusHealthy)
+			}
+
+			r := NewController(
+				WithAutoPause(&tc.fields.autoPause),
+				WithBackendStore(bs),
+				WithKubeClientUncached(c),
+				WithKubeClientCached(c),
+				WithLogger(logr.Discard()))
+
+			got, err := r.Reconcile(context.Background(), tc.req)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want.res, got, "unexpected result")
+
+			backfilled, unpaused := []string{}, []string{}
+			bl := &v1alpha1.BucketList{}
+			require.NoError(t, c.List(context.Background(), bl))
+			nsbl := &nsv1alpha1.BucketList{}
+			require.NoError(t, c.List(context.Background(), nsbl))
+			buckets := []client.Object{}
+			for i := range bl.Items {
+				buckets = append(buckets, &bl.Items[i])
+			}
+			for i := range nsbl.Items {
+				buckets = append(buckets, &nsbl.Items[i])
+			}
+			for _, b := range buckets {
+				if b.GetAnnotations()[v1alpha1.BackfillAnnotation] == backendstore.BackendName(tc.req.Namespace, tc.req.Name) {
+					backfilled = append(backfilled, b.GetName())
+				}
+				if value, ok := b.GetLabels()[meta.AnnotationKeyReconciliationPaused]; ok && value == "" {
+					unpaused = append(unpaused, b.GetName())
+				}
+			}
+			assert.ElementsMatch(t, tc.want.backfilled, backfilled, "unexpected backfilled buckets")
+			assert.ElementsMatch(t, tc.want.unpaused, unpaused, "unexpected unpaused buckets")
+
+			pc, err := utils.GetProviderConfig(context.Background(), c, backendstore.BackendName(tc.req.Namespace, tc.req.Name))
+			require.NoError(t, err)
+			status := pc.GetStatus().Backfill
+			if tc.want.status == nil {
+				assert.Nil(t, status, "unexpected backfill status")
+
+				return
+			}
+			require.NotNil(t, status, "missing backfill status")
+			assert.NotNil(t, status.StartTime, "missing start time")
+			assert.NotNil(t, status.CompletionTime, "missing completion time")
+			assert.Equal(t, tc.want.status.Total, status.Total, "unexpected total")
+			assert.Equal(t, tc.want.status.Backfilled, status.Backfilled, "unexpected backfilled")
+			assert.Equal(t, tc.want.status.Progress, status.Progress, "unexpected progress")
+		})
+	}
+}
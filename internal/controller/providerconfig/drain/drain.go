@@ -0,0 +1,100 @@
+package drain
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/providerconfig"
+	"github.com/go-logr/logr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	apisnsv1alpha1 "github.com/linode/provider-ceph/apis/namespaced/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+)
+
+const controllerName = "drain-controller"
+
+// Controller drains the backends of ProviderConfigs in the Draining mode, ie
+// it moves each bucket on the backend to other backends according to its
+// placement and then disables the backend by label on the Bucket. The progress
+// is reported in the status of the ProviderConfig.
+type Controller struct {
+	kubeClientUncached client.Client
+	kubeClientCached   client.Client
+	backendStore       *backendstore.BackendStore
+	log                logr.Logger
+	autoPauseBucket    bool
+}
+
+func NewController(options ...func(*Controller)) *Controller {
+	r := &Controller{}
+	for _, o := range options {
+		o(r)
+	}
+
+	return r
+}
+
+func WithKubeClientUncached(k client.Client) func(*Controller) {
+	return func(r *Controller) {
+		r.kubeClientUncached = k
+	}
+}
+
+func WithKubeClientCached(k client.Client) func(*Controller) {
+	return func(r *Controller) {
+		r.kubeClientCached = k
+	}
+}
+
+func WithLogger(l logr.Logger) func(*Controller) {
+	return func(r *Controller) {
+		r.log = l.WithValues(apisv1alpha1.ProviderConfigGroupKind, providerconfig.ControllerName(controllerName))
+	}
+}
+
+func WithBackendStore(b *backendstore.BackendStore) func(*Controller) {
+	return func(r *Controller) {
+		r.backendStore = b
+	}
+}
+
+func WithAutoPause(autoPause *bool) func(*Controller) {
+	return func(r *Controller) {
+		r.autoPauseBucket = *autoPause
+	}
+}
+
+func (c *Controller) SetupWithManager(mgr ctrl.Manager) error {
+	// Only changes of the spec of a ProviderConfig start or stop a drain. A
+	// drain in progress is requeued until it is complete.
+	if err := ctrl.NewControllerManagedBy(mgr).
+		Named(controllerName).
+		For(&apisv1alpha1.ProviderConfig{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(c); err != nil {
+		return err
+	}
+
+	if err := ctrl.NewControllerManagedBy(mgr).
+		Named(controllerName+"-namespaced").
+		For(&apisnsv1alpha1.ProviderConfig{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(c.forKind(func() apisv1alpha1.ProviderConfigObject { return &apisnsv1alpha1.ProviderConfig{} })); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(controllerName+"-cluster").
+		For(&apisnsv1alpha1.ClusterProviderConfig{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(c.forKind(func() apisv1alpha1.ProviderConfigObject { return &apisnsv1alpha1.ClusterProviderConfig{} }))
+}
+
+// forKind returns a reconciler for the ProviderConfig kind created by newProviderConfig.
+func (c *Controller) forKind(newProviderConfig func() apisv1alpha1.ProviderConfigObject) reconcile.Reconciler {
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		return c.reconcile(ctx, req, newProviderConfig())
+	})
+}
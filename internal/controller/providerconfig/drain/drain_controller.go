@@ -0,0 +1,334 @@
+package drain
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"go.opentelemetry.io/otel"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	nsv1alpha1 "github.com/linode/provider-ceph/apis/namespaced/provider-ceph/v1alpha1"
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/otel/traces"
+	"github.com/linode/provider-ceph/internal/utils"
+)
+
+const (
+	errGetProviderConfig  = "failed to get ProviderConfig"
+	errListBuckets        = "failed to list Buckets"
+	errUpdateDrainStatus  = "failed to update drain status of ProviderConfig"
+	errDisableBackend     = "failed to disable backend on Bucket"
+	errMoveBucket         = "failed to move Bucket"
+	errUpdateBucketStatus = "failed to update Bucket status"
+
+	// retryAfter is the delay before the buckets which have not been drained
+	// yet are checked again.
+	retryAfter = time.Minute
+)
+
+// bucket is the part of a Bucket of either scope which determines how it is
+// drained from a backend. The status points to that of the Bucket.
+type bucket struct {
+	client.Object
+	providers []string
+	placement *v1alpha1.PlacementPolicy
+	autoPause bool
+	status    *v1alpha1.BucketStatus
+}
+
+func (c *Controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return c.reconcile(ctx, req, &apisv1alpha1.ProviderConfig{})
+}
+
+//nolint:cyclop // Buckets are drained in several steps.
+func (c *Controller) reconcile(ctx context.Context, req ctrl.Request, pc apisv1alpha1.ProviderConfigObject) (ctrl.Result, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "drain.Controller.Reconcile")
+	defer span.End()
+	ctx, log := traces.InjectTraceAndLogger(ctx, c.log)
+
+	backendName := backendstore.BackendName(req.Namespace, req.Name)
+
+	if err := c.kubeClientCached.Get(ctx, req.NamespacedName, pc); err != nil {
+		if kerrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		err = errors.Wrap(err, errGetProviderConfig)
+		traces.SetAndRecordError(span, err)
+
+		return ctrl.Result{}, err
+	}
+
+	// The backend of a shadowed ClusterProviderConfig belongs to the
+	// ProviderConfig with the same name, so it is not drained. It is checked
+	// again after a while in case the ProviderConfig is deleted.
+	shadowed, err := utils.IsShadowed(ctx, c.kubeClientCached, pc, req.Name)
+	if err != nil {
+		err = errors.Wrap(err, errGetProviderConfig)
+		traces.SetAndRecordError(span, err)
+
+		return ctrl.Result{}, err
+	}
+	if shadowed {
+		return ctrl.Result{RequeueAfter: retryAfter}, nil
+	}
+
+	prev := pc.GetStatus().Drain
+	if pc.GetSpec().Mode != apisv1alpha1.BackendModeDraining {
+		if prev == nil {
+			return ctrl.Result{}, nil
+		}
+
+		// The backend is no longer draining, so the progress of the drain is
+		// obsolete. The backend remains disabled on the drained buckets.
+		log.Info("Backend is no longer draining", consts.KeyBackendName, backendName)
+		if err := c.updateDrainStatus(ctx, pc, nil); err != nil {
+			err = errors.Wrap(err, errUpdateDrainStatus)
+			traces.SetAndRecordError(span, err)
+
+			return ctrl.Result{}, err
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	if prev != nil && prev.CompletionTime != nil {
+		log.V(1).Info("Backend has already been drained", consts.KeyBackendName, backendName)
+
+		return ctrl.Result{}, nil
+	}
+
+	buckets, err := c.listBuckets(ctx, backendName)
+	if err != nil {
+		err = errors.Wrap(err, errListBuckets)
+		traces.SetAndRecordError(span, err)
+
+		return ctrl.Result{}, err
+	}
+
+	status := &apisv1alpha1.DrainStatus{StartTime: &metav1.Time{Time: time.Now()}}
+	if prev != nil && prev.StartTime != nil {
+		status.StartTime = prev.StartTime
+	}
+
+	backendLabel := utils.GetBackendLabel(backendName)
+	for _, b := range buckets {
+		value, ok := b.GetLabels()[backendLabel]
+		if !ok || meta.WasDeleted(b) {
+			continue
+		}
+		status.Total++
+
+		switch {
+		case value != consts.TrueStr:
+			status.Drained++
+		case c.isPinned(b, backendName):
+			status.Blocked++
+		case c.isMoved(b, backendName):
+			if err := c.disableBackend(ctx, b, backendName); err != nil {
+				log.Info("Failed to disable backend on bucket", consts.KeyBucketName, b.GetName(), consts.KeyBackendName, backendName, "error", err.Error())
+
+				continue
+			}
+			log.Info("Drained bucket from backend", consts.KeyBucketName, b.GetName(), consts.KeyBackendName, backendName)
+			status.Drained++
+		default:
+			if err := c.moveBucket(ctx, b, backendName); err != nil {
+				log.Info("Failed to move bucket from backend", consts.KeyBucketName, b.GetName(), consts.KeyBackendName, backendName, "error", err.Error())
+			}
+		}
+	}
+
+	// Pinned buckets cannot be moved, so they remain on the backend once the
+	// drain is complete.
+	if status.Drained+status.Blocked == status.Total {
+		status.CompletionTime = &metav1.Time{Time: time.Now()}
+	}
+
+	if err := c.updateDrainStatus(ctx, pc, status); err != nil {
+		err = errors.Wrap(err, errUpdateDrainStatus)
+		traces.SetAndRecordError(span, err)
+
+		return ctrl.Result{}, err
+	}
+
+	if status.CompletionTime == nil {
+		log.V(1).Info("Draining backend", consts.KeyBackendName, backendName, "progress", status.Progress)
+
+		return ctrl.Result{RequeueAfter: retryAfter}, nil
+	}
+
+	log.Info("Drained backend", consts.KeyBackendName, backendName, "buckets", status.Total, "pinned", status.Blocked)
+
+	return ctrl.Result{}, nil
+}
+
+// isPinned returns true if the backend is specified as one of the providers of
+// the bucket, in which case the bucket cannot be moved to other backends. The
+// providers of a namespaced Bucket refer to a ProviderConfig in the namespace of
+// the Bucket or, if there is none with that name, to a ClusterProviderConfig,
+// but never to a legacy ProviderConfig.
+func (c *Controller) isPinned(b bucket, backendName string) bool {
+	namespace, name := backendstore.SplitBackendName(backendName)
+	if !slices.Contains(b.providers, name) {
+		return false
+	}
+
+	switch {
+	case namespace != "":
+		return namespace == b.GetNamespace()
+	case b.GetNamespace() != "":
+		return !c.backendStore.IsBackendLegacy(backendName) && !c.backendStore.BackendExists(backendstore.BackendName(b.GetNamespace(), name))
+	default:
+		return true
+	}
+}
+
+// isMoved returns true if the bucket is available on enough backends other than
+// those being drained, ie on as many backends as required by its placement policy
+// or, without a placement policy, on at least one other backend.
+func (c *Controller) isMoved(b bucket, backendName string) bool {
+	required := 1
+	if b.placement != nil && len(b.providers) == 0 {
+		required = max(required, int(b.placement.Replicas))
+	}
+
+	available := 0
+	for name, backend := range b.status.AtProvider.Backends {
+		if name == backendName || backend == nil || !backend.BucketCondition.Equal(xpv1.Available()) {
+			continue
+		}
+		if c.backendStore.GetBackendMode(name) == apisv1alpha1.BackendModeDraining {
+			continue
+		}
+		available++
+	}
+
+	return available >= required
+}
+
+// moveBucket triggers the reconciliation of the bucket, which places it on other
+// backends, by annotating it with the backend and unpausing it if it has been
+// auto-paused. Buckets paused by the user are left alone.
+func (c *Controller) moveBucket(ctx context.Context, b bucket, backendName string) error {
+	bucketLabels := b.GetLabels()
+	paused := bucketLabels[meta.AnnotationKeyReconciliationPaused] == consts.TrueStr
+	if paused && !c.autoPauseBucket && !b.autoPause {
+		return nil
+	}
+	if !paused && b.GetAnnotations()[v1alpha1.DrainAnnotation] == backendName {
+		return nil
+	}
+
+	patch := client.MergeFrom(b.DeepCopyObject().(client.Object)) //nolint:forcetypeassert // Type is guaranteed.
+
+	meta.AddAnnotations(b, map[string]string{v1alpha1.DrainAnnotation: backendName})
+	if paused {
+		bucketLabels[meta.AnnotationKeyReconciliationPaused] = ""
+	}
+
+	return errors.Wrap(resource.Ignore(kerrors.IsNotFound, c.kubeClientCached.Patch(ctx, b.Object, patch)), errMoveBucket)
+}
+
+// disableBackend removes the backend from the placement and the status of the
+// bucket and then disables it by label, so that the bucket is no longer managed
+// on the backend.
+func (c *Controller) disableBackend(ctx context.Context, b bucket, backendName string) error {
+	statusPatch := client.MergeFrom(b.DeepCopyObject().(client.Object)) //nolint:forcetypeassert // Type is guaranteed.
+
+	b.status.AtProvider.PlacedBackends = slices.DeleteFunc(slices.Clone(b.status.AtProvider.PlacedBackends), func(name string) bool {
+		return name == backendName
+	})
+	delete(b.status.AtProvider.Backends, backendName)
+
+	if err := c.kubeClientCached.Status().Patch(ctx, b.Object, statusPatch); err != nil {
+		return errors.Wrap(resource.Ignore(kerrors.IsNotFound, err), errUpdateBucketStatus)
+	}
+
+	patch := client.MergeFrom(b.DeepCopyObject().(client.Object)) //nolint:forcetypeassert // Type is guaranteed.
+
+	b.GetLabels()[utils.GetBackendLabel(backendName)] = "false"
+	meta.RemoveAnnotations(b, v1alpha1.DrainAnnotation)
+
+	return errors.Wrap(resource.Ignore(kerrors.IsNotFound, c.kubeClientCached.Patch(ctx, b.Object, patch)), errDisableBackend)
+}
+
+// listBuckets lists the Buckets which may be placed on the backend. Cluster scoped
+// Buckets can only be placed on backends of cluster scoped ProviderConfigs, whereas
+// namespaced Buckets can be placed on backends of ClusterProviderConfigs and of
+// ProviderConfigs in their namespace. Paused Buckets are not cached, so the Buckets
+// are listed from the API server.
+func (c *Controller) listBuckets(ctx context.Context, backendName string) ([]bucket, error) {
+	buckets := []bucket{}
+
+	namespace, _ := backendstore.SplitBackendName(backendName)
+	if namespace == "" {
+		list := &v1alpha1.BucketList{}
+		if err := c.kubeClientUncached.List(ctx, list); err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			b := &list.Items[i]
+			buckets = append(buckets, bucket{
+				Object:    b,
+				providers: b.Spec.Providers,
+				placement: b.Spec.Placement,
+				autoPause: b.Spec.AutoPause,
+				status:    &b.Status,
+			})
+		}
+	}
+
+	// An empty namespace lists the namespaced Buckets in all namespaces.
+	nsList := &nsv1alpha1.BucketList{}
+	if err := c.kubeClientUncached.List(ctx, nsList, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+	for i := range nsList.Items {
+		b := &nsList.Items[i]
+		buckets = append(buckets, bucket{
+			Object:    b,
+			providers: b.Spec.Providers,
+			placement: b.Spec.Placement,
+			autoPause: b.Spec.AutoPause,
+			status:    &b.Status,
+		})
+	}
+
+	return buckets, nil
+}
+
+// updateDrainStatus persists the drain status in the latest version of the ProviderConfig.
+func (c *Controller) updateDrainStatus(ctx context.Context, pc apisv1alpha1.ProviderConfigObject, status *apisv1alpha1.DrainStatus) error {
+	if status != nil {
+		status.Progress = fmt.Sprintf("%d of %d buckets drained", status.Drained, status.Total)
+		if status.Blocked != 0 {
+			status.Progress += fmt.Sprintf(", %d pinned by providers", status.Blocked)
+		}
+	}
+
+	err := retry.OnError(retry.DefaultRetry, resource.IsAPIError, func() error {
+		if err := c.kubeClientCached.Get(ctx, client.ObjectKeyFromObject(pc), pc); err != nil {
+			return err
+		}
+
+		pcCopy, _ := pc.DeepCopyObject().(client.Object)
+		pc.GetStatus().Drain = status.DeepCopy()
+
+		return c.kubeClientCached.Status().Patch(ctx, pc, client.MergeFrom(pcCopy))
+	})
+
+	return resource.Ignore(kerrors.IsNotFound, err)
+}
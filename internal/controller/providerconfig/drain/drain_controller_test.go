@@ -0,0 +1,283 @@
+package drain
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	nsv1alpha1 "github.com/linode/provider-ceph/apis/namespaced/provider-ceph/v1alpha1"
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/backendstore/backendstorefakes"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/utils"
+)
+
+//nolint:maintidx // Function requires numerous checks.
+func TestReconcile(t *testing.T) {
+	t.Parallel()
+	backendName := "old-backend"
+	otherBackendName := "new-backend"
+
+	onBackend := func(value string) map[string]string {
+		return map[string]string{utils.GetBackendLabel(backendName): value}
+	}
+	availableOn := func(backendNames ...string) v1alpha1.BucketStatus {
+		backends := v1alpha1.Backends{}
+		for _, name := range backendNames {
+			backends[name] = &v1alpha1.BackendInfo{BucketCondition: xpv1.Available()}
+		}
+
+		return v1alpha1.BucketStatus{AtProvider: v1alpha1.BucketObservation{Backends: backends}}
+	}
+	draining := &apisv1alpha1.ProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: backendName},
+		Spec:       apisv1alpha1.ProviderConfigSpec{Mode: apisv1alpha1.BackendModeDraining},
+	}
+
+	type want struct {
+		res ctrl.Result
+		// labels are the values of the backend label of each Bucket.
+		labels map[string]string
+		// moved are the names of the Buckets annotated with the backend.
+		moved  []string
+		placed map[string][]string
+		status *apisv1alpha1.DrainStatus
+	}
+
+	cases := map[string]struct {
+		objects []client.Object
+		want    want
+	}{
+		"Drain status is removed once the backend is active": {
+			objects: []client.Object{
+				&apisv1alpha1.ProviderConfig{
+					ObjectMeta: metav1.ObjectMeta{Name: backendName},
+					Spec:       apisv1alpha1.ProviderConfigSpec{Mode: apisv1alpha1.BackendModeActive},
+					Status: apisv1alpha1.ProviderConfigStatus{
+						Drain: &apisv1alpha1.DrainStatus{Total: 1, Progress: "0 of 1 buckets drained"},
+					},
+				},
+				&v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{Name: "bucket", Labels: onBackend(consts.TrueStr)},
+					Status:     availableOn(backendName, otherBackendName),
+				},
+			},
+			want: want{
+				labels: map[string]string{"bucket": consts.TrueStr},
+				moved:  []string{},
+			},
+		},
+		"Buckets are drained according to their placement": {
+			objects: []client.Object{
+				draining.DeepCopy(),
+				&v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{Name: "replicated-bucket", Labels: onBackend(consts.TrueStr)},
+					Status:     availableOn(backendName, otherBackendName),
+				},
+				&v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{Name: "single-bucket", Labels: onBackend(consts.TrueStr)},
+					Status:     availableOn(backendName),
+				},
+				&v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "user-paused-bucket",
+						Labels: map[string]string{
+							utils.GetBackendLabel(backendName):     consts.TrueStr,
+							meta.AnnotationKeyReconciliationPaused: consts.TrueStr,
+						},
+					},
+					Status: availableOn(backendName),
+				},
+				&v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{Name: "placed-bucket", Labels: onBackend(consts.TrueStr)},
+					Spec:       v1alpha1.BucketSpec{Placement: &v1alpha1.PlacementPolicy{Replicas: 1}},
+					Status: func() v1alpha1.BucketStatus {
+						s := availableOn(backendName, otherBackendName)
+						s.AtProvider.PlacedBackends = []string{backendName, otherBackendName}
+
+						return s
+					}(),
+				},
+				&v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{Name: "pinned-bucket", Labels: onBackend(consts.TrueStr)},
+					Spec:       v1alpha1.BucketSpec{Providers: []string{backendName}},
+					Status:     availableOn(backendName),
+				},
+				&v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{Name: "drained-bucket", Labels: onBackend("false")},
+					Status:     availableOn(otherBackendName),
+				},
+				&v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{Name: "other-bucket"},
+					Status:     availableOn(otherBackendName),
+				},
+			},
+			want: want{
+				res: ctrl.Result{RequeueAfter: retryAfter},
+				labels: map[string]string{
+					"replicated-bucket":  "false",
+					"single-bucket":      consts.TrueStr,
+					"user-paused-bucket": consts.TrueStr,
+					"placed-bucket":      "false",
+					"pinned-bucket":      consts.TrueStr,
+					"drained-bucket":     "false",
+					"other-bucket":       "",
+				},
+				moved:  []string{"single-bucket"},
+				placed: map[string][]string{"placed-bucket": {otherBackendName}},
+				status: &apisv1alpha1.DrainStatus{
+					Total:    6,
+					Drained:  3,
+					Blocked:  1,
+					Progress: "3 of 6 buckets drained, 1 pinned by providers",
+				},
+			},
+		},
+		"Drain is complete once all buckets have been drained": {
+			objects: []client.Object{
+				draining.DeepCopy(),
+				&v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{Name: "bucket", Labels: onBackend(consts.TrueStr)},
+					Status:     availableOn(backendName, otherBackendName),
+				},
+				&nsv1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "namespaced-bucket", Labels: onBackend(consts.TrueStr)},
+					Status:     availableOn(backendName, otherBackendName),
+				},
+			},
+			want: want{
+				labels: map[string]string{"bucket": "false", "namespaced-bucket": "false"},
+				moved:  []string{},
+				status: &apisv1alpha1.DrainStatus{
+					Total:    2,
+					Drained:  2,
+					Progress: "2 of 2 buckets drained",
+				},
+			},
+		},
+		"Drain is complete once all buckets but pinned buckets have been drained": {
+			objects: []client.Object{
+				draining.DeepCopy(),
+				&v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{Name: "bucket", Labels: onBackend(consts.TrueStr)},
+					Status:     availableOn(backendName, otherBackendName),
+				},
+				&v1alpha1.Bucket{
+					ObjectMeta: metav1.ObjectMeta{Name: "pinned-bucket", Labels: onBackend(consts.TrueStr)},
+					Spec:       v1alpha1.BucketSpec{Providers: []string{backendName}},
+					Status:     availableOn(backendName),
+				},
+			},
+			want: want{
+				labels: map[string]string{"bucket": "false", "pinned-bucket": consts.TrueStr},
+				moved:  []string{},
+				status: &apisv1alpha1.DrainStatus{
+					Total:    2,
+					Drained:  1,
+					Blocked:  1,
+					Progress: "1 of 2 buckets drained, 1 pinned by providers",
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			scheme := runtime.NewScheme()
+			scheme.AddKnownTypes(apisv1alpha1.SchemeGroupVersion,
+				&apisv1alpha1.ProviderConfig{},
+				&apisv1alpha1.ProviderConfigList{})
+			scheme.AddKnownTypes(v1alpha1.SchemeGroupVersion,
+				&v1alpha1.Bucket{},
+				&v1alpha1.BucketList{})
+			scheme.AddKnownTypes(nsv1alpha1.SchemeGroupVersion,
+				&nsv1alpha1.Bucket{},
+				&nsv1alpha1.BucketList{})
+
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(tc.objects...).
+				WithStatusSubresource(tc.objects...).
+				Build()
+
+			bs := backendstore.NewBackendStore()
+			bs.AddOrUpdateBackend(backendName, &backendstorefakes.FakeS3Client{}, nil, apisv1alpha1.HealthStatusHealthy)
+			bs.SetBackendMode(backendName, apisv1alpha1.BackendModeDraining)
+			bs.AddOrUpdateBackend(otherBackendName, &backendstorefakes.FakeS3Client{}, nil, apisv1alpha1.HealthStatusHealthy)
+
+			autoPause := false
+			r := NewController(
+				WithAutoPause(&autoPause),
+				WithBackendStore(bs),
+				WithKubeClientUncached(c),
+				WithKubeClientCached(c),
+				WithLogger(logr.Discard()))
+
+			got, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: backendName}})
+			require.NoError(t, err)
+			assert.Equal(t, tc.want.res, got, "unexpected result")
+
+			bl := &v1alpha1.BucketList{}
+			require.NoError(t, c.List(context.Background(), bl))
+			nsbl := &nsv1alpha1.BucketList{}
+			require.NoError(t, c.List(context.Background(), nsbl))
+
+			labels, moved, placed := map[string]string{}, []string{}, map[string][]string{}
+			record := func(b client.Object, status *v1alpha1.BucketStatus) {
+				labels[b.GetName()] = b.GetLabels()[utils.GetBackendLabel(backendName)]
+				if b.GetAnnotations()[v1alpha1.DrainAnnotation] == backendName {
+					moved = append(moved, b.GetName())
+				}
+				if len(status.AtProvider.PlacedBackends) != 0 {
+					placed[b.GetName()] = status.AtProvider.PlacedBackends
+				}
+				if labels[b.GetName()] == "false" {
+					assert.NotContains(t, status.AtProvider.Backends, backendName, "drained backend in status of %s", b.GetName())
+				}
+			}
+			for i := range bl.Items {
+				record(&bl.Items[i], &bl.Items[i].Status)
+			}
+			for i := range nsbl.Items {
+				record(&nsbl.Items[i], &nsbl.Items[i].Status)
+			}
+			for bucketName, value := range tc.want.labels {
+				assert.Equal(t, value, labels[bucketName], "unexpected backend label of %s", bucketName)
+			}
+			assert.ElementsMatch(t, tc.want.moved, moved, "unexpected moved buckets")
+			for bucketName, backends := range tc.want.placed {
+				assert.Equal(t, backends, placed[bucketName], "unexpected placement of %s", bucketName)
+			}
+
+			pc := &apisv1alpha1.ProviderConfig{}
+			require.NoError(t, c.Get(context.Background(), types.NamespacedName{Name: backendName}, pc))
+			if tc.want.status == nil {
+				assert.Nil(t, pc.Status.Drain, "unexpected drain status")
+
+				return
+			}
+			require.NotNil(t, pc.Status.Drain, "missing drain status")
+			assert.NotNil(t, pc.Status.Drain.StartTime, "missing start time")
+			assert.Equal(t, tc.want.res.IsZero(), pc.Status.Drain.CompletionTime != nil, "unexpected completion time")
+			assert.Equal(t, tc.want.status.Total, pc.Status.Drain.Total, "unexpected total")
+			assert.Equal(t, tc.want.status.Drained, pc.Status.Drain.Drained, "unexpected drained")
+			assert.Equal(t, tc.want.status.Blocked, pc.Status.Drain.Blocked, "unexpected blocked")
+			assert.Equal(t, tc.want.status.Progress, pc.Status.Drain.Progress, "unexpected progress")
+		})
+	}
+}
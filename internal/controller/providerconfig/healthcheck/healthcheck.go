@@ -1,15 +1,20 @@
 package healthcheck
 
 import (
+	"context"
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/providerconfig"
 	"github.com/go-logr/logr"
+	apisnsv1alpha1 "github.com/linode/provider-ceph/apis/namespaced/v1alpha1"
 	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
 	"github.com/linode/provider-ceph/internal/backendstore"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
 const controllerName = "health-check-controller"
@@ -21,6 +26,10 @@ type Controller struct {
 	httpClient         *http.Client
 	log                logr.Logger
 	autoPauseBucket    bool
+	failoverPeriod     time.Duration
+	// failedOver holds the backends whose Buckets have been unpaused for
+	// failover since the backends became unhealthy.
+	failedOver sync.Map
 }
 
 func NewController(options ...func(*Controller)) *Controller {
@@ -62,6 +71,15 @@ func WithAutoPause(autoPause *bool) func(*Controller) {
 	}
 }
 
+// WithFailoverPeriod unpauses the Buckets of backends which have been unhealthy
+// for longer than the given period, so that failover replicas can be added to
+// them. Failover is disabled if zero.
+func WithFailoverPeriod(t time.Duration) func(*Controller) {
+	return func(r *Controller) {
+		r.failoverPeriod = t
+	}
+}
+
 func WithHttpClient(httpClient *http.Client) func(*Controller) {
 	return func(r *Controller) {
 		r.httpClient = httpClient
@@ -71,11 +89,40 @@ func WithHttpClient(httpClient *http.Client) func(*Controller) {
 func (r *Controller) SetupWithManager(mgr ctrl.Manager) error {
 	const maxReconciles = 5
 
-	return ctrl.NewControllerManagedBy(mgr).
+	if err := ctrl.NewControllerManagedBy(mgr).
 		For(&apisv1alpha1.ProviderConfig{}).
 		Named(controllerName).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: maxReconciles,
 		}.ForControllerRuntime()).
-		Complete(r)
+		Complete(r); err != nil {
+		return err
+	}
+
+	// The namespaced ProviderConfig and the ClusterProviderConfig are
+	// health checked by separate controllers sharing the same backend store.
+	if err := ctrl.NewControllerManagedBy(mgr).
+		For(&apisnsv1alpha1.ProviderConfig{}).
+		Named(controllerName + "-namespaced").
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: maxReconciles,
+		}.ForControllerRuntime()).
+		Complete(r.forKind(func() apisv1alpha1.ProviderConfigObject { return &apisnsv1alpha1.ProviderConfig{} })); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&apisnsv1alpha1.ClusterProviderConfig{}).
+		Named(controllerName + "-cluster").
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: maxReconciles,
+		}.ForControllerRuntime()).
+		Complete(r.forKind(func() apisv1alpha1.ProviderConfigObject { return &apisnsv1alpha1.ClusterProviderConfig{} }))
+}
+
+// forKind returns a reconciler for the ProviderConfig kind created by newProviderConfig.
+func (r *Controller) forKind(newProviderConfig func() apisv1alpha1.ProviderConfigObject) reconcile.Reconciler {
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		return r.reconcile(ctx, req, newProviderConfig())
+	})
 }
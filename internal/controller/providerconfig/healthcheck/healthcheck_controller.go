@@ -35,8 +35,10 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 
+	nsv1alpha1 "github.com/linode/provider-ceph/apis/namespaced/provider-ceph/v1alpha1"
 	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
 	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
 	"github.com/linode/provider-ceph/internal/consts"
 	"github.com/linode/provider-ceph/internal/otel/traces"
 	"github.com/linode/provider-ceph/internal/utils"
@@ -49,16 +51,29 @@ const (
 	healthCheckSuffix = "-health-check"
 )
 
+// unpauseBackoff is the backoff for listing and unpausing Buckets.
+var unpauseBackoff = wait.Backoff{
+	Steps:    4,
+	Duration: time.Second,
+	Factor:   5,
+	Jitter:   0.1,
+}
+
 func (c *Controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return c.reconcile(ctx, req, &apisv1alpha1.ProviderConfig{})
+}
+
+func (c *Controller) reconcile(ctx context.Context, req ctrl.Request, providerConfig apisv1alpha1.ProviderConfigObject) (ctrl.Result, error) {
 	ctx, span := otel.Tracer("").Start(ctx, "healthcheck.Controller.Reconcile")
 	defer span.End()
 	ctx, log := traces.InjectTraceAndLogger(ctx, c.log)
 
-	log.V(1).Info("Reconciling health of s3 backend", consts.KeyBackendName, req.Name)
+	backendName := backendstore.BackendName(req.Namespace, req.Name)
+
+	log.V(1).Info("Reconciling health of s3 backend", consts.KeyBackendName, backendName)
 
 	bucketName := req.Name + healthCheckSuffix
 
-	providerConfig := &apisv1alpha1.ProviderConfig{}
 	if err := c.kubeClientCached.Get(ctx, req.NamespacedName, providerConfig); err != nil {
 		if kerrors.IsNotFound(err) {
 			// ProviderConfig has been deleted so there is nothing to do and no need to requeue.
@@ -69,16 +84,22 @@ func (c *Controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, err
 	}
 
-	if providerConfig.Spec.DisableHealthCheck {
-		log.V(1).Info("Health check is disabled for s3 backend", consts.KeyBackendName, providerConfig.Name)
+	// The backend of a shadowed ClusterProviderConfig belongs to the
+	// ProviderConfig with the same name, which is health checked instead.
+	if shadowed, err := utils.IsShadowed(ctx, c.kubeClientCached, providerConfig, req.Name); err != nil || shadowed {
+		return ctrl.Result{}, err
+	}
+
+	if providerConfig.GetSpec().DisableHealthCheck {
+		log.V(1).Info("Health check is disabled for s3 backend", consts.KeyBackendName, backendName)
 
-		c.backendStore.SetBackendHealthStatus(req.Name, apisv1alpha1.HealthStatusUnknown)
-		if providerConfig.Status.GetCondition(v1.TypeReady).Equal(v1alpha1.HealthCheckDisabled()) {
+		c.backendStore.SetBackendHealthStatus(backendName, apisv1alpha1.HealthStatusUnknown)
+		if providerConfig.GetCondition(v1.TypeReady).Equal(v1alpha1.HealthCheckDisabled()) {
 			return ctrl.Result{}, nil
 		}
 
-		if err := UpdateProviderConfigStatus(ctx, c.kubeClientCached, providerConfig, func(_, pcLatest *apisv1alpha1.ProviderConfig) {
-			pcLatest.Status.SetConditions(v1alpha1.HealthCheckDisabled())
+		if err := UpdateProviderConfigStatus(ctx, c.kubeClientCached, providerConfig, func(_, pcLatest apisv1alpha1.ProviderConfigObject) {
+			pcLatest.SetConditions(v1alpha1.HealthCheckDisabled())
 		}); err != nil {
 			err = errors.Wrap(err, errUpdateHealthStatus)
 			traces.SetAndRecordError(span, err)
@@ -91,21 +112,21 @@ func (c *Controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 	// Store the condition before the check so that we can compare
 	// with the condition after the check.
-	conditionBeforeCheck := providerConfig.Status.GetCondition(v1.TypeReady)
+	conditionBeforeCheck := providerConfig.GetCondition(v1.TypeReady)
 
 	// Assume the backend is unhealthy and set a HealthCheckFail  condition until we can verify otherwise.
-	providerConfig.Status.SetConditions(v1alpha1.HealthCheckFail())
+	providerConfig.SetConditions(v1alpha1.HealthCheckFail())
 
 	defer func() {
-		health := utils.MapConditionToHealthStatus(providerConfig.Status.GetCondition(v1.TypeReady))
-		c.backendStore.SetBackendHealthStatus(req.Name, health)
+		health := utils.MapConditionToHealthStatus(providerConfig.GetCondition(v1.TypeReady))
+		c.backendStore.SetBackendHealthStatus(backendName, health)
 
-		if providerConfig.Status.GetCondition(v1.TypeReady).Equal(conditionBeforeCheck) {
+		if providerConfig.GetCondition(v1.TypeReady).Equal(conditionBeforeCheck) {
 			return
 		}
 
-		if err := UpdateProviderConfigStatus(ctx, c.kubeClientCached, providerConfig, func(pcDeepCopy, pcLatest *apisv1alpha1.ProviderConfig) {
-			pcLatest.Status.SetConditions(pcDeepCopy.Status.Conditions...)
+		if err := UpdateProviderConfigStatus(ctx, c.kubeClientCached, providerConfig, func(pcDeepCopy, pcLatest apisv1alpha1.ProviderConfigObject) {
+			pcLatest.SetConditions(pcDeepCopy.GetCondition(v1.TypeReady))
 		}); err != nil {
 			err = errors.Wrap(err, errUpdateHealthStatus)
 			traces.SetAndRecordError(span, err)
@@ -115,38 +136,65 @@ func (c *Controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	// Perform the health check. By calling this function, we are implicitly updating
 	// the health status of the ProviderConfig with whatever the health check reports.
 	if err := c.doHealthCheck(ctx, providerConfig); err != nil {
-		log.Info("Failed to do health check on s3 backend", consts.KeyBucketName, bucketName, consts.KeyBackendName, providerConfig.Name)
+		log.Info("Failed to do health check on s3 backend", consts.KeyBucketName, bucketName, consts.KeyBackendName, backendName)
 
-		providerConfig.Status.SetConditions(v1alpha1.HealthCheckFail().WithMessage(errNoRequestID(err)))
+		providerConfig.SetConditions(v1alpha1.HealthCheckFail().WithMessage(errNoRequestID(err)))
 		traces.SetAndRecordError(span, err)
 
+		// Paused Bucket CRs are not reconciled, so the Bucket CRs on a backend which has been
+		// unhealthy for longer than the failover period are unpaused once, in order to place
+		// failover replicas of their buckets on healthy backends.
+		if c.isFailoverDue(backendName) {
+			log.Info("Backend has been unhealthy for longer than the failover period - unpausing all Buckets on backend to allow failover", consts.KeyBackendName, backendName)
+			go c.unpauseBuckets(ctx, backendName)
+		}
+
 		return ctrl.Result{}, err
 	}
 
+	c.failedOver.Delete(backendName)
+
 	// Check if the backend is healthy, where prior to the check it was unhealthy.
 	// In which case, we need to unpause all Bucket CRs that have buckets stored
 	// on this backend. We do this to allow these Bucket CRs be reconciled again.
-	conditionAfterCheck := providerConfig.Status.GetCondition(v1.TypeReady)
+	conditionAfterCheck := providerConfig.GetCondition(v1.TypeReady)
 
 	if conditionAfterCheck.Equal(v1alpha1.HealthCheckSuccess()) && !conditionBeforeCheck.Equal(conditionAfterCheck) {
-		log.Info("Backend is healthy where previously it was unhealthy - unpausing all Buckets on backend to allow Observation", consts.KeyBackendName, providerConfig.Name)
-		go c.unpauseBuckets(ctx, providerConfig.Name)
+		log.Info("Backend is healthy where previously it was unhealthy - unpausing all Buckets on backend to allow Observation", consts.KeyBackendName, backendName)
+		go c.unpauseBuckets(ctx, backendName)
 	}
 
 	// Health check interval is 30s by default.
 	// It is safe to requeue after the same object multiple times,
 	// because controller runtime reconcilies only once.
 	return ctrl.Result{
-		RequeueAfter: time.Duration(providerConfig.Spec.HealthCheckIntervalSeconds) * time.Second,
+		RequeueAfter: time.Duration(providerConfig.GetSpec().HealthCheckIntervalSeconds) * time.Second,
 	}, nil
 }
 
+// isFailoverDue returns true the first time the backend is found to have been
+// unhealthy for longer than the failover period since it became unhealthy.
+func (c *Controller) isFailoverDue(backendName string) bool {
+	if c.failoverPeriod <= 0 {
+		return false
+	}
+
+	since := c.backendStore.GetBackendUnhealthySince(backendName)
+	if since.IsZero() || time.Since(since) < c.failoverPeriod {
+		return false
+	}
+
+	_, loaded := c.failedOver.LoadOrStore(backendName, struct{}{})
+
+	return !loaded
+}
+
 // doHealthCheck performs a basic http request to the hostbase address.
-func (c *Controller) doHealthCheck(ctx context.Context, providerConfig *apisv1alpha1.ProviderConfig) error {
+func (c *Controller) doHealthCheck(ctx context.Context, providerConfig apisv1alpha1.ProviderConfigObject) error {
 	ctx, span := otel.Tracer("").Start(ctx, "Controller.doHealthCheck")
 	defer span.End()
 
-	address := utils.ResolveHostBase(providerConfig.Spec.HostBase, providerConfig.Spec.UseHTTPS)
+	address := utils.ResolveHostBase(providerConfig.GetSpec().HostBase, providerConfig.GetSpec().UseHTTPS)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, address, http.NoBody)
 	if err != nil {
@@ -170,67 +218,75 @@ func (c *Controller) doHealthCheck(ctx context.Context, providerConfig *apisv1al
 	// Instead, we assume healthiness by simply making the connection successfully.
 
 	// Health check completed successfully, update status.
-	providerConfig.Status.SetConditions(v1alpha1.HealthCheckSuccess())
+	providerConfig.SetConditions(v1alpha1.HealthCheckSuccess())
 
 	return resp.Body.Close()
 }
 
 // unpauseBuckets lists all buckets that exist on the given backend by using the custom
 // backend label. Then, using retry.OnError(), it attempts to unpause each of these buckets
-// by unsetting the Pause label.
+// by unsetting the Pause label. Cluster scoped Buckets can only exist on backends of
+// cluster scoped ProviderConfigs, whereas namespaced Buckets can exist on backends of
+// ClusterProviderConfigs and of ProviderConfigs in their namespace.
 func (c *Controller) unpauseBuckets(ctx context.Context, s3BackendName string) {
-	const (
-		steps    = 4
-		duration = time.Second
-		factor   = 5
-		jitter   = 0.1
-	)
 	ctx, log := traces.InjectTraceAndLogger(ctx, c.log)
 
 	// Only list Buckets that (a) were created on s3BackendName
 	// and (b) are already paused.
-	listLabels := labels.SelectorFromSet(labels.Set(map[string]string{
-		utils.GetBackendLabel(s3BackendName):   consts.TrueStr,
-		meta.AnnotationKeyReconciliationPaused: consts.TrueStr,
-	}))
-
-	buckets := &v1alpha1.BucketList{}
-	err := retry.OnError(wait.Backoff{
-		Steps:    steps,
-		Duration: duration,
-		Factor:   factor,
-		Jitter:   jitter,
-	}, resource.IsAPIError, func() error {
-		return c.kubeClientUncached.List(ctx, buckets, &client.ListOptions{
-			LabelSelector: listLabels,
-		})
-	})
-	if err != nil {
-		log.Info("Error attempting to list Buckets on backend", "error", err.Error(), consts.KeyBackendName, s3BackendName)
+	listOpts := []client.ListOption{
+		client.MatchingLabelsSelector{Selector: labels.SelectorFromSet(labels.Set(map[string]string{
+			utils.GetBackendLabel(s3BackendName):   consts.TrueStr,
+			meta.AnnotationKeyReconciliationPaused: consts.TrueStr,
+		}))},
+	}
 
-		return
+	namespace, _ := backendstore.SplitBackendName(s3BackendName)
+	if namespace == "" {
+		buckets := &v1alpha1.BucketList{}
+		if err := c.listBuckets(ctx, buckets, listOpts...); err != nil {
+			log.Info("Error attempting to list Buckets on backend", "error", err.Error(), consts.KeyBackendName, s3BackendName)
+		}
+		for i := range buckets.Items {
+			c.unpauseBucket(ctx, &buckets.Items[i], buckets.Items[i].Spec.AutoPause)
+		}
 	}
 
-	for i := range buckets.Items {
-		log.V(1).Info("Attempting to unpause bucket", consts.KeyBucketName, buckets.Items[i].Name)
-		err := retry.OnError(wait.Backoff{
-			Steps:    steps,
-			Duration: duration,
-			Factor:   factor,
-			Jitter:   jitter,
-		}, resource.IsAPIError, func() error {
-			if (c.autoPauseBucket || buckets.Items[i].Spec.AutoPause) &&
-				buckets.Items[i].Labels[meta.AnnotationKeyReconciliationPaused] == consts.TrueStr {
-				buckets.Items[i].Labels[meta.AnnotationKeyReconciliationPaused] = ""
-
-				return c.kubeClientCached.Update(ctx, &buckets.Items[i])
-			}
-
-			return nil
-		})
-
-		if err != nil {
-			log.Info("Error attempting to unpause bucket", "error", err.Error(), "bucket", buckets.Items[i].Name)
+	// An empty namespace lists the namespaced Buckets in all namespaces.
+	nsBuckets := &nsv1alpha1.BucketList{}
+	if err := c.listBuckets(ctx, nsBuckets, append(listOpts, client.InNamespace(namespace))...); err != nil {
+		log.Info("Error attempting to list namespaced Buckets on backend", "error", err.Error(), consts.KeyBackendName, s3BackendName)
+	}
+	for i := range nsBuckets.Items {
+		c.unpauseBucket(ctx, &nsBuckets.Items[i], nsBuckets.Items[i].Spec.AutoPause)
+	}
+}
+
+// listBuckets lists Buckets of either scope, retrying on API errors.
+func (c *Controller) listBuckets(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	return retry.OnError(unpauseBackoff, resource.IsAPIError, func() error {
+		return c.kubeClientUncached.List(ctx, list, opts...)
+	})
+}
+
+// unpauseBucket unsets the Pause label of a Bucket of either scope, if auto pause
+// is enabled for the Bucket.
+func (c *Controller) unpauseBucket(ctx context.Context, bucket client.Object, autoPause bool) {
+	ctx, log := traces.InjectTraceAndLogger(ctx, c.log)
+
+	log.V(1).Info("Attempting to unpause bucket", consts.KeyBucketName, bucket.GetName())
+	err := retry.OnError(unpauseBackoff, resource.IsAPIError, func() error {
+		bucketLabels := bucket.GetLabels()
+		if (c.autoPauseBucket || autoPause) &&
+			bucketLabels[meta.AnnotationKeyReconciliationPaused] == consts.TrueStr {
+			bucketLabels[meta.AnnotationKeyReconciliationPaused] = ""
+
+			return c.kubeClientCached.Update(ctx, bucket)
 		}
+
+		return nil
+	})
+
+	if err != nil {
+		log.Info("Error attempting to unpause bucket", "error", err.Error(), "bucket", bucket.GetName())
 	}
 }
@@ -546,3 +546,30 @@ func TestReconcile(t *testing.T) {
 		})
 	}
 }
+
+func TestIsFailoverDue(t *testing.T) {
+	t.Parallel()
+
+	bs := backendstore.NewBackendStore()
+	bs.AddOrUpdateBackend(consts.S3Backend1, &backendstorefakes.FakeS3Client{}, nil, apisv1alpha1.HealthStatusHealthy)
+
+	disabled := NewController(WithBackendStore(bs))
+	c := NewController(WithBackendStore(bs), WithFailoverPeriod(time.Nanosecond))
+
+	assert.False(t, c.isFailoverDue(consts.S3Backend1), "failover is not due for healthy backend")
+
+	bs.SetBackendHealthStatus(consts.S3Backend1, apisv1alpha1.HealthStatusUnhealthy)
+	time.Sleep(time.Millisecond)
+
+	assert.False(t, disabled.isFailoverDue(consts.S3Backend1), "failover is not due when disabled")
+	assert.True(t, c.isFailoverDue(consts.S3Backend1), "failover is due for unhealthy backend")
+	assert.False(t, c.isFailoverDue(consts.S3Backend1), "failover is only due once")
+
+	// The backend recovers and becomes unhealthy again.
+	c.failedOver.Delete(consts.S3Backend1)
+	bs.SetBackendHealthStatus(consts.S3Backend1, apisv1alpha1.HealthStatusHealthy)
+	bs.SetBackendHealthStatus(consts.S3Backend1, apisv1alpha1.HealthStatusUnhealthy)
+	time.Sleep(time.Millisecond)
+
+	assert.True(t, c.isFailoverDue(consts.S3Backend1), "failover is due again for unhealthy backend")
+}
@@ -25,38 +25,38 @@ import (
 //
 // Callback example 1, updating the latest version of pc with a field from your version of pc:
 //
-//	func(pcDeepCopy, pcLatest *apisv1alpha1.ProviderConfig) {
-//	  pcLatest.Status.SomeOtherField = pcDeepCopy.Status.SomeOtherField
+//	func(pcDeepCopy, pcLatest apisv1alpha1.ProviderConfigObject) {
+//	  pcLatest.SetConditions(pcDeepCopy.GetCondition(v1.TypeReady))
 //	},
 //
-// Callback example 2, updating the latest version of pc with a string:
+// Callback example 2, updating the latest version of pc with a condition:
 //
-//	func(_, pcLatest *apisv1alpha1.ProviderConfig) {
-//	  pcLatest.Status.SomeOtherField = "some-value"
+//	func(_, pcLatest apisv1alpha1.ProviderConfigObject) {
+//	  pcLatest.SetConditions(v1alpha1.HealthCheckDisabled())
 //	},
 //
 // Example usage with above callback example 1:
 //
-//	err := UpdateProviderConfigStatus(ctx, pc, func(pcDeepCopy, pcLatest *apisv1alpha1.ProviderConfig) {
-//	  pcLatest.Status.SomeOtherField = pcDeepCopy.Status.SomeOtherField
+//	err := UpdateProviderConfigStatus(ctx, pc, func(pcDeepCopy, pcLatest apisv1alpha1.ProviderConfigObject) {
+//	  pcLatest.SetConditions(pcDeepCopy.GetCondition(v1.TypeReady))
 //	})
 //
 //	if err != nil {
 //	  // Handle error
 //	}
-func UpdateProviderConfigStatus(ctx context.Context, kubeClient client.Client, pc *apisv1alpha1.ProviderConfig, callback func(*apisv1alpha1.ProviderConfig, *apisv1alpha1.ProviderConfig)) error {
+func UpdateProviderConfigStatus(ctx context.Context, kubeClient client.Client, pc apisv1alpha1.ProviderConfigObject, callback func(apisv1alpha1.ProviderConfigObject, apisv1alpha1.ProviderConfigObject)) error {
 	const (
 		steps  = 4
 		factor = 0.5
 		jitter = 0.1
 	)
 
-	nn := types.NamespacedName{Name: pc.GetName(), Namespace: pc.Namespace}
-	pcDeepCopy := pc.DeepCopy()
+	nn := types.NamespacedName{Name: pc.GetName(), Namespace: pc.GetNamespace()}
+	pcDeepCopy, _ := pc.DeepCopyObject().(apisv1alpha1.ProviderConfigObject)
 
 	err := retry.OnError(wait.Backoff{
 		Steps:    steps,
-		Duration: (time.Duration(pc.Spec.HealthCheckIntervalSeconds) * time.Second) - time.Second,
+		Duration: (time.Duration(pc.GetSpec().HealthCheckIntervalSeconds) * time.Second) - time.Second,
 		Factor:   factor,
 		Jitter:   jitter,
 	}, resource.IsAPIError, func() error {
@@ -7,14 +7,20 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/providerconfig"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	apisnsv1alpha1 "github.com/linode/provider-ceph/apis/namespaced/v1alpha1"
 	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
 	"github.com/linode/provider-ceph/internal/controller/providerconfig/backendmonitor"
+	"github.com/linode/provider-ceph/internal/controller/providerconfig/backfill"
+	"github.com/linode/provider-ceph/internal/controller/providerconfig/drain"
 	"github.com/linode/provider-ceph/internal/controller/providerconfig/healthcheck"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-// Setup adds controllers to reconcile the backend store and backend health.
-func Setup(mgr ctrl.Manager, o controller.Options, b *backendmonitor.Controller, h *healthcheck.Controller) error {
+// Setup adds controllers to reconcile the backend store and backend health, to
+// drain backends and, unless bf is nil, to backfill existing buckets onto new backends.
+func Setup(mgr ctrl.Manager, o controller.Options, b *backendmonitor.Controller, h *healthcheck.Controller, d *drain.Controller, bf *backfill.Controller) error {
 	// Add an 'internal' controller to the manager for the ProviderConfig.
 	// This will be used to reconcile the backend store.
 	if err := b.SetupWithManager(mgr); err != nil {
@@ -27,6 +33,20 @@ func Setup(mgr ctrl.Manager, o controller.Options, b *backendmonitor.Controller,
 		return errors.Wrap(err, "failed to setup health check controller")
 	}
 
+	// Add an 'internal' controller to the manager for the ProviderConfig.
+	// This will be used to drain the buckets from draining backends.
+	if err := d.SetupWithManager(mgr); err != nil {
+		return errors.Wrap(err, "failed to setup drain controller")
+	}
+
+	// Add an 'internal' controller to the manager for the backends added
+	// to the backend store. This will be used to backfill existing buckets.
+	if bf != nil {
+		if err := bf.SetupWithManager(mgr); err != nil {
+			return errors.Wrap(err, "failed to setup backfill controller")
+		}
+	}
+
 	name := providerconfig.ControllerName(apisv1alpha1.ProviderConfigGroupKind)
 
 	of := resource.ProviderConfigKinds{
@@ -39,10 +59,44 @@ func Setup(mgr ctrl.Manager, o controller.Options, b *backendmonitor.Controller,
 		providerconfig.WithLogger(o.Logger.WithValues("providerconfig-reconciler", name)),
 		providerconfig.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
 
-	return ctrl.NewControllerManagedBy(mgr).
+	if err := ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		For(&apisv1alpha1.ProviderConfig{}).
 		Watches(&apisv1alpha1.ProviderConfigUsage{}, &resource.EnqueueRequestForProviderConfig{}).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter)); err != nil {
+		return err
+	}
+
+	// Namespaced ProviderConfigs and ClusterProviderConfigs are both used by
+	// namespaced managed resources, which track their usage with namespaced
+	// ProviderConfigUsages.
+	if err := setupNamespaced(mgr, o, apisnsv1alpha1.ProviderConfigGroupKind, apisnsv1alpha1.ProviderConfigGroupVersionKind, &apisnsv1alpha1.ProviderConfig{}); err != nil {
+		return err
+	}
+
+	return setupNamespaced(mgr, o, apisnsv1alpha1.ClusterProviderConfigGroupKind, apisnsv1alpha1.ClusterProviderConfigGroupVersionKind, &apisnsv1alpha1.ClusterProviderConfig{})
+}
+
+// setupNamespaced adds a controller that counts the namespaced managed resources
+// using a ProviderConfig of the given kind.
+func setupNamespaced(mgr ctrl.Manager, o controller.Options, groupKind string, gvk schema.GroupVersionKind, pc client.Object) error {
+	name := providerconfig.ControllerName(groupKind)
+
+	of := resource.ProviderConfigKinds{
+		Config:    gvk,
+		Usage:     apisnsv1alpha1.ProviderConfigUsageGroupVersionKind,
+		UsageList: apisnsv1alpha1.ProviderConfigUsageListGroupVersionKind,
+	}
+
+	r := providerconfig.NewReconciler(mgr, of,
+		providerconfig.WithLogger(o.Logger.WithValues("providerconfig-reconciler", name)),
+		providerconfig.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(pc).
+		Watches(&apisnsv1alpha1.ProviderConfigUsage{}, &resource.EnqueueRequestForProviderConfig{}).
 		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
 }
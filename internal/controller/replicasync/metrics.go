@@ -0,0 +1,76 @@
+package replicasync
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+)
+
+const (
+	metricNamespace = "provider_ceph"
+	metricSubsystem = "bucket_replica_sync"
+
+	labelNamespace = "namespace"
+	labelBucket    = "bucket"
+	labelBackend   = "backend"
+)
+
+// Metrics exports the progress of the synchronisation of Buckets to their
+// backends as Prometheus metrics.
+type Metrics struct {
+	lag         *prometheus.GaugeVec
+	copiedBytes *prometheus.CounterVec
+}
+
+// NewMetrics returns Metrics which must be registered with a Prometheus registry.
+func NewMetrics() *Metrics {
+	return &Metrics{
+		lag: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricNamespace,
+			Subsystem: metricSubsystem,
+			Name:      "lag_seconds",
+			Help:      "How far the replica of the bucket on the backend is behind its replica on the primary backend.",
+		}, []string{labelNamespace, labelBucket, labelBackend}),
+		copiedBytes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricNamespace,
+			Subsystem: metricSubsystem,
+			Name:      "copied_bytes_total",
+			Help:      "The total size in bytes of the objects copied to the backend.",
+		}, []string{labelBackend}),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
+	m.lag.Describe(ch)
+	m.copiedBytes.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
+	m.lag.Collect(ch)
+	m.copiedBytes.Collect(ch)
+}
+
+// setBucketLag replaces the lag gauges of the bucket with the lag of each backend.
+// The namespace of a cluster scoped bucket is empty.
+func (m *Metrics) setBucketLag(namespace, bucketName string, syncs v1alpha1.ReplicaSyncStatuses) {
+	m.deleteBucket(namespace, bucketName)
+
+	for backendName, s := range syncs {
+		if s == nil || s.Lag == nil {
+			continue
+		}
+		m.lag.WithLabelValues(namespace, bucketName, backendName).Set(s.Lag.Seconds())
+	}
+}
+
+// addCopiedBytes adds to the number of bytes copied to the backend.
+func (m *Metrics) addCopiedBytes(backendName string, n int64) {
+	m.copiedBytes.WithLabelValues(backendName).Add(float64(n))
+}
+
+// deleteBucket removes the lag gauges of the bucket on all backends.
+func (m *Metrics) deleteBucket(namespace, bucketName string) {
+	m.lag.DeletePartialMatch(prometheus.Labels{labelNamespace: namespace, labelBucket: bucketName})
+}
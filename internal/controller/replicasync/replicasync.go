@@ -0,0 +1,176 @@
+package replicasync
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/go-logr/logr"
+	"golang.org/x/time/rate"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	nsv1alpha1 "github.com/linode/provider-ceph/apis/namespaced/provider-ceph/v1alpha1"
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/controller/s3clienthandler"
+)
+
+const (
+	controllerName = "replica-sync-controller"
+
+	// defaultPageSize is the number of object versions listed per request.
+	defaultPageSize = 1000
+)
+
+// Controller periodically synchronises the objects of each Bucket which
+// specifies a ReplicaSync from its primary backend to its other backends.
+// It runs independently of the Bucket controller and checkpoints its
+// progress in the status of the Bucket, so that a pass which is interrupted
+// by a restart or an error is resumed rather than started over.
+type Controller struct {
+	kubeClient client.Client
+	// kubeReader reads Buckets from the API server, as paused Buckets are
+	// not cached by the manager.
+	kubeReader client.Reader
+	// bucketCache watches the metadata of all Buckets, including paused
+	// Buckets.
+	bucketCache     cache.Cache
+	backendStore    *backendstore.BackendStore
+	s3ClientHandler *s3clienthandler.Handler
+	metrics         *Metrics
+	limiter         *rate.Limiter
+	log             logr.Logger
+	interval        time.Duration
+	pageSize        int32
+	now             func() time.Time
+}
+
+func NewController(options ...func(*Controller)) *Controller {
+	r := &Controller{
+		metrics:  NewMetrics(),
+		pageSize: defaultPageSize,
+		now:      time.Now,
+	}
+	for _, o := range options {
+		o(r)
+	}
+
+	return r
+}
+
+func WithKubeClient(k client.Client) func(*Controller) {
+	return func(r *Controller) {
+		r.kubeClient = k
+	}
+}
+
+func WithKubeReader(k client.Reader) func(*Controller) {
+	return func(r *Controller) {
+		r.kubeReader = k
+	}
+}
+
+// WithBucketCache sets the cache watching the metadata of Buckets. It must not
+// filter out paused Buckets.
+func WithBucketCache(c cache.Cache) func(*Controller) {
+	return func(r *Controller) {
+		r.bucketCache = c
+	}
+}
+
+func WithLogger(l logr.Logger) func(*Controller) {
+	return func(r *Controller) {
+		r.log = l.WithValues(v1alpha1.BucketGroupKind, managed.ControllerName(controllerName))
+	}
+}
+
+func WithBackendStore(b *backendstore.BackendStore) func(*Controller) {
+	return func(r *Controller) {
+		r.backendStore = b
+	}
+}
+
+func WithS3ClientHandler(h *s3clienthandler.Handler) func(*Controller) {
+	return func(r *Controller) {
+		r.s3ClientHandler = h
+	}
+}
+
+func WithMetrics(m *Metrics) func(*Controller) {
+	return func(r *Controller) {
+		r.metrics = m
+	}
+}
+
+func WithInterval(t time.Duration) func(*Controller) {
+	return func(r *Controller) {
+		r.interval = t
+	}
+}
+
+// WithBandwidthLimit limits the rate, in bytes per second, at which the objects
+// of all Buckets together are copied. The rate is unlimited if it is not positive.
+func WithBandwidthLimit(bytesPerSecond int64) func(*Controller) {
+	return func(r *Controller) {
+		r.limiter = newLimiter(bytesPerSecond)
+	}
+}
+
+// newLimiter returns a limiter of the given rate in bytes per second, or nil if
+// the rate is not positive. Up to one second worth of bytes may be read at once.
+func newLimiter(bytesPerSecond int64) *rate.Limiter {
+	if bytesPerSecond <= 0 {
+		return nil
+	}
+
+	return rate.NewLimiter(rate.Limit(bytesPerSecond), int(min(bytesPerSecond, math.MaxInt32)))
+}
+
+func (c *Controller) SetupWithManager(mgr ctrl.Manager) error {
+	const maxReconciles = 5
+
+	// Status updates do not change the generation of a Bucket, so the
+	// controller is not triggered by its own checkpoints. Buckets are instead
+	// requeued once their next pass is due or their pass continues.
+	if err := ctrl.NewControllerManagedBy(mgr).
+		Named(controllerName).
+		WatchesRawSource(c.bucketSource(v1alpha1.BucketGroupVersionKind)).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: maxReconciles,
+		}.ForControllerRuntime()).
+		Complete(c); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(controllerName + "-namespaced").
+		WatchesRawSource(c.bucketSource(nsv1alpha1.BucketGroupVersionKind)).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: maxReconciles,
+		}.ForControllerRuntime()).
+		Complete(reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+			return c.reconcile(ctx, req, &nsv1alpha1.Bucket{})
+		}))
+}
+
+// bucketSource returns a source of the Buckets of the kind, which are watched
+// by their metadata in the bucket cache, as the cache of the manager does not
+// hold paused Buckets.
+func (c *Controller) bucketSource(gvk schema.GroupVersionKind) source.Source {
+	bucket := &metav1.PartialObjectMetadata{}
+	bucket.SetGroupVersionKind(gvk)
+
+	return source.Kind(c.bucketCache, bucket,
+		&handler.TypedEnqueueRequestForObject[*metav1.PartialObjectMetadata]{},
+		predicate.TypedGenerationChangedPredicate[*metav1.PartialObjectMetadata]{})
+}
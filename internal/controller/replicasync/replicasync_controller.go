@@ -0,0 +1,316 @@
+package replicasync
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/time/rate"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	nsv1alpha1 "github.com/linode/provider-ceph/apis/namespaced/provider-ceph/v1alpha1"
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/otel/traces"
+)
+
+const (
+	errGetBucket         = "failed to get Bucket"
+	errGetS3Client       = "failed to get S3 client of backend"
+	errSyncBackend       = "failed to synchronise bucket to backend"
+	errUpdateReplicaSync = "failed to update Bucket replica sync status"
+
+	msgPrimaryUnavailable = "bucket is not available on the primary backend"
+	msgBackendUnhealthy   = "backend is unhealthy"
+
+	// continueAfter is the delay before a pass which has not been completed
+	// within a single reconcile is continued.
+	continueAfter = time.Second
+)
+
+func (c *Controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return c.reconcile(ctx, req, &v1alpha1.Bucket{})
+}
+
+// reconcile synchronises the objects of a Bucket of either scope to each of its
+// backends on which a pass is due or in progress.
+//
+//nolint:cyclop // Backends are synchronised independently of each other.
+func (c *Controller) reconcile(ctx context.Context, req ctrl.Request, bucket client.Object) (ctrl.Result, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "replicasync.Controller.Reconcile")
+	defer span.End()
+	ctx, log := traces.InjectTraceAndLogger(ctx, c.log)
+
+	if err := c.kubeReader.Get(ctx, req.NamespacedName, bucket); err != nil {
+		if kerrors.IsNotFound(err) {
+			// Bucket has been deleted so there is nothing to synchronise and no need to requeue.
+			c.metrics.deleteBucket(req.Namespace, req.Name)
+
+			return ctrl.Result{}, nil
+		}
+		err = errors.Wrap(err, errGetBucket)
+		traces.SetAndRecordError(span, err)
+
+		return ctrl.Result{}, err
+	}
+
+	if meta.WasDeleted(bucket) {
+		c.metrics.deleteBucket(bucket.GetNamespace(), bucket.GetName())
+
+		return ctrl.Result{}, nil
+	}
+
+	spec, status := bucketReplicaSync(bucket)
+	if spec == nil {
+		c.metrics.deleteBucket(bucket.GetNamespace(), bucket.GetName())
+		if len(status.AtProvider.ReplicaSync) == 0 {
+			return ctrl.Result{}, nil
+		}
+
+		// Replica sync has been removed from the Bucket, so its status is obsolete.
+		if err := c.updateReplicaSync(ctx, bucket, nil); err != nil {
+			err = errors.Wrap(err, errUpdateReplicaSync)
+			traces.SetAndRecordError(span, err)
+
+			return ctrl.Result{}, err
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	primary := c.resolvePrimary(bucket.GetNamespace(), spec.Primary)
+	primaryUsable := c.isUsable(status, primary)
+	var primaryClient backendstore.S3Client
+	var primaryErr error
+	if primaryUsable {
+		primaryClient, primaryErr = c.getClient(ctx, bucket, primary)
+	}
+	limiters := c.getLimiters(spec)
+
+	syncs := make(v1alpha1.ReplicaSyncStatuses)
+	requeueAfter := c.interval
+	attempted := false
+	var syncErr error
+
+	for _, backendName := range targetBackends(status, primary) {
+		s := &v1alpha1.ReplicaSyncStatus{}
+		if prev, ok := status.AtProvider.ReplicaSync[backendName]; ok && prev != nil {
+			s = prev.DeepCopy()
+		}
+		syncs[backendName] = s
+
+		if wait := c.untilDue(s); wait > 0 {
+			requeueAfter = min(requeueAfter, wait)
+
+			continue
+		}
+		attempted = true
+
+		if !primaryUsable {
+			s.Message = msgPrimaryUnavailable
+
+			continue
+		}
+		if primaryErr != nil {
+			s.Message = primaryErr.Error()
+			syncErr = errors.Wrap(primaryErr, errGetS3Client)
+
+			continue
+		}
+		if !c.isUsable(status, backendName) {
+			s.Message = msgBackendUnhealthy
+
+			continue
+		}
+		targetClient, err := c.getClient(ctx, bucket, backendName)
+		if err != nil {
+			s.Message = err.Error()
+			syncErr = errors.Wrap(err, errGetS3Client)
+
+			continue
+		}
+
+		log.V(1).Info("Synchronising bucket to backend", consts.KeyBucketName, bucket.GetName(), consts.KeyBackendName, backendName, "primary", primary)
+
+		done, err := c.syncBackend(ctx, bucket.GetName(), spec, primaryClient, targetClient, backendName, s, limiters)
+		if err != nil {
+			log.Info("Failed to synchronise bucket to backend", consts.KeyBucketName, bucket.GetName(), consts.KeyBackendName, backendName, "error", err.Error())
+			s.Message = err.Error()
+			syncErr = errors.Wrap(err, errSyncBackend)
+
+			continue
+		}
+		s.Message = ""
+
+		if !done {
+			requeueAfter = continueAfter
+		}
+	}
+
+	c.setLag(bucket, syncs)
+	c.metrics.setBucketLag(bucket.GetNamespace(), bucket.GetName(), syncs)
+
+	if attempted || len(syncs) != len(status.AtProvider.ReplicaSync) {
+		if err := c.updateReplicaSync(ctx, bucket, syncs); err != nil {
+			err = errors.Wrap(err, errUpdateReplicaSync)
+			traces.SetAndRecordError(span, err)
+
+			return ctrl.Result{}, err
+		}
+	}
+
+	if syncErr != nil {
+		// The checkpoint of the failed backend has been persisted, so
+		// its pass is resumed from there when the Bucket is retried.
+		traces.SetAndRecordError(span, syncErr)
+
+		return ctrl.Result{}, syncErr
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// untilDue returns the time until the next pass of the synchronisation to a
+// backend must start. A pass is due immediately if one is in progress or the
+// backend has never been synchronised.
+func (c *Controller) untilDue(s *v1alpha1.ReplicaSyncStatus) time.Duration {
+	if s.PassStarted != nil || s.SyncedUntil == nil {
+		return 0
+	}
+
+	return c.interval - c.now().Sub(s.SyncedUntil.Time)
+}
+
+// setLag sets the lag of each backend, which is measured from the creation of
+// the bucket for backends which have never been synchronised.
+func (c *Controller) setLag(bucket client.Object, syncs v1alpha1.ReplicaSyncStatuses) {
+	for _, s := range syncs {
+		since := bucket.GetCreationTimestamp().Time
+		if s.SyncedUntil != nil {
+			since = s.SyncedUntil.Time
+		}
+		s.Lag = &metav1.Duration{Duration: c.now().Sub(since).Truncate(time.Second)}
+	}
+}
+
+// resolvePrimary returns the name of the backend of the primary ProviderConfig.
+// The primary of a namespaced Bucket refers to a ProviderConfig in the namespace
+// of the Bucket or, if there is none with that name, to a ClusterProviderConfig,
+// but never to a legacy ProviderConfig.
+func (c *Controller) resolvePrimary(namespace, primary string) string {
+	if namespace == "" {
+		return primary
+	}
+
+	backendName := backendstore.BackendName(namespace, primary)
+	if !c.backendStore.BackendExists(backendName) && c.backendStore.BackendExists(primary) && !c.backendStore.IsBackendLegacy(primary) {
+		return primary
+	}
+
+	return backendName
+}
+
+// isUsable returns true if the backend is healthy and the bucket is available on it.
+func (c *Controller) isUsable(status *v1alpha1.BucketStatus, backendName string) bool {
+	return isAvailable(status, backendName) && c.backendStore.GetBackendHealthStatus(backendName) != apisv1alpha1.HealthStatusUnhealthy
+}
+
+// getClient returns the S3 client of the bucket on a backend. The client assumes
+// the role of the bucket or of the backend, if any, like the Bucket controller.
+func (c *Controller) getClient(ctx context.Context, bucket client.Object, backendName string) (backendstore.S3Client, error) {
+	return c.s3ClientHandler.GetS3Client(ctx, asClusterBucket(bucket), backendName)
+}
+
+// getLimiters returns the limiters of the rate at which the objects of the
+// bucket are copied, ie the limiter shared by all buckets and that of the bucket.
+func (c *Controller) getLimiters(spec *v1alpha1.ReplicaSync) []*rate.Limiter {
+	limiters := []*rate.Limiter{}
+	if c.limiter != nil {
+		limiters = append(limiters, c.limiter)
+	}
+	if spec.BandwidthLimit != nil {
+		if l := newLimiter(spec.BandwidthLimit.Value()); l != nil {
+			limiters = append(limiters, l)
+		}
+	}
+
+	return limiters
+}
+
+// updateReplicaSync persists the replica sync status in the latest version of the bucket.
+func (c *Controller) updateReplicaSync(ctx context.Context, bucket client.Object, syncs v1alpha1.ReplicaSyncStatuses) error {
+	err := retry.OnError(retry.DefaultRetry, resource.IsAPIError, func() error {
+		if err := c.kubeReader.Get(ctx, client.ObjectKeyFromObject(bucket), bucket); err != nil {
+			return err
+		}
+
+		bucketCopy, _ := bucket.DeepCopyObject().(client.Object)
+		_, status := bucketReplicaSync(bucket)
+		status.AtProvider.ReplicaSync = syncs
+
+		return c.kubeClient.Status().Patch(ctx, bucket, client.MergeFrom(bucketCopy))
+	})
+
+	return resource.Ignore(kerrors.IsNotFound, err)
+}
+
+// bucketReplicaSync returns the replica sync and the status of a Bucket of
+// either scope, which are of the same types.
+func bucketReplicaSync(bucket client.Object) (*v1alpha1.ReplicaSync, *v1alpha1.BucketStatus) {
+	switch b := bucket.(type) {
+	case *v1alpha1.Bucket:
+		return b.Spec.ReplicaSync, &b.Status
+	case *nsv1alpha1.Bucket:
+		return b.Spec.ReplicaSync, &b.Status
+	default:
+		return nil, &v1alpha1.BucketStatus{}
+	}
+}
+
+// asClusterBucket returns a Bucket of either scope as a cluster scoped Bucket
+// with the same parameters, which determine the role assumed by its S3 clients.
+func asClusterBucket(bucket client.Object) *v1alpha1.Bucket {
+	switch b := bucket.(type) {
+	case *v1alpha1.Bucket:
+		return b
+	case *nsv1alpha1.Bucket:
+		return &v1alpha1.Bucket{
+			ObjectMeta: b.ObjectMeta,
+			Spec:       v1alpha1.BucketSpec{ForProvider: b.Spec.ForProvider},
+		}
+	default:
+		return &v1alpha1.Bucket{ObjectMeta: metav1.ObjectMeta{Namespace: bucket.GetNamespace(), Name: bucket.GetName()}}
+	}
+}
+
+// targetBackends returns the backends, sorted by name, to which the bucket is
+// synchronised, ie those other than the primary on which it is available.
+func targetBackends(status *v1alpha1.BucketStatus, primary string) []string {
+	targets := []string{}
+	for backendName := range status.AtProvider.Backends {
+		if backendName != primary && isAvailable(status, backendName) {
+			targets = append(targets, backendName)
+		}
+	}
+	slices.Sort(targets)
+
+	return targets
+}
+
+// isAvailable returns true if the bucket is available on the backend.
+func isAvailable(status *v1alpha1.BucketStatus, backendName string) bool {
+	backend, ok := status.AtProvider.Backends[backendName]
+
+	return ok && backend != nil && backend.BucketCondition.Equal(xpv1.Available())
+}
@@ -0,0 +1,560 @@
+package replicasync
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go/middleware"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/backendstore/backendstorefakes"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/controller/s3clienthandler"
+	"github.com/linode/provider-ceph/internal/rgw"
+)
+
+var (
+	testNow      = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	testInterval = 5 * time.Minute
+	errExternal  = errors.New("external error")
+)
+
+// listed is an object version or delete marker listed on the primary backend.
+type listed struct {
+	key      string
+	id       string
+	modified time.Duration
+	latest   bool
+	marker   bool
+}
+
+// testListing lists the versions of object "a" and "c" and the delete marker
+// over object "b" in the order of S3, ie by key and latest version first.
+// The current versions of "a" and "b" were modified recently.
+var testListing = []listed{
+	{key: "a", id: "a2", modified: 10 * time.Minute, latest: true},
+	{key: "a", id: "a1", modified: 2 * time.Hour},
+	{key: "b", id: "b-marker", modified: 10 * time.Minute, latest: true, marker: true},
+	{key: "b", id: "b1", modified: 2 * time.Hour},
+	{key: "c", id: "c1", modified: 2 * time.Hour, latest: true},
+}
+
+// listStub lists the versions of up to MaxKeys keys after KeyMarker.
+func listStub(listing []listed) func(context.Context, *s3.ListObjectVersionsInput, ...func(*s3.Options)) (*s3.ListObjectVersionsOutput, error) {
+	return func(_ context.Context, in *s3.ListObjectVersionsInput, _ ...func(*s3.Options)) (*s3.ListObjectVersionsOutput, error) {
+		keys := []string{}
+		for _, l := range listing {
+			if l.key > aws.ToString(in.KeyMarker) && !slices.Contains(keys, l.key) {
+				keys = append(keys, l.key)
+			}
+		}
+		n := min(len(keys), int(aws.ToInt32(in.MaxKeys)))
+
+		out := &s3.ListObjectVersionsOutput{IsTruncated: aws.Bool(n < len(keys))}
+		if n < len(keys) {
+			out.NextKeyMarker = aws.String(keys[n-1])
+		}
+		for _, l := range listing {
+			if !slices.Contains(keys[:n], l.key) {
+				continue
+			}
+			modified := testNow.Add(-l.modified)
+			if l.marker {
+				out.DeleteMarkers = append(out.DeleteMarkers, s3types.DeleteMarkerEntry{
+					Key: aws.String(l.key), VersionId: aws.String(l.id), LastModified: &modified, IsLatest: aws.Bool(l.latest),
+				})
+
+				continue
+			}
+			out.Versions = append(out.Versions, s3types.ObjectVersion{
+				Key: aws.String(l.key), VersionId: aws.String(l.id), LastModified: &modified, IsLatest: aws.Bool(l.latest),
+			})
+		}
+
+		return out, nil
+	}
+}
+
+// versionListStub lists up to MaxKeys object versions of the listing after
+// KeyMarker and VersionIdMarker, so that the versions of a key may span pages.
+func versionListStub(listing []listed) func(context.Context, *s3.ListObjectVersionsInput, ...func(*s3.Options)) (*s3.ListObjectVersionsOutput, error) {
+	return func(_ context.Context, in *s3.ListObjectVersionsInput, _ ...func(*s3.Options)) (*s3.ListObjectVersionsOutput, error) {
+		start := slices.IndexFunc(listing, func(l listed) bool {
+			return l.key > aws.ToString(in.KeyMarker)
+		})
+		if in.VersionIdMarker != nil {
+			start = slices.IndexFunc(listing, func(l listed) bool {
+				return l.key == aws.ToString(in.KeyMarker) && l.id == aws.ToString(in.VersionIdMarker)
+			}) + 1
+		}
+		if start < 0 {
+			start = len(listing)
+		}
+		end := min(len(listing), start+int(aws.ToInt32(in.MaxKeys)))
+
+		out, err := listStub(listing[start:end])(context.Background(), &s3.ListObjectVersionsInput{MaxKeys: aws.Int32(int32(len(listing)))})
+		if end < len(listing) {
+			out.IsTruncated = aws.Bool(true)
+			out.NextKeyMarker = aws.String(listing[end-1].key)
+			out.NextVersionIdMarker = aws.String(listing[end-1].id)
+		}
+
+		return out, err
+	}
+}
+
+// getStub returns the version ID of the object version as its body.
+func getStub(_ context.Context, in *s3.GetObjectInput, _ ...func(*s3.Options)) (*s3.GetObjectOutput, error) {
+	return &s3.GetObjectOutput{
+		Body:          io.NopCloser(strings.NewReader(aws.ToString(in.VersionId))),
+		ContentLength: aws.Int64(int64(len(aws.ToString(in.VersionId)))),
+	}, nil
+}
+
+func syncBucket(spec *v1alpha1.ReplicaSync, syncs v1alpha1.ReplicaSyncStatuses) *v1alpha1.Bucket {
+	return &v1alpha1.Bucket{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: consts.TestBucket,
+		},
+		Spec: v1alpha1.BucketSpec{
+			ReplicaSync: spec,
+		},
+		Status: v1alpha1.BucketStatus{
+			AtProvider: v1alpha1.BucketObservation{
+				Backends: v1alpha1.Backends{
+					consts.S3Backend1: &v1alpha1.BackendInfo{BucketCondition: xpv1.Available()},
+					consts.S3Backend2: &v1alpha1.BackendInfo{BucketCondition: xpv1.Available()},
+				},
+				ReplicaSync: syncs,
+			},
+		},
+	}
+}
+
+// pausedBucketFilter returns a client which does not find paused Buckets, like
+// the client of the manager whose cache does not hold paused Buckets.
+func pausedBucketFilter(cl client.WithWatch) client.WithWatch {
+	return interceptor.NewClient(cl, interceptor.Funcs{
+		Get: func(ctx context.Context, cl client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+			if err := cl.Get(ctx, key, obj, opts...); err != nil {
+				return err
+			}
+			if obj.GetLabels()[meta.AnnotationKeyReconciliationPaused] == "true" {
+				return kerrors.NewNotFound(schema.GroupResource{}, key.Name)
+			}
+
+			return nil
+		},
+	})
+}
+
+//nolint:maintidx // Requires many scenarios for full coverage.
+func TestReconcile(t *testing.T) {
+	t.Parallel()
+
+	at := func(d time.Duration) *metav1.Time {
+		t := metav1.NewTime(testNow.Add(-d))
+
+		return &t
+	}
+
+	type args struct {
+		bucket        *v1alpha1.Bucket
+		primaryHealth apisv1alpha1.HealthStatus
+		pageSize      int32
+		pageVersions  bool
+		putErr        error
+	}
+
+	type want struct {
+		result   ctrl.Result
+		err      error
+		sync     *v1alpha1.ReplicaSyncStatus
+		gets     []string
+		deletes  int
+		lagGauge float64
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"Bucket without replica sync is not synchronised": {
+			args: args{
+				bucket: syncBucket(nil, nil),
+			},
+			want: want{
+				result: ctrl.Result{},
+			},
+		},
+		"First pass copies current versions and delete markers": {
+			args: args{
+				bucket: syncBucket(&v1alpha1.ReplicaSync{Primary: consts.S3Backend1}, nil),
+			},
+			want: want{
+				result:  ctrl.Result{RequeueAfter: testInterval},
+				sync:    &v1alpha1.ReplicaSyncStatus{SyncedUntil: at(0), ObjectsCopied: 3, Lag: &metav1.Duration{}},
+				gets:    []string{"a2", "c1"},
+				deletes: 1,
+			},
+		},
+		"Paused bucket is synchronised": {
+			args: args{
+				bucket: func() *v1alpha1.Bucket {
+					bucket := syncBucket(&v1alpha1.ReplicaSync{Primary: consts.S3Backend1}, nil)
+					bucket.Labels = map[string]string{meta.AnnotationKeyReconciliationPaused: "true"}
+
+					return bucket
+				}(),
+			},
+			want: want{
+				result:  ctrl.Result{RequeueAfter: testInterval},
+				sync:    &v1alpha1.ReplicaSyncStatus{SyncedUntil: at(0), ObjectsCopied: 3, Lag: &metav1.Duration{}},
+				gets:    []string{"a2", "c1"},
+				deletes: 1,
+			},
+		},
+		"Noncurrent versions are copied oldest first": {
+			args: args{
+				bucket: syncBucket(&v1alpha1.ReplicaSync{Primary: consts.S3Backend1, Versions: true}, nil),
+			},
+			want: want{
+				result:  ctrl.Result{RequeueAfter: testInterval},
+				sync:    &v1alpha1.ReplicaSyncStatus{SyncedUntil: at(0), ObjectsCopied: 5, Lag: &metav1.Duration{}},
+				gets:    []string{"a1", "a2", "b1", "c1"},
+				deletes: 1,
+			},
+		},
+		"Versions of a key listed on more than one page are copied oldest first": {
+			args: args{
+				bucket:       syncBucket(&v1alpha1.ReplicaSync{Primary: consts.S3Backend1, Versions: true}, nil),
+				pageSize:     1,
+				pageVersions: true,
+			},
+			want: want{
+				result:  ctrl.Result{RequeueAfter: testInterval},
+				sync:    &v1alpha1.ReplicaSyncStatus{SyncedUntil: at(0), ObjectsCopied: 5, Lag: &metav1.Duration{}},
+				gets:    []string{"a1", "a2", "b1", "c1"},
+				deletes: 1,
+			},
+		},
+		"Only versions modified since the last pass are copied": {
+			args: args{
+				bucket: syncBucket(&v1alpha1.ReplicaSync{Primary: consts.S3Backend1}, v1alpha1.ReplicaSyncStatuses{
+					consts.S3Backend2: &v1alpha1.ReplicaSyncStatus{SyncedUntil: at(time.Hour)},
+				}),
+			},
+			want: want{
+				result:  ctrl.Result{RequeueAfter: testInterval},
+				sync:    &v1alpha1.ReplicaSyncStatus{SyncedUntil: at(0), ObjectsCopied: 2, Lag: &metav1.Duration{}},
+				gets:    []string{"a2"},
+				deletes: 1,
+			},
+		},
+		"Pass is not due so nothing is synchronised": {
+			args: args{
+				bucket: syncBucket(&v1alpha1.ReplicaSync{Primary: consts.S3Backend1}, v1alpha1.ReplicaSyncStatuses{
+					consts.S3Backend2: &v1alpha1.ReplicaSyncStatus{SyncedUntil: at(time.Minute)},
+				}),
+			},
+			want: want{
+				result:   ctrl.Result{RequeueAfter: testInterval - time.Minute},
+				sync:     &v1alpha1.ReplicaSyncStatus{SyncedUntil: at(time.Minute)},
+				lagGauge: time.Minute.Seconds(),
+			},
+		},
+		"Pass in progress resumes from its checkpoint": {
+			args: args{
+				bucket: syncBucket(&v1alpha1.ReplicaSync{Primary: consts.S3Backend1}, v1alpha1.ReplicaSyncStatuses{
+					consts.S3Backend2: &v1alpha1.ReplicaSyncStatus{PassStarted: at(time.Minute), KeyMarker: "b", ObjectsCopied: 2},
+				}),
+			},
+			want: want{
+				result: ctrl.Result{RequeueAfter: testInterval},
+				sync:   &v1alpha1.ReplicaSyncStatus{SyncedUntil: at(time.Minute), ObjectsCopied: 3, Lag: &metav1.Duration{Duration: time.Minute}},
+				gets:   []string{"c1"},
+			},
+		},
+		"Failed pass keeps its checkpoint": {
+			args: args{
+				bucket:   syncBucket(&v1alpha1.ReplicaSync{Primary: consts.S3Backend1}, nil),
+				pageSize: 2,
+				putErr:   errExternal,
+			},
+			want: want{
+				err: errExternal,
+				// The first page lists "a" and "b", of which "b" is held back as
+				// its versions may continue on the next page. The pass fails on
+				// the second page, so it resumes after "a".
+				sync:    &v1alpha1.ReplicaSyncStatus{PassStarted: at(0), KeyMarker: "a", ObjectsCopied: 2, Message: "failed to put object: external error"},
+				gets:    []string{"a2", "c1"},
+				deletes: 1,
+			},
+		},
+		"Primary backend is unhealthy": {
+			args: args{
+				bucket:        syncBucket(&v1alpha1.ReplicaSync{Primary: consts.S3Backend1}, nil),
+				primaryHealth: apisv1alpha1.HealthStatusUnhealthy,
+			},
+			want: want{
+				result: ctrl.Result{RequeueAfter: testInterval},
+				sync:   &v1alpha1.ReplicaSyncStatus{Message: msgPrimaryUnavailable},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// The lag of backends which have never been synchronised is
+			// measured from the creation of the bucket.
+			tc.args.bucket.CreationTimestamp = metav1.NewTime(testNow.Add(-time.Hour))
+
+			s := runtime.NewScheme()
+			require.NoError(t, v1alpha1.SchemeBuilder.AddToScheme(s))
+			cl := fake.NewClientBuilder().
+				WithScheme(s).
+				WithObjects(tc.args.bucket).
+				WithStatusSubresource(tc.args.bucket).
+				Build()
+
+			primary := &backendstorefakes.FakeS3Client{
+				ListObjectVersionsStub: listStub(testListing),
+				GetObjectStub:          getStub,
+			}
+			if tc.args.pageVersions {
+				primary.ListObjectVersionsStub = versionListStub(testListing)
+			}
+			target := &backendstorefakes.FakeS3Client{}
+			target.PutObjectReturns(&s3.PutObjectOutput{}, nil)
+			if tc.args.putErr != nil {
+				// The first object is copied, the second fails.
+				target.PutObjectReturnsOnCall(1, nil, tc.args.putErr)
+			}
+
+			primaryHealth := tc.args.primaryHealth
+			if primaryHealth == "" {
+				primaryHealth = apisv1alpha1.HealthStatusHealthy
+			}
+			bs := backendstore.NewBackendStore()
+			bs.AddOrUpdateBackend(consts.S3Backend1, primary, nil, primaryHealth)
+			bs.AddOrUpdateBackend(consts.S3Backend2, target, nil, apisv1alpha1.HealthStatusHealthy)
+
+			m := NewMetrics()
+			c := NewController(
+				WithKubeClient(pausedBucketFilter(cl)),
+				WithKubeReader(cl),
+				WithBackendStore(bs),
+				WithS3ClientHandler(s3clienthandler.NewHandler(s3clienthandler.WithBackendStore(bs))),
+				WithMetrics(m),
+				WithInterval(testInterval),
+				WithLogger(logr.Discard()))
+			c.now = func() time.Time { return testNow }
+			if tc.args.pageSize != 0 {
+				c.pageSize = tc.args.pageSize
+			}
+
+			got, err := c.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: consts.TestBucket}})
+			if tc.want.err != nil {
+				require.ErrorIs(t, err, tc.want.err, "unexpected error")
+			} else {
+				require.NoError(t, err, "unexpected error")
+				assert.Equal(t, tc.want.result, got, "unexpected result")
+			}
+
+			var gets []string
+			for i := range primary.GetObjectCallCount() {
+				_, in, _ := primary.GetObjectArgsForCall(i)
+				gets = append(gets, aws.ToString(in.VersionId))
+			}
+			assert.Equal(t, len(tc.want.gets), target.PutObjectCallCount(), "unexpected number of copied objects")
+			assert.Equal(t, tc.want.gets, gets, "unexpected object versions copied")
+			assert.Equal(t, tc.want.deletes, target.DeleteObjectCallCount(), "unexpected number of deleted objects")
+
+			bucket := &v1alpha1.Bucket{}
+			require.NoError(t, cl.Get(context.Background(), client.ObjectKeyFromObject(tc.args.bucket), bucket))
+			if tc.want.sync == nil {
+				assert.Empty(t, bucket.Status.AtProvider.ReplicaSync, "unexpected replica sync status")
+				assert.Equal(t, 0, testutil.CollectAndCount(m.lag), "unexpected lag gauges")
+
+				return
+			}
+
+			assert.NotContains(t, bucket.Status.AtProvider.ReplicaSync, consts.S3Backend1, "primary backend must not be synchronised")
+			status := bucket.Status.AtProvider.ReplicaSync[consts.S3Backend2]
+			require.NotNil(t, status, "missing replica sync status")
+			assert.True(t, tc.want.sync.SyncedUntil.Equal(status.SyncedUntil), "unexpected synced until %v", status.SyncedUntil)
+			assert.True(t, tc.want.sync.PassStarted.Equal(status.PassStarted), "unexpected pass started %v", status.PassStarted)
+			assert.Equal(t, tc.want.sync.KeyMarker, status.KeyMarker, "unexpected checkpoint")
+			assert.Equal(t, tc.want.sync.ObjectsCopied, status.ObjectsCopied, "unexpected number of copied objects")
+			assert.Equal(t, tc.want.sync.Message, status.Message, "unexpected message")
+			if tc.want.sync.Lag != nil {
+				assert.Equal(t, tc.want.sync.Lag, status.Lag, "unexpected lag")
+			}
+			if tc.want.lagGauge != 0 {
+				assert.InDelta(t, tc.want.lagGauge, testutil.ToFloat64(m.lag.WithLabelValues("", consts.TestBucket, consts.S3Backend2)), 0, "unexpected lag gauge")
+			}
+		})
+	}
+}
+
+func TestHoldBackLastKey(t *testing.T) {
+	t.Parallel()
+
+	next := checkpoint{keyMarker: "b", versionIDMarker: "b1"}
+
+	versions := listedVersions(&s3.ListObjectVersionsOutput{Versions: []s3types.ObjectVersion{
+		{Key: aws.String("a"), VersionId: aws.String("a1")},
+		{Key: aws.String("b"), VersionId: aws.String("b2")},
+	}})
+	held, got := holdBackLastKey(versions, next)
+	assert.Equal(t, []version{versions[0]}, held, "versions of the last key must be held back")
+	assert.Equal(t, checkpoint{keyMarker: "a"}, got, "listing must resume with the last key")
+
+	single := versions[1:]
+	held, got = holdBackLastKey(single, next)
+	assert.Equal(t, single, held, "versions of a single key must not be held back")
+	assert.Equal(t, next, got, "listing must resume from the next marker")
+}
+
+func TestLimitedReader(t *testing.T) {
+	t.Parallel()
+
+	const burst = 4
+
+	r := &limitedReader{
+		ctx:      context.Background(),
+		r:        strings.NewReader("0123456789"),
+		limiters: []*rate.Limiter{rate.NewLimiter(rate.Inf, burst)},
+	}
+
+	p := make([]byte, 10)
+	n, err := r.Read(p)
+	require.NoError(t, err)
+	assert.Equal(t, burst, n, "reads must not exceed the burst of the limiter")
+
+	rest, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, "0123456789", string(p[:n])+string(rest), "unexpected content")
+}
+
+func TestServerTime(t *testing.T) {
+	t.Parallel()
+
+	const date = "Mon, 01 Jan 2024 11:58:30 GMT"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Date", date)
+		_, _ = w.Write([]byte(`<ListVersionsResult></ListVersionsResult>`))
+	}))
+	t.Cleanup(server.Close)
+
+	cl, err := rgw.NewS3Client(context.Background(), map[string][]byte{
+		consts.KeyAccessKey: []byte("access"),
+		consts.KeySecretKey: []byte("secret"),
+	}, &apisv1alpha1.ProviderConfigSpec{HostBase: server.URL}, time.Second, nil)
+	require.NoError(t, err)
+
+	resp, err := cl.ListObjectVersions(context.Background(), &s3.ListObjectVersionsInput{Bucket: aws.String(consts.TestBucket)})
+	require.NoError(t, err)
+
+	now := func() time.Time { return testNow }
+	assert.Equal(t, time.Date(2024, 1, 1, 11, 58, 30, 0, time.UTC), serverTime(resp.ResultMetadata, now).UTC(), "time of the backend must be used")
+	assert.Equal(t, testNow, serverTime(middleware.Metadata{}, now), "time of the provider must be used without a response")
+}
+
+func TestSyncVersionMultipart(t *testing.T) {
+	t.Parallel()
+
+	const parts = 6
+
+	cases := map[string]struct {
+		uploadErr   error
+		wantErr     bool
+		wantAborted bool
+	}{
+		"Object larger than a single PutObject is copied in parts": {},
+		"Failed multipart upload is aborted": {
+			uploadErr:   errExternal,
+			wantErr:     true,
+			wantAborted: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			primary := &backendstorefakes.FakeS3Client{}
+			primary.GetObjectReturns(&s3.GetObjectOutput{
+				Body:          io.NopCloser(strings.NewReader("")),
+				ContentLength: aws.Int64(parts*multipartPartSize - 1),
+				ContentType:   aws.String("application/octet-stream"),
+			}, nil)
+
+			target := &backendstorefakes.FakeS3Client{}
+			target.CreateMultipartUploadReturns(&s3.CreateMultipartUploadOutput{UploadId: aws.String("upload")}, nil)
+			target.UploadPartReturns(&s3.UploadPartOutput{ETag: aws.String("etag")}, nil)
+			if tc.uploadErr != nil {
+				target.UploadPartReturnsOnCall(1, nil, tc.uploadErr)
+			}
+
+			c := NewController(WithLogger(logr.Discard()))
+			err := c.syncVersion(context.Background(), consts.TestBucket, version{key: "a"}, primary, target, consts.S3Backend2, nil)
+			if tc.wantErr {
+				require.Error(t, err, "expected error")
+			} else {
+				require.NoError(t, err, "unexpected error")
+			}
+
+			assert.Zero(t, target.PutObjectCallCount(), "object must not be copied by PutObject")
+			require.Equal(t, 1, target.CreateMultipartUploadCallCount(), "unexpected number of multipart uploads")
+			_, create, _ := target.CreateMultipartUploadArgsForCall(0)
+			assert.Equal(t, "application/octet-stream", aws.ToString(create.ContentType), "content type must be copied")
+
+			if tc.wantAborted {
+				assert.Equal(t, 1, target.AbortMultipartUploadCallCount(), "failed upload must be aborted")
+				assert.Zero(t, target.CompleteMultipartUploadCallCount(), "failed upload must not be completed")
+
+				return
+			}
+
+			require.Equal(t, parts, target.UploadPartCallCount(), "unexpected number of parts")
+			_, last, _ := target.UploadPartArgsForCall(parts - 1)
+			assert.Equal(t, int64(multipartPartSize-1), aws.ToInt64(last.ContentLength), "last part must hold the rest of the object")
+			require.Equal(t, 1, target.CompleteMultipartUploadCallCount(), "upload must be completed")
+			_, complete, _ := target.CompleteMultipartUploadArgsForCall(0)
+			assert.Len(t, complete.MultipartUpload.Parts, parts, "all parts must be completed")
+			assert.Zero(t, target.AbortMultipartUploadCallCount(), "completed upload must not be aborted")
+		})
+	}
+}
@@ -0,0 +1,410 @@
+package replicasync
+
+import (
+	"cmp"
+	"context"
+	"io"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/time/rate"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/otel/traces"
+	"github.com/linode/provider-ceph/internal/rgw"
+)
+
+const (
+	// maxPagesPerReconcile is the number of pages of object versions synchronised
+	// to a backend in a single reconcile, after which the pass is checkpointed.
+	maxPagesPerReconcile = 10
+
+	// maxPutObjectSize is the size of the largest object which can be uploaded
+	// by a single PutObject. Larger objects are uploaded by a multipart upload.
+	maxPutObjectSize = 5 << 30
+
+	// multipartPartSize is the size of the parts of a multipart upload, which
+	// allows objects of up to 10 TiB to be uploaded in 10,000 parts.
+	multipartPartSize = 1 << 30
+)
+
+// version is an object version or delete marker listed on the primary backend.
+type version struct {
+	key          string
+	versionID    string
+	lastModified time.Time
+	isLatest     bool
+	deleteMarker bool
+}
+
+// checkpoint is the position in the listing of object versions at which a pass resumes.
+type checkpoint struct {
+	keyMarker       string
+	versionIDMarker string
+}
+
+// syncBackend continues the pass of the synchronisation of the bucket to the backend
+// from the checkpoint in s, for up to maxPagesPerReconcile pages of object versions.
+// The checkpoint is advanced after each page which has been synchronised. It returns
+// true once the pass is complete.
+func (c *Controller) syncBackend(ctx context.Context, bucketName string, spec *v1alpha1.ReplicaSync, primary, target backendstore.S3Client, backendName string, s *v1alpha1.ReplicaSyncStatus, limiters []*rate.Limiter) (bool, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "replicasync.Controller.syncBackend")
+	defer span.End()
+
+	if s.PassStarted == nil {
+		s.KeyMarker, s.VersionIDMarker = "", ""
+		s.ObjectsCopied = 0
+	}
+
+	for range maxPagesPerReconcile {
+		resp, err := rgw.ListObjectVersions(ctx, primary, &awss3.ListObjectVersionsInput{
+			Bucket:          aws.String(bucketName),
+			KeyMarker:       optional(s.KeyMarker),
+			VersionIdMarker: optional(s.VersionIDMarker),
+			MaxKeys:         aws.Int32(c.pageSize),
+		})
+		if err != nil {
+			traces.SetAndRecordError(span, err)
+
+			return false, err
+		}
+		if s.PassStarted == nil {
+			// The pass starts at the time of the backend rather than that of
+			// the provider, as it is compared with the LastModified times of
+			// object versions, which are set by the clock of the backend.
+			started := metav1.NewTime(serverTime(resp.ResultMetadata, c.now))
+			s.PassStarted = &started
+		}
+
+		versions := listedVersions(resp)
+		next := checkpoint{keyMarker: aws.ToString(resp.NextKeyMarker), versionIDMarker: aws.ToString(resp.NextVersionIdMarker)}
+		truncated := aws.ToBool(resp.IsTruncated)
+		if truncated && len(versions) > 0 && versions[0].key == versions[len(versions)-1].key {
+			// The versions of the only key of the page may continue on the
+			// next pages, so they are all listed before any is synchronised.
+			versions, next, truncated, err = c.listKeyVersions(ctx, bucketName, primary, versions, next)
+			if err != nil {
+				traces.SetAndRecordError(span, err)
+
+				return false, err
+			}
+		}
+		if truncated {
+			versions, next = holdBackLastKey(versions, next)
+		}
+
+		for _, v := range versions {
+			if !needsSync(v, spec, s) {
+				continue
+			}
+			if err := c.syncVersion(ctx, bucketName, v, primary, target, backendName, limiters); err != nil {
+				traces.SetAndRecordError(span, err)
+
+				return false, err
+			}
+			s.ObjectsCopied++
+		}
+
+		if !truncated {
+			s.SyncedUntil = s.PassStarted
+			s.PassStarted = nil
+			s.KeyMarker, s.VersionIDMarker = "", ""
+
+			return true, nil
+		}
+		s.KeyMarker, s.VersionIDMarker = next.keyMarker, next.versionIDMarker
+	}
+
+	return false, nil
+}
+
+// needsSync returns true if the version must be synchronised in the current pass,
+// ie it has been modified since the last pass started and it is the current
+// version of its object or noncurrent versions are synchronised too.
+func needsSync(v version, spec *v1alpha1.ReplicaSync, s *v1alpha1.ReplicaSyncStatus) bool {
+	if !v.isLatest && !spec.Versions {
+		return false
+	}
+
+	// LastModified has a resolution of a second, so versions modified in
+	// the second in which the last pass started are synchronised again.
+	return s.SyncedUntil == nil || !v.lastModified.Before(s.SyncedUntil.Truncate(time.Second))
+}
+
+// serverTime returns the time of a response according to its Date header, or
+// the current time of the provider if the response has no valid Date header.
+func serverTime(metadata middleware.Metadata, now func() time.Time) time.Time {
+	if raw, ok := awsmiddleware.GetRawResponse(metadata).(*smithyhttp.Response); ok {
+		if t, err := http.ParseTime(raw.Header.Get("Date")); err == nil {
+			return t
+		}
+	}
+
+	return now()
+}
+
+// syncVersion copies an object version to the backend, or deletes the object from
+// the backend if the version is a delete marker. Objects larger than a single
+// PutObject allows are copied by a multipart upload.
+func (c *Controller) syncVersion(ctx context.Context, bucketName string, v version, primary, target backendstore.S3Client, backendName string, limiters []*rate.Limiter) error {
+	if v.deleteMarker {
+		return rgw.DeleteObject(ctx, target, &awss3.DeleteObjectInput{
+			Bucket: aws.String(bucketName),
+			Key:    aws.String(v.key),
+		})
+	}
+
+	obj, err := rgw.GetObject(ctx, primary, &awss3.GetObjectInput{
+		Bucket:    aws.String(bucketName),
+		Key:       aws.String(v.key),
+		VersionId: optional(v.versionID),
+	})
+	if err != nil {
+		return err
+	}
+	defer obj.Body.Close()
+
+	body := &limitedReader{ctx: ctx, r: obj.Body, limiters: limiters}
+	size := aws.ToInt64(obj.ContentLength)
+	if size > maxPutObjectSize {
+		err = c.putObjectMultipart(ctx, bucketName, v.key, obj, body, target)
+	} else {
+		// The body is streamed from the primary backend, so it cannot be
+		// hashed for the signature of the request in advance.
+		err = rgw.PutObject(ctx, target, &awss3.PutObjectInput{
+			Bucket:             aws.String(bucketName),
+			Key:                aws.String(v.key),
+			Body:               body,
+			ContentLength:      obj.ContentLength,
+			ContentType:        obj.ContentType,
+			ContentEncoding:    obj.ContentEncoding,
+			ContentDisposition: obj.ContentDisposition,
+			ContentLanguage:    obj.ContentLanguage,
+			CacheControl:       obj.CacheControl,
+			Metadata:           obj.Metadata,
+		}, awss3.WithAPIOptions(v4.SwapComputePayloadSHA256ForUnsignedPayloadMiddleware))
+	}
+	if err != nil {
+		return err
+	}
+
+	c.metrics.addCopiedBytes(backendName, size)
+
+	return nil
+}
+
+// putObjectMultipart copies the body of an object to the backend by a multipart
+// upload in parts of up to multipartPartSize bytes. The upload is aborted if it
+// fails, so that its parts do not take up space on the backend.
+func (c *Controller) putObjectMultipart(ctx context.Context, bucketName, key string, obj *awss3.GetObjectOutput, body io.Reader, target backendstore.S3Client) error {
+	upload, err := rgw.CreateMultipartUpload(ctx, target, &awss3.CreateMultipartUploadInput{
+		Bucket:             aws.String(bucketName),
+		Key:                aws.String(key),
+		ContentType:        obj.ContentType,
+		ContentEncoding:    obj.ContentEncoding,
+		ContentDisposition: obj.ContentDisposition,
+		ContentLanguage:    obj.ContentLanguage,
+		CacheControl:       obj.CacheControl,
+		Metadata:           obj.Metadata,
+	})
+	if err != nil {
+		return err
+	}
+
+	parts, err := uploadParts(ctx, bucketName, key, upload.UploadId, body, aws.ToInt64(obj.ContentLength), target)
+	if err != nil {
+		// The upload is aborted even if the copy was cancelled.
+		if abortErr := rgw.AbortMultipartUpload(context.WithoutCancel(ctx), target, &awss3.AbortMultipartUploadInput{
+			Bucket:   aws.String(bucketName),
+			Key:      aws.String(key),
+			UploadId: upload.UploadId,
+		}); abortErr != nil {
+			c.log.Info("Failed to abort multipart upload", consts.KeyBucketName, bucketName, "key", key, "error", abortErr.Error())
+		}
+
+		return err
+	}
+
+	return rgw.CompleteMultipartUpload(ctx, target, &awss3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(bucketName),
+		Key:             aws.String(key),
+		UploadId:        upload.UploadId,
+		MultipartUpload: &s3types.CompletedMultipartUpload{Parts: parts},
+	})
+}
+
+// uploadParts uploads size bytes of the body as consecutive parts of a multipart
+// upload and returns the completed parts.
+func uploadParts(ctx context.Context, bucketName, key string, uploadID *string, body io.Reader, size int64, target backendstore.S3Client) ([]s3types.CompletedPart, error) {
+	parts := []s3types.CompletedPart{}
+	for number := int32(1); size > 0; number++ {
+		partSize := min(size, multipartPartSize)
+		resp, err := rgw.UploadPart(ctx, target, &awss3.UploadPartInput{
+			Bucket:        aws.String(bucketName),
+			Key:           aws.String(key),
+			UploadId:      uploadID,
+			PartNumber:    aws.Int32(number),
+			Body:          io.LimitReader(body, partSize),
+			ContentLength: aws.Int64(partSize),
+		}, awss3.WithAPIOptions(v4.SwapComputePayloadSHA256ForUnsignedPayloadMiddleware))
+		if err != nil {
+			return nil, err
+		}
+
+		parts = append(parts, s3types.CompletedPart{ETag: resp.ETag, PartNumber: aws.Int32(number)})
+		size -= partSize
+	}
+
+	return parts, nil
+}
+
+// listedVersions returns the object versions and delete markers of a page of the
+// listing, ordered by key and, within a key, from the oldest to the latest version.
+func listedVersions(resp *awss3.ListObjectVersionsOutput) []version {
+	versions := make([]version, 0, len(resp.Versions)+len(resp.DeleteMarkers))
+	for _, v := range resp.Versions {
+		versions = append(versions, version{
+			key:          aws.ToString(v.Key),
+			versionID:    aws.ToString(v.VersionId),
+			lastModified: aws.ToTime(v.LastModified),
+			isLatest:     aws.ToBool(v.IsLatest),
+		})
+	}
+	for _, m := range resp.DeleteMarkers {
+		versions = append(versions, version{
+			key:          aws.ToString(m.Key),
+			versionID:    aws.ToString(m.VersionId),
+			lastModified: aws.ToTime(m.LastModified),
+			isLatest:     aws.ToBool(m.IsLatest),
+			deleteMarker: true,
+		})
+	}
+
+	sortVersions(versions)
+
+	return versions
+}
+
+// listKeyVersions lists the remaining versions of the key of a truncated page which
+// lists a single key, from the checkpoint of the page. It returns the versions of
+// the key from all pages, ordered from the oldest to the latest version, and the
+// checkpoint after the key, which is truncated unless the listing is complete.
+func (c *Controller) listKeyVersions(ctx context.Context, bucketName string, primary backendstore.S3Client, versions []version, next checkpoint) ([]version, checkpoint, bool, error) {
+	key := versions[0].key
+	for {
+		resp, err := rgw.ListObjectVersions(ctx, primary, &awss3.ListObjectVersionsInput{
+			Bucket:          aws.String(bucketName),
+			KeyMarker:       optional(next.keyMarker),
+			VersionIdMarker: optional(next.versionIDMarker),
+			MaxKeys:         aws.Int32(c.pageSize),
+		})
+		if err != nil {
+			return nil, next, false, err
+		}
+
+		page := listedVersions(resp)
+		if i := slices.IndexFunc(page, func(v version) bool { return v.key != key }); i >= 0 {
+			versions = append(versions, page[:i]...)
+			sortVersions(versions)
+
+			return versions, checkpoint{keyMarker: key}, true, nil
+		}
+		versions = append(versions, page...)
+
+		if !aws.ToBool(resp.IsTruncated) {
+			sortVersions(versions)
+
+			return versions, checkpoint{}, false, nil
+		}
+		next = checkpoint{keyMarker: aws.ToString(resp.NextKeyMarker), versionIDMarker: aws.ToString(resp.NextVersionIdMarker)}
+	}
+}
+
+// sortVersions orders object versions by key and, within a key, from the oldest
+// to the latest version.
+func sortVersions(versions []version) {
+	slices.SortStableFunc(versions, func(a, b version) int {
+		if c := cmp.Compare(a.key, b.key); c != 0 {
+			return c
+		}
+		if c := a.lastModified.Compare(b.lastModified); c != 0 {
+			return c
+		}
+		// The latest version goes last if it was modified in the same second.
+		switch {
+		case a.isLatest == b.isLatest:
+			return 0
+		case a.isLatest:
+			return 1
+		default:
+			return -1
+		}
+	})
+}
+
+// holdBackLastKey removes the versions of the last key of a truncated page, whose
+// older versions may be listed on the next page, unless the page lists a single key,
+// whose remaining versions are listed by listKeyVersions.
+// The returned checkpoint resumes the listing with the removed key, so that the
+// versions of each key are synchronised from the oldest to the latest.
+func holdBackLastKey(versions []version, next checkpoint) ([]version, checkpoint) {
+	if len(versions) == 0 {
+		return versions, next
+	}
+
+	last := versions[len(versions)-1].key
+	i := slices.IndexFunc(versions, func(v version) bool {
+		return v.key == last
+	})
+	if i == 0 {
+		return versions, next
+	}
+
+	return versions[:i], checkpoint{keyMarker: versions[i-1].key}
+}
+
+// optional returns nil for an empty string, so that it is omitted from a request.
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return aws.String(s)
+}
+
+// limitedReader limits the rate at which the underlying reader is read to that
+// of the slowest of its limiters.
+type limitedReader struct {
+	ctx      context.Context //nolint:containedctx // Reads are bound to the context of the copy.
+	r        io.Reader
+	limiters []*rate.Limiter
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	for _, limiter := range l.limiters {
+		if len(p) > limiter.Burst() {
+			p = p[:limiter.Burst()]
+		}
+	}
+
+	n, err := l.r.Read(p)
+	for _, limiter := range l.limiters {
+		if werr := limiter.WaitN(l.ctx, n); werr != nil {
+			return n, werr
+		}
+	}
+
+	return n, err
+}
@@ -0,0 +1,130 @@
+package s3clienthandler
+
+import (
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// refreshWindow is how long before their expiration cached AssumeRole
+	// credentials are refreshed, so that S3 operations never use credentials
+	// which expire while they are in flight.
+	refreshWindow = 5 * time.Minute
+	// refreshWindowDivisor limits the refresh window of short sessions to a
+	// fraction of their lifetime, so that they are still cached.
+	refreshWindowDivisor = 4
+)
+
+// cachedS3Client is an S3 client created from AssumeRole credentials.
+type cachedS3Client struct {
+	s3Client backendstore.S3Client
+	// expiration is the time at which the credentials of the client expire.
+	expiration time.Time
+	// refreshAt is the time from which the credentials are refreshed. It is
+	// set when the client is cached.
+	refreshAt time.Time
+	// credentialsVersion is the version of the credentials of the backend
+	// which were used to assume the role.
+	credentialsVersion string
+}
+
+// credentialCache caches S3 clients created from AssumeRole credentials by
+// backend, role, session policy and assume role tags, so that STS is only
+// called when the credentials are about to expire or the backend credentials
+// change.
+type credentialCache struct {
+	entries map[string]cachedS3Client
+	// group deduplicates concurrent AssumeRole calls for the same key.
+	group singleflight.Group
+	mu    sync.RWMutex
+}
+
+func newCredentialCache() *credentialCache {
+	return &credentialCache{
+		entries: make(map[string]cachedS3Client),
+	}
+}
+
+// credentialCacheKey returns the cache key of the given backend, role, session
+// policy and assume role tags. Tags are sorted, as their order does not change
+// the assumed session.
+func credentialCacheKey(backendName, roleArn, policy string, tags []v1alpha1.Tag) string {
+	sorted := make([]v1alpha1.Tag, len(tags))
+	copy(sorted, tags)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Key != sorted[j].Key {
+			return sorted[i].Key < sorted[j].Key
+		}
+
+		return sorted[i].Value < sorted[j].Value
+	})
+
+	// Marshalling a struct of strings cannot fail.
+	key, _ := json.Marshal(struct {
+		Backend string         `json:"backend"`
+		RoleArn string         `json:"roleArn"`
+		Policy  string         `json:"policy"`
+		Tags    []v1alpha1.Tag `json:"tags"`
+	}{backendName, roleArn, policy, sorted})
+
+	return string(key)
+}
+
+// get returns the cached S3 client of the key if its credentials were created
+// from the given version of the backend credentials and are not about to expire.
+// Entries of an outdated version are dropped.
+func (c *credentialCache) get(key, credentialsVersion string, now time.Time) (backendstore.S3Client, bool) {
+	c.mu.RLock()
+	entry, ok := c.entries[key]
+	c.mu.RUnlock()
+	if !ok {
+		return nil, false
+	}
+
+	if entry.credentialsVersion != credentialsVersion {
+		c.mu.Lock()
+		if c.entries[key].credentialsVersion == entry.credentialsVersion {
+			delete(c.entries, key)
+		}
+		c.mu.Unlock()
+
+		return nil, false
+	}
+
+	if !now.Before(entry.refreshAt) {
+		return nil, false
+	}
+
+	return entry.s3Client, true
+}
+
+// set caches the S3 client of the key and drops all expired entries.
+func (c *credentialCache) set(key string, entry cachedS3Client, now time.Time) {
+	entry.refreshAt = refreshTime(entry.expiration, now)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for k, e := range c.entries {
+		if !now.Before(e.expiration) {
+			delete(c.entries, k)
+		}
+	}
+
+	c.entries[key] = entry
+}
+
+// refreshTime returns the time from which credentials expiring at the given
+// time are refreshed. The refresh window is scaled down to a fraction of the
+// remaining lifetime of the credentials if that is shorter than refreshWindow.
+func refreshTime(expiration, now time.Time) time.Time {
+	window := min(refreshWindow, max(expiration.Sub(now), 0)/refreshWindowDivisor)
+
+	return expiration.Add(-window)
+}
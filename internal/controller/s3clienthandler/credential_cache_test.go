@@ -0,0 +1,155 @@
+package s3clienthandler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore/backendstorefakes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCredentialCacheKey(t *testing.T) {
+	t.Parallel()
+
+	key := credentialCacheKey("backend", "role", "", []v1alpha1.Tag{{Key: "a", Value: "1"}, {Key: "b", Value: "2"}})
+
+	assert.Equal(t, key, credentialCacheKey("backend", "role", "", []v1alpha1.Tag{{Key: "b", Value: "2"}, {Key: "a", Value: "1"}}), "tag order must not change the key")
+	assert.NotEqual(t, key, credentialCacheKey("other", "role", "", []v1alpha1.Tag{{Key: "a", Value: "1"}, {Key: "b", Value: "2"}}), "backend must change the key")
+	assert.NotEqual(t, key, credentialCacheKey("backend", "other", "", []v1alpha1.Tag{{Key: "a", Value: "1"}, {Key: "b", Value: "2"}}), "role must change the key")
+	assert.NotEqual(t, key, credentialCacheKey("backend", "role", "policy", []v1alpha1.Tag{{Key: "a", Value: "1"}, {Key: "b", Value: "2"}}), "policy must change the key")
+	assert.NotEqual(t, key, credentialCacheKey("backend", "role", "", []v1alpha1.Tag{{Key: "a", Value: "1"}}), "tags must change the key")
+	assert.NotEqual(t, credentialCacheKey("backend", "role", "", []v1alpha1.Tag{{Key: "a,b", Value: ""}}), credentialCacheKey("backend", "role", "", []v1alpha1.Tag{{Key: "a", Value: "b"}}), "tags must be encoded unambiguously")
+}
+
+func TestCredentialCacheGet(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	type args struct {
+		entry              *cachedS3Client
+		credentialsVersion string
+		// elapsed is the time elapsed between caching and getting the entry.
+		elapsed time.Duration
+	}
+
+	type want struct {
+		hit     bool
+		dropped bool
+	}
+
+	tests := map[string]struct {
+		args args
+		want want
+	}{
+		"no entry": {
+			args: args{
+				credentialsVersion: "1",
+			},
+			want: want{
+				hit: false,
+			},
+		},
+		"valid entry": {
+			args: args{
+				entry: &cachedS3Client{
+					s3Client:           &backendstorefakes.FakeS3Client{},
+					expiration:         now.Add(time.Hour),
+					credentialsVersion: "1",
+				},
+				credentialsVersion: "1",
+			},
+			want: want{
+				hit: true,
+			},
+		},
+		"entry within refresh window": {
+			args: args{
+				entry: &cachedS3Client{
+					s3Client:           &backendstorefakes.FakeS3Client{},
+					expiration:         now.Add(time.Hour),
+					credentialsVersion: "1",
+				},
+				credentialsVersion: "1",
+				elapsed:            time.Hour - refreshWindow + time.Second,
+			},
+			want: want{
+				hit: false,
+			},
+		},
+		"entry of session shorter than refresh window": {
+			args: args{
+				entry: &cachedS3Client{
+					s3Client:           &backendstorefakes.FakeS3Client{},
+					expiration:         now.Add(refreshWindow - time.Second),
+					credentialsVersion: "1",
+				},
+				credentialsVersion: "1",
+			},
+			want: want{
+				hit: true,
+			},
+		},
+		"entry of short session within scaled refresh window": {
+			args: args{
+				entry: &cachedS3Client{
+					s3Client:           &backendstorefakes.FakeS3Client{},
+					expiration:         now.Add(4 * time.Minute),
+					credentialsVersion: "1",
+				},
+				credentialsVersion: "1",
+				elapsed:            3*time.Minute + time.Second,
+			},
+			want: want{
+				hit: false,
+			},
+		},
+		"entry of outdated credentials version": {
+			args: args{
+				entry: &cachedS3Client{
+					s3Client:           &backendstorefakes.FakeS3Client{},
+					expiration:         now.Add(time.Hour),
+					credentialsVersion: "1",
+				},
+				credentialsVersion: "2",
+			},
+			want: want{
+				hit:     false,
+				dropped: true,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := newCredentialCache()
+			if tt.args.entry != nil {
+				c.set("key", *tt.args.entry, now)
+			}
+
+			_, hit := c.get("key", tt.args.credentialsVersion, now.Add(tt.args.elapsed))
+			assert.Equal(t, tt.want.hit, hit, "unexpected cache hit")
+
+			_, ok := c.entries["key"]
+			assert.Equal(t, tt.args.entry != nil && !tt.want.dropped, ok, "unexpected cache entry")
+		})
+	}
+}
+
+func TestCredentialCacheSetDropsExpiredEntries(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	c := newCredentialCache()
+	c.set("expired", cachedS3Client{expiration: now.Add(time.Minute)}, now)
+	c.set("valid", cachedS3Client{expiration: now.Add(time.Hour)}, now)
+
+	c.set("new", cachedS3Client{expiration: now.Add(time.Hour)}, now.Add(2*time.Minute))
+
+	assert.NotContains(t, c.entries, "expired", "expired entry should be dropped")
+	assert.Contains(t, c.entries, "valid", "valid entry should be kept")
+	assert.Contains(t, c.entries, "new", "new entry should be cached")
+}
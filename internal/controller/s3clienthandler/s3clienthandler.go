@@ -13,19 +13,24 @@ import (
 	"github.com/linode/provider-ceph/internal/backendstore"
 	"github.com/linode/provider-ceph/internal/consts"
 	"github.com/linode/provider-ceph/internal/rgw"
+	"github.com/linode/provider-ceph/internal/utils"
 	"github.com/pkg/errors"
-	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
 	roleSessionNamePrefix = "provider-ceph"
+
+	// defaultAssumeRoleTimeout is the timeout of assuming a role when no S3
+	// timeout is configured.
+	defaultAssumeRoleTimeout = 10 * time.Second
 )
 
 var (
 	errFailedToCreateAssumeRoleS3Client = errors.New("Failed to create s3 client via assume role")
 	errNoSTSClient                      = errors.New("No STS client found for backend")
 	errNoCreds                          = errors.New("AssumeRole response does not contain required credentials to create s3 client")
+	errRoleNotAllowed                   = errors.New("Role of bucket is not allowed by the ProviderConfig of the backend")
 )
 
 type Handler struct {
@@ -34,10 +39,14 @@ type Handler struct {
 	backendStore  *backendstore.BackendStore
 	s3Timeout     time.Duration
 	log           logr.Logger
+	// credentialCache caches the S3 clients created from AssumeRole credentials.
+	credentialCache *credentialCache
 }
 
 func NewHandler(options ...func(*Handler)) *Handler {
-	h := &Handler{}
+	h := &Handler{
+		credentialCache: newCredentialCache(),
+	}
 	for _, o := range options {
 		o(h)
 	}
@@ -76,8 +85,12 @@ func WithLog(l logr.Logger) func(*Handler) {
 }
 
 func (h *Handler) GetS3Client(ctx context.Context, b *v1alpha1.Bucket, backendName string) (backendstore.S3Client, error) {
-	if h.assumeRoleArn != nil && *h.assumeRoleArn != "" {
-		return h.createAssumeRoleS3Client(ctx, b, backendName)
+	roleArn, err := h.roleArn(b, backendName)
+	if err != nil {
+		return nil, err
+	}
+	if roleArn != "" {
+		return h.getAssumeRoleS3Client(ctx, b, backendName, roleArn)
 	}
 
 	cl := h.backendStore.GetBackendS3Client(backendName)
@@ -88,10 +101,120 @@ func (h *Handler) GetS3Client(ctx context.Context, b *v1alpha1.Bucket, backendNa
 	return cl, nil
 }
 
-func (h *Handler) createAssumeRoleS3Client(ctx context.Context, b *v1alpha1.Bucket, backendName string) (backendstore.S3Client, error) {
+// GetAdminClient returns the Admin Ops API client of the backend. Unlike S3
+// clients, admin clients always use the ProviderConfig credentials, because
+// temporary AssumeRole credentials do not carry RGW admin capabilities.
+func (h *Handler) GetAdminClient(backendName string) (backendstore.AdminClient, error) {
+	cl := h.backendStore.GetBackendAdminClient(backendName)
+	if cl == nil {
+		return nil, errors.New("No admin client found for backend")
+	}
+
+	return cl, nil
+}
+
+// roleArn returns the ARN of the role assumed for S3 operations on the bucket
+// on the backend. The role of the bucket takes precedence over the role of the
+// ProviderConfig of the backend, which takes precedence over the role set by
+// the --assume-role-arn flag. No role is assumed if the ARN is empty. The role
+// of the bucket must be allowed by the ProviderConfig of the backend, so that
+// Buckets cannot assume arbitrary roles with the backend credentials.
+func (h *Handler) roleArn(b *v1alpha1.Bucket, backendName string) (string, error) {
+	assumeRole := h.backendStore.GetBackendAssumeRole(backendName)
+
+	if roleArn := aws.ToString(b.Spec.ForProvider.AssumeRoleArn); roleArn != "" {
+		if !assumeRole.AllowsRoleARN(roleArn) {
+			return "", errors.Wrapf(errRoleNotAllowed, "role %q on backend %q", roleArn, backendName)
+		}
+
+		return roleArn, nil
+	}
+
+	if assumeRole != nil && aws.ToString(assumeRole.RoleARN) != "" {
+		return *assumeRole.RoleARN, nil
+	}
+
+	return aws.ToString(h.assumeRoleArn), nil
+}
+
+// getAssumeRoleS3Client returns a cached S3 client created from AssumeRole
+// credentials of the backend, the role and the assume role tags and session
+// policy of the bucket. The role
+// is only assumed again when the cached credentials are about to expire, or
+// when the ProviderConfig of the backend or its Secret have changed.
+func (h *Handler) getAssumeRoleS3Client(ctx context.Context, b *v1alpha1.Bucket, backendName, roleArn string) (backendstore.S3Client, error) {
+	key := credentialCacheKey(backendName, roleArn, aws.ToString(b.Spec.ForProvider.AssumeRolePolicy), b.Spec.ForProvider.AssumeRoleTags)
+	credentialsVersion := h.backendStore.GetBackendCredentialsVersion(backendName)
+	if cl, ok := h.credentialCache.get(key, credentialsVersion, time.Now()); ok {
+		return cl, nil
+	}
+
+	// The role is assumed with a context detached from the caller, as the
+	// result is shared by all concurrent callers and must not fail because
+	// the context of the first one is cancelled. Callers only share a result
+	// created from the same version of the backend credentials.
+	ch := h.credentialCache.group.DoChan(key+"/"+credentialsVersion, func() (any, error) {
+		// The credentials may have been refreshed by a concurrent call.
+		if cl, ok := h.credentialCache.get(key, credentialsVersion, time.Now()); ok {
+			return cl, nil
+		}
+
+		assumeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), h.assumeRoleTimeout())
+		defer cancel()
+
+		cl, expiration, err := h.createAssumeRoleS3Client(assumeCtx, b, backendName, roleArn)
+		if err != nil {
+			return nil, err
+		}
+
+		// Credentials without an expiration are not cached, as it is
+		// unknown when they have to be refreshed.
+		if !expiration.IsZero() {
+			h.credentialCache.set(key, cachedS3Client{
+				s3Client:           cl,
+				expiration:         expiration,
+				credentialsVersion: credentialsVersion,
+			}, time.Now())
+		}
+
+		return cl, nil
+	})
+
+	var cl any
+	select {
+	case <-ctx.Done():
+		return nil, errors.Wrap(ctx.Err(), errFailedToCreateAssumeRoleS3Client.Error())
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		cl = res.Val
+	}
+
+	s3Client, ok := cl.(backendstore.S3Client)
+	if !ok {
+		return nil, errFailedToCreateAssumeRoleS3Client
+	}
+
+	return s3Client, nil
+}
+
+// assumeRoleTimeout returns the timeout of assuming a role, which is the S3
+// timeout if one is configured.
+func (h *Handler) assumeRoleTimeout() time.Duration {
+	if h.s3Timeout > 0 {
+		return h.s3Timeout
+	}
+
+	return defaultAssumeRoleTimeout
+}
+
+// createAssumeRoleS3Client assumes the role on the backend and returns an S3 client created
+// from the temporary credentials, along with the time at which they expire.
+func (h *Handler) createAssumeRoleS3Client(ctx context.Context, b *v1alpha1.Bucket, backendName, roleArn string) (backendstore.S3Client, time.Time, error) {
 	roleSessionName, err := newRoleSessionNameGenerator().generate(roleSessionNamePrefix)
 	if err != nil {
-		return nil, errors.Wrap(err, errFailedToCreateAssumeRoleS3Client.Error())
+		return nil, time.Time{}, errors.Wrap(err, errFailedToCreateAssumeRoleS3Client.Error())
 	}
 
 	copiedTags := make([]ststypes.Tag, 0)
@@ -100,43 +223,59 @@ func (h *Handler) createAssumeRoleS3Client(ctx context.Context, b *v1alpha1.Buck
 	}
 
 	input := &sts.AssumeRoleInput{
-		RoleArn:         h.assumeRoleArn,
+		RoleArn:         &roleArn,
 		RoleSessionName: &roleSessionName,
 		Tags:            copiedTags,
+		Policy:          b.Spec.ForProvider.AssumeRolePolicy,
+	}
+
+	// The session settings of the ProviderConfig are meant for its own role,
+	// or the role of the flag, and are not passed for other roles of Buckets.
+	if assumeRole := h.backendStore.GetBackendAssumeRole(backendName); assumeRole != nil && !isBucketRoleOverride(b, assumeRole) {
+		input.DurationSeconds = assumeRole.DurationSeconds
+		input.ExternalId = assumeRole.ExternalID
 	}
 
 	stsClient := h.backendStore.GetBackendSTSClient(backendName)
 	if stsClient == nil {
-		return nil, errors.Wrap(errNoSTSClient, errFailedToCreateAssumeRoleS3Client.Error())
+		return nil, time.Time{}, errors.Wrap(errNoSTSClient, errFailedToCreateAssumeRoleS3Client.Error())
 	}
 
 	resp, err := rgw.AssumeRole(ctx, stsClient, input)
 	if err != nil {
-		return nil, errors.Wrap(err, errFailedToCreateAssumeRoleS3Client.Error())
+		return nil, time.Time{}, errors.Wrap(err, errFailedToCreateAssumeRoleS3Client.Error())
 	}
 
 	if resp.Credentials == nil ||
 		resp.Credentials.AccessKeyId == nil ||
 		resp.Credentials.SecretAccessKey == nil ||
 		resp.Credentials.SessionToken == nil {
-		return nil, errors.Wrap(errNoCreds, errFailedToCreateAssumeRoleS3Client.Error())
+		return nil, time.Time{}, errors.Wrap(errNoCreds, errFailedToCreateAssumeRoleS3Client.Error())
 	}
 
 	data := map[string][]byte{
 		consts.KeyAccessKey: []byte(*resp.Credentials.AccessKeyId),
 		consts.KeySecretKey: []byte(*resp.Credentials.SecretAccessKey)}
 
-	pc := &apisv1alpha1.ProviderConfig{}
-	if err := h.kubeClient.Get(ctx, types.NamespacedName{Name: backendName}, pc); err != nil {
-		return nil, errors.Wrap(err, errFailedToCreateAssumeRoleS3Client.Error())
+	pc, err := utils.GetProviderConfig(ctx, h.kubeClient, backendName)
+	if err != nil {
+		return nil, time.Time{}, errors.Wrap(err, errFailedToCreateAssumeRoleS3Client.Error())
 	}
 
-	s3Client, err := rgw.NewS3Client(ctx, data, &pc.Spec, h.s3Timeout, resp.Credentials.SessionToken)
+	s3Client, err := rgw.NewS3Client(ctx, data, pc.GetSpec(), h.s3Timeout, resp.Credentials.SessionToken)
 	if err != nil {
-		return nil, errors.Wrap(err, errFailedToCreateAssumeRoleS3Client.Error())
+		return nil, time.Time{}, errors.Wrap(err, errFailedToCreateAssumeRoleS3Client.Error())
 	}
 
-	return s3Client, nil
+	return s3Client, aws.ToTime(resp.Credentials.Expiration), nil
+}
+
+// isBucketRoleOverride returns true if the bucket assumes a role other than the
+// role of the ProviderConfig of the backend.
+func isBucketRoleOverride(b *v1alpha1.Bucket, assumeRole *apisv1alpha1.AssumeRoleConfig) bool {
+	roleArn := aws.ToString(b.Spec.ForProvider.AssumeRoleArn)
+
+	return roleArn != "" && roleArn != aws.ToString(assumeRole.RoleARN)
 }
 
 // copySTSTags converts a list of local v1alpha1.Tags to STS Tags
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
@@ -443,10 +444,535 @@ func TestCreateAssumeRoleS3Client(t *testing.T) {
 				WithS3Timeout(time.Second*5),
 				WithLog(logr.Discard()))
 
-			_, err := h.createAssumeRoleS3Client(context.TODO(), tt.args.bucket, tt.args.backendName)
+			_, _, err := h.createAssumeRoleS3Client(context.TODO(), tt.args.bucket, tt.args.backendName, aws.ToString(tt.fields.roleArn))
 			if tt.want.requireErr != nil {
 				tt.want.requireErr(t, err)
 			}
 		})
 	}
 }
+
+func TestGetS3ClientCachesAssumeRoleCredentials(t *testing.T) {
+	t.Parallel()
+
+	roleArn := "role-arn"
+	dummySK := "secretkey"
+	dummyAK := "accesskey"
+	dummyST := "sessiontoken"
+
+	bucket := func(tags ...v1alpha1.Tag) *v1alpha1.Bucket {
+		return &v1alpha1.Bucket{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: consts.TestBucket,
+			},
+			Spec: v1alpha1.BucketSpec{
+				ForProvider: v1alpha1.BucketParameters{
+					AssumeRoleTags: tags,
+				},
+			},
+		}
+	}
+
+	type args struct {
+		expiration *time.Time
+		first      *v1alpha1.Bucket
+		second     *v1alpha1.Bucket
+		// changeCredentials changes the credentials version of the
+		// backend between the two calls.
+		changeCredentials bool
+	}
+
+	type want struct {
+		assumeRoleCalls int
+	}
+
+	tests := map[string]struct {
+		args args
+		want want
+	}{
+		"same tags use cached credentials": {
+			args: args{
+				expiration: aws.Time(time.Now().Add(time.Hour)),
+				first:      bucket(v1alpha1.Tag{Key: "a", Value: "1"}, v1alpha1.Tag{Key: "b", Value: "2"}),
+				second:     bucket(v1alpha1.Tag{Key: "b", Value: "2"}, v1alpha1.Tag{Key: "a", Value: "1"}),
+			},
+			want: want{
+				assumeRoleCalls: 1,
+			},
+		},
+		"different tags assume role again": {
+			args: args{
+				expiration: aws.Time(time.Now().Add(time.Hour)),
+				first:      bucket(v1alpha1.Tag{Key: "a", Value: "1"}),
+				second:     bucket(v1alpha1.Tag{Key: "a", Value: "2"}),
+			},
+			want: want{
+				assumeRoleCalls: 2,
+			},
+		},
+		"credentials of short sessions are cached": {
+			args: args{
+				expiration: aws.Time(time.Now().Add(time.Minute)),
+				first:      bucket(),
+				second:     bucket(),
+			},
+			want: want{
+				assumeRoleCalls: 1,
+			},
+		},
+		"expired credentials are refreshed": {
+			args: args{
+				expiration: aws.Time(time.Now().Add(-time.Minute)),
+				first:      bucket(),
+				second:     bucket(),
+			},
+			want: want{
+				assumeRoleCalls: 2,
+			},
+		},
+		"credentials without expiration are not cached": {
+			args: args{
+				first:  bucket(),
+				second: bucket(),
+			},
+			want: want{
+				assumeRoleCalls: 2,
+			},
+		},
+		"changed backend credentials drop cached credentials": {
+			args: args{
+				expiration:        aws.Time(time.Now().Add(time.Hour)),
+				first:             bucket(),
+				second:            bucket(),
+				changeCredentials: true,
+			},
+			want: want{
+				assumeRoleCalls: 2,
+			},
+		},
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	s := scheme.Scheme
+	s.AddKnownTypes(apisv1alpha1.SchemeGroupVersion, pc)
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			fakeSTS := &backendstorefakes.FakeSTSClient{
+				AssumeRoleStub: func(context.Context, *sts.AssumeRoleInput, ...func(*sts.Options)) (*sts.AssumeRoleOutput, error) {
+					return &sts.AssumeRoleOutput{
+						Credentials: &ststypes.Credentials{
+							AccessKeyId:     &dummyAK,
+							SecretAccessKey: &dummySK,
+							SessionToken:    &dummyST,
+							Expiration:      tt.args.expiration,
+						},
+					}, nil
+				},
+			}
+
+			bs := backendstore.NewBackendStore()
+			bs.AddOrUpdateBackend(consts.S3Backend1, nil, fakeSTS, apisv1alpha1.HealthStatusHealthy)
+			bs.SetBackendCredentialsVersion(consts.S3Backend1, "1")
+
+			cl := fake.NewClientBuilder().
+				WithObjects(&apisv1alpha1.ProviderConfig{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.S3Backend1,
+					},
+				}).
+				WithScheme(s).Build()
+
+			h := NewHandler(
+				WithBackendStore(bs),
+				WithAssumeRoleArn(&roleArn),
+				WithKubeClient(cl),
+				WithS3Timeout(time.Second*5),
+				WithLog(logr.Discard()))
+
+			_, err := h.GetS3Client(context.TODO(), tt.args.first, consts.S3Backend1)
+			require.NoError(t, err, "unexpected error")
+
+			if tt.args.changeCredentials {
+				bs.SetBackendCredentialsVersion(consts.S3Backend1, "2")
+			}
+
+			_, err = h.GetS3Client(context.TODO(), tt.args.second, consts.S3Backend1)
+			require.NoError(t, err, "unexpected error")
+
+			require.Equal(t, tt.want.assumeRoleCalls, fakeSTS.AssumeRoleCallCount(), "unexpected number of AssumeRole calls")
+		})
+	}
+}
+
+func TestGetS3ClientSharesAssumeRoleOfCancelledCaller(t *testing.T) {
+	t.Parallel()
+
+	roleArn := "role-arn"
+	dummySK := "secretkey"
+	dummyAK := "accesskey"
+	dummyST := "sessiontoken"
+
+	release := make(chan struct{})
+	fakeSTS := &backendstorefakes.FakeSTSClient{
+		AssumeRoleStub: func(ctx context.Context, _ *sts.AssumeRoleInput, _ ...func(*sts.Options)) (*sts.AssumeRoleOutput, error) {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-release:
+			}
+
+			return &sts.AssumeRoleOutput{
+				Credentials: &ststypes.Credentials{
+					AccessKeyId:     &dummyAK,
+					SecretAccessKey: &dummySK,
+					SessionToken:    &dummyST,
+					Expiration:      aws.Time(time.Now().Add(time.Hour)),
+				},
+			}, nil
+		},
+	}
+
+	bs := backendstore.NewBackendStore()
+	bs.AddOrUpdateBackend(consts.S3Backend1, nil, fakeSTS, apisv1alpha1.HealthStatusHealthy)
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	s := scheme.Scheme
+	s.AddKnownTypes(apisv1alpha1.SchemeGroupVersion, pc)
+
+	cl := fake.NewClientBuilder().
+		WithObjects(&apisv1alpha1.ProviderConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: consts.S3Backend1,
+			},
+		}).
+		WithScheme(s).Build()
+
+	h := NewHandler(
+		WithBackendStore(bs),
+		WithAssumeRoleArn(&roleArn),
+		WithKubeClient(cl),
+		WithS3Timeout(time.Second*5),
+		WithLog(logr.Discard()))
+
+	bucket := &v1alpha1.Bucket{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: consts.TestBucket,
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	firstErr := make(chan error, 1)
+	go func() {
+		_, err := h.GetS3Client(ctx, bucket, consts.S3Backend1)
+		firstErr <- err
+	}()
+
+	require.Eventually(t, func() bool { return fakeSTS.AssumeRoleCallCount() == 1 }, time.Second, time.Millisecond, "role was not assumed")
+
+	secondErr := make(chan error, 1)
+	go func() {
+		_, err := h.GetS3Client(context.Background(), bucket, consts.S3Backend1)
+		secondErr <- err
+	}()
+
+	cancel()
+	require.ErrorIs(t, <-firstErr, context.Canceled, "cancelled caller should return its context error")
+
+	close(release)
+	require.NoError(t, <-secondErr, "concurrent caller should not fail because the first caller was cancelled")
+	require.Equal(t, 1, fakeSTS.AssumeRoleCallCount(), "unexpected number of AssumeRole calls")
+}
+
+func TestGetS3ClientDoesNotShareAssumeRoleOfOutdatedCredentials(t *testing.T) {
+	t.Parallel()
+
+	roleArn := "role-arn"
+	dummySK := "secretkey"
+	dummyAK := "accesskey"
+	dummyST := "sessiontoken"
+
+	release := make(chan struct{})
+	fakeSTS := &backendstorefakes.FakeSTSClient{
+		AssumeRoleStub: func(ctx context.Context, _ *sts.AssumeRoleInput, _ ...func(*sts.Options)) (*sts.AssumeRoleOutput, error) {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-release:
+			}
+
+			return &sts.AssumeRoleOutput{
+				Credentials: &ststypes.Credentials{
+					AccessKeyId:     &dummyAK,
+					SecretAccessKey: &dummySK,
+					SessionToken:    &dummyST,
+					Expiration:      aws.Time(time.Now().Add(time.Hour)),
+				},
+			}, nil
+		},
+	}
+
+	bs := backendstore.NewBackendStore()
+	bs.AddOrUpdateBackend(consts.S3Backend1, nil, fakeSTS, apisv1alpha1.HealthStatusHealthy)
+	bs.SetBackendCredentialsVersion(consts.S3Backend1, "1")
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	s := scheme.Scheme
+	s.AddKnownTypes(apisv1alpha1.SchemeGroupVersion, pc)
+
+	cl := fake.NewClientBuilder().
+		WithObjects(&apisv1alpha1.ProviderConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: consts.S3Backend1,
+			},
+		}).
+		WithScheme(s).Build()
+
+	h := NewHandler(
+		WithBackendStore(bs),
+		WithAssumeRoleArn(&roleArn),
+		WithKubeClient(cl),
+		WithS3Timeout(time.Second*5),
+		WithLog(logr.Discard()))
+
+	bucket := &v1alpha1.Bucket{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: consts.TestBucket,
+		},
+	}
+
+	firstErr := make(chan error, 1)
+	go func() {
+		_, err := h.GetS3Client(context.Background(), bucket, consts.S3Backend1)
+		firstErr <- err
+	}()
+
+	require.Eventually(t, func() bool { return fakeSTS.AssumeRoleCallCount() == 1 }, time.Second, time.Millisecond, "role was not assumed")
+
+	bs.SetBackendCredentialsVersion(consts.S3Backend1, "2")
+
+	secondErr := make(chan error, 1)
+	go func() {
+		_, err := h.GetS3Client(context.Background(), bucket, consts.S3Backend1)
+		secondErr <- err
+	}()
+
+	require.Eventually(t, func() bool { return fakeSTS.AssumeRoleCallCount() == 2 }, time.Second, time.Millisecond, "role was not assumed with changed credentials")
+
+	close(release)
+	require.NoError(t, <-firstErr, "unexpected error")
+	require.NoError(t, <-secondErr, "unexpected error")
+}
+
+func TestGetS3ClientRoleSelection(t *testing.T) {
+	t.Parallel()
+
+	dummySK := "secretkey"
+	dummyAK := "accesskey"
+	dummyST := "sessiontoken"
+	policy := `{"Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`
+
+	type args struct {
+		flagRoleArn *string
+		assumeRole  *apisv1alpha1.AssumeRoleConfig
+		bucket      v1alpha1.BucketParameters
+	}
+
+	type want struct {
+		input *sts.AssumeRoleInput
+		err   error
+	}
+
+	tests := map[string]struct {
+		args args
+		want want
+	}{
+		"no role uses backend credentials": {
+			args: args{},
+			want: want{
+				input: nil,
+			},
+		},
+		"role of flag": {
+			args: args{
+				flagRoleArn: aws.String("flag-role"),
+			},
+			want: want{
+				input: &sts.AssumeRoleInput{
+					RoleArn: aws.String("flag-role"),
+				},
+			},
+		},
+		"role of provider config takes precedence over flag": {
+			args: args{
+				flagRoleArn: aws.String("flag-role"),
+				assumeRole: &apisv1alpha1.AssumeRoleConfig{
+					RoleARN:         aws.String("pc-role"),
+					DurationSeconds: aws.Int32(900),
+					ExternalID:      aws.String("external-id"),
+				},
+			},
+			want: want{
+				input: &sts.AssumeRoleInput{
+					RoleArn:         aws.String("pc-role"),
+					DurationSeconds: aws.Int32(900),
+					ExternalId:      aws.String("external-id"),
+				},
+			},
+		},
+		"provider config without role uses flag with its session settings": {
+			args: args{
+				flagRoleArn: aws.String("flag-role"),
+				assumeRole: &apisv1alpha1.AssumeRoleConfig{
+					DurationSeconds: aws.Int32(3600),
+				},
+			},
+			want: want{
+				input: &sts.AssumeRoleInput{
+					RoleArn:         aws.String("flag-role"),
+					DurationSeconds: aws.Int32(3600),
+				},
+			},
+		},
+		"allowed role of bucket takes precedence over provider config without its session settings": {
+			args: args{
+				flagRoleArn: aws.String("flag-role"),
+				assumeRole: &apisv1alpha1.AssumeRoleConfig{
+					RoleARN:         aws.String("pc-role"),
+					DurationSeconds: aws.Int32(900),
+					ExternalID:      aws.String("external-id"),
+					AllowedRoleARNs: []string{"bucket-role"},
+				},
+				bucket: v1alpha1.BucketParameters{
+					AssumeRoleArn:    aws.String("bucket-role"),
+					AssumeRolePolicy: &policy,
+				},
+			},
+			want: want{
+				input: &sts.AssumeRoleInput{
+					RoleArn: aws.String("bucket-role"),
+					Policy:  &policy,
+				},
+			},
+		},
+		"role of bucket equal to role of provider config keeps its session settings": {
+			args: args{
+				assumeRole: &apisv1alpha1.AssumeRoleConfig{
+					RoleARN:         aws.String("pc-role"),
+					ExternalID:      aws.String("external-id"),
+					AllowedRoleARNs: []string{"bucket-role"},
+				},
+				bucket: v1alpha1.BucketParameters{
+					AssumeRoleArn: aws.String("pc-role"),
+				},
+			},
+			want: want{
+				input: &sts.AssumeRoleInput{
+					RoleArn:    aws.String("pc-role"),
+					ExternalId: aws.String("external-id"),
+				},
+			},
+		},
+		"role of bucket not allowed by provider config is rejected": {
+			args: args{
+				assumeRole: &apisv1alpha1.AssumeRoleConfig{
+					RoleARN:         aws.String("pc-role"),
+					AllowedRoleARNs: []string{"other-role"},
+				},
+				bucket: v1alpha1.BucketParameters{
+					AssumeRoleArn: aws.String("bucket-role"),
+				},
+			},
+			want: want{
+				err: errRoleNotAllowed,
+			},
+		},
+		"role of bucket is rejected without provider config assume role settings": {
+			args: args{
+				flagRoleArn: aws.String("flag-role"),
+				bucket: v1alpha1.BucketParameters{
+					AssumeRoleArn: aws.String("bucket-role"),
+				},
+			},
+			want: want{
+				err: errRoleNotAllowed,
+			},
+		},
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	s := scheme.Scheme
+	s.AddKnownTypes(apisv1alpha1.SchemeGroupVersion, pc)
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			fakeS3 := &backendstorefakes.FakeS3Client{}
+			fakeSTS := &backendstorefakes.FakeSTSClient{
+				AssumeRoleStub: func(context.Context, *sts.AssumeRoleInput, ...func(*sts.Options)) (*sts.AssumeRoleOutput, error) {
+					return &sts.AssumeRoleOutput{
+						Credentials: &ststypes.Credentials{
+							AccessKeyId:     &dummyAK,
+							SecretAccessKey: &dummySK,
+							SessionToken:    &dummyST,
+						},
+					}, nil
+				},
+			}
+
+			bs := backendstore.NewBackendStore()
+			bs.AddOrUpdateBackend(consts.S3Backend1, fakeS3, fakeSTS, apisv1alpha1.HealthStatusHealthy)
+			bs.SetBackendAssumeRole(consts.S3Backend1, tt.args.assumeRole)
+
+			cl := fake.NewClientBuilder().
+				WithObjects(&apisv1alpha1.ProviderConfig{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: consts.S3Backend1,
+					},
+				}).
+				WithScheme(s).Build()
+
+			h := NewHandler(
+				WithBackendStore(bs),
+				WithAssumeRoleArn(tt.args.flagRoleArn),
+				WithKubeClient(cl),
+				WithS3Timeout(time.Second*5),
+				WithLog(logr.Discard()))
+
+			bucket := &v1alpha1.Bucket{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: consts.TestBucket,
+				},
+				Spec: v1alpha1.BucketSpec{
+					ForProvider: tt.args.bucket,
+				},
+			}
+
+			s3Client, err := h.GetS3Client(context.TODO(), bucket, consts.S3Backend1)
+			if tt.want.err != nil {
+				require.ErrorIs(t, err, tt.want.err, "unexpected error")
+				require.Zero(t, fakeSTS.AssumeRoleCallCount(), "unexpected AssumeRole call")
+
+				return
+			}
+			require.NoError(t, err, "unexpected error")
+
+			if tt.want.input == nil {
+				require.Equal(t, backendstore.S3Client(fakeS3), s3Client, "expected the S3 client of the backend")
+				require.Zero(t, fakeSTS.AssumeRoleCallCount(), "unexpected AssumeRole call")
+
+				return
+			}
+
+			require.Equal(t, 1, fakeSTS.AssumeRoleCallCount(), "unexpected number of AssumeRole calls")
+			_, input, _ := fakeSTS.AssumeRoleArgsForCall(0)
+			require.Equal(t, tt.want.input.RoleArn, input.RoleArn, "unexpected role")
+			require.Equal(t, tt.want.input.Policy, input.Policy, "unexpected session policy")
+			require.Equal(t, tt.want.input.DurationSeconds, input.DurationSeconds, "unexpected session duration")
+			require.Equal(t, tt.want.input.ExternalId, input.ExternalId, "unexpected external ID")
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package topic
+
+import (
+	"context"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/go-logr/logr"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/controller/s3clienthandler"
+)
+
+// A Connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type Connector struct {
+	backendStore        *backendstore.BackendStore
+	s3ClientHandler     *s3clienthandler.Handler
+	log                 logr.Logger
+	operationTimeout    time.Duration
+	creationGracePeriod time.Duration
+	pollInterval        time.Duration
+	usage               *resource.LegacyProviderConfigUsageTracker
+}
+
+func NewConnector(options ...func(*Connector)) *Connector {
+	c := &Connector{}
+	for _, o := range options {
+		o(c)
+	}
+
+	return c
+}
+
+func WithOperationTimeout(t time.Duration) func(*Connector) {
+	return func(c *Connector) {
+		c.operationTimeout = t
+	}
+}
+
+func WithCreationGracePeriod(t time.Duration) func(*Connector) {
+	return func(c *Connector) {
+		c.creationGracePeriod = t
+	}
+}
+
+func WithPollInterval(t time.Duration) func(*Connector) {
+	return func(c *Connector) {
+		c.pollInterval = t
+	}
+}
+
+func WithUsage(u *resource.LegacyProviderConfigUsageTracker) func(*Connector) {
+	return func(c *Connector) {
+		c.usage = u
+	}
+}
+
+func WithBackendStore(s *backendstore.BackendStore) func(*Connector) {
+	return func(c *Connector) {
+		c.backendStore = s
+	}
+}
+
+func WithS3ClientHandler(h *s3clienthandler.Handler) func(*Connector) {
+	return func(c *Connector) {
+		c.s3ClientHandler = h
+	}
+}
+
+func WithLog(l logr.Logger) func(*Connector) {
+	return func(c *Connector) {
+		c.log = l
+	}
+}
+
+func (c *Connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	legacyMg, ok := mg.(resource.LegacyManaged)
+	if !ok {
+		return nil, errors.Wrap(errors.New("failed to assert to legacy managed type"), errTrackPCUsage)
+	}
+	if err := c.usage.Track(ctx, legacyMg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	return &external{
+			operationTimeout: c.operationTimeout,
+			backendStore:     c.backendStore,
+			s3ClientHandler:  c.s3ClientHandler,
+			log:              c.log,
+		},
+		nil
+}
+
+// external observes, then either creates, updates, or deletes an external
+// resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	operationTimeout time.Duration
+	backendStore     *backendstore.BackendStore
+	s3ClientHandler  *s3clienthandler.Handler
+	log              logr.Logger
+}
@@ -0,0 +1,21 @@
+package topic
+
+import "github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+var errUnhealthyBackend = errors.New("backend marked as unhealthy in backendstore")
+
+const (
+	// k8s error messages.
+	errNotTopic     = "managed resource is not a Topic custom resource"
+	errTrackPCUsage = "failed to track ProviderConfig usage"
+
+	// Backend store error messages.
+	errNoS3BackendsStored = "no s3 backends stored in backendstore"
+	errNoHealthyBackends  = "no healthy s3 backends available for this topic"
+
+	// Topic error messages.
+	errObserveTopic = "failed to observe topic"
+	errCreateTopic  = "failed to create topic"
+	errUpdateTopic  = "failed to update topic"
+	errDeleteTopic  = "failed to delete topic"
+)
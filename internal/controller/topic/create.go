@@ -0,0 +1,49 @@
+package topic
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	"github.com/linode/provider-ceph/internal/otel/traces"
+)
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "topic.external.Create")
+	defer span.End()
+
+	topic, ok := mg.(*v1alpha1.Topic)
+	if !ok {
+		err := errors.New(errNotTopic)
+		traces.SetAndRecordError(span, err)
+
+		return managed.ExternalCreation{}, err
+	}
+
+	topic.SetConditions(xpv1.Creating())
+
+	if !c.backendStore.BackendsAreStored() {
+		err := errors.New(errNoS3BackendsStored)
+		traces.SetAndRecordError(span, err)
+
+		return managed.ExternalCreation{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.operationTimeout)
+	defer cancel()
+
+	if err := c.ensureTopicOnBackends(ctx, topic); err != nil {
+		err = errors.Wrap(err, errCreateTopic)
+		traces.SetAndRecordError(span, err)
+
+		return managed.ExternalCreation{}, err
+	}
+
+	return managed.ExternalCreation{}, nil
+}
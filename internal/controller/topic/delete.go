@@ -0,0 +1,75 @@
+package topic
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"golang.org/x/sync/errgroup"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/otel/traces"
+	"github.com/linode/provider-ceph/internal/rgw"
+)
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "topic.external.Delete")
+	defer span.End()
+	ctx, log := traces.InjectTraceAndLogger(ctx, c.log)
+
+	topic, ok := mg.(*v1alpha1.Topic)
+	if !ok {
+		err := errors.New(errNotTopic)
+		traces.SetAndRecordError(span, err)
+
+		return managed.ExternalDelete{}, err
+	}
+
+	if !c.backendStore.BackendsAreStored() {
+		err := errors.New(errNoS3BackendsStored)
+		traces.SetAndRecordError(span, err)
+
+		return managed.ExternalDelete{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.operationTimeout)
+	defer cancel()
+
+	tb := newTopicBackends()
+
+	g := new(errgroup.Group)
+	for _, backendName := range getTopicProviders(topic, c.backendStore.GetBackendNamesForNamespace("")) {
+		log.Info("Deleting topic on backend", consts.KeyBackendName, backendName)
+		g.Go(func() error {
+			cl, err := c.s3ClientHandler.GetAdminClient(backendName)
+			if err != nil {
+				tb.setTopicCondition(backendName, "", xpv1.Deleting().WithMessage(err.Error()))
+
+				return err
+			}
+
+			if err := rgw.DeleteTopic(ctx, cl, topic.Name); err != nil {
+				tb.setTopicCondition(backendName, "", xpv1.Deleting().WithMessage(err.Error()))
+
+				return err
+			}
+
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		topic.Status.AtProvider.Backends = tb.getBackends()
+		err = errors.Wrap(err, errDeleteTopic)
+		traces.SetAndRecordError(span, err)
+
+		return managed.ExternalDelete{}, err
+	}
+
+	return managed.ExternalDelete{}, nil
+}
@@ -0,0 +1,79 @@
+package topic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/backendstore/backendstorefakes"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDelete(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		providers []string
+		deleteErr error
+		wantCalls map[string]int
+		wantErr   error
+	}{
+		"Topic is deleted from all backends": {
+			wantCalls: map[string]int{consts.S3Backend1: 1, consts.S3Backend2: 1},
+		},
+		"Topic is deleted only from specified providers": {
+			providers: []string{consts.S3Backend2},
+			wantCalls: map[string]int{consts.S3Backend1: 0, consts.S3Backend2: 1},
+		},
+		"Missing topic is ignored": {
+			deleteErr: errNoSuchTopic,
+			wantCalls: map[string]int{consts.S3Backend1: 1, consts.S3Backend2: 1},
+		},
+		"Delete fails": {
+			deleteErr: errExternal,
+			wantCalls: map[string]int{consts.S3Backend1: 1, consts.S3Backend2: 1},
+			wantErr:   errExternal,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			fakes := map[string]*backendstorefakes.FakeAdminClient{}
+			bs := backendstore.NewBackendStore()
+			for _, be := range []string{consts.S3Backend1, consts.S3Backend2} {
+				fake := &backendstorefakes.FakeAdminClient{}
+				fake.DeleteTopicReturns(tc.deleteErr)
+				fakes[be] = fake
+				bs.AddOrUpdateBackend(be, nil, nil, apisv1alpha1.HealthStatusHealthy)
+				bs.SetBackendAdminClient(be, fake)
+			}
+
+			topic := newTestTopic()
+			topic.Spec.Providers = tc.providers
+
+			_, err := newExternal(bs).Delete(context.Background(), topic)
+			require.ErrorIs(t, err, tc.wantErr, "unexpected err")
+			for be, calls := range tc.wantCalls {
+				assert.Equal(t, calls, fakes[be].DeleteTopicCallCount(), "unexpected number of calls on %s", be)
+			}
+		})
+	}
+}
+
+func TestGetTopicProviders(t *testing.T) {
+	t.Parallel()
+
+	all := []string{consts.S3Backend1, consts.S3Backend2}
+
+	topic := &v1alpha1.Topic{}
+	assert.Equal(t, all, getTopicProviders(topic, all), "all backends expected when providers are unset")
+
+	topic.Spec.Providers = []string{consts.S3Backend1}
+	assert.Equal(t, []string{consts.S3Backend1}, getTopicProviders(topic, all), "only specified providers expected")
+}
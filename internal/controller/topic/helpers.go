@@ -0,0 +1,132 @@
+package topic
+
+import (
+	"context"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/rgw"
+	"github.com/linode/provider-ceph/internal/rgw/admin"
+)
+
+// getTopicProviders returns the names of the backends on which the topic
+// should exist. If the Topic does not specify any providers, the topic
+// is expected on all backends.
+func getTopicProviders(topic *v1alpha1.Topic, allBackendNames []string) []string {
+	if len(topic.Spec.Providers) != 0 {
+		return topic.Spec.Providers
+	}
+
+	return allBackendNames
+}
+
+// topicBackends is a concurrency-safe store of topic conditions per backend.
+type topicBackends struct {
+	mu       sync.RWMutex
+	backends v1alpha1.TopicBackends
+}
+
+func newTopicBackends() *topicBackends {
+	return &topicBackends{
+		backends: make(v1alpha1.TopicBackends),
+	}
+}
+
+func (t *topicBackends) setTopicCondition(backendName, arn string, c xpv1.Condition) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.backends[backendName] = &v1alpha1.TopicBackendInfo{TopicCondition: c, ARN: arn}
+}
+
+// getBackends returns a copy of the stored backends.
+func (t *topicBackends) getBackends() v1alpha1.TopicBackends {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	backends := make(v1alpha1.TopicBackends, len(t.backends))
+	for k, v := range t.backends {
+		backends[k] = v.DeepCopy()
+	}
+
+	return backends
+}
+
+// externalTopics is a concurrency-safe store of the topics observed per
+// backend. A nil topic means that the topic does not exist on the backend.
+type externalTopics struct {
+	mu     sync.Mutex
+	topics map[string]*admin.Topic
+}
+
+func newExternalTopics() *externalTopics {
+	return &externalTopics{topics: map[string]*admin.Topic{}}
+}
+
+func (e *externalTopics) set(backendName string, topic *admin.Topic) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.topics[backendName] = topic
+}
+
+// existing returns the topics which exist on their backend.
+func (e *externalTopics) existing() map[string]*admin.Topic {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	topics := map[string]*admin.Topic{}
+	for backendName, topic := range e.topics {
+		if topic != nil {
+			topics[backendName] = topic
+		}
+	}
+
+	return topics
+}
+
+// ensureTopic creates the topic on the backend, or overwrites its attributes
+// if it already exists, and returns the ARN of the topic.
+func (c *external) ensureTopic(ctx context.Context, topic *v1alpha1.Topic, backendName string) (string, error) {
+	if c.backendStore.GetBackendHealthStatus(backendName) == apisv1alpha1.HealthStatusUnhealthy {
+		return "", errUnhealthyBackend
+	}
+
+	cl, err := c.s3ClientHandler.GetAdminClient(backendName)
+	if err != nil {
+		return "", err
+	}
+
+	return rgw.CreateTopic(ctx, cl, rgw.TopicToCreateTopicInput(topic))
+}
+
+// ensureTopicOnBackends calls ensureTopic for each of the topic's backends
+// concurrently and records the outcome per backend in the Topic status.
+func (c *external) ensureTopicOnBackends(ctx context.Context, topic *v1alpha1.Topic) error {
+	tb := newTopicBackends()
+
+	g := new(errgroup.Group)
+	for _, backendName := range getTopicProviders(topic, c.backendStore.GetBackendNamesForNamespace("")) {
+		g.Go(func() error {
+			arn, err := c.ensureTopic(ctx, topic, backendName)
+			if err != nil {
+				tb.setTopicCondition(backendName, "", xpv1.Unavailable().WithMessage(err.Error()))
+
+				return err
+			}
+			tb.setTopicCondition(backendName, arn, xpv1.Available())
+
+			return nil
+		})
+	}
+
+	err := g.Wait()
+	topic.Status.AtProvider.Backends = tb.getBackends()
+
+	return err
+}
@@ -0,0 +1,109 @@
+package topic
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"golang.org/x/sync/errgroup"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/otel/traces"
+	"github.com/linode/provider-ceph/internal/rgw"
+)
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "topic.external.Observe")
+	defer span.End()
+	ctx, log := traces.InjectTraceAndLogger(ctx, c.log)
+
+	topic, ok := mg.(*v1alpha1.Topic)
+	if !ok {
+		err := errors.New(errNotTopic)
+		traces.SetAndRecordError(span, err)
+
+		return managed.ExternalObservation{}, err
+	}
+
+	if !c.backendStore.BackendsAreStored() {
+		err := errors.New(errNoS3BackendsStored)
+		traces.SetAndRecordError(span, err)
+
+		return managed.ExternalObservation{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.operationTimeout)
+	defer cancel()
+
+	observed := newExternalTopics()
+
+	g := new(errgroup.Group)
+	for _, backendName := range getTopicProviders(topic, c.backendStore.GetBackendNamesForNamespace("")) {
+		if c.backendStore.GetBackendHealthStatus(backendName) == apisv1alpha1.HealthStatusUnhealthy {
+			log.Info("Backend is marked unhealthy, skipping observation of topic", consts.KeyBackendName, backendName)
+
+			continue
+		}
+
+		cl, err := c.s3ClientHandler.GetAdminClient(backendName)
+		if err != nil {
+			traces.SetAndRecordError(span, err)
+
+			return managed.ExternalObservation{}, errors.Wrap(err, errObserveTopic)
+		}
+
+		g.Go(func() error {
+			externalTopic, err := rgw.GetTopic(ctx, cl, topic.Name)
+			if err != nil {
+				return err
+			}
+			observed.set(backendName, externalTopic)
+
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		err = errors.Wrap(err, errObserveTopic)
+		traces.SetAndRecordError(span, err)
+
+		return managed.ExternalObservation{}, err
+	}
+
+	if len(observed.topics) == 0 {
+		err := errors.New(errNoHealthyBackends)
+		traces.SetAndRecordError(span, err)
+
+		return managed.ExternalObservation{}, err
+	}
+
+	existingTopics := observed.existing()
+	if len(existingTopics) == 0 {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	topicUpToDate := len(existingTopics) == len(observed.topics)
+	backends := v1alpha1.TopicBackends{}
+	for backendName, externalTopic := range existingTopics {
+		backends[backendName] = &v1alpha1.TopicBackendInfo{TopicCondition: xpv1.Available(), ARN: externalTopic.ARN}
+
+		if !rgw.IsTopicUpToDate(topic, externalTopic) {
+			log.Info("Topic is not up to date on backend", consts.KeyBackendName, backendName)
+			topicUpToDate = false
+		}
+	}
+
+	topic.Status.AtProvider.Backends = backends
+	topic.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: topicUpToDate,
+	}, nil
+}
@@ -0,0 +1,222 @@
+package topic
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/go-logr/logr"
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/backendstore/backendstorefakes"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/controller/s3clienthandler"
+	"github.com/linode/provider-ceph/internal/rgw/admin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	testTopic    = "test-topic"
+	testEndpoint = "https://example.com/events"
+)
+
+var (
+	errExternal    = errors.New("external error")
+	errNoSuchTopic = &admin.Error{StatusCode: 404, Code: "NoSuchKey"}
+)
+
+func newTestTopic() *v1alpha1.Topic {
+	return &v1alpha1.Topic{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testTopic,
+		},
+		Spec: v1alpha1.TopicSpec{
+			ForProvider: v1alpha1.TopicParameters{
+				Endpoint: v1alpha1.TopicEndpoint{
+					HTTP: &v1alpha1.HTTPEndpoint{URI: testEndpoint},
+				},
+			},
+		},
+	}
+}
+
+// newExternalTopic returns a topic as observed on a backend with the given
+// push endpoint.
+func newExternalTopic(backendName, endpoint string) *admin.Topic {
+	return &admin.Topic{
+		Name:       testTopic,
+		ARN:        "arn:aws:sns:" + backendName + "::" + testTopic,
+		Attributes: map[string]string{"push-endpoint": endpoint},
+	}
+}
+
+func newExternal(bs *backendstore.BackendStore) *external {
+	return &external{
+		backendStore: bs,
+		s3ClientHandler: s3clienthandler.NewHandler(
+			s3clienthandler.WithBackendStore(bs)),
+		log:              logr.Discard(),
+		operationTimeout: time.Second * 5,
+	}
+}
+
+func TestObserve(t *testing.T) {
+	t.Parallel()
+
+	type want struct {
+		o          managed.ExternalObservation
+		statusDiff func(t *testing.T, mg resource.Managed)
+		err        error
+	}
+
+	cases := map[string]struct {
+		fakes map[string]*backendstorefakes.FakeAdminClient
+		// unhealthy backends are added with an unhealthy status.
+		unhealthy []string
+		mg        resource.Managed
+		want      want
+	}{
+		"Invalid managed resource": {
+			mg: &v1alpha1.Bucket{},
+			want: want{
+				err: errors.New(errNotTopic),
+			},
+		},
+		"No backends stored": {
+			mg: newTestTopic(),
+			want: want{
+				err: errors.New(errNoS3BackendsStored),
+			},
+		},
+		"No healthy backends": {
+			fakes: map[string]*backendstorefakes.FakeAdminClient{
+				consts.S3Backend1: {},
+			},
+			unhealthy: []string{consts.S3Backend1},
+			mg:        newTestTopic(),
+			want: want{
+				err: errors.New(errNoHealthyBackends),
+			},
+		},
+		"Error getting topic": {
+			fakes: map[string]*backendstorefakes.FakeAdminClient{
+				consts.S3Backend1: func() *backendstorefakes.FakeAdminClient {
+					fake := &backendstorefakes.FakeAdminClient{}
+					fake.GetTopicReturns(nil, errExternal)
+
+					return fake
+				}(),
+			},
+			mg: newTestTopic(),
+			want: want{
+				err: errExternal,
+			},
+		},
+		"Topic does not exist": {
+			fakes: map[string]*backendstorefakes.FakeAdminClient{
+				consts.S3Backend1: func() *backendstorefakes.FakeAdminClient {
+					fake := &backendstorefakes.FakeAdminClient{}
+					fake.GetTopicReturns(nil, errNoSuchTopic)
+
+					return fake
+				}(),
+			},
+			mg: newTestTopic(),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"Topic is missing on one backend": {
+			fakes: map[string]*backendstorefakes.FakeAdminClient{
+				consts.S3Backend1: func() *backendstorefakes.FakeAdminClient {
+					fake := &backendstorefakes.FakeAdminClient{}
+					fake.GetTopicReturns(newExternalTopic(consts.S3Backend1, testEndpoint), nil)
+
+					return fake
+				}(),
+				consts.S3Backend2: func() *backendstorefakes.FakeAdminClient {
+					fake := &backendstorefakes.FakeAdminClient{}
+					fake.GetTopicReturns(nil, errNoSuchTopic)
+
+					return fake
+				}(),
+			},
+			mg: newTestTopic(),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"Topic endpoint has drifted": {
+			fakes: map[string]*backendstorefakes.FakeAdminClient{
+				consts.S3Backend1: func() *backendstorefakes.FakeAdminClient {
+					fake := &backendstorefakes.FakeAdminClient{}
+					fake.GetTopicReturns(newExternalTopic(consts.S3Backend1, "https://other.com"), nil)
+
+					return fake
+				}(),
+			},
+			mg: newTestTopic(),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"Topic is up to date and unhealthy backend is skipped": {
+			fakes: map[string]*backendstorefakes.FakeAdminClient{
+				consts.S3Backend1: func() *backendstorefakes.FakeAdminClient {
+					fake := &backendstorefakes.FakeAdminClient{}
+					fake.GetTopicReturns(newExternalTopic(consts.S3Backend1, testEndpoint), nil)
+
+					return fake
+				}(),
+				consts.S3Backend2: {},
+			},
+			unhealthy: []string{consts.S3Backend2},
+			mg:        newTestTopic(),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				statusDiff: func(t *testing.T, mg resource.Managed) {
+					t.Helper()
+					topic, _ := mg.(*v1alpha1.Topic)
+					require.Contains(t, topic.Status.AtProvider.Backends, consts.S3Backend1, "missing backend in status")
+					assert.Equal(t, "arn:aws:sns:"+consts.S3Backend1+"::"+testTopic, topic.Status.AtProvider.Backends[consts.S3Backend1].ARN, "unexpected topic arn")
+					assert.NotContains(t, topic.Status.AtProvider.Backends, consts.S3Backend2, "unexpected backend in status")
+					assert.True(t, topic.GetCondition(v1.TypeReady).Equal(v1.Available()), "unexpected ready condition")
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			bs := backendstore.NewBackendStore()
+			for be, fake := range tc.fakes {
+				bs.AddOrUpdateBackend(be, nil, nil, apisv1alpha1.HealthStatusHealthy)
+				bs.SetBackendAdminClient(be, fake)
+			}
+			for _, be := range tc.unhealthy {
+				bs.SetBackendHealthStatus(be, apisv1alpha1.HealthStatusUnhealthy)
+			}
+
+			o, err := newExternal(bs).Observe(context.Background(), tc.mg)
+			if tc.want.err != nil {
+				require.ErrorContains(t, err, tc.want.err.Error(), "unexpected error")
+
+				return
+			}
+			require.NoError(t, err, "unexpected error")
+			assert.Equal(t, tc.want.o, o, "unexpected observation")
+			if tc.want.statusDiff != nil {
+				tc.want.statusDiff(t, tc.mg)
+			}
+		})
+	}
+}
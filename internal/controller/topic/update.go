@@ -0,0 +1,49 @@
+package topic
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	"github.com/linode/provider-ceph/internal/otel/traces"
+)
+
+// Update creates the topic on the backends where it is missing and overwrites
+// the attributes of the topic where they have drifted, since RGW creates
+// topics idempotently.
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "topic.external.Update")
+	defer span.End()
+
+	topic, ok := mg.(*v1alpha1.Topic)
+	if !ok {
+		err := errors.New(errNotTopic)
+		traces.SetAndRecordError(span, err)
+
+		return managed.ExternalUpdate{}, err
+	}
+
+	if !c.backendStore.BackendsAreStored() {
+		err := errors.New(errNoS3BackendsStored)
+		traces.SetAndRecordError(span, err)
+
+		return managed.ExternalUpdate{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.operationTimeout)
+	defer cancel()
+
+	if err := c.ensureTopicOnBackends(ctx, topic); err != nil {
+		err = errors.Wrap(err, errUpdateTopic)
+		traces.SetAndRecordError(span, err)
+
+		return managed.ExternalUpdate{}, err
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
@@ -0,0 +1,82 @@
+package topic
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/backendstore/backendstorefakes"
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/rgw"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdate(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		createErr map[string]error
+		// unhealthy backends are added with an unhealthy status.
+		unhealthy []string
+		wantCalls map[string]int
+		wantErr   error
+	}{
+		"Topic is created on all backends": {
+			wantCalls: map[string]int{consts.S3Backend1: 1, consts.S3Backend2: 1},
+		},
+		"Create fails on one backend": {
+			createErr: map[string]error{consts.S3Backend2: errExternal},
+			wantCalls: map[string]int{consts.S3Backend1: 1, consts.S3Backend2: 1},
+			wantErr:   errExternal,
+		},
+		"Unhealthy backend is not called": {
+			unhealthy: []string{consts.S3Backend2},
+			wantCalls: map[string]int{consts.S3Backend1: 1, consts.S3Backend2: 0},
+			wantErr:   errUnhealthyBackend,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			fakes := map[string]*backendstorefakes.FakeAdminClient{}
+			bs := backendstore.NewBackendStore()
+			for _, be := range []string{consts.S3Backend1, consts.S3Backend2} {
+				fake := &backendstorefakes.FakeAdminClient{}
+				fake.CreateTopicReturns("arn:aws:sns:"+be+"::"+testTopic, tc.createErr[be])
+				fakes[be] = fake
+				bs.AddOrUpdateBackend(be, nil, nil, apisv1alpha1.HealthStatusHealthy)
+				bs.SetBackendAdminClient(be, fake)
+			}
+			for _, be := range tc.unhealthy {
+				bs.SetBackendHealthStatus(be, apisv1alpha1.HealthStatusUnhealthy)
+			}
+
+			topic := newTestTopic()
+			_, err := newExternal(bs).Update(context.Background(), topic)
+			require.ErrorIs(t, err, tc.wantErr, "unexpected err")
+
+			for be, calls := range tc.wantCalls {
+				assert.Equal(t, calls, fakes[be].CreateTopicCallCount(), "unexpected number of calls on %s", be)
+				if calls == 0 {
+					continue
+				}
+				_, in := fakes[be].CreateTopicArgsForCall(0)
+				assert.Equal(t, rgw.TopicToCreateTopicInput(topic), in, "unexpected create topic input on %s", be)
+
+				info := topic.Status.AtProvider.Backends[be]
+				require.NotNil(t, info, "missing backend %s in status", be)
+				if tc.createErr[be] == nil {
+					assert.True(t, info.TopicCondition.Equal(v1.Available()), "unexpected condition on %s", be)
+					assert.Equal(t, "arn:aws:sns:"+be+"::"+testTopic, info.ARN, "unexpected arn on %s", be)
+				} else {
+					assert.Equal(t, v1.Unavailable().Reason, info.TopicCondition.Reason, "unexpected condition on %s", be)
+				}
+			}
+		})
+	}
+}
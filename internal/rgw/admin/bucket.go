@@ -0,0 +1,87 @@
+package admin
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// QuotaUnlimited is the value RGW uses for an unlimited quota dimension.
+const QuotaUnlimited int64 = -1
+
+// Bucket is an RGW bucket as returned by the Admin Ops API.
+type Bucket struct {
+	Bucket      string `json:"bucket"`
+	Owner       string `json:"owner"`
+	BucketQuota Quota  `json:"bucket_quota"`
+	// Usage is only populated when stats are requested. It is keyed by
+	// RGW storage category (eg "rgw.main", "rgw.multimeta").
+	Usage map[string]BucketUsage `json:"usage,omitempty"`
+}
+
+// BucketUsage is the usage of a single RGW storage category of a bucket.
+type BucketUsage struct {
+	Size       int64 `json:"size"`
+	SizeActual int64 `json:"size_actual"`
+	NumObjects int64 `json:"num_objects"`
+}
+
+// TotalUsage returns the sum of the usage over all storage categories.
+func (b *Bucket) TotalUsage() BucketUsage {
+	total := BucketUsage{}
+	for _, u := range b.Usage {
+		total.Size += u.Size
+		total.SizeActual += u.SizeActual
+		total.NumObjects += u.NumObjects
+	}
+
+	return total
+}
+
+// Quota is an RGW bucket or user quota.
+type Quota struct {
+	Enabled    bool  `json:"enabled"`
+	MaxSize    int64 `json:"max_size"`
+	MaxObjects int64 `json:"max_objects"`
+}
+
+// GetBucketInfoInput is the input for GetBucketInfo.
+type GetBucketInfoInput struct {
+	Bucket string
+	// Stats requests the usage statistics of the bucket.
+	Stats bool
+}
+
+// SetBucketQuotaInput is the input for SetBucketQuota.
+type SetBucketQuotaInput struct {
+	// UID is the owner of the bucket.
+	UID    string
+	Bucket string
+	Quota  Quota
+}
+
+// GetBucketInfo returns the bucket with the given name.
+func (c *Client) GetBucketInfo(ctx context.Context, in *GetBucketInfoInput) (*Bucket, error) {
+	query := url.Values{}
+	query.Set("bucket", in.Bucket)
+	if in.Stats {
+		query.Set("stats", "true")
+	}
+
+	bucket := &Bucket{}
+	if err := c.do(ctx, http.MethodGet, "bucket", query, nil, bucket); err != nil {
+		return nil, err
+	}
+
+	return bucket, nil
+}
+
+// SetBucketQuota sets the quota of the given bucket.
+func (c *Client) SetBucketQuota(ctx context.Context, in *SetBucketQuotaInput) error {
+	query := url.Values{}
+	query.Set("quota", "")
+	query.Set("uid", in.UID)
+	query.Set("bucket", in.Bucket)
+
+	return c.do(ctx, http.MethodPut, "bucket", query, in.Quota, nil)
+}
@@ -0,0 +1,123 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBucketRequests(t *testing.T) {
+	t.Parallel()
+
+	type want struct {
+		method string
+		query  map[string]string
+		body   *Quota
+		bucket *Bucket
+	}
+
+	cases := map[string]struct {
+		status int
+		body   string
+		call   func(c *Client) (*Bucket, error)
+		want   want
+	}{
+		"Get bucket info": {
+			status: http.StatusOK,
+			body:   `{"bucket":"bucket","owner":"user","bucket_quota":{"enabled":true,"max_size":1024,"max_size_kb":1,"max_objects":-1}}`,
+			call: func(c *Client) (*Bucket, error) {
+				return c.GetBucketInfo(context.Background(), &GetBucketInfoInput{Bucket: "bucket"})
+			},
+			want: want{
+				method: http.MethodGet,
+				query:  map[string]string{"bucket": "bucket", "format": "json"},
+				bucket: &Bucket{
+					Bucket:      "bucket",
+					Owner:       "user",
+					BucketQuota: Quota{Enabled: true, MaxSize: 1024, MaxObjects: QuotaUnlimited},
+				},
+			},
+		},
+		"Get bucket info with stats": {
+			status: http.StatusOK,
+			body:   `{"bucket":"bucket","owner":"user","usage":{"rgw.main":{"size":1000,"size_actual":4096,"num_objects":2},"rgw.multimeta":{"size":0,"size_actual":0,"num_objects":1}}}`,
+			call: func(c *Client) (*Bucket, error) {
+				return c.GetBucketInfo(context.Background(), &GetBucketInfoInput{Bucket: "bucket", Stats: true})
+			},
+			want: want{
+				method: http.MethodGet,
+				query:  map[string]string{"bucket": "bucket", "stats": "true"},
+				bucket: &Bucket{
+					Bucket: "bucket",
+					Owner:  "user",
+					Usage: map[string]BucketUsage{
+						"rgw.main":      {Size: 1000, SizeActual: 4096, NumObjects: 2},
+						"rgw.multimeta": {NumObjects: 1},
+					},
+				},
+			},
+		},
+		"Set bucket quota": {
+			status: http.StatusOK,
+			call: func(c *Client) (*Bucket, error) {
+				return nil, c.SetBucketQuota(context.Background(), &SetBucketQuotaInput{
+					UID:    "user",
+					Bucket: "bucket",
+					Quota:  Quota{Enabled: true, MaxSize: QuotaUnlimited, MaxObjects: 100},
+				})
+			},
+			want: want{
+				method: http.MethodPut,
+				query:  map[string]string{"uid": "user", "bucket": "bucket"},
+				body:   &Quota{Enabled: true, MaxSize: QuotaUnlimited, MaxObjects: 100},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tc.want.method, r.Method, "unexpected method")
+				assert.Equal(t, "/admin/bucket", r.URL.Path, "unexpected path")
+				for k, v := range tc.want.query {
+					assert.Equal(t, v, r.URL.Query().Get(k), "unexpected value for query parameter %s", k)
+				}
+				if tc.want.body != nil {
+					assert.True(t, r.URL.Query().Has("quota"), "missing quota sub-resource")
+					payload, err := io.ReadAll(r.Body)
+					assert.NoError(t, err)
+					got := &Quota{}
+					assert.NoError(t, json.Unmarshal(payload, got))
+					assert.Equal(t, tc.want.body, got, "unexpected request body")
+				}
+
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer srv.Close()
+
+			bucket, err := tc.call(NewClient(srv.URL, "access", "secret", srv.Client()))
+			require.NoError(t, err)
+			assert.Equal(t, tc.want.bucket, bucket, "unexpected bucket")
+		})
+	}
+}
+
+func TestBucketTotalUsage(t *testing.T) {
+	t.Parallel()
+
+	bucket := &Bucket{Usage: map[string]BucketUsage{
+		"rgw.main":      {Size: 1000, SizeActual: 4096, NumObjects: 2},
+		"rgw.multimeta": {NumObjects: 1},
+	}}
+	assert.Equal(t, BucketUsage{Size: 1000, SizeActual: 4096, NumObjects: 3}, bucket.TotalUsage())
+	assert.Equal(t, BucketUsage{}, (&Bucket{}).TotalUsage())
+}
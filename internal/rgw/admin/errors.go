@@ -0,0 +1,92 @@
+package admin
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+)
+
+const (
+	// Error codes returned by the Admin Ops API.
+	CodeNoSuchUser   = "NoSuchUser"
+	CodeNoSuchKey    = "NoSuchKey"
+	CodeInvalidKey   = "InvalidAccessKeyId"
+	CodeNoSuchBucket = "NoSuchBucket"
+)
+
+// Error is returned when the Admin Ops API responds with a non-2XX status code.
+type Error struct {
+	StatusCode int    `json:"-" xml:"-"`
+	Code       string `json:"Code" xml:"Code"`
+	RequestID  string `json:"RequestId" xml:"RequestId"`
+}
+
+// xmlErrorResponse is the error body of the SNS compatible topic API.
+type xmlErrorResponse struct {
+	Error Error `xml:"Error"`
+}
+
+func newError(statusCode int, body []byte) *Error {
+	e := &Error{StatusCode: statusCode}
+	// The body is not guaranteed to be JSON (eg when a proxy responds),
+	// in which case only the status code is reported. The topic API
+	// responds with XML errors instead, either bare or wrapped in an
+	// ErrorResponse element.
+	if json.Unmarshal(body, e) == nil {
+		return e
+	}
+	if xml.Unmarshal(body, e) == nil && e.Code != "" {
+		return e
+	}
+	wrapped := &xmlErrorResponse{}
+	if xml.Unmarshal(body, wrapped) == nil {
+		e.Code = wrapped.Error.Code
+		e.RequestID = wrapped.Error.RequestID
+	}
+
+	return e
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("admin ops request failed with status code %d: %s", e.StatusCode, e.Code)
+}
+
+// IsNoSuchUser returns true if the error is a NoSuchUser error.
+func IsNoSuchUser(err error) bool {
+	return hasCode(err, CodeNoSuchUser)
+}
+
+// IsNoSuchKey returns true if the error indicates that the access key
+// does not exist.
+func IsNoSuchKey(err error) bool {
+	return hasCode(err, CodeNoSuchKey) || hasCode(err, CodeInvalidKey)
+}
+
+// IsNoSuchBucket returns true if the error is a NoSuchBucket error.
+func IsNoSuchBucket(err error) bool {
+	return hasCode(err, CodeNoSuchBucket)
+}
+
+// IsNoSuchTopic returns true if the error indicates that the topic does not
+// exist. RGW reports missing topics with different error codes between
+// releases, so only the status code is checked.
+func IsNoSuchTopic(err error) bool {
+	var adminErr *Error
+	if !errors.As(err, &adminErr) {
+		return false
+	}
+
+	return adminErr.StatusCode == http.StatusNotFound
+}
+
+func hasCode(err error, code string) bool {
+	var adminErr *Error
+	if !errors.As(err, &adminErr) {
+		return false
+	}
+
+	return adminErr.Code == code
+}
@@ -0,0 +1,192 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+)
+
+// Topics are not part of the Admin Ops API, RGW serves an SNS compatible
+// API for them on the root path of the S3 endpoint instead.
+// See https://docs.ceph.com/en/latest/radosgw/notifications/ for the API reference.
+const (
+	topicAPIVersion = "2010-03-31"
+
+	// topicARNPrefix is used to address topics by name. RGW only takes the
+	// tenant and the resource of a topic ARN into account.
+	topicARNPrefix = "arn:aws:sns:::"
+
+	// Attribute keys of the GetTopicAttributes response.
+	topicAttributeName       = "Name"
+	topicAttributeARN        = "TopicArn"
+	topicAttributeOpaqueData = "OpaqueData"
+	topicAttributeEndpoint   = "EndPoint"
+
+	// endpointArgVersion is the API version which RGW stores along with
+	// the endpoint arguments.
+	endpointArgVersion = "Version"
+
+	errDecodeEndpoint  = "failed to decode topic endpoint"
+	errDecodeTopicResp = "failed to decode topic response"
+)
+
+// Topic is an RGW notification topic as returned by GetTopicAttributes.
+type Topic struct {
+	Name       string
+	ARN        string
+	OpaqueData string
+	// Attributes are the endpoint attributes the topic was created with,
+	// eg "push-endpoint" or "verify-ssl".
+	Attributes map[string]string
+}
+
+// CreateTopicInput is the input for CreateTopic.
+type CreateTopicInput struct {
+	Name       string
+	Attributes map[string]string
+}
+
+// GetTopicInput is the input for GetTopic.
+type GetTopicInput struct {
+	Name string
+}
+
+// DeleteTopicInput is the input for DeleteTopic.
+type DeleteTopicInput struct {
+	Name string
+}
+
+type createTopicResponse struct {
+	TopicARN string `xml:"CreateTopicResult>TopicArn"`
+}
+
+type getTopicAttributesResponse struct {
+	Entries []struct {
+		Key   string `xml:"key"`
+		Value string `xml:"value"`
+	} `xml:"GetTopicAttributesResult>Attributes>entry"`
+}
+
+// topicEndpoint is the JSON encoded "EndPoint" attribute of a topic.
+type topicEndpoint struct {
+	EndpointArgs string `json:"EndpointArgs"`
+}
+
+// CreateTopic creates a topic, or overwrites the attributes of an existing
+// topic, and returns the ARN of the topic.
+func (c *Client) CreateTopic(ctx context.Context, in *CreateTopicInput) (string, error) {
+	form := url.Values{}
+	form.Set("Action", "CreateTopic")
+	form.Set("Name", in.Name)
+
+	keys := make([]string, 0, len(in.Attributes))
+	for k := range in.Attributes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for i, k := range keys {
+		entry := "Attributes.entry." + strconv.Itoa(i+1)
+		form.Set(entry+".key", k)
+		form.Set(entry+".value", in.Attributes[k])
+	}
+
+	out := &createTopicResponse{}
+	if err := c.doTopic(ctx, form, out); err != nil {
+		return "", err
+	}
+
+	return out.TopicARN, nil
+}
+
+// GetTopic returns the topic with the given name.
+func (c *Client) GetTopic(ctx context.Context, in *GetTopicInput) (*Topic, error) {
+	form := url.Values{}
+	form.Set("Action", "GetTopicAttributes")
+	form.Set("TopicArn", topicARNPrefix+in.Name)
+
+	out := &getTopicAttributesResponse{}
+	if err := c.doTopic(ctx, form, out); err != nil {
+		return nil, err
+	}
+
+	topic := &Topic{Attributes: map[string]string{}}
+	for _, e := range out.Entries {
+		switch e.Key {
+		case topicAttributeName:
+			topic.Name = e.Value
+		case topicAttributeARN:
+			topic.ARN = e.Value
+		case topicAttributeOpaqueData:
+			topic.OpaqueData = e.Value
+		case topicAttributeEndpoint:
+			endpoint := &topicEndpoint{}
+			if err := json.Unmarshal([]byte(e.Value), endpoint); err != nil {
+				return nil, errors.Wrap(err, errDecodeEndpoint)
+			}
+			topic.Attributes = parseEndpointArgs(endpoint.EndpointArgs)
+		}
+	}
+
+	return topic, nil
+}
+
+// DeleteTopic deletes the topic with the given name.
+func (c *Client) DeleteTopic(ctx context.Context, in *DeleteTopicInput) error {
+	form := url.Values{}
+	form.Set("Action", "DeleteTopic")
+	form.Set("TopicArn", topicARNPrefix+in.Name)
+
+	return c.doTopic(ctx, form, nil)
+}
+
+// doTopic performs a signed form encoded request against the topic API. If
+// out is non-nil, the XML response body is decoded into it.
+func (c *Client) doTopic(ctx context.Context, form url.Values, out interface{}) error {
+	form.Set("Version", topicAPIVersion)
+	payload := []byte(form.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint+"/", bytes.NewReader(payload))
+	if err != nil {
+		return errors.Wrap(err, errBuildRequest)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	respBody, err := c.send(ctx, req, payload)
+	if err != nil {
+		return err
+	}
+
+	if out == nil || len(respBody) == 0 {
+		return nil
+	}
+
+	if err := xml.Unmarshal(respBody, out); err != nil {
+		return errors.Wrap(err, errDecodeTopicResp)
+	}
+
+	return nil
+}
+
+// parseEndpointArgs parses the endpoint arguments stored by RGW, which are
+// of the form "k1=v1&k2=v2&". The values are stored unescaped, so they are
+// split on the first "=" rather than parsed as a query string.
+func parseEndpointArgs(args string) map[string]string {
+	attributes := map[string]string{}
+	for _, arg := range strings.Split(args, "&") {
+		k, v, _ := strings.Cut(arg, "=")
+		if k == "" || k == endpointArgVersion {
+			continue
+		}
+		attributes[k] = v
+	}
+
+	return attributes
+}
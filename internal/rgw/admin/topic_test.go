@@ -0,0 +1,137 @@
+package admin
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTopicRequests(t *testing.T) {
+	t.Parallel()
+
+	type want struct {
+		form  map[string]string
+		topic *Topic
+		err   func(t *testing.T, err error)
+	}
+
+	cases := map[string]struct {
+		status int
+		body   string
+		call   func(c *Client) (*Topic, error)
+		want   want
+	}{
+		"Create topic": {
+			status: http.StatusOK,
+			body:   `<CreateTopicResponse xmlns="https://sns.amazonaws.com/doc/2010-03-31/"><CreateTopicResult><TopicArn>arn:aws:sns:zg::topic</TopicArn></CreateTopicResult></CreateTopicResponse>`,
+			call: func(c *Client) (*Topic, error) {
+				arn, err := c.CreateTopic(context.Background(), &CreateTopicInput{
+					Name: "topic",
+					Attributes: map[string]string{
+						"verify-ssl":    "true",
+						"push-endpoint": "https://example.com",
+					},
+				})
+
+				return &Topic{ARN: arn}, err
+			},
+			want: want{
+				form: map[string]string{
+					"Action":                   "CreateTopic",
+					"Name":                     "topic",
+					"Attributes.entry.1.key":   "push-endpoint",
+					"Attributes.entry.1.value": "https://example.com",
+					"Attributes.entry.2.key":   "verify-ssl",
+					"Attributes.entry.2.value": "true",
+					"Version":                  topicAPIVersion,
+				},
+				topic: &Topic{ARN: "arn:aws:sns:zg::topic"},
+			},
+		},
+		"Get topic": {
+			status: http.StatusOK,
+			body: `<GetTopicAttributesResponse xmlns="https://sns.amazonaws.com/doc/2010-03-31/"><GetTopicAttributesResult><Attributes>` +
+				`<entry><key>Name</key><value>topic</value></entry>` +
+				`<entry><key>EndPoint</key><value>{"EndpointAddress":"amqp://broker","EndpointArgs":"Version=2010-03-31&amp;amqp-exchange=ex&amp;push-endpoint=amqp://broker&amp;","EndpointTopic":"topic","HasStoredSecret":"false","Persistent":"false"}</value></entry>` +
+				`<entry><key>TopicArn</key><value>arn:aws:sns:zg::topic</value></entry>` +
+				`<entry><key>OpaqueData</key><value>data</value></entry>` +
+				`</Attributes></GetTopicAttributesResult></GetTopicAttributesResponse>`,
+			call: func(c *Client) (*Topic, error) {
+				return c.GetTopic(context.Background(), &GetTopicInput{Name: "topic"})
+			},
+			want: want{
+				form: map[string]string{"Action": "GetTopicAttributes", "TopicArn": "arn:aws:sns:::topic"},
+				topic: &Topic{
+					Name:       "topic",
+					ARN:        "arn:aws:sns:zg::topic",
+					OpaqueData: "data",
+					Attributes: map[string]string{"amqp-exchange": "ex", "push-endpoint": "amqp://broker"},
+				},
+			},
+		},
+		"Get topic not found": {
+			status: http.StatusNotFound,
+			body:   `<?xml version="1.0" encoding="UTF-8"?><Error><Code>NoSuchKey</Code><RequestId>abc</RequestId></Error>`,
+			call: func(c *Client) (*Topic, error) {
+				return c.GetTopic(context.Background(), &GetTopicInput{Name: "topic"})
+			},
+			want: want{
+				form: map[string]string{"Action": "GetTopicAttributes"},
+				err: func(t *testing.T, err error) {
+					t.Helper()
+					assert.True(t, IsNoSuchTopic(err), "expected NoSuchTopic error")
+				},
+			},
+		},
+		"Delete topic error": {
+			status: http.StatusForbidden,
+			body:   `<ErrorResponse><Error><Code>AccessDenied</Code></Error></ErrorResponse>`,
+			call: func(c *Client) (*Topic, error) {
+				return nil, c.DeleteTopic(context.Background(), &DeleteTopicInput{Name: "topic"})
+			},
+			want: want{
+				form: map[string]string{"Action": "DeleteTopic", "TopicArn": "arn:aws:sns:::topic"},
+				err: func(t *testing.T, err error) {
+					t.Helper()
+					require.Error(t, err)
+					assert.False(t, IsNoSuchTopic(err), "unexpected NoSuchTopic error")
+					assert.Contains(t, err.Error(), "AccessDenied")
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method, "unexpected method")
+				assert.Equal(t, "/", r.URL.Path, "unexpected path")
+				assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256"), "request is not signed")
+				assert.NoError(t, r.ParseForm(), "unexpected form")
+				for k, v := range tc.want.form {
+					assert.Equal(t, v, r.PostForm.Get(k), "unexpected value for form parameter %s", k)
+				}
+
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer srv.Close()
+
+			topic, err := tc.call(NewClient(srv.URL, "access", "secret", srv.Client()))
+			if tc.want.err != nil {
+				tc.want.err(t, err)
+
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want.topic, topic, "unexpected topic")
+		})
+	}
+}
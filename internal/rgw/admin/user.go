@@ -0,0 +1,178 @@
+package admin
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// User is an RGW user as returned by the Admin Ops API.
+type User struct {
+	UserID      string    `json:"user_id"`
+	DisplayName string    `json:"display_name"`
+	Email       string    `json:"email"`
+	Suspended   int       `json:"suspended"`
+	MaxBuckets  int32     `json:"max_buckets"`
+	Keys        []UserKey `json:"keys"`
+}
+
+// UserKey is an S3 key pair belonging to an RGW user.
+type UserKey struct {
+	User      string `json:"user"`
+	AccessKey string `json:"access_key"`
+	SecretKey string `json:"secret_key"`
+}
+
+// GetUserInput is the input for GetUser.
+type GetUserInput struct {
+	UID string
+}
+
+// CreateUserInput is the input for CreateUser.
+type CreateUserInput struct {
+	UID         string
+	DisplayName string
+	Email       *string
+	MaxBuckets  *int32
+	Suspended   *bool
+}
+
+// ModifyUserInput is the input for ModifyUser.
+type ModifyUserInput struct {
+	UID         string
+	DisplayName string
+	Email       *string
+	MaxBuckets  *int32
+	Suspended   *bool
+}
+
+// RemoveUserInput is the input for RemoveUser.
+type RemoveUserInput struct {
+	UID string
+	// PurgeData removes all objects and buckets owned by the user.
+	PurgeData bool
+}
+
+// GetUser returns the user with the given UID.
+func (c *Client) GetUser(ctx context.Context, in *GetUserInput) (*User, error) {
+	query := url.Values{}
+	query.Set("uid", in.UID)
+
+	user := &User{}
+	if err := c.do(ctx, http.MethodGet, "user", query, nil, user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// CreateUser creates a user without generating any keys.
+func (c *Client) CreateUser(ctx context.Context, in *CreateUserInput) (*User, error) {
+	query := userQuery(in.UID, in.DisplayName, in.Email, in.MaxBuckets, in.Suspended)
+	query.Set("generate-key", "false")
+
+	user := &User{}
+	if err := c.do(ctx, http.MethodPut, "user", query, nil, user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// ModifyUser modifies an existing user.
+func (c *Client) ModifyUser(ctx context.Context, in *ModifyUserInput) (*User, error) {
+	query := userQuery(in.UID, in.DisplayName, in.Email, in.MaxBuckets, in.Suspended)
+
+	user := &User{}
+	if err := c.do(ctx, http.MethodPost, "user", query, nil, user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// RemoveUser removes an existing user.
+func (c *Client) RemoveUser(ctx context.Context, in *RemoveUserInput) error {
+	query := url.Values{}
+	query.Set("uid", in.UID)
+	query.Set("purge-data", strconv.FormatBool(in.PurgeData))
+
+	return c.do(ctx, http.MethodDelete, "user", query, nil, nil)
+}
+
+func userQuery(uid, displayName string, email *string, maxBuckets *int32, suspended *bool) url.Values {
+	query := url.Values{}
+	query.Set("uid", uid)
+	query.Set("display-name", displayName)
+	if email != nil {
+		query.Set("email", *email)
+	}
+	if maxBuckets != nil {
+		query.Set("max-buckets", strconv.FormatInt(int64(*maxBuckets), 10))
+	}
+	if suspended != nil {
+		query.Set("suspended", strconv.FormatBool(*suspended))
+	}
+
+	return query
+}
+
+// CreateKeyInput is the input for CreateKey.
+type CreateKeyInput struct {
+	UID       string
+	AccessKey string
+	SecretKey string
+}
+
+// RemoveKeyInput is the input for RemoveKey.
+type RemoveKeyInput struct {
+	UID       string
+	AccessKey string
+}
+
+// CreateKey adds the given S3 key pair to an existing user and returns all
+// keys of the user.
+func (c *Client) CreateKey(ctx context.Context, in *CreateKeyInput) ([]UserKey, error) {
+	query := url.Values{}
+	query.Set("key", "")
+	query.Set("uid", in.UID)
+	query.Set("key-type", "s3")
+	query.Set("access-key", in.AccessKey)
+	query.Set("secret-key", in.SecretKey)
+
+	keys := []UserKey{}
+	if err := c.do(ctx, http.MethodPut, "user", query, nil, &keys); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// RemoveKey removes an S3 key from an existing user.
+func (c *Client) RemoveKey(ctx context.Context, in *RemoveKeyInput) error {
+	query := url.Values{}
+	query.Set("key", "")
+	query.Set("uid", in.UID)
+	query.Set("key-type", "s3")
+	query.Set("access-key", in.AccessKey)
+
+	return c.do(ctx, http.MethodDelete, "user", query, nil, nil)
+}
+
+// HasKey returns true if the user owns the given access key.
+func (u *User) HasKey(accessKey string) bool {
+	return u.GetSecretKey(accessKey) != ""
+}
+
+// GetSecretKey returns the secret key belonging to the given access key,
+// or an empty string if the user does not own the access key.
+func (u *User) GetSecretKey(accessKey string) string {
+	for _, k := range u.Keys {
+		if k.AccessKey == accessKey {
+			return k.SecretKey
+		}
+	}
+
+	return ""
+}
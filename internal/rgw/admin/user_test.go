@@ -0,0 +1,183 @@
+package admin
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserRequests(t *testing.T) {
+	t.Parallel()
+
+	type want struct {
+		method string
+		query  map[string]string
+		user   *User
+		err    func(t *testing.T, err error)
+	}
+
+	cases := map[string]struct {
+		status int
+		body   string
+		call   func(c *Client) (*User, error)
+		want   want
+	}{
+		"Get user": {
+			status: http.StatusOK,
+			body:   `{"user_id":"tenant$user","display_name":"User","max_buckets":10,"suspended":1}`,
+			call: func(c *Client) (*User, error) {
+				return c.GetUser(context.Background(), &GetUserInput{UID: "tenant$user"})
+			},
+			want: want{
+				method: http.MethodGet,
+				query:  map[string]string{"uid": "tenant$user", "format": "json"},
+				user:   &User{UserID: "tenant$user", DisplayName: "User", MaxBuckets: 10, Suspended: 1},
+			},
+		},
+		"Get user not found": {
+			status: http.StatusNotFound,
+			body:   `{"Code":"NoSuchUser","RequestId":"abc"}`,
+			call: func(c *Client) (*User, error) {
+				return c.GetUser(context.Background(), &GetUserInput{UID: "user"})
+			},
+			want: want{
+				method: http.MethodGet,
+				query:  map[string]string{"uid": "user"},
+				err: func(t *testing.T, err error) {
+					t.Helper()
+					assert.True(t, IsNoSuchUser(err), "expected NoSuchUser error")
+				},
+			},
+		},
+		"Non JSON error response": {
+			status: http.StatusBadGateway,
+			body:   `bad gateway`,
+			call: func(c *Client) (*User, error) {
+				return c.GetUser(context.Background(), &GetUserInput{UID: "user"})
+			},
+			want: want{
+				method: http.MethodGet,
+				query:  map[string]string{"uid": "user"},
+				err: func(t *testing.T, err error) {
+					t.Helper()
+					require.Error(t, err)
+					assert.False(t, IsNoSuchUser(err), "unexpected NoSuchUser error")
+					assert.Contains(t, err.Error(), "502")
+				},
+			},
+		},
+		"Create user sets optional parameters": {
+			status: http.StatusOK,
+			body:   `{"user_id":"user","display_name":"User","email":"user@example.com"}`,
+			call: func(c *Client) (*User, error) {
+				return c.CreateUser(context.Background(), &CreateUserInput{
+					UID:         "user",
+					DisplayName: "User",
+					Email:       aws.String("user@example.com"),
+					MaxBuckets:  aws.Int32(5),
+					Suspended:   aws.Bool(false),
+				})
+			},
+			want: want{
+				method: http.MethodPut,
+				query: map[string]string{
+					"uid":          "user",
+					"display-name": "User",
+					"email":        "user@example.com",
+					"max-buckets":  "5",
+					"suspended":    "false",
+					"generate-key": "false",
+				},
+				user: &User{UserID: "user", DisplayName: "User", Email: "user@example.com"},
+			},
+		},
+		"Modify user": {
+			status: http.StatusOK,
+			body:   `{"user_id":"user","display_name":"New"}`,
+			call: func(c *Client) (*User, error) {
+				return c.ModifyUser(context.Background(), &ModifyUserInput{UID: "user", DisplayName: "New"})
+			},
+			want: want{
+				method: http.MethodPost,
+				query:  map[string]string{"uid": "user", "display-name": "New"},
+				user:   &User{UserID: "user", DisplayName: "New"},
+			},
+		},
+		"Create key": {
+			status: http.StatusOK,
+			body:   `[{"user":"user","access_key":"AK","secret_key":"SK"}]`,
+			call: func(c *Client) (*User, error) {
+				keys, err := c.CreateKey(context.Background(), &CreateKeyInput{UID: "user", AccessKey: "AK", SecretKey: "SK"})
+				if err != nil {
+					return nil, err
+				}
+
+				return &User{Keys: keys}, nil
+			},
+			want: want{
+				method: http.MethodPut,
+				query:  map[string]string{"uid": "user", "key-type": "s3", "access-key": "AK", "secret-key": "SK"},
+				user:   &User{Keys: []UserKey{{User: "user", AccessKey: "AK", SecretKey: "SK"}}},
+			},
+		},
+		"Remove key not found": {
+			status: http.StatusNotFound,
+			body:   `{"Code":"InvalidAccessKeyId"}`,
+			call: func(c *Client) (*User, error) {
+				return nil, c.RemoveKey(context.Background(), &RemoveKeyInput{UID: "user", AccessKey: "AK"})
+			},
+			want: want{
+				method: http.MethodDelete,
+				query:  map[string]string{"uid": "user", "access-key": "AK"},
+				err: func(t *testing.T, err error) {
+					t.Helper()
+					assert.True(t, IsNoSuchKey(err), "expected NoSuchKey error")
+				},
+			},
+		},
+		"Remove user": {
+			status: http.StatusOK,
+			call: func(c *Client) (*User, error) {
+				return nil, c.RemoveUser(context.Background(), &RemoveUserInput{UID: "user", PurgeData: true})
+			},
+			want: want{
+				method: http.MethodDelete,
+				query:  map[string]string{"uid": "user", "purge-data": "true"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tc.want.method, r.Method, "unexpected method")
+				assert.Equal(t, "/admin/user", r.URL.Path, "unexpected path")
+				assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256"), "request is not signed")
+				for k, v := range tc.want.query {
+					assert.Equal(t, v, r.URL.Query().Get(k), "unexpected value for query parameter %s", k)
+				}
+
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer srv.Close()
+
+			user, err := tc.call(NewClient(srv.URL, "access", "secret", srv.Client()))
+			if tc.want.err != nil {
+				tc.want.err(t, err)
+
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want.user, user, "unexpected user")
+		})
+	}
+}
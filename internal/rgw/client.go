@@ -19,6 +19,7 @@ import (
 
 	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
 	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/linode/provider-ceph/internal/rgw/admin"
 	"github.com/linode/provider-ceph/internal/utils"
 )
 
@@ -27,11 +28,26 @@ const (
 )
 
 func NewS3Client(ctx context.Context, data map[string][]byte, pcSpec *apisv1alpha1.ProviderConfigSpec, s3Timeout time.Duration, sessionToken *string) (*s3.Client, error) {
-	sessionConfig, err := buildSessionConfig(ctx, data)
+	sessionConfig, err := buildSessionConfig(ctx, staticCredentials(data))
 	if err != nil {
 		return nil, err
 	}
 
+	return newS3Client(sessionConfig, pcSpec, s3Timeout, sessionToken), nil
+}
+
+// NewS3ClientWithCredentials creates an S3 client which signs its requests
+// with the credentials retrieved from the given provider.
+func NewS3ClientWithCredentials(ctx context.Context, creds aws.CredentialsProvider, pcSpec *apisv1alpha1.ProviderConfigSpec, s3Timeout time.Duration) (*s3.Client, error) {
+	sessionConfig, err := buildSessionConfig(ctx, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return newS3Client(sessionConfig, pcSpec, s3Timeout, nil), nil
+}
+
+func newS3Client(sessionConfig aws.Config, pcSpec *apisv1alpha1.ProviderConfigSpec, s3Timeout time.Duration, sessionToken *string) *s3.Client {
 	resolvedAddress := utils.ResolveHostBase(pcSpec.HostBase, pcSpec.UseHTTPS)
 
 	return s3.NewFromConfig(sessionConfig, func(o *s3.Options) {
@@ -46,10 +62,18 @@ func NewS3Client(ctx context.Context, data map[string][]byte, pcSpec *apisv1alph
 				smithyhttp.AddHeaderValue(consts.KeySecurityToken, *sessionToken),
 			}
 		}
-	}), nil
+	})
 }
 
 func NewSTSClient(ctx context.Context, data map[string][]byte, pcSpec *apisv1alpha1.ProviderConfigSpec, s3Timeout time.Duration) (*sts.Client, error) {
+	return NewSTSClientWithCredentials(ctx, staticCredentials(data), pcSpec, s3Timeout)
+}
+
+// NewSTSClientWithCredentials creates an STS client which signs its requests
+// with the credentials retrieved from the given provider. Requests which need
+// no signature, such as AssumeRoleWithWebIdentity, are made with anonymous
+// credentials.
+func NewSTSClientWithCredentials(ctx context.Context, creds aws.CredentialsProvider, pcSpec *apisv1alpha1.ProviderConfigSpec, s3Timeout time.Duration) (*sts.Client, error) {
 	// If an STSAddress has not been set in the ProviderConfig Spec, use the HostBase.
 	// The STSAddress is only necessary if we wish to contact an STS compliant authentication
 	// service separate to the HostBase (i.e RGW address).
@@ -58,7 +82,7 @@ func NewSTSClient(ctx context.Context, data map[string][]byte, pcSpec *apisv1alp
 		stsAddress = &pcSpec.HostBase
 	}
 
-	sessionConfig, err := buildSessionConfig(ctx, data)
+	sessionConfig, err := buildSessionConfig(ctx, creds)
 	if err != nil {
 		return nil, err
 	}
@@ -74,14 +98,35 @@ func NewSTSClient(ctx context.Context, data map[string][]byte, pcSpec *apisv1alp
 	}), nil
 }
 
-func buildSessionConfig(ctx context.Context, data map[string][]byte) (aws.Config, error) {
+// NewAdminClient creates a client for the RGW Admin Ops API which is served
+// on the same address as the S3 API (HostBase).
+func NewAdminClient(data map[string][]byte, pcSpec *apisv1alpha1.ProviderConfigSpec, s3Timeout time.Duration) *admin.Client {
+	resolvedAddress := utils.ResolveHostBase(pcSpec.HostBase, pcSpec.UseHTTPS)
+
+	return admin.NewClient(
+		resolvedAddress,
+		string(data[consts.KeyAccessKey]),
+		string(data[consts.KeySecretKey]),
+		&http.Client{
+			Timeout:   s3Timeout,
+			Transport: otelhttp.NewTransport(http.DefaultTransport),
+		})
+}
+
+func buildSessionConfig(ctx context.Context, creds aws.CredentialsProvider) (aws.Config, error) {
 	return config.LoadDefaultConfig(ctx,
 		config.WithRetryMaxAttempts(retry.DefaultRetry.Steps),
 		config.WithRetryMode(aws.RetryModeStandard),
 		config.WithRegion(defaultRegion),
-		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
-			string(data[consts.KeyAccessKey]),
-			string(data[consts.KeySecretKey]),
-			"",
-		)))
+		config.WithCredentialsProvider(creds))
+}
+
+// staticCredentials returns a provider of the access key and secret key in
+// the data of a ProviderConfig Secret.
+func staticCredentials(data map[string][]byte) aws.CredentialsProvider {
+	return credentials.NewStaticCredentialsProvider(
+		string(data[consts.KeyAccessKey]),
+		string(data[consts.KeySecretKey]),
+		"",
+	)
 }
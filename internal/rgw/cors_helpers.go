@@ -0,0 +1,50 @@
+package rgw
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+)
+
+// GenerateCORSConfigurationInput creates the PutBucketCorsInput for the AWS SDK
+func GenerateCORSConfigurationInput(name string, config *v1alpha1.CORSConfiguration) *awss3.PutBucketCorsInput {
+	if config == nil {
+		return nil
+	}
+
+	return &awss3.PutBucketCorsInput{
+		Bucket:            aws.String(name),
+		CORSConfiguration: &types.CORSConfiguration{CORSRules: GenerateCORSRules(config.CORSRules)},
+	}
+}
+
+// GenerateCORSRules creates the list of CORSRules for the AWS SDK
+func GenerateCORSRules(in []v1alpha1.CORSRule) []types.CORSRule {
+	var result []types.CORSRule //nolint:prealloc // AWS requires nil instead of 0-length for empty slices.
+	for _, local := range in {
+		result = append(result, types.CORSRule{
+			AllowedHeaders: local.AllowedHeaders,
+			AllowedMethods: local.AllowedMethods,
+			AllowedOrigins: local.AllowedOrigins,
+			ExposeHeaders:  local.ExposeHeaders,
+			ID:             local.ID,
+			MaxAgeSeconds:  local.MaxAgeSeconds,
+		})
+	}
+
+	return result
+}
+
+// CORSNotFoundErrCode is the error code sent by Ceph when the cors config does not exist
+var CORSNotFoundErrCode = "NoSuchCORSConfiguration"
+
+// CORSConfigurationNotFound parses the error and validates if the cors configuration does not exist
+func CORSConfigurationNotFound(err error) bool {
+	var awsErr smithy.APIError
+
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == CORSNotFoundErrCode
+}
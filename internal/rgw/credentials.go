@@ -0,0 +1,54 @@
+package rgw
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/smithy-go"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"go.opentelemetry.io/otel"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/otel/traces"
+)
+
+const (
+	errCredentialsRejected = "credentials were rejected by the backend"
+
+	// Error codes of requests signed with unknown or invalid credentials.
+	codeInvalidAccessKeyID    = "InvalidAccessKeyId"
+	codeSignatureDoesNotMatch = "SignatureDoesNotMatch"
+)
+
+// VerifyCredentials returns an error if the backend rejects the credentials of
+// the S3 client. The credentials are verified by getting the ACL of the
+// lifecycle configuration validation bucket, which is owned by the provider.
+// Other errors, such as the bucket not existing or the backend being
+// unreachable, do not tell whether the credentials are valid and are ignored.
+func VerifyCredentials(ctx context.Context, s3Backend backendstore.S3Client) error {
+	ctx, span := otel.Tracer("").Start(ctx, "VerifyCredentials")
+	defer span.End()
+
+	_, err := s3Backend.GetBucketAcl(ctx, &awss3.GetBucketAclInput{Bucket: aws.String(v1alpha1.LifecycleConfigValidationBucketName)})
+	if IsInvalidCredentials(err) {
+		err = errors.Wrap(err, errCredentialsRejected)
+		traces.SetAndRecordError(span, err)
+
+		return err
+	}
+
+	return nil
+}
+
+// IsInvalidCredentials returns true if the error is returned for a request
+// signed with an unknown access key or an invalid secret key.
+func IsInvalidCredentials(err error) bool {
+	var ae smithy.APIError
+	if !errors.As(err, &ae) {
+		return false
+	}
+
+	return ae.ErrorCode() == codeInvalidAccessKeyID || ae.ErrorCode() == codeSignatureDoesNotMatch
+}
@@ -0,0 +1,62 @@
+package rgw
+
+import (
+	"context"
+	"testing"
+
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore/backendstorefakes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyCredentials(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err         error
+		expectedErr bool
+	}{
+		"credentials accepted": {},
+		"bucket does not exist": {
+			err: &s3types.NoSuchBucket{},
+		},
+		"backend unreachable": {
+			err: errors.New("connection refused"),
+		},
+		"unknown access key": {
+			err:         &smithy.GenericAPIError{Code: "InvalidAccessKeyId"},
+			expectedErr: true,
+		},
+		"invalid secret key": {
+			err:         &smithy.GenericAPIError{Code: "SignatureDoesNotMatch"},
+			expectedErr: true,
+		},
+		"access denied": {
+			err: &smithy.GenericAPIError{Code: "AccessDenied"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			fake := &backendstorefakes.FakeS3Client{}
+			fake.GetBucketAclReturns(nil, tc.err)
+
+			err := VerifyCredentials(context.Background(), fake)
+			if tc.expectedErr {
+				assert.ErrorContains(t, err, errCredentialsRejected, "unexpected error")
+			} else {
+				assert.NoError(t, err, "unexpected error")
+			}
+
+			require.Equal(t, 1, fake.GetBucketAclCallCount(), "credentials should be verified once")
+			_, input, _ := fake.GetBucketAclArgsForCall(0)
+			assert.Equal(t, v1alpha1.LifecycleConfigValidationBucketName, *input.Bucket, "unexpected bucket")
+		})
+	}
+}
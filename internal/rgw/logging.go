@@ -0,0 +1,68 @@
+package rgw
+
+import (
+	"context"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"go.opentelemetry.io/otel"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/otel/traces"
+)
+
+const (
+	errGetBucketLogging    = "failed to get bucket logging"
+	errPutBucketLogging    = "failed to put bucket logging"
+	errDeleteBucketLogging = "failed to delete bucket logging"
+)
+
+func PutBucketLogging(ctx context.Context, s3Backend backendstore.S3Client, b *v1alpha1.Bucket) (*awss3.PutBucketLoggingOutput, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "PutBucketLogging")
+	defer span.End()
+
+	resp, err := s3Backend.PutBucketLogging(ctx, GenerateBucketLoggingInput(b.Name, b.Spec.ForProvider.LoggingConfiguration))
+	if err != nil {
+		err := errors.Wrap(err, errPutBucketLogging)
+		traces.SetAndRecordError(span, err)
+
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// DeleteBucketLogging disables the logging of the bucket. There is no API to
+// delete the logging configuration, logging is disabled by putting an empty one.
+func DeleteBucketLogging(ctx context.Context, s3Backend backendstore.S3Client, bucketName *string) error {
+	ctx, span := otel.Tracer("").Start(ctx, "DeleteBucketLogging")
+	defer span.End()
+
+	_, err := s3Backend.PutBucketLogging(ctx, &awss3.PutBucketLoggingInput{Bucket: bucketName, BucketLoggingStatus: &types.BucketLoggingStatus{}})
+	if err != nil {
+		err := errors.Wrap(err, errDeleteBucketLogging)
+		traces.SetAndRecordError(span, err)
+
+		return err
+	}
+
+	return nil
+}
+
+func GetBucketLogging(ctx context.Context, s3Backend backendstore.S3Client, bucketName *string) (*awss3.GetBucketLoggingOutput, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "GetBucketLogging")
+	defer span.End()
+
+	resp, err := s3Backend.GetBucketLogging(ctx, &awss3.GetBucketLoggingInput{Bucket: bucketName})
+	if resource.IgnoreAny(err, IsBucketNotFound) != nil {
+		err = errors.Wrap(err, errGetBucketLogging)
+		traces.SetAndRecordError(span, err)
+
+		return resp, err
+	}
+
+	return resp, nil
+}
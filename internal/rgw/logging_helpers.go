@@ -0,0 +1,74 @@
+package rgw
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+)
+
+// GenerateBucketLoggingInput creates the PutBucketLoggingInput for the AWS SDK
+func GenerateBucketLoggingInput(name string, config *v1alpha1.LoggingConfiguration) *awss3.PutBucketLoggingInput {
+	if config == nil {
+		return nil
+	}
+
+	return &awss3.PutBucketLoggingInput{
+		Bucket:              aws.String(name),
+		BucketLoggingStatus: &types.BucketLoggingStatus{LoggingEnabled: GenerateLoggingEnabled(config)},
+	}
+}
+
+// GenerateLoggingEnabled creates the LoggingEnabled for the AWS SDK. The
+// defaults of the log format and partition date source are set explicitly.
+func GenerateLoggingEnabled(config *v1alpha1.LoggingConfiguration) *types.LoggingEnabled {
+	if config == nil {
+		return nil
+	}
+
+	loggingEnabled := &types.LoggingEnabled{
+		TargetBucket: aws.String(config.TargetBucket),
+		TargetPrefix: aws.String(aws.ToString(config.TargetPrefix)),
+	}
+	if aws.ToString(config.LogFormat) == v1alpha1.LogFormatPartitionedPrefix {
+		dateSource := types.PartitionDateSourceDeliveryTime
+		if config.PartitionDateSource != nil {
+			dateSource = types.PartitionDateSource(*config.PartitionDateSource)
+		}
+		loggingEnabled.TargetObjectKeyFormat = &types.TargetObjectKeyFormat{
+			PartitionedPrefix: &types.PartitionedPrefix{PartitionDateSource: dateSource},
+		}
+	} else {
+		loggingEnabled.TargetObjectKeyFormat = &types.TargetObjectKeyFormat{SimplePrefix: &types.SimplePrefix{}}
+	}
+
+	return loggingEnabled
+}
+
+// LoggingEnabledFromOutput returns the logging configuration of a bucket with
+// the same defaults as GenerateLoggingEnabled, or nil if logging is disabled.
+func LoggingEnabledFromOutput(out *awss3.GetBucketLoggingOutput) *types.LoggingEnabled {
+	if out == nil || out.LoggingEnabled == nil {
+		return nil
+	}
+
+	loggingEnabled := &types.LoggingEnabled{
+		TargetBucket: aws.String(aws.ToString(out.LoggingEnabled.TargetBucket)),
+		TargetPrefix: aws.String(aws.ToString(out.LoggingEnabled.TargetPrefix)),
+	}
+	format := out.LoggingEnabled.TargetObjectKeyFormat
+	if format != nil && format.PartitionedPrefix != nil {
+		dateSource := format.PartitionedPrefix.PartitionDateSource
+		if dateSource == "" {
+			dateSource = types.PartitionDateSourceDeliveryTime
+		}
+		loggingEnabled.TargetObjectKeyFormat = &types.TargetObjectKeyFormat{
+			PartitionedPrefix: &types.PartitionedPrefix{PartitionDateSource: dateSource},
+		}
+	} else {
+		loggingEnabled.TargetObjectKeyFormat = &types.TargetObjectKeyFormat{SimplePrefix: &types.SimplePrefix{}}
+	}
+
+	return loggingEnabled
+}
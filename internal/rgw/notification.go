@@ -0,0 +1,70 @@
+package rgw
+
+import (
+	"context"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"go.opentelemetry.io/otel"
+
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/otel/traces"
+)
+
+const (
+	errGetNotificationConfig    = "failed to get bucket notification configuration"
+	errPutNotificationConfig    = "failed to put bucket notification configuration"
+	errDeleteNotificationConfig = "failed to delete bucket notification configuration"
+)
+
+func PutBucketNotificationConfiguration(ctx context.Context, s3Backend backendstore.S3Client, input *awss3.PutBucketNotificationConfigurationInput) (*awss3.PutBucketNotificationConfigurationOutput, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "PutBucketNotificationConfiguration")
+	defer span.End()
+
+	resp, err := s3Backend.PutBucketNotificationConfiguration(ctx, input)
+	if err != nil {
+		err := errors.Wrap(err, errPutNotificationConfig)
+		traces.SetAndRecordError(span, err)
+
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// DeleteBucketNotificationConfiguration removes all notifications of the bucket.
+// S3 has no delete operation for notifications, an empty configuration is put instead.
+func DeleteBucketNotificationConfiguration(ctx context.Context, s3Backend backendstore.S3Client, bucketName *string) error {
+	ctx, span := otel.Tracer("").Start(ctx, "DeleteBucketNotificationConfiguration")
+	defer span.End()
+
+	_, err := s3Backend.PutBucketNotificationConfiguration(ctx, &awss3.PutBucketNotificationConfigurationInput{
+		Bucket:                    bucketName,
+		NotificationConfiguration: &types.NotificationConfiguration{},
+	})
+	if err != nil {
+		err := errors.Wrap(err, errDeleteNotificationConfig)
+		traces.SetAndRecordError(span, err)
+
+		return err
+	}
+
+	return nil
+}
+
+func GetBucketNotificationConfiguration(ctx context.Context, s3Backend backendstore.S3Client, bucketName *string) (*awss3.GetBucketNotificationConfigurationOutput, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "GetBucketNotificationConfiguration")
+	defer span.End()
+
+	resp, err := s3Backend.GetBucketNotificationConfiguration(ctx, &awss3.GetBucketNotificationConfigurationInput{Bucket: bucketName})
+	if resource.Ignore(IsBucketNotFound, err) != nil {
+		err = errors.Wrap(err, errGetNotificationConfig)
+		traces.SetAndRecordError(span, err)
+
+		return resp, err
+	}
+
+	return resp, nil
+}
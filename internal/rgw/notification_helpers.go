@@ -0,0 +1,103 @@
+package rgw
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+)
+
+// GenerateNotificationConfigurationInput creates the PutBucketNotificationConfigurationInput
+// for the AWS SDK. topicARNs maps the names of the topics to their ARNs on the backend.
+func GenerateNotificationConfigurationInput(name string, config *v1alpha1.NotificationConfiguration, topicARNs map[string]string) *awss3.PutBucketNotificationConfigurationInput {
+	if config == nil {
+		return nil
+	}
+
+	return &awss3.PutBucketNotificationConfigurationInput{
+		Bucket: aws.String(name),
+		NotificationConfiguration: &types.NotificationConfiguration{
+			TopicConfigurations: GenerateTopicConfigurations(config.TopicConfigurations, topicARNs),
+		},
+	}
+}
+
+// GenerateTopicConfigurations creates the list of TopicConfigurations for the AWS SDK.
+// topicARNs maps the names of the topics to their ARNs on the backend.
+func GenerateTopicConfigurations(in []v1alpha1.TopicConfiguration, topicARNs map[string]string) []types.TopicConfiguration {
+	var result []types.TopicConfiguration //nolint:prealloc // AWS requires nil instead of 0-length for empty slices.
+	for _, local := range in {
+		config := types.TopicConfiguration{
+			Id:       aws.String(local.ID),
+			TopicArn: aws.String(topicARNs[local.Topic]),
+		}
+		for _, event := range local.Events {
+			config.Events = append(config.Events, types.Event(event))
+		}
+		if local.Filter != nil {
+			var rules []types.FilterRule
+			if local.Filter.Prefix != nil {
+				rules = append(rules, types.FilterRule{Name: types.FilterRuleNamePrefix, Value: local.Filter.Prefix})
+			}
+			if local.Filter.Suffix != nil {
+				rules = append(rules, types.FilterRule{Name: types.FilterRuleNameSuffix, Value: local.Filter.Suffix})
+			}
+			config.Filter = &types.NotificationConfigurationFilter{Key: &types.S3KeyFilter{FilterRules: rules}}
+		}
+		result = append(result, config)
+	}
+
+	return result
+}
+
+// NormalizeTopicConfigurations returns a copy of the topic configurations
+// sorted by ID, with sorted events and filter rules, lower case filter rule
+// names and without empty filters, as backends do not report them consistently.
+func NormalizeTopicConfigurations(in []types.TopicConfiguration) []types.TopicConfiguration {
+	out := make([]types.TopicConfiguration, 0, len(in))
+	for _, config := range in {
+		config.Events = append([]types.Event(nil), config.Events...)
+		sort.Slice(config.Events, func(i, j int) bool { return config.Events[i] < config.Events[j] })
+
+		var rules []types.FilterRule
+		if config.Filter != nil && config.Filter.Key != nil {
+			for _, rule := range config.Filter.Key.FilterRules {
+				rule.Name = types.FilterRuleName(strings.ToLower(string(rule.Name)))
+				rules = append(rules, rule)
+			}
+		}
+		sort.Slice(rules, func(i, j int) bool { return rules[i].Name < rules[j].Name })
+		config.Filter = nil
+		if len(rules) != 0 {
+			config.Filter = &types.NotificationConfigurationFilter{Key: &types.S3KeyFilter{FilterRules: rules}}
+		}
+
+		out = append(out, config)
+	}
+	sort.Slice(out, func(i, j int) bool { return aws.ToString(out[i].Id) < aws.ToString(out[j].Id) })
+
+	return out
+}
+
+// NotificationTopics returns the distinct names of the topics referred to by
+// the notification configuration.
+func NotificationTopics(config *v1alpha1.NotificationConfiguration) []string {
+	if config == nil {
+		return nil
+	}
+
+	seen := map[string]bool{}
+	topics := []string{}
+	for _, c := range config.TopicConfigurations {
+		if !seen[c.Topic] {
+			seen[c.Topic] = true
+			topics = append(topics, c.Topic)
+		}
+	}
+
+	return topics
+}
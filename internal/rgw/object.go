@@ -16,6 +16,11 @@ const (
 	errDeleteObject       = "failed to delete object"
 	errGetObject          = "failed to get object"
 	errPutObject          = "failed to put object"
+
+	errCreateMultipartUpload   = "failed to create multipart upload"
+	errUploadPart              = "failed to upload part"
+	errCompleteMultipartUpload = "failed to complete multipart upload"
+	errAbortMultipartUpload    = "failed to abort multipart upload"
 )
 
 func GetObject(ctx context.Context, s3Backend backendstore.S3Client, input *awss3.GetObjectInput, o ...func(*awss3.Options)) (*awss3.GetObjectOutput, error) {
@@ -92,3 +97,63 @@ func ListObjectVersions(ctx context.Context, s3Backend backendstore.S3Client, in
 
 	return resp, nil
 }
+
+func CreateMultipartUpload(ctx context.Context, s3Backend backendstore.S3Client, input *awss3.CreateMultipartUploadInput, o ...func(*awss3.Options)) (*awss3.CreateMultipartUploadOutput, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "CreateMultipartUpload")
+	defer span.End()
+
+	resp, err := s3Backend.CreateMultipartUpload(ctx, input, o...)
+	if err != nil {
+		err = errors.Wrap(err, errCreateMultipartUpload)
+		traces.SetAndRecordError(span, err)
+
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+func UploadPart(ctx context.Context, s3Backend backendstore.S3Client, input *awss3.UploadPartInput, o ...func(*awss3.Options)) (*awss3.UploadPartOutput, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "UploadPart")
+	defer span.End()
+
+	resp, err := s3Backend.UploadPart(ctx, input, o...)
+	if err != nil {
+		err = errors.Wrap(err, errUploadPart)
+		traces.SetAndRecordError(span, err)
+
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+func CompleteMultipartUpload(ctx context.Context, s3Backend backendstore.S3Client, input *awss3.CompleteMultipartUploadInput, o ...func(*awss3.Options)) error {
+	ctx, span := otel.Tracer("").Start(ctx, "CompleteMultipartUpload")
+	defer span.End()
+
+	_, err := s3Backend.CompleteMultipartUpload(ctx, input, o...)
+	if err != nil {
+		err = errors.Wrap(err, errCompleteMultipartUpload)
+		traces.SetAndRecordError(span, err)
+
+		return err
+	}
+
+	return nil
+}
+
+func AbortMultipartUpload(ctx context.Context, s3Backend backendstore.S3Client, input *awss3.AbortMultipartUploadInput, o ...func(*awss3.Options)) error {
+	ctx, span := otel.Tracer("").Start(ctx, "AbortMultipartUpload")
+	defer span.End()
+
+	_, err := s3Backend.AbortMultipartUpload(ctx, input, o...)
+	if err != nil {
+		err = errors.Wrap(err, errAbortMultipartUpload)
+		traces.SetAndRecordError(span, err)
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package rgw
+
+import (
+	"context"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"go.opentelemetry.io/otel"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/otel/traces"
+)
+
+const (
+	errGetBucketOwnershipControls    = "failed to get bucket ownership controls"
+	errPutBucketOwnershipControls    = "failed to put bucket ownership controls"
+	errDeleteBucketOwnershipControls = "failed to delete bucket ownership controls"
+)
+
+func PutBucketOwnershipControls(ctx context.Context, s3Backend backendstore.S3Client, b *v1alpha1.Bucket) (*awss3.PutBucketOwnershipControlsOutput, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "PutBucketOwnershipControls")
+	defer span.End()
+
+	resp, err := s3Backend.PutBucketOwnershipControls(ctx, BucketToPutBucketOwnershipControlsInput(b))
+	if err != nil {
+		err := errors.Wrap(err, errPutBucketOwnershipControls)
+		traces.SetAndRecordError(span, err)
+
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+func DeleteBucketOwnershipControls(ctx context.Context, s3Backend backendstore.S3Client, bucketName *string) error {
+	ctx, span := otel.Tracer("").Start(ctx, "DeleteBucketOwnershipControls")
+	defer span.End()
+
+	_, err := s3Backend.DeleteBucketOwnershipControls(ctx, &awss3.DeleteBucketOwnershipControlsInput{Bucket: bucketName})
+	if err != nil {
+		err := errors.Wrap(err, errDeleteBucketOwnershipControls)
+		traces.SetAndRecordError(span, err)
+
+		return err
+	}
+
+	return nil
+}
+
+func GetBucketOwnershipControls(ctx context.Context, s3Backend backendstore.S3Client, bucketName *string) (*awss3.GetBucketOwnershipControlsOutput, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "GetBucketOwnershipControls")
+	defer span.End()
+
+	resp, err := s3Backend.GetBucketOwnershipControls(ctx, &awss3.GetBucketOwnershipControlsInput{Bucket: bucketName})
+	if resource.IgnoreAny(err, OwnershipControlsNotFound, IsBucketNotFound) != nil {
+		err = errors.Wrap(err, errGetBucketOwnershipControls)
+		traces.SetAndRecordError(span, err)
+
+		return resp, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,28 @@
+package rgw
+
+import (
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/smithy-go"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+)
+
+// ObjectOwnershipFromOutput returns the object ownership of the ownership
+// controls of a bucket, or an empty string if the bucket has none.
+func ObjectOwnershipFromOutput(out *awss3.GetBucketOwnershipControlsOutput) string {
+	if out == nil || out.OwnershipControls == nil || len(out.OwnershipControls.Rules) == 0 {
+		return ""
+	}
+
+	return string(out.OwnershipControls.Rules[0].ObjectOwnership)
+}
+
+// OwnershipControlsNotFoundErrCode is the error code sent by Ceph when the bucket has no ownership controls
+var OwnershipControlsNotFoundErrCode = "OwnershipControlsNotFoundError"
+
+// OwnershipControlsNotFound parses the error and validates if the ownership
+// controls do not exist
+func OwnershipControlsNotFound(err error) bool {
+	var awsErr smithy.APIError
+
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == OwnershipControlsNotFoundErrCode
+}
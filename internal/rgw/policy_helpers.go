@@ -0,0 +1,262 @@
+package rgw
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+)
+
+const (
+	defaultPolicyVersion = "2012-10-17"
+	policyPrincipalAll   = "*"
+
+	errMarshalPolicy      = "failed to marshal bucket policy"
+	errParsePolicy        = "bucket policy is not a valid JSON object"
+	errParseSessionPolicy = "session policy is not a valid JSON object"
+)
+
+// policyVersions are the versions of the policy language.
+var policyVersions = []string{defaultPolicyVersion, "2008-10-17"}
+
+// policyDocument is a BucketPolicy in the JSON policy language.
+type policyDocument struct {
+	Version   string            `json:"Version"`
+	ID        string            `json:"Id,omitempty"`
+	Statement []policyStatement `json:"Statement"`
+}
+
+type policyStatement struct {
+	Sid       string                         `json:"Sid,omitempty"`
+	Effect    string                         `json:"Effect"`
+	Principal interface{}                    `json:"Principal,omitempty"`
+	Action    []string                       `json:"Action"`
+	Resource  []string                       `json:"Resource"`
+	Condition map[string]map[string][]string `json:"Condition,omitempty"`
+}
+
+// GenerateBucketPolicy returns the JSON bucket policy of the bucket, which is
+// either the JSON string of Policy or the rendered BucketPolicy. An empty
+// string is returned if the bucket has neither.
+func GenerateBucketPolicy(params *v1alpha1.BucketParameters) (string, error) {
+	if params.Policy != "" || params.BucketPolicy == nil {
+		return params.Policy, nil
+	}
+
+	doc := policyDocument{
+		Version:   params.BucketPolicy.Version,
+		ID:        aws.ToString(params.BucketPolicy.ID),
+		Statement: make([]policyStatement, 0, len(params.BucketPolicy.Statements)),
+	}
+	if doc.Version == "" {
+		doc.Version = defaultPolicyVersion
+	}
+
+	for _, s := range params.BucketPolicy.Statements {
+		statement := policyStatement{
+			Sid:      aws.ToString(s.SID),
+			Effect:   s.Effect,
+			Action:   s.Actions,
+			Resource: s.Resources,
+		}
+		switch {
+		case aws.ToBool(s.Principal.AllPrincipals):
+			statement.Principal = policyPrincipalAll
+		case len(s.Principal.AWS) != 0:
+			statement.Principal = map[string][]string{"AWS": s.Principal.AWS}
+		}
+		for _, c := range s.Conditions {
+			if statement.Condition == nil {
+				statement.Condition = map[string]map[string][]string{}
+			}
+			if statement.Condition[c.Operator] == nil {
+				statement.Condition[c.Operator] = map[string][]string{}
+			}
+			statement.Condition[c.Operator][c.Key] = append(statement.Condition[c.Operator][c.Key], c.Values...)
+		}
+		doc.Statement = append(doc.Statement, statement)
+	}
+
+	policy, err := json.Marshal(doc)
+	if err != nil {
+		return "", errors.Wrap(err, errMarshalPolicy)
+	}
+
+	return string(policy), nil
+}
+
+// ValidateBucketPolicy checks that the JSON bucket policy is a JSON object
+// with at least one statement, and that each statement has a valid effect, a
+// principal, actions and resources.
+func ValidateBucketPolicy(policy string) error {
+	doc := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return errors.Wrap(err, errParsePolicy)
+	}
+
+	return validatePolicyStatements(doc, "bucket policy", true)
+}
+
+// ValidateSessionPolicy checks that the JSON session policy of an AssumeRole
+// request is a JSON object with a supported version and at least one
+// statement, and that each statement has a valid effect, actions and
+// resources. Session policies apply to the assumed role, so their statements
+// must not have a principal.
+func ValidateSessionPolicy(policy string) error {
+	doc := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return errors.Wrap(err, errParseSessionPolicy)
+	}
+
+	if version, _ := doc["Version"].(string); !slices.Contains(policyVersions, version) {
+		return errors.New(fmt.Sprintf("session policy has invalid Version %v, must be one of %v", doc["Version"], policyVersions))
+	}
+
+	return validatePolicyStatements(doc, "session policy", false)
+}
+
+// validatePolicyStatements checks that the policy has at least one statement,
+// and that each statement has a valid effect, actions and resources, and a
+// principal if required or none otherwise.
+func validatePolicyStatements(doc map[string]interface{}, kind string, requirePrincipal bool) error {
+	statements := asPolicyList(doc["Statement"])
+	if len(statements) == 0 {
+		return errors.New(fmt.Sprintf("%s has no statement", kind))
+	}
+
+	required := [][]string{{"Action", "NotAction"}, {"Resource", "NotResource"}}
+	if requirePrincipal {
+		required = append(required, []string{"Principal", "NotPrincipal"})
+	}
+
+	for i, s := range statements {
+		statement, ok := s.(map[string]interface{})
+		if !ok {
+			return errors.New(fmt.Sprintf("statement %d of %s is not a JSON object", i, kind))
+		}
+		if effect := statement["Effect"]; effect != "Allow" && effect != "Deny" {
+			return errors.New(fmt.Sprintf("statement %d of %s has invalid Effect %v, must be Allow or Deny", i, kind, effect))
+		}
+		for _, keys := range required {
+			if statement[keys[0]] == nil && statement[keys[1]] == nil {
+				return errors.New(fmt.Sprintf("statement %d of %s has neither %s nor %s", i, kind, keys[0], keys[1]))
+			}
+		}
+		if !requirePrincipal && (statement["Principal"] != nil || statement["NotPrincipal"] != nil) {
+			return errors.New(fmt.Sprintf("statement %d of %s must not have a Principal", i, kind))
+		}
+	}
+
+	return nil
+}
+
+// NormalizeBucketPolicy parses the JSON bucket policy into a canonical form,
+// so that policies which only differ in formatting compare equal. Elements
+// which may either be a single value or a list of values are converted to
+// sorted lists, and the statements are sorted.
+func NormalizeBucketPolicy(policy string) (map[string]interface{}, error) {
+	doc := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return nil, errors.Wrap(err, errParsePolicy)
+	}
+
+	statements := asPolicyList(doc["Statement"])
+	for _, s := range statements {
+		if statement, ok := s.(map[string]interface{}); ok {
+			normalizePolicyStatement(statement)
+		}
+	}
+	sortPolicyList(statements)
+	doc["Statement"] = statements
+
+	return doc, nil
+}
+
+// BucketPoliciesEqual returns true if the JSON bucket policies are equal after
+// normalization. Policies which cannot be parsed are compared as strings.
+func BucketPoliciesEqual(a, b string) bool {
+	normalizedA, errA := NormalizeBucketPolicy(a)
+	normalizedB, errB := NormalizeBucketPolicy(b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+
+	return reflect.DeepEqual(normalizedA, normalizedB)
+}
+
+func normalizePolicyStatement(statement map[string]interface{}) {
+	if statement["Sid"] == "" {
+		delete(statement, "Sid")
+	}
+
+	for _, k := range []string{"Action", "NotAction", "Resource", "NotResource"} {
+		if v, ok := statement[k]; ok {
+			statement[k] = sortPolicyList(asPolicyList(v))
+		}
+	}
+
+	// Principals are either "*" or a map of principal types to principals.
+	// Principal types without principals are dropped, and {"AWS": "*"} is
+	// equivalent to "*".
+	for _, k := range []string{"Principal", "NotPrincipal"} {
+		if principals, ok := statement[k].(map[string]interface{}); ok {
+			for principalType, v := range principals {
+				list := asPolicyList(v)
+				if len(list) == 0 {
+					delete(principals, principalType)
+
+					continue
+				}
+				principals[principalType] = sortPolicyList(list)
+			}
+			if awsPrincipals := asPolicyList(principals["AWS"]); len(principals) == 1 && len(awsPrincipals) == 1 && awsPrincipals[0] == policyPrincipalAll {
+				statement[k] = policyPrincipalAll
+			}
+		}
+	}
+
+	// Conditions are a map of condition operators to maps of condition keys to values.
+	if conditions, ok := statement["Condition"].(map[string]interface{}); ok {
+		for _, c := range conditions {
+			if keys, ok := c.(map[string]interface{}); ok {
+				for k, v := range keys {
+					keys[k] = sortPolicyList(asPolicyList(v))
+				}
+			}
+		}
+	}
+}
+
+// asPolicyList returns the policy element as a list, as a single value is
+// equivalent to a list with one value.
+func asPolicyList(v interface{}) []interface{} {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		return v
+	default:
+		return []interface{}{v}
+	}
+}
+
+// sortPolicyList sorts the policy elements by their JSON encoding.
+func sortPolicyList(list []interface{}) []interface{} {
+	slices.SortFunc(list, func(a, b interface{}) int {
+		return strings.Compare(policyElementKey(a), policyElementKey(b))
+	})
+
+	return list
+}
+
+func policyElementKey(v interface{}) string {
+	key, _ := json.Marshal(v)
+
+	return string(key)
+}
@@ -0,0 +1,282 @@
+package rgw
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateBucketPolicy(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		params v1alpha1.BucketParameters
+		want   string
+	}{
+		"No policy": {
+			params: v1alpha1.BucketParameters{},
+			want:   "",
+		},
+		"JSON policy is returned as is": {
+			params: v1alpha1.BucketParameters{Policy: `{"Statement": []}`},
+			want:   `{"Statement": []}`,
+		},
+		"Structured policy for all principals": {
+			params: v1alpha1.BucketParameters{
+				BucketPolicy: &v1alpha1.BucketPolicy{
+					Statements: []v1alpha1.BucketPolicyStatement{
+						{
+							SID:       aws.String("public-read"),
+							Effect:    "Allow",
+							Principal: v1alpha1.BucketPolicyPrincipal{AllPrincipals: aws.Bool(true)},
+							Actions:   []string{"s3:GetObject"},
+							Resources: []string{"arn:aws:s3:::bucket/*"},
+						},
+					},
+				},
+			},
+			want: `{"Version":"2012-10-17","Statement":[{"Sid":"public-read","Effect":"Allow","Principal":"*","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::bucket/*"]}]}`,
+		},
+		"Structured policy with conditions": {
+			params: v1alpha1.BucketParameters{
+				BucketPolicy: &v1alpha1.BucketPolicy{
+					Version: "2008-10-17",
+					ID:      aws.String("policy"),
+					Statements: []v1alpha1.BucketPolicyStatement{
+						{
+							Effect:    "Deny",
+							Principal: v1alpha1.BucketPolicyPrincipal{AWS: []string{"arn:aws:iam:::user/alice"}},
+							Actions:   []string{"s3:*"},
+							Resources: []string{"arn:aws:s3:::bucket", "arn:aws:s3:::bucket/*"},
+							Conditions: []v1alpha1.BucketPolicyCondition{
+								{Operator: "NotIpAddress", Key: "aws:SourceIp", Values: []string{"10.0.0.0/8"}},
+								{Operator: "NotIpAddress", Key: "aws:SourceIp", Values: []string{"192.168.0.0/16"}},
+								{Operator: "Bool", Key: "aws:SecureTransport", Values: []string{"false"}},
+							},
+						},
+					},
+				},
+			},
+			want: `{"Version":"2008-10-17","Id":"policy","Statement":[{"Effect":"Deny","Principal":{"AWS":["arn:aws:iam:::user/alice"]},"Action":["s3:*"],"Resource":["arn:aws:s3:::bucket","arn:aws:s3:::bucket/*"],"Condition":{"Bool":{"aws:SecureTransport":["false"]},"NotIpAddress":{"aws:SourceIp":["10.0.0.0/8","192.168.0.0/16"]}}}]}`,
+		},
+		"Structured policy without principals omits the principal": {
+			params: v1alpha1.BucketParameters{
+				BucketPolicy: &v1alpha1.BucketPolicy{
+					Statements: []v1alpha1.BucketPolicyStatement{
+						{
+							Effect:    "Allow",
+							Principal: v1alpha1.BucketPolicyPrincipal{AllPrincipals: aws.Bool(false)},
+							Actions:   []string{"s3:GetObject"},
+							Resources: []string{"arn:aws:s3:::bucket/*"},
+						},
+					},
+				},
+			},
+			want: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::bucket/*"]}]}`,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := GenerateBucketPolicy(&tc.params)
+			require.NoError(t, err, "unexpected error")
+			assert.Equal(t, tc.want, got, "unexpected policy")
+		})
+	}
+}
+
+func TestBucketPoliciesEqual(t *testing.T) {
+	t.Parallel()
+
+	policy := `{
+		"Version": "2012-10-17",
+		"Statement": [
+			{"Sid": "read", "Effect": "Allow", "Principal": {"AWS": ["arn:aws:iam:::user/alice"]}, "Action": ["s3:GetObject"], "Resource": ["arn:aws:s3:::bucket/*"]},
+			{"Effect": "Deny", "Principal": "*", "Action": ["s3:PutObject", "s3:DeleteObject"], "Resource": "arn:aws:s3:::bucket/*", "Condition": {"Bool": {"aws:SecureTransport": ["false"]}}}
+		]
+	}`
+
+	cases := map[string]struct {
+		a    string
+		b    string
+		want bool
+	}{
+		"Identical policies": {
+			a:    policy,
+			b:    policy,
+			want: true,
+		},
+		"Reordered statements, single values and whitespace": {
+			a: policy,
+			b: `{"Statement":[{"Condition":{"Bool":{"aws:SecureTransport":"false"}},"Effect":"Deny","Principal":"*","Action":["s3:DeleteObject","s3:PutObject"],"Resource":["arn:aws:s3:::bucket/*"]},` +
+				`{"Sid":"read","Effect":"Allow","Principal":{"AWS":"arn:aws:iam:::user/alice"},"Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}],"Version":"2012-10-17"}`,
+			want: true,
+		},
+		"Different actions": {
+			a: policy,
+			b: `{"Version":"2012-10-17","Statement":[{"Sid":"read","Effect":"Allow","Principal":{"AWS":"arn:aws:iam:::user/alice"},"Action":"s3:*","Resource":"arn:aws:s3:::bucket/*"},` +
+				`{"Effect":"Deny","Principal":"*","Action":["s3:PutObject","s3:DeleteObject"],"Resource":"arn:aws:s3:::bucket/*","Condition":{"Bool":{"aws:SecureTransport":"false"}}}]}`,
+			want: false,
+		},
+		"All principals as wildcard and as wildcard AWS principal": {
+			a:    `{"Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`,
+			b:    `{"Statement":[{"Effect":"Allow","Principal":{"AWS":["*"]},"Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`,
+			want: true,
+		},
+		"Wildcard AWS principal differs from wildcard with other principal types": {
+			a:    `{"Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`,
+			b:    `{"Statement":[{"Effect":"Allow","Principal":{"AWS":"*","Service":"s3.amazonaws.com"},"Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`,
+			want: false,
+		},
+		"Empty principal types are ignored": {
+			a:    `{"Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam:::user/alice"},"Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`,
+			b:    `{"Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam:::user/alice"],"CanonicalUser":null},"Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`,
+			want: true,
+		},
+		"Invalid policies are compared as strings": {
+			a:    `{"Statement": [},]}`,
+			b:    `{"Statement": [},]}`,
+			want: true,
+		},
+		"Invalid policy differs from valid policy": {
+			a:    `{"Statement": [},]}`,
+			b:    policy,
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.want, BucketPoliciesEqual(tc.a, tc.b), "unexpected result")
+		})
+	}
+}
+
+func TestValidateBucketPolicy(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		policy  string
+		wantErr bool
+	}{
+		"Valid policy": {
+			policy: `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}}`,
+		},
+		"Valid policy with negated elements": {
+			policy: `{"Statement":[{"Effect":"Deny","NotPrincipal":{"AWS":"arn:aws:iam:::user/alice"},"NotAction":"s3:GetObject","NotResource":"arn:aws:s3:::bucket/public/*"}]}`,
+		},
+		"Invalid JSON": {
+			policy:  `{"Statement": [},]}`,
+			wantErr: true,
+		},
+		"Not a JSON object": {
+			policy:  `["s3:GetObject"]`,
+			wantErr: true,
+		},
+		"No statement": {
+			policy:  `{"Version":"2012-10-17","Statement":[]}`,
+			wantErr: true,
+		},
+		"Invalid effect": {
+			policy:  `{"Statement":[{"Effect":"allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`,
+			wantErr: true,
+		},
+		"Missing principal": {
+			policy:  `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`,
+			wantErr: true,
+		},
+		"Missing resource": {
+			policy:  `{"Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject"}]}`,
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateBucketPolicy(tc.policy)
+			if tc.wantErr {
+				assert.Error(t, err, "expected policy to be rejected")
+			} else {
+				assert.NoError(t, err, "unexpected error")
+			}
+		})
+	}
+}
+
+func TestValidateSessionPolicy(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		policy  string
+		wantErr bool
+	}{
+		"Valid policy": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"arn:aws:s3:::bucket/*"}]}`,
+		},
+		"Valid policy with negated elements": {
+			policy: `{"Version":"2012-10-17","Statement":{"Effect":"Deny","NotAction":"s3:GetObject","NotResource":"arn:aws:s3:::bucket/public/*"}}`,
+		},
+		"Invalid JSON": {
+			policy:  `{"Statement": [}`,
+			wantErr: true,
+		},
+		"Not a JSON object": {
+			policy:  `["s3:*"]`,
+			wantErr: true,
+		},
+		"Missing version": {
+			policy:  `{"Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`,
+			wantErr: true,
+		},
+		"Invalid version": {
+			policy:  `{"Version":"2024-01-01","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`,
+			wantErr: true,
+		},
+		"No statement": {
+			policy:  `{"Version":"2012-10-17"}`,
+			wantErr: true,
+		},
+		"Statement which is not a JSON object": {
+			policy:  `{"Version":"2012-10-17","Statement":["s3:*"]}`,
+			wantErr: true,
+		},
+		"Invalid effect": {
+			policy:  `{"Version":"2012-10-17","Statement":[{"Effect":"Permit","Action":"s3:*","Resource":"*"}]}`,
+			wantErr: true,
+		},
+		"Missing action": {
+			policy:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Resource":"*"}]}`,
+			wantErr: true,
+		},
+		"Missing resource": {
+			policy:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*"}]}`,
+			wantErr: true,
+		},
+		"Principal": {
+			policy:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:*","Resource":"*"}]}`,
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateSessionPolicy(tc.policy)
+			if tc.wantErr {
+				assert.Error(t, err, "expected policy to be rejected")
+			} else {
+				assert.NoError(t, err, "unexpected error")
+			}
+		})
+	}
+}
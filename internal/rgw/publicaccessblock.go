@@ -0,0 +1,65 @@
+package rgw
+
+import (
+	"context"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"go.opentelemetry.io/otel"
+
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/otel/traces"
+)
+
+const (
+	errGetPublicAccessBlock    = "failed to get bucket public access block"
+	errPutPublicAccessBlock    = "failed to put bucket public access block"
+	errDeletePublicAccessBlock = "failed to delete bucket public access block"
+)
+
+func PutPublicAccessBlock(ctx context.Context, s3Backend backendstore.S3Client, bucketName *string, config *types.PublicAccessBlockConfiguration) (*awss3.PutPublicAccessBlockOutput, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "PutPublicAccessBlock")
+	defer span.End()
+
+	resp, err := s3Backend.PutPublicAccessBlock(ctx, &awss3.PutPublicAccessBlockInput{Bucket: bucketName, PublicAccessBlockConfiguration: config})
+	if err != nil {
+		err := errors.Wrap(err, errPutPublicAccessBlock)
+		traces.SetAndRecordError(span, err)
+
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+func DeletePublicAccessBlock(ctx context.Context, s3Backend backendstore.S3Client, bucketName *string) error {
+	ctx, span := otel.Tracer("").Start(ctx, "DeletePublicAccessBlock")
+	defer span.End()
+
+	_, err := s3Backend.DeletePublicAccessBlock(ctx, &awss3.DeletePublicAccessBlockInput{Bucket: bucketName})
+	if err != nil {
+		err := errors.Wrap(err, errDeletePublicAccessBlock)
+		traces.SetAndRecordError(span, err)
+
+		return err
+	}
+
+	return nil
+}
+
+func GetPublicAccessBlock(ctx context.Context, s3Backend backendstore.S3Client, bucketName *string) (*awss3.GetPublicAccessBlockOutput, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "GetPublicAccessBlock")
+	defer span.End()
+
+	resp, err := s3Backend.GetPublicAccessBlock(ctx, &awss3.GetPublicAccessBlockInput{Bucket: bucketName})
+	if resource.IgnoreAny(err, PublicAccessBlockNotFound, IsBucketNotFound) != nil {
+		err = errors.Wrap(err, errGetPublicAccessBlock)
+		traces.SetAndRecordError(span, err)
+
+		return resp, err
+	}
+
+	return resp, nil
+}
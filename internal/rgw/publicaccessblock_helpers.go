@@ -0,0 +1,64 @@
+package rgw
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+)
+
+// GeneratePublicAccessBlockConfiguration creates the public access block
+// configuration of the bucket for the AWS SDK. A bucket without a public
+// access block configuration blocks all public access if blockByDefault is
+// true, and has no public access block configuration otherwise.
+func GeneratePublicAccessBlockConfiguration(config *v1alpha1.PublicAccessBlockConfiguration, blockByDefault bool) *types.PublicAccessBlockConfiguration {
+	if config == nil {
+		if !blockByDefault {
+			return nil
+		}
+
+		return &types.PublicAccessBlockConfiguration{
+			BlockPublicAcls:       aws.Bool(true),
+			IgnorePublicAcls:      aws.Bool(true),
+			BlockPublicPolicy:     aws.Bool(true),
+			RestrictPublicBuckets: aws.Bool(true),
+		}
+	}
+
+	return &types.PublicAccessBlockConfiguration{
+		BlockPublicAcls:       aws.Bool(aws.ToBool(config.BlockPublicAcls)),
+		IgnorePublicAcls:      aws.Bool(aws.ToBool(config.IgnorePublicAcls)),
+		BlockPublicPolicy:     aws.Bool(aws.ToBool(config.BlockPublicPolicy)),
+		RestrictPublicBuckets: aws.Bool(aws.ToBool(config.RestrictPublicBuckets)),
+	}
+}
+
+// PublicAccessBlockConfigurationFromOutput returns the public access block
+// configuration of a bucket with all fields set, or nil if the bucket has none.
+func PublicAccessBlockConfigurationFromOutput(out *awss3.GetPublicAccessBlockOutput) *types.PublicAccessBlockConfiguration {
+	if out == nil || out.PublicAccessBlockConfiguration == nil {
+		return nil
+	}
+	config := out.PublicAccessBlockConfiguration
+
+	return &types.PublicAccessBlockConfiguration{
+		BlockPublicAcls:       aws.Bool(aws.ToBool(config.BlockPublicAcls)),
+		IgnorePublicAcls:      aws.Bool(aws.ToBool(config.IgnorePublicAcls)),
+		BlockPublicPolicy:     aws.Bool(aws.ToBool(config.BlockPublicPolicy)),
+		RestrictPublicBuckets: aws.Bool(aws.ToBool(config.RestrictPublicBuckets)),
+	}
+}
+
+// PublicAccessBlockNotFoundErrCode is the error code sent by Ceph when the bucket has no public access block configuration
+var PublicAccessBlockNotFoundErrCode = "NoSuchPublicAccessBlockConfiguration"
+
+// PublicAccessBlockNotFound parses the error and validates if the public
+// access block configuration does not exist
+func PublicAccessBlockNotFound(err error) bool {
+	var awsErr smithy.APIError
+
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == PublicAccessBlockNotFoundErrCode
+}
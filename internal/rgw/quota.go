@@ -0,0 +1,51 @@
+package rgw
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"go.opentelemetry.io/otel"
+
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/otel/traces"
+	"github.com/linode/provider-ceph/internal/rgw/admin"
+)
+
+const (
+	errGetBucketInfo  = "failed to get bucket info"
+	errSetBucketQuota = "failed to set bucket quota"
+)
+
+// GetBucketInfo returns the bucket with the given name as seen by the Admin Ops API.
+// If the bucket does not exist on the backend, a nil bucket is returned without error.
+func GetBucketInfo(ctx context.Context, adminClient backendstore.AdminClient, bucketName string) (*admin.Bucket, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "GetBucketInfo")
+	defer span.End()
+
+	resp, err := adminClient.GetBucketInfo(ctx, &admin.GetBucketInfoInput{Bucket: bucketName})
+	if err != nil {
+		if admin.IsNoSuchBucket(err) {
+			return nil, nil
+		}
+		err = errors.Wrap(err, errGetBucketInfo)
+		traces.SetAndRecordError(span, err)
+
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func SetBucketQuota(ctx context.Context, adminClient backendstore.AdminClient, input *admin.SetBucketQuotaInput) error {
+	ctx, span := otel.Tracer("").Start(ctx, "SetBucketQuota")
+	defer span.End()
+
+	if err := adminClient.SetBucketQuota(ctx, input); err != nil {
+		err = errors.Wrap(err, errSetBucketQuota)
+		traces.SetAndRecordError(span, err)
+
+		return err
+	}
+
+	return nil
+}
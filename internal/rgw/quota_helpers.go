@@ -0,0 +1,52 @@
+package rgw
+
+import (
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	"github.com/linode/provider-ceph/internal/rgw/admin"
+)
+
+// GenerateBucketQuota creates the Admin Ops quota for the given bucket quota.
+// A nil quota results in a disabled quota without limits.
+func GenerateBucketQuota(config *v1alpha1.BucketQuota) admin.Quota {
+	quota := admin.Quota{
+		MaxSize:    admin.QuotaUnlimited,
+		MaxObjects: admin.QuotaUnlimited,
+	}
+	if config == nil {
+		return quota
+	}
+
+	quota.Enabled = config.Enabled == nil || *config.Enabled
+	if config.MaxObjects != nil {
+		quota.MaxObjects = *config.MaxObjects
+	}
+	if config.MaxSize != nil {
+		quota.MaxSize = config.MaxSize.Value()
+	}
+
+	return quota
+}
+
+// IsBucketQuotaUpToDate returns true if the external quota matches the desired quota.
+// Limits of a disabled quota are not enforced, so they are ignored.
+func IsBucketQuotaUpToDate(external, desired admin.Quota) bool {
+	if external.Enabled != desired.Enabled {
+		return false
+	}
+	if !desired.Enabled {
+		return true
+	}
+
+	return normalizeQuotaLimit(external.MaxObjects) == normalizeQuotaLimit(desired.MaxObjects) &&
+		normalizeQuotaLimit(external.MaxSize) == normalizeQuotaLimit(desired.MaxSize)
+}
+
+// normalizeQuotaLimit maps all negative limits to QuotaUnlimited, as RGW treats
+// any negative value as unlimited.
+func normalizeQuotaLimit(limit int64) int64 {
+	if limit < 0 {
+		return admin.QuotaUnlimited
+	}
+
+	return limit
+}
@@ -0,0 +1,90 @@
+package rgw
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	"github.com/linode/provider-ceph/internal/rgw/admin"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestGenerateBucketQuota(t *testing.T) {
+	t.Parallel()
+
+	maxSize := resource.MustParse("1Gi")
+
+	cases := map[string]struct {
+		config *v1alpha1.BucketQuota
+		want   admin.Quota
+	}{
+		"Nil quota is disabled": {
+			want: admin.Quota{MaxSize: admin.QuotaUnlimited, MaxObjects: admin.QuotaUnlimited},
+		},
+		"Quota is enabled by default": {
+			config: &v1alpha1.BucketQuota{MaxObjects: aws.Int64(10)},
+			want:   admin.Quota{Enabled: true, MaxSize: admin.QuotaUnlimited, MaxObjects: 10},
+		},
+		"Quota is explicitly disabled": {
+			config: &v1alpha1.BucketQuota{Enabled: aws.Bool(false), MaxObjects: aws.Int64(10)},
+			want:   admin.Quota{MaxSize: admin.QuotaUnlimited, MaxObjects: 10},
+		},
+		"Max size is converted to bytes": {
+			config: &v1alpha1.BucketQuota{MaxSize: &maxSize},
+			want:   admin.Quota{Enabled: true, MaxSize: 1 << 30, MaxObjects: admin.QuotaUnlimited},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.want, GenerateBucketQuota(tc.config))
+		})
+	}
+}
+
+func TestIsBucketQuotaUpToDate(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		external admin.Quota
+		desired  admin.Quota
+		want     bool
+	}{
+		"Both disabled with different limits": {
+			external: admin.Quota{MaxObjects: 10, MaxSize: 100},
+			desired:  admin.Quota{MaxObjects: admin.QuotaUnlimited, MaxSize: admin.QuotaUnlimited},
+			want:     true,
+		},
+		"Enabled differs": {
+			external: admin.Quota{MaxObjects: admin.QuotaUnlimited, MaxSize: admin.QuotaUnlimited},
+			desired:  admin.Quota{Enabled: true, MaxObjects: admin.QuotaUnlimited, MaxSize: admin.QuotaUnlimited},
+			want:     false,
+		},
+		"Max objects differs": {
+			external: admin.Quota{Enabled: true, MaxObjects: 10, MaxSize: admin.QuotaUnlimited},
+			desired:  admin.Quota{Enabled: true, MaxObjects: 20, MaxSize: admin.QuotaUnlimited},
+			want:     false,
+		},
+		"Negative limits are unlimited": {
+			external: admin.Quota{Enabled: true, MaxObjects: -5, MaxSize: -1},
+			desired:  admin.Quota{Enabled: true, MaxObjects: admin.QuotaUnlimited, MaxSize: admin.QuotaUnlimited},
+			want:     true,
+		},
+		"Limits match": {
+			external: admin.Quota{Enabled: true, MaxObjects: 10, MaxSize: 1024},
+			desired:  admin.Quota{Enabled: true, MaxObjects: 10, MaxSize: 1024},
+			want:     true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.want, IsBucketQuotaUpToDate(tc.external, tc.desired))
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package rgw
+
+import (
+	"context"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"go.opentelemetry.io/otel"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/otel/traces"
+)
+
+const (
+	errGetBucketReplication    = "failed to get bucket replication"
+	errPutBucketReplication    = "failed to put bucket replication"
+	errDeleteBucketReplication = "failed to delete bucket replication"
+)
+
+func PutBucketReplication(ctx context.Context, s3Backend backendstore.S3Client, b *v1alpha1.Bucket) (*awss3.PutBucketReplicationOutput, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "PutBucketReplication")
+	defer span.End()
+
+	resp, err := s3Backend.PutBucketReplication(ctx, GenerateReplicationConfigurationInput(b.Name, b.Spec.ForProvider.ReplicationConfiguration))
+	if err != nil {
+		err := errors.Wrap(err, errPutBucketReplication)
+		traces.SetAndRecordError(span, err)
+
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+func DeleteBucketReplication(ctx context.Context, s3Backend backendstore.S3Client, bucketName *string) error {
+	ctx, span := otel.Tracer("").Start(ctx, "DeleteBucketReplication")
+	defer span.End()
+
+	_, err := s3Backend.DeleteBucketReplication(ctx, &awss3.DeleteBucketReplicationInput{Bucket: bucketName})
+	if err != nil {
+		err := errors.Wrap(err, errDeleteBucketReplication)
+		traces.SetAndRecordError(span, err)
+
+		return err
+	}
+
+	return nil
+}
+
+func GetBucketReplication(ctx context.Context, s3Backend backendstore.S3Client, bucketName *string) (*awss3.GetBucketReplicationOutput, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "GetBucketReplication")
+	defer span.End()
+
+	resp, err := s3Backend.GetBucketReplication(ctx, &awss3.GetBucketReplicationInput{Bucket: bucketName})
+	if resource.IgnoreAny(err, ReplicationConfigurationNotFound, IsBucketNotFound) != nil {
+		err = errors.Wrap(err, errGetBucketReplication)
+		traces.SetAndRecordError(span, err)
+
+		return resp, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,137 @@
+package rgw
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+)
+
+// bucketARNPrefix is prepended to the name of a destination bucket, as
+// replication rules refer to destination buckets by ARN.
+const bucketARNPrefix = "arn:aws:s3:::"
+
+// GenerateReplicationConfigurationInput creates the PutBucketReplicationInput for the AWS SDK
+func GenerateReplicationConfigurationInput(name string, config *v1alpha1.ReplicationConfiguration) *awss3.PutBucketReplicationInput {
+	if config == nil {
+		return nil
+	}
+
+	return &awss3.PutBucketReplicationInput{
+		Bucket:                   aws.String(name),
+		ReplicationConfiguration: GenerateReplicationConfiguration(config),
+	}
+}
+
+// GenerateReplicationConfiguration creates the ReplicationConfiguration for the AWS SDK
+func GenerateReplicationConfiguration(config *v1alpha1.ReplicationConfiguration) *types.ReplicationConfiguration {
+	if config == nil {
+		return nil
+	}
+
+	return &types.ReplicationConfiguration{
+		Role:  config.Role,
+		Rules: GenerateReplicationRules(config.Rules),
+	}
+}
+
+// GenerateReplicationRules creates the list of ReplicationRules for the AWS SDK
+func GenerateReplicationRules(in []v1alpha1.ReplicationRule) []types.ReplicationRule {
+	var result []types.ReplicationRule //nolint:prealloc // AWS requires nil instead of 0-length for empty slices.
+	for _, local := range in {
+		rule := types.ReplicationRule{
+			ID:       aws.String(local.ID),
+			Priority: local.Priority,
+			Status:   types.ReplicationRuleStatus(local.Status),
+			// S3 expects an empty prefix filter, and never nil, when the rule applies to all objects.
+			Filter: &types.ReplicationRuleFilterMemberPrefix{},
+			Destination: &types.Destination{
+				Bucket:       aws.String(bucketARNPrefix + local.Destination.Bucket),
+				StorageClass: types.StorageClass(aws.ToString(local.Destination.StorageClass)),
+			},
+			DeleteMarkerReplication: &types.DeleteMarkerReplication{
+				Status: types.DeleteMarkerReplicationStatusDisabled,
+			},
+		}
+
+		if local.DeleteMarkerReplication != nil {
+			rule.DeleteMarkerReplication.Status = types.DeleteMarkerReplicationStatus(local.DeleteMarkerReplication.Status)
+		}
+
+		if local.Filter != nil {
+			switch {
+			case local.Filter.Prefix != nil:
+				rule.Filter = &types.ReplicationRuleFilterMemberPrefix{Value: *local.Filter.Prefix}
+			case local.Filter.Tag != nil:
+				rule.Filter = &types.ReplicationRuleFilterMemberTag{Value: types.Tag{Key: aws.String(local.Filter.Tag.Key), Value: aws.String(local.Filter.Tag.Value)}}
+			case local.Filter.And != nil:
+				rule.Filter = &types.ReplicationRuleFilterMemberAnd{Value: types.ReplicationRuleAndOperator{
+					Prefix: local.Filter.And.Prefix,
+					Tags:   sortS3TagSet(copyTags(local.Filter.And.Tags)),
+				}}
+			}
+		}
+
+		result = append(result, rule)
+	}
+
+	return result
+}
+
+// NormalizeReplicationRules returns a copy of the rules sorted by ID, in which
+// the fields that backends report inconsistently are set to their defaults:
+// a missing filter applies to all objects, a missing priority is 0, delete
+// markers are not replicated unless enabled, and destination buckets are ARNs.
+func NormalizeReplicationRules(in []types.ReplicationRule) []types.ReplicationRule {
+	out := make([]types.ReplicationRule, 0, len(in))
+	for _, rule := range in {
+		rule.Priority = aws.Int32(aws.ToInt32(rule.Priority))
+
+		switch filter := rule.Filter.(type) {
+		case nil:
+			rule.Filter = &types.ReplicationRuleFilterMemberPrefix{}
+		case *types.ReplicationRuleFilterMemberAnd:
+			rule.Filter = &types.ReplicationRuleFilterMemberAnd{Value: types.ReplicationRuleAndOperator{
+				Prefix: filter.Value.Prefix,
+				Tags:   sortS3TagSet(filter.Value.Tags),
+			}}
+		}
+
+		if rule.DeleteMarkerReplication == nil || rule.DeleteMarkerReplication.Status == "" {
+			rule.DeleteMarkerReplication = &types.DeleteMarkerReplication{Status: types.DeleteMarkerReplicationStatusDisabled}
+		}
+
+		if rule.Destination != nil {
+			destination := *rule.Destination
+			if bucket := aws.ToString(destination.Bucket); !strings.HasPrefix(bucket, bucketARNPrefix) {
+				destination.Bucket = aws.String(bucketARNPrefix + bucket)
+			}
+			rule.Destination = &destination
+		}
+
+		out = append(out, rule)
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		return aws.ToString(out[i].ID) < aws.ToString(out[j].ID)
+	})
+
+	return out
+}
+
+// ReplicationNotFoundErrCode is the error code sent by Ceph when the bucket has no replication configuration
+var ReplicationNotFoundErrCode = "ReplicationConfigurationNotFoundError"
+
+// ReplicationConfigurationNotFound parses the error and validates if the
+// replication configuration does not exist
+func ReplicationConfigurationNotFound(err error) bool {
+	var awsErr smithy.APIError
+
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == ReplicationNotFoundErrCode
+}
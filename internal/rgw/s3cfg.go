@@ -0,0 +1,70 @@
+package rgw
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/linode/provider-ceph/internal/consts"
+)
+
+const (
+	errParseS3cfg = "failed to parse s3cfg"
+
+	// s3cfgDefaultSection is the section of an s3cfg file read by s3cmd
+	// unless another profile is selected.
+	s3cfgDefaultSection = "default"
+)
+
+// ParseS3cfg returns the access key and the secret key of an s3cmd
+// configuration file, keyed by consts.KeyAccessKey and consts.KeySecretKey.
+// The keys are read from the default section, or from the top of the file if
+// it has no default section. Other settings of the file are ignored.
+func ParseS3cfg(cfg []byte) (map[string][]byte, error) {
+	sections := map[string]map[string]string{"": {}}
+	section := ""
+
+	scanner := bufio.NewScanner(bytes.NewReader(cfg))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		switch {
+		case text == "", strings.HasPrefix(text, "#"), strings.HasPrefix(text, ";"):
+			continue
+		case strings.HasPrefix(text, "["):
+			if !strings.HasSuffix(text, "]") {
+				return nil, errors.New(fmt.Sprintf("%s: invalid section on line %d", errParseS3cfg, line))
+			}
+			section = strings.TrimSpace(text[1 : len(text)-1])
+			if _, ok := sections[section]; !ok {
+				sections[section] = map[string]string{}
+			}
+		default:
+			key, value, found := strings.Cut(text, "=")
+			if !found {
+				return nil, errors.New(fmt.Sprintf("%s: expected key = value on line %d", errParseS3cfg, line))
+			}
+			sections[section][strings.TrimSpace(key)] = strings.TrimSpace(value)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, errParseS3cfg)
+	}
+
+	values, ok := sections[s3cfgDefaultSection]
+	if !ok {
+		values = sections[""]
+	}
+
+	data := map[string][]byte{}
+	for _, key := range []string{consts.KeyAccessKey, consts.KeySecretKey} {
+		if values[key] == "" {
+			return nil, errors.New(fmt.Sprintf("%s: %s is not set", errParseS3cfg, key))
+		}
+		data[key] = []byte(values[key])
+	}
+
+	return data, nil
+}
@@ -0,0 +1,81 @@
+package rgw
+
+import (
+	"testing"
+
+	"github.com/linode/provider-ceph/internal/consts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseS3cfg(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		cfg          string
+		expectedData map[string][]byte
+		expectedErr  string
+	}{
+		"default section": {
+			cfg: `# s3cmd configuration
+[default]
+access_key = access
+secret_key = secret
+host_base = rgw.example.com
+
+[other]
+access_key = other-access
+secret_key = other-secret
+`,
+			expectedData: map[string][]byte{
+				consts.KeyAccessKey: []byte("access"),
+				consts.KeySecretKey: []byte("secret"),
+			},
+		},
+		"no section": {
+			cfg: "access_key=access\nsecret_key=secret\n",
+			expectedData: map[string][]byte{
+				consts.KeyAccessKey: []byte("access"),
+				consts.KeySecretKey: []byte("secret"),
+			},
+		},
+		"secret key containing an equals sign": {
+			cfg: "[default]\naccess_key = access\nsecret_key = abc=def\n",
+			expectedData: map[string][]byte{
+				consts.KeyAccessKey: []byte("access"),
+				consts.KeySecretKey: []byte("abc=def"),
+			},
+		},
+		"missing secret key": {
+			cfg:         "[default]\naccess_key = access\n",
+			expectedErr: "secret_key is not set",
+		},
+		"keys only in another section": {
+			cfg:         "[other]\naccess_key = access\nsecret_key = secret\n",
+			expectedErr: "access_key is not set",
+		},
+		"invalid section": {
+			cfg:         "[default\naccess_key = access\n",
+			expectedErr: "invalid section on line 1",
+		},
+		"invalid line": {
+			cfg:         "[default]\naccess_key\n",
+			expectedErr: "expected key = value on line 2",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := ParseS3cfg([]byte(tc.cfg))
+			if tc.expectedErr != "" {
+				assert.ErrorContains(t, err, tc.expectedErr, "unexpected error")
+
+				return
+			}
+			require.NoError(t, err, "unexpected error")
+			assert.Equal(t, tc.expectedData, data, "unexpected credentials")
+		})
+	}
+}
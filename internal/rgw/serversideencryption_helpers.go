@@ -0,0 +1,62 @@
+package rgw
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+)
+
+// GenerateServerSideEncryptionConfigurationInput creates the PutBucketEncryptionInput for the AWS SDK
+func GenerateServerSideEncryptionConfigurationInput(name string, config *v1alpha1.ServerSideEncryptionConfiguration) *awss3.PutBucketEncryptionInput {
+	if config == nil {
+		return nil
+	}
+
+	return &awss3.PutBucketEncryptionInput{
+		Bucket:                            aws.String(name),
+		ServerSideEncryptionConfiguration: &types.ServerSideEncryptionConfiguration{Rules: GenerateServerSideEncryptionRules(config.Rules)},
+	}
+}
+
+// GenerateServerSideEncryptionRules creates the list of ServerSideEncryptionRules for the AWS SDK
+func GenerateServerSideEncryptionRules(in []v1alpha1.ServerSideEncryptionRule) []types.ServerSideEncryptionRule {
+	var result []types.ServerSideEncryptionRule //nolint:prealloc // AWS requires nil instead of 0-length for empty slices.
+	for _, local := range in {
+		result = append(result, types.ServerSideEncryptionRule{
+			ApplyServerSideEncryptionByDefault: &types.ServerSideEncryptionByDefault{
+				SSEAlgorithm:   types.ServerSideEncryption(local.ApplyServerSideEncryptionByDefault.SSEAlgorithm),
+				KMSMasterKeyID: local.ApplyServerSideEncryptionByDefault.KMSMasterKeyID,
+			},
+			BucketKeyEnabled: local.BucketKeyEnabled,
+		})
+	}
+
+	return result
+}
+
+// NormalizeServerSideEncryptionRules returns a copy of the rules in which an
+// unset BucketKeyEnabled is false, as backends do not report the field consistently.
+func NormalizeServerSideEncryptionRules(in []types.ServerSideEncryptionRule) []types.ServerSideEncryptionRule {
+	out := make([]types.ServerSideEncryptionRule, 0, len(in))
+	for _, rule := range in {
+		rule.BucketKeyEnabled = aws.Bool(aws.ToBool(rule.BucketKeyEnabled))
+		out = append(out, rule)
+	}
+
+	return out
+}
+
+// SSENotFoundErrCode is the error code sent by Ceph when the bucket has no encryption configuration
+var SSENotFoundErrCode = "ServerSideEncryptionConfigurationNotFoundError"
+
+// ServerSideEncryptionConfigurationNotFound parses the error and validates if the
+// server-side encryption configuration does not exist
+func ServerSideEncryptionConfigurationNotFound(err error) bool {
+	var awsErr smithy.APIError
+
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == SSENotFoundErrCode
+}
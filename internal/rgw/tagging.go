@@ -0,0 +1,65 @@
+package rgw
+
+import (
+	"context"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"go.opentelemetry.io/otel"
+
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/otel/traces"
+)
+
+const (
+	errGetBucketTagging    = "failed to get bucket tagging"
+	errPutBucketTagging    = "failed to put bucket tagging"
+	errDeleteBucketTagging = "failed to delete bucket tagging"
+)
+
+func PutBucketTagging(ctx context.Context, s3Backend backendstore.S3Client, bucketName *string, tags []types.Tag) (*awss3.PutBucketTaggingOutput, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "PutBucketTagging")
+	defer span.End()
+
+	resp, err := s3Backend.PutBucketTagging(ctx, &awss3.PutBucketTaggingInput{Bucket: bucketName, Tagging: &types.Tagging{TagSet: tags}})
+	if err != nil {
+		err := errors.Wrap(err, errPutBucketTagging)
+		traces.SetAndRecordError(span, err)
+
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+func DeleteBucketTagging(ctx context.Context, s3Backend backendstore.S3Client, bucketName *string) error {
+	ctx, span := otel.Tracer("").Start(ctx, "DeleteBucketTagging")
+	defer span.End()
+
+	_, err := s3Backend.DeleteBucketTagging(ctx, &awss3.DeleteBucketTaggingInput{Bucket: bucketName})
+	if err != nil {
+		err := errors.Wrap(err, errDeleteBucketTagging)
+		traces.SetAndRecordError(span, err)
+
+		return err
+	}
+
+	return nil
+}
+
+func GetBucketTagging(ctx context.Context, s3Backend backendstore.S3Client, bucketName *string) (*awss3.GetBucketTaggingOutput, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "GetBucketTagging")
+	defer span.End()
+
+	resp, err := s3Backend.GetBucketTagging(ctx, &awss3.GetBucketTaggingInput{Bucket: bucketName})
+	if resource.IgnoreAny(err, TagSetNotFound, IsBucketNotFound) != nil {
+		err = errors.Wrap(err, errGetBucketTagging)
+		traces.SetAndRecordError(span, err)
+
+		return resp, err
+	}
+
+	return resp, nil
+}
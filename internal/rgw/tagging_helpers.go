@@ -0,0 +1,52 @@
+package rgw
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+)
+
+// GenerateBucketTags creates the sorted tag set of the bucket for the AWS SDK.
+// The labels of the bucket whose keys start with labelPrefix are converted to
+// tags keyed by the remainder of the label key, unless labelPrefix is empty.
+// The tags of the bucket take precedence over tags from labels with the same key.
+func GenerateBucketTags(b *v1alpha1.Bucket, labelPrefix string) []types.Tag {
+	merged := map[string]string{}
+	if labelPrefix != "" {
+		for k, v := range b.GetLabels() {
+			if key, ok := strings.CutPrefix(k, labelPrefix); ok && key != "" {
+				merged[key] = v
+			}
+		}
+	}
+	for _, tag := range b.Spec.ForProvider.Tags {
+		merged[tag.Key] = tag.Value
+	}
+
+	tags := make([]v1alpha1.Tag, 0, len(merged))
+	for k, v := range merged {
+		tags = append(tags, v1alpha1.Tag{Key: k, Value: v})
+	}
+
+	return SortTagSet(copyTags(tags))
+}
+
+// SortTagSet sorts an external s3 tag set by key, so that tag sets can be
+// compared regardless of the order in which the tags are returned.
+func SortTagSet(tags []types.Tag) []types.Tag {
+	return sortS3TagSet(tags)
+}
+
+// NoSuchTagSetErrCode is the error code sent by Ceph when the bucket has no tags
+var NoSuchTagSetErrCode = "NoSuchTagSet"
+
+// TagSetNotFound parses the error and validates if the bucket has no tags
+func TagSetNotFound(err error) bool {
+	var awsErr smithy.APIError
+
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == NoSuchTagSetErrCode
+}
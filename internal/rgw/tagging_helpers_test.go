@@ -0,0 +1,70 @@
+package rgw
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGenerateBucketTags(t *testing.T) {
+	t.Parallel()
+
+	prefix := "tags.example.com/"
+	bucket := func(labels map[string]string, tags ...v1alpha1.Tag) *v1alpha1.Bucket {
+		return &v1alpha1.Bucket{
+			ObjectMeta: metav1.ObjectMeta{Name: "bucket", Labels: labels},
+			Spec:       v1alpha1.BucketSpec{ForProvider: v1alpha1.BucketParameters{Tags: tags}},
+		}
+	}
+
+	cases := map[string]struct {
+		bucket *v1alpha1.Bucket
+		prefix string
+		want   []types.Tag
+	}{
+		"No tags": {
+			bucket: bucket(nil),
+			prefix: prefix,
+			want:   []types.Tag{},
+		},
+		"Tags are sorted by key": {
+			bucket: bucket(nil, v1alpha1.Tag{Key: "team", Value: "storage"}, v1alpha1.Tag{Key: "cost-center", Value: "42"}),
+			prefix: prefix,
+			want: []types.Tag{
+				{Key: aws.String("cost-center"), Value: aws.String("42")},
+				{Key: aws.String("team"), Value: aws.String("storage")},
+			},
+		},
+		"Labels with prefix are merged and tags take precedence": {
+			bucket: bucket(
+				map[string]string{
+					prefix + "team":        "platform",
+					prefix + "environment": "production",
+					prefix:                 "ignored",
+					"app":                  "ignored",
+				},
+				v1alpha1.Tag{Key: "team", Value: "storage"}),
+			prefix: prefix,
+			want: []types.Tag{
+				{Key: aws.String("environment"), Value: aws.String("production")},
+				{Key: aws.String("team"), Value: aws.String("storage")},
+			},
+		},
+		"Labels are ignored without prefix": {
+			bucket: bucket(map[string]string{prefix + "team": "platform"}),
+			want:   []types.Tag{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.want, GenerateBucketTags(tc.bucket, tc.prefix))
+		})
+	}
+}
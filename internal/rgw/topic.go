@@ -0,0 +1,71 @@
+package rgw
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"go.opentelemetry.io/otel"
+
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/otel/traces"
+	"github.com/linode/provider-ceph/internal/rgw/admin"
+)
+
+const (
+	errGetTopic    = "failed to get topic"
+	errCreateTopic = "failed to create topic"
+	errDeleteTopic = "failed to delete topic"
+)
+
+// GetTopic returns the topic with the given name. If the topic does not exist
+// on the backend, a nil topic is returned without error.
+func GetTopic(ctx context.Context, adminClient backendstore.AdminClient, name string) (*admin.Topic, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "GetTopic")
+	defer span.End()
+
+	resp, err := adminClient.GetTopic(ctx, &admin.GetTopicInput{Name: name})
+	if err != nil {
+		if admin.IsNoSuchTopic(err) {
+			return nil, nil
+		}
+		err = errors.Wrap(err, errGetTopic)
+		traces.SetAndRecordError(span, err)
+
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// CreateTopic creates the topic, or overwrites the attributes of an existing
+// topic, and returns the ARN of the topic.
+func CreateTopic(ctx context.Context, adminClient backendstore.AdminClient, input *admin.CreateTopicInput) (string, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "CreateTopic")
+	defer span.End()
+
+	arn, err := adminClient.CreateTopic(ctx, input)
+	if err != nil {
+		err = errors.Wrap(err, errCreateTopic)
+		traces.SetAndRecordError(span, err)
+
+		return "", err
+	}
+
+	return arn, nil
+}
+
+func DeleteTopic(ctx context.Context, adminClient backendstore.AdminClient, name string) error {
+	ctx, span := otel.Tracer("").Start(ctx, "DeleteTopic")
+	defer span.End()
+
+	err := adminClient.DeleteTopic(ctx, &admin.DeleteTopicInput{Name: name})
+	if resource.Ignore(admin.IsNoSuchTopic, err) != nil {
+		err = errors.Wrap(err, errDeleteTopic)
+		traces.SetAndRecordError(span, err)
+
+		return err
+	}
+
+	return nil
+}
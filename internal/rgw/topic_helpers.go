@@ -0,0 +1,100 @@
+package rgw
+
+import (
+	"strconv"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	"github.com/linode/provider-ceph/internal/rgw/admin"
+)
+
+// Attribute keys of RGW topics.
+const (
+	topicAttributePushEndpoint  = "push-endpoint"
+	topicAttributeOpaqueData    = "OpaqueData"
+	topicAttributePersistent    = "persistent"
+	topicAttributeVerifySSL     = "verify-ssl"
+	topicAttributeUseSSL        = "use-ssl"
+	topicAttributeCloudEvents   = "cloudevents"
+	topicAttributeAMQPExchange  = "amqp-exchange"
+	topicAttributeAMQPAckLevel  = "amqp-ack-level"
+	topicAttributeKafkaAckLevel = "kafka-ack-level"
+)
+
+// topicAttributeKeys are the attribute keys managed by the provider. Other
+// attributes stored by the backend are not compared.
+var topicAttributeKeys = []string{
+	topicAttributePushEndpoint,
+	topicAttributeOpaqueData,
+	topicAttributePersistent,
+	topicAttributeVerifySSL,
+	topicAttributeUseSSL,
+	topicAttributeCloudEvents,
+	topicAttributeAMQPExchange,
+	topicAttributeAMQPAckLevel,
+	topicAttributeKafkaAckLevel,
+}
+
+func TopicToCreateTopicInput(topic *v1alpha1.Topic) *admin.CreateTopicInput {
+	return &admin.CreateTopicInput{
+		Name:       topic.Name,
+		Attributes: GenerateTopicAttributes(topic),
+	}
+}
+
+// GenerateTopicAttributes returns the RGW attributes of the topic. Optional
+// fields which are unset in the Topic are omitted, leaving them to the backend.
+func GenerateTopicAttributes(topic *v1alpha1.Topic) map[string]string {
+	params := topic.Spec.ForProvider
+	attributes := map[string]string{}
+	setBool := func(key string, b *bool) {
+		if b != nil {
+			attributes[key] = strconv.FormatBool(*b)
+		}
+	}
+	setString := func(key string, s *string) {
+		if s != nil {
+			attributes[key] = *s
+		}
+	}
+
+	setString(topicAttributeOpaqueData, params.OpaqueData)
+	setBool(topicAttributePersistent, params.Persistent)
+
+	switch endpoint := params.Endpoint; {
+	case endpoint.HTTP != nil:
+		attributes[topicAttributePushEndpoint] = endpoint.HTTP.URI
+		setBool(topicAttributeVerifySSL, endpoint.HTTP.VerifySSL)
+		setBool(topicAttributeCloudEvents, endpoint.HTTP.CloudEvents)
+	case endpoint.AMQP != nil:
+		attributes[topicAttributePushEndpoint] = endpoint.AMQP.URI
+		attributes[topicAttributeAMQPExchange] = endpoint.AMQP.Exchange
+		setString(topicAttributeAMQPAckLevel, endpoint.AMQP.AckLevel)
+		setBool(topicAttributeVerifySSL, endpoint.AMQP.VerifySSL)
+	case endpoint.Kafka != nil:
+		attributes[topicAttributePushEndpoint] = endpoint.Kafka.URI
+		setString(topicAttributeKafkaAckLevel, endpoint.Kafka.AckLevel)
+		setBool(topicAttributeUseSSL, endpoint.Kafka.UseSSL)
+		setBool(topicAttributeVerifySSL, endpoint.Kafka.VerifySSL)
+	}
+
+	return attributes
+}
+
+// IsTopicUpToDate returns true if the managed attributes of the topic on the
+// backend match the desired state of the Topic.
+func IsTopicUpToDate(topic *v1alpha1.Topic, external *admin.Topic) bool {
+	if external == nil {
+		return false
+	}
+
+	observed := map[string]string{}
+	for _, key := range topicAttributeKeys {
+		if v, ok := external.Attributes[key]; ok {
+			observed[key] = v
+		}
+	}
+
+	return cmp.Equal(GenerateTopicAttributes(topic), observed)
+}
@@ -0,0 +1,106 @@
+package rgw
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	"github.com/linode/provider-ceph/internal/rgw/admin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateTopicAttributes(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		params v1alpha1.TopicParameters
+		want   map[string]string
+	}{
+		"HTTP endpoint": {
+			params: v1alpha1.TopicParameters{
+				Endpoint: v1alpha1.TopicEndpoint{
+					HTTP: &v1alpha1.HTTPEndpoint{URI: "https://example.com", CloudEvents: aws.Bool(true)},
+				},
+				Persistent: aws.Bool(true),
+			},
+			want: map[string]string{
+				"push-endpoint": "https://example.com",
+				"cloudevents":   "true",
+				"persistent":    "true",
+			},
+		},
+		"AMQP endpoint": {
+			params: v1alpha1.TopicParameters{
+				Endpoint: v1alpha1.TopicEndpoint{
+					AMQP: &v1alpha1.AMQPEndpoint{URI: "amqp://broker", Exchange: "ex", AckLevel: aws.String("broker")},
+				},
+				OpaqueData: aws.String("data"),
+			},
+			want: map[string]string{
+				"push-endpoint":  "amqp://broker",
+				"amqp-exchange":  "ex",
+				"amqp-ack-level": "broker",
+				"OpaqueData":     "data",
+			},
+		},
+		"Kafka endpoint": {
+			params: v1alpha1.TopicParameters{
+				Endpoint: v1alpha1.TopicEndpoint{
+					Kafka: &v1alpha1.KafkaEndpoint{URI: "kafka://broker:9092", UseSSL: aws.Bool(false)},
+				},
+			},
+			want: map[string]string{
+				"push-endpoint": "kafka://broker:9092",
+				"use-ssl":       "false",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			topic := &v1alpha1.Topic{Spec: v1alpha1.TopicSpec{ForProvider: tc.params}}
+			assert.Equal(t, tc.want, GenerateTopicAttributes(topic))
+		})
+	}
+}
+
+func TestIsTopicUpToDate(t *testing.T) {
+	t.Parallel()
+
+	topic := &v1alpha1.Topic{Spec: v1alpha1.TopicSpec{ForProvider: v1alpha1.TopicParameters{
+		Endpoint: v1alpha1.TopicEndpoint{
+			HTTP: &v1alpha1.HTTPEndpoint{URI: "https://example.com"},
+		},
+	}}}
+
+	cases := map[string]struct {
+		external *admin.Topic
+		want     bool
+	}{
+		"Missing topic": {
+			want: false,
+		},
+		"Unmanaged attributes are not compared": {
+			external: &admin.Topic{Attributes: map[string]string{"push-endpoint": "https://example.com", "unknown": "value"}},
+			want:     true,
+		},
+		"Endpoint differs": {
+			external: &admin.Topic{Attributes: map[string]string{"push-endpoint": "https://other.com"}},
+			want:     false,
+		},
+		"Attribute removed from Topic": {
+			external: &admin.Topic{Attributes: map[string]string{"push-endpoint": "https://example.com", "verify-ssl": "false"}},
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.want, IsTopicUpToDate(topic, tc.external))
+		})
+	}
+}
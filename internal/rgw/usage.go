@@ -0,0 +1,36 @@
+package rgw
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"go.opentelemetry.io/otel"
+
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/otel/traces"
+	"github.com/linode/provider-ceph/internal/rgw/admin"
+)
+
+const errGetBucketStats = "failed to get bucket stats"
+
+// GetBucketStats returns the total usage of the bucket with the given name. If the
+// bucket does not exist on the backend, a nil usage is returned without error.
+func GetBucketStats(ctx context.Context, adminClient backendstore.AdminClient, bucketName string) (*admin.BucketUsage, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "GetBucketStats")
+	defer span.End()
+
+	resp, err := adminClient.GetBucketInfo(ctx, &admin.GetBucketInfoInput{Bucket: bucketName, Stats: true})
+	if err != nil {
+		if admin.IsNoSuchBucket(err) {
+			return nil, nil
+		}
+		err = errors.Wrap(err, errGetBucketStats)
+		traces.SetAndRecordError(span, err)
+
+		return nil, err
+	}
+
+	usage := resp.TotalUsage()
+
+	return &usage, nil
+}
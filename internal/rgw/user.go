@@ -0,0 +1,116 @@
+package rgw
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"go.opentelemetry.io/otel"
+
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/otel/traces"
+	"github.com/linode/provider-ceph/internal/rgw/admin"
+)
+
+const (
+	errGetUser    = "failed to get user"
+	errCreateUser = "failed to create user"
+	errModifyUser = "failed to modify user"
+	errRemoveUser = "failed to remove user"
+	errCreateKey  = "failed to create user key"
+	errRemoveKey  = "failed to remove user key"
+)
+
+// GetUser returns the user with the given uid. If the user does not exist
+// on the backend, a nil user is returned without error.
+func GetUser(ctx context.Context, adminClient backendstore.AdminClient, uid string) (*admin.User, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "GetUser")
+	defer span.End()
+
+	resp, err := adminClient.GetUser(ctx, &admin.GetUserInput{UID: uid})
+	if err != nil {
+		if admin.IsNoSuchUser(err) {
+			return nil, nil
+		}
+		err = errors.Wrap(err, errGetUser)
+		traces.SetAndRecordError(span, err)
+
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func CreateUser(ctx context.Context, adminClient backendstore.AdminClient, input *admin.CreateUserInput) (*admin.User, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "CreateUser")
+	defer span.End()
+
+	resp, err := adminClient.CreateUser(ctx, input)
+	if err != nil {
+		err = errors.Wrap(err, errCreateUser)
+		traces.SetAndRecordError(span, err)
+
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+func ModifyUser(ctx context.Context, adminClient backendstore.AdminClient, input *admin.ModifyUserInput) (*admin.User, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "ModifyUser")
+	defer span.End()
+
+	resp, err := adminClient.ModifyUser(ctx, input)
+	if err != nil {
+		err = errors.Wrap(err, errModifyUser)
+		traces.SetAndRecordError(span, err)
+
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+func RemoveUser(ctx context.Context, adminClient backendstore.AdminClient, uid string) error {
+	ctx, span := otel.Tracer("").Start(ctx, "RemoveUser")
+	defer span.End()
+
+	err := adminClient.RemoveUser(ctx, &admin.RemoveUserInput{UID: uid})
+	if resource.Ignore(admin.IsNoSuchUser, err) != nil {
+		err = errors.Wrap(err, errRemoveUser)
+		traces.SetAndRecordError(span, err)
+
+		return err
+	}
+
+	return nil
+}
+
+func CreateKey(ctx context.Context, adminClient backendstore.AdminClient, input *admin.CreateKeyInput) error {
+	ctx, span := otel.Tracer("").Start(ctx, "CreateKey")
+	defer span.End()
+
+	if _, err := adminClient.CreateKey(ctx, input); err != nil {
+		err = errors.Wrap(err, errCreateKey)
+		traces.SetAndRecordError(span, err)
+
+		return err
+	}
+
+	return nil
+}
+
+func RemoveKey(ctx context.Context, adminClient backendstore.AdminClient, uid, accessKey string) error {
+	ctx, span := otel.Tracer("").Start(ctx, "RemoveKey")
+	defer span.End()
+
+	err := adminClient.RemoveKey(ctx, &admin.RemoveKeyInput{UID: uid, AccessKey: accessKey})
+	if resource.Ignore(admin.IsNoSuchKey, err) != nil {
+		err = errors.Wrap(err, errRemoveKey)
+		traces.SetAndRecordError(span, err)
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package rgw
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	"github.com/linode/provider-ceph/internal/rgw/admin"
+)
+
+// tenantSeparator separates the tenant from the user ID in a RGW uid.
+const tenantSeparator = "$"
+
+// CephUserToUID returns the RGW uid of the CephUser, ie "<tenant>$<name>"
+// or "<name>" if no tenant is specified.
+func CephUserToUID(user *v1alpha1.CephUser) string {
+	if user.Spec.ForProvider.Tenant == "" {
+		return user.Name
+	}
+
+	return user.Spec.ForProvider.Tenant + tenantSeparator + user.Name
+}
+
+func CephUserToCreateUserInput(user *v1alpha1.CephUser) *admin.CreateUserInput {
+	return &admin.CreateUserInput{
+		UID:         CephUserToUID(user),
+		DisplayName: user.Spec.ForProvider.DisplayName,
+		Email:       user.Spec.ForProvider.Email,
+		MaxBuckets:  user.Spec.ForProvider.MaxBuckets,
+		Suspended:   user.Spec.ForProvider.Suspended,
+	}
+}
+
+func CephUserToModifyUserInput(user *v1alpha1.CephUser) *admin.ModifyUserInput {
+	return &admin.ModifyUserInput{
+		UID:         CephUserToUID(user),
+		DisplayName: user.Spec.ForProvider.DisplayName,
+		Email:       user.Spec.ForProvider.Email,
+		MaxBuckets:  user.Spec.ForProvider.MaxBuckets,
+		Suspended:   user.Spec.ForProvider.Suspended,
+	}
+}
+
+// IsUserUpToDate returns true if the user on the backend matches the desired
+// state of the CephUser. Optional fields which are unset in the CephUser are
+// left to the backend and therefore not compared.
+func IsUserUpToDate(user *v1alpha1.CephUser, external *admin.User) bool {
+	if external == nil {
+		return false
+	}
+
+	params := user.Spec.ForProvider
+	if params.DisplayName != external.DisplayName {
+		return false
+	}
+	if params.Email != nil && aws.ToString(params.Email) != external.Email {
+		return false
+	}
+	if params.MaxBuckets != nil && aws.ToInt32(params.MaxBuckets) != external.MaxBuckets {
+		return false
+	}
+	if params.Suspended != nil && aws.ToBool(params.Suspended) != (external.Suspended != 0) {
+		return false
+	}
+
+	return true
+}
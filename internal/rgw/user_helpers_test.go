@@ -0,0 +1,80 @@
+package rgw
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	"github.com/linode/provider-ceph/internal/rgw/admin"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCephUserToUID(t *testing.T) {
+	t.Parallel()
+
+	user := &v1alpha1.CephUser{ObjectMeta: metav1.ObjectMeta{Name: "user"}}
+	assert.Equal(t, "user", CephUserToUID(user))
+
+	user.Spec.ForProvider.Tenant = "tenant"
+	assert.Equal(t, "tenant$user", CephUserToUID(user))
+}
+
+func TestIsUserUpToDate(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		params   v1alpha1.CephUserParameters
+		external *admin.User
+		want     bool
+	}{
+		"Missing user": {
+			params: v1alpha1.CephUserParameters{DisplayName: "User"},
+			want:   false,
+		},
+		"Unset optional fields are not compared": {
+			params:   v1alpha1.CephUserParameters{DisplayName: "User"},
+			external: &admin.User{DisplayName: "User", Email: "user@example.com", MaxBuckets: 1000, Suspended: 1},
+			want:     true,
+		},
+		"Display name differs": {
+			params:   v1alpha1.CephUserParameters{DisplayName: "User"},
+			external: &admin.User{DisplayName: "Other"},
+			want:     false,
+		},
+		"Email differs": {
+			params:   v1alpha1.CephUserParameters{DisplayName: "User", Email: aws.String("user@example.com")},
+			external: &admin.User{DisplayName: "User"},
+			want:     false,
+		},
+		"Max buckets differs": {
+			params:   v1alpha1.CephUserParameters{DisplayName: "User", MaxBuckets: aws.Int32(10)},
+			external: &admin.User{DisplayName: "User", MaxBuckets: 1000},
+			want:     false,
+		},
+		"Suspended differs": {
+			params:   v1alpha1.CephUserParameters{DisplayName: "User", Suspended: aws.Bool(false)},
+			external: &admin.User{DisplayName: "User", Suspended: 1},
+			want:     false,
+		},
+		"All fields match": {
+			params: v1alpha1.CephUserParameters{
+				DisplayName: "User",
+				Email:       aws.String("user@example.com"),
+				MaxBuckets:  aws.Int32(10),
+				Suspended:   aws.Bool(true),
+			},
+			external: &admin.User{DisplayName: "User", Email: "user@example.com", MaxBuckets: 10, Suspended: 1},
+			want:     true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			user := &v1alpha1.CephUser{Spec: v1alpha1.CephUserSpec{ForProvider: tc.params}}
+			assert.Equal(t, tc.want, IsUserUpToDate(user, tc.external))
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package rgw
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/otel/traces"
+	"github.com/linode/provider-ceph/internal/utils"
+	"go.opentelemetry.io/otel"
+)
+
+const (
+	errAssumeRoleWithWebIdentity = "failed to assume role with web identity"
+	errReadWebIdentityToken      = "failed to read web identity token"
+	errNoWebIdentityCreds        = "AssumeRoleWithWebIdentity response does not contain required credentials"
+
+	webIdentityRoleSessionName = "provider-ceph"
+	webIdentityCredentialsName = "WebIdentityCredentials"
+	// webIdentityExpiryWindow is how long before their expiration web
+	// identity credentials are refreshed.
+	webIdentityExpiryWindow = 5 * time.Minute
+)
+
+func AssumeRoleWithWebIdentity(ctx context.Context, stsClient backendstore.STSClient, input *sts.AssumeRoleWithWebIdentityInput) (*sts.AssumeRoleWithWebIdentityOutput, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "AssumeRoleWithWebIdentity")
+	defer span.End()
+
+	resp, err := stsClient.AssumeRoleWithWebIdentity(ctx, input)
+	if err != nil {
+		err = errors.Wrap(err, errAssumeRoleWithWebIdentity)
+		traces.SetAndRecordError(span, err)
+
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// NewWebIdentityCredentials returns a provider of temporary credentials of the
+// role, obtained by exchanging the projected ServiceAccount token at tokenPath
+// with AssumeRoleWithWebIdentity. The credentials are cached and refreshed
+// shortly before they expire.
+func NewWebIdentityCredentials(stsClient backendstore.STSClient, roleArn, tokenPath string) aws.CredentialsProvider {
+	return aws.NewCredentialsCache(&webIdentityCredentialsProvider{
+		stsClient: stsClient,
+		roleArn:   roleArn,
+		tokenPath: tokenPath,
+	}, func(o *aws.CredentialsCacheOptions) {
+		o.ExpiryWindow = webIdentityExpiryWindow
+	})
+}
+
+type webIdentityCredentialsProvider struct {
+	stsClient backendstore.STSClient
+	roleArn   string
+	tokenPath string
+}
+
+// Retrieve reads the token, which is rotated by the kubelet, and exchanges it
+// for temporary credentials of the role.
+func (p *webIdentityCredentialsProvider) Retrieve(ctx context.Context) (aws.Credentials, error) {
+	token, err := utils.ReadFile(p.tokenPath)
+	if err != nil {
+		return aws.Credentials{}, errors.Wrap(err, errReadWebIdentityToken)
+	}
+
+	resp, err := AssumeRoleWithWebIdentity(ctx, p.stsClient, &sts.AssumeRoleWithWebIdentityInput{
+		RoleArn:          aws.String(p.roleArn),
+		RoleSessionName:  aws.String(webIdentityRoleSessionName),
+		WebIdentityToken: aws.String(strings.TrimSpace(string(token))),
+	})
+	if err != nil {
+		return aws.Credentials{}, err
+	}
+
+	if resp.Credentials == nil ||
+		resp.Credentials.AccessKeyId == nil ||
+		resp.Credentials.SecretAccessKey == nil ||
+		resp.Credentials.SessionToken == nil {
+		return aws.Credentials{}, errors.New(errNoWebIdentityCreds)
+	}
+
+	creds := aws.Credentials{
+		AccessKeyID:     *resp.Credentials.AccessKeyId,
+		SecretAccessKey: *resp.Credentials.SecretAccessKey,
+		SessionToken:    *resp.Credentials.SessionToken,
+		Source:          webIdentityCredentialsName,
+	}
+	if resp.Credentials.Expiration != nil {
+		creds.CanExpire = true
+		creds.Expires = *resp.Credentials.Expiration
+	}
+
+	return creds, nil
+}
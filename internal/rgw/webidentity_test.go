@@ -0,0 +1,141 @@
+package rgw
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/linode/provider-ceph/internal/backendstore/backendstorefakes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebIdentityCredentials(t *testing.T) {
+	t.Parallel()
+
+	errSTS := errors.New("some sts error")
+	validCreds := &ststypes.Credentials{
+		AccessKeyId:     aws.String("access-key"),
+		SecretAccessKey: aws.String("secret-key"),
+		SessionToken:    aws.String("session-token"),
+		Expiration:      aws.Time(time.Now().Add(time.Hour)),
+	}
+
+	testCases := map[string]struct {
+		token         *string
+		output        *sts.AssumeRoleWithWebIdentityOutput
+		stsErr        error
+		expectedCreds aws.Credentials
+		expectedErr   string
+	}{
+		"ok": {
+			token:  aws.String("token\n"),
+			output: &sts.AssumeRoleWithWebIdentityOutput{Credentials: validCreds},
+			expectedCreds: aws.Credentials{
+				AccessKeyID:     "access-key",
+				SecretAccessKey: "secret-key",
+				SessionToken:    "session-token",
+				CanExpire:       true,
+				Expires:         *validCreds.Expiration,
+			},
+		},
+		"token file does not exist": {
+			expectedErr: errReadWebIdentityToken,
+		},
+		"sts error": {
+			token:       aws.String("token"),
+			stsErr:      errSTS,
+			expectedErr: errAssumeRoleWithWebIdentity,
+		},
+		"response without credentials": {
+			token:       aws.String("token"),
+			output:      &sts.AssumeRoleWithWebIdentityOutput{},
+			expectedErr: errNoWebIdentityCreds,
+		},
+		"response without session token": {
+			token: aws.String("token"),
+			output: &sts.AssumeRoleWithWebIdentityOutput{Credentials: &ststypes.Credentials{
+				AccessKeyId:     aws.String("access-key"),
+				SecretAccessKey: aws.String("secret-key"),
+			}},
+			expectedErr: errNoWebIdentityCreds,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tokenPath := filepath.Join(t.TempDir(), "token")
+			if tc.token != nil {
+				require.NoError(t, os.WriteFile(tokenPath, []byte(*tc.token), 0o600))
+			}
+
+			fake := &backendstorefakes.FakeSTSClient{}
+			fake.AssumeRoleWithWebIdentityReturns(tc.output, tc.stsErr)
+
+			creds := NewWebIdentityCredentials(fake, "role-arn", tokenPath)
+
+			got, err := creds.Retrieve(context.Background())
+			if tc.expectedErr != "" {
+				assert.ErrorContains(t, err, tc.expectedErr, "unexpected error")
+
+				return
+			}
+			require.NoError(t, err, "unexpected error")
+			assert.Equal(t, tc.expectedCreds.AccessKeyID, got.AccessKeyID, "unexpected access key")
+			assert.Equal(t, tc.expectedCreds.SecretAccessKey, got.SecretAccessKey, "unexpected secret key")
+			assert.Equal(t, tc.expectedCreds.SessionToken, got.SessionToken, "unexpected session token")
+			assert.Equal(t, tc.expectedCreds.CanExpire, got.CanExpire, "unexpected expiry")
+			// The credentials are considered expired at the start of the expiry window.
+			assert.WithinDuration(t, tc.expectedCreds.Expires, got.Expires, webIdentityExpiryWindow, "unexpected expiration")
+
+			_, input, _ := fake.AssumeRoleWithWebIdentityArgsForCall(0)
+			assert.Equal(t, "role-arn", aws.ToString(input.RoleArn), "unexpected role")
+			assert.Equal(t, "token", aws.ToString(input.WebIdentityToken), "unexpected token")
+
+			// Valid credentials are cached until they are about to expire.
+			_, err = creds.Retrieve(context.Background())
+			require.NoError(t, err, "unexpected error")
+			assert.Equal(t, 1, fake.AssumeRoleWithWebIdentityCallCount(), "credentials should be cached")
+		})
+	}
+}
+
+func TestWebIdentityCredentialsRefresh(t *testing.T) {
+	t.Parallel()
+
+	tokenPath := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenPath, []byte("first-token"), 0o600))
+
+	fake := &backendstorefakes.FakeSTSClient{}
+	// The credentials expire within the expiry window, so they are
+	// refreshed on each retrieval.
+	fake.AssumeRoleWithWebIdentityReturns(&sts.AssumeRoleWithWebIdentityOutput{Credentials: &ststypes.Credentials{
+		AccessKeyId:     aws.String("access-key"),
+		SecretAccessKey: aws.String("secret-key"),
+		SessionToken:    aws.String("session-token"),
+		Expiration:      aws.Time(time.Now().Add(time.Minute)),
+	}}, nil)
+
+	creds := NewWebIdentityCredentials(fake, "role-arn", tokenPath)
+
+	_, err := creds.Retrieve(context.Background())
+	require.NoError(t, err, "unexpected error")
+
+	// The rotated token is read on refresh.
+	require.NoError(t, os.WriteFile(tokenPath, []byte("second-token"), 0o600))
+
+	_, err = creds.Retrieve(context.Background())
+	require.NoError(t, err, "unexpected error")
+
+	require.Equal(t, 2, fake.AssumeRoleWithWebIdentityCallCount(), "credentials should be refreshed")
+	_, input, _ := fake.AssumeRoleWithWebIdentityArgsForCall(1)
+	assert.Equal(t, "second-token", aws.ToString(input.WebIdentityToken), "unexpected token")
+}
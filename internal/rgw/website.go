@@ -0,0 +1,65 @@
+package rgw
+
+import (
+	"context"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"go.opentelemetry.io/otel"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+	"github.com/linode/provider-ceph/internal/otel/traces"
+)
+
+const (
+	errGetBucketWebsite    = "failed to get bucket website"
+	errPutBucketWebsite    = "failed to put bucket website"
+	errDeleteBucketWebsite = "failed to delete bucket website"
+)
+
+func PutBucketWebsite(ctx context.Context, s3Backend backendstore.S3Client, b *v1alpha1.Bucket) (*awss3.PutBucketWebsiteOutput, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "PutBucketWebsite")
+	defer span.End()
+
+	resp, err := s3Backend.PutBucketWebsite(ctx, GenerateWebsiteConfigurationInput(b.Name, b.Spec.ForProvider.WebsiteConfiguration))
+	if err != nil {
+		err := errors.Wrap(err, errPutBucketWebsite)
+		traces.SetAndRecordError(span, err)
+
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+func DeleteBucketWebsite(ctx context.Context, s3Backend backendstore.S3Client, bucketName *string) error {
+	ctx, span := otel.Tracer("").Start(ctx, "DeleteBucketWebsite")
+	defer span.End()
+
+	_, err := s3Backend.DeleteBucketWebsite(ctx, &awss3.DeleteBucketWebsiteInput{Bucket: bucketName})
+	if err != nil {
+		err := errors.Wrap(err, errDeleteBucketWebsite)
+		traces.SetAndRecordError(span, err)
+
+		return err
+	}
+
+	return nil
+}
+
+func GetBucketWebsite(ctx context.Context, s3Backend backendstore.S3Client, bucketName *string) (*awss3.GetBucketWebsiteOutput, error) {
+	ctx, span := otel.Tracer("").Start(ctx, "GetBucketWebsite")
+	defer span.End()
+
+	resp, err := s3Backend.GetBucketWebsite(ctx, &awss3.GetBucketWebsiteInput{Bucket: bucketName})
+	if resource.IgnoreAny(err, WebsiteConfigurationNotFound, IsBucketNotFound) != nil {
+		err = errors.Wrap(err, errGetBucketWebsite)
+		traces.SetAndRecordError(span, err)
+
+		return resp, err
+	}
+
+	return resp, nil
+}
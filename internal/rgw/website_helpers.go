@@ -0,0 +1,90 @@
+package rgw
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+
+	"github.com/linode/provider-ceph/apis/provider-ceph/v1alpha1"
+)
+
+// GenerateWebsiteConfigurationInput creates the PutBucketWebsiteInput for the AWS SDK
+func GenerateWebsiteConfigurationInput(name string, config *v1alpha1.WebsiteConfiguration) *awss3.PutBucketWebsiteInput {
+	if config == nil {
+		return nil
+	}
+
+	return &awss3.PutBucketWebsiteInput{
+		Bucket:               aws.String(name),
+		WebsiteConfiguration: GenerateWebsiteConfiguration(config),
+	}
+}
+
+// GenerateWebsiteConfiguration creates the WebsiteConfiguration for the AWS SDK
+func GenerateWebsiteConfiguration(config *v1alpha1.WebsiteConfiguration) *types.WebsiteConfiguration {
+	if config == nil {
+		return nil
+	}
+
+	out := &types.WebsiteConfiguration{}
+	if config.IndexDocument != nil {
+		out.IndexDocument = &types.IndexDocument{Suffix: aws.String(config.IndexDocument.Suffix)}
+	}
+	if config.ErrorDocument != nil {
+		out.ErrorDocument = &types.ErrorDocument{Key: aws.String(config.ErrorDocument.Key)}
+	}
+	if config.RedirectAllRequestsTo != nil {
+		out.RedirectAllRequestsTo = &types.RedirectAllRequestsTo{
+			HostName: aws.String(config.RedirectAllRequestsTo.HostName),
+			Protocol: types.Protocol(aws.ToString(config.RedirectAllRequestsTo.Protocol)),
+		}
+	}
+	for _, local := range config.RoutingRules {
+		rule := types.RoutingRule{
+			Redirect: &types.Redirect{
+				HostName:             local.Redirect.HostName,
+				HttpRedirectCode:     local.Redirect.HTTPRedirectCode,
+				Protocol:             types.Protocol(aws.ToString(local.Redirect.Protocol)),
+				ReplaceKeyPrefixWith: local.Redirect.ReplaceKeyPrefixWith,
+				ReplaceKeyWith:       local.Redirect.ReplaceKeyWith,
+			},
+		}
+		if local.Condition != nil {
+			rule.Condition = &types.Condition{
+				HttpErrorCodeReturnedEquals: local.Condition.HTTPErrorCodeReturnedEquals,
+				KeyPrefixEquals:             local.Condition.KeyPrefixEquals,
+			}
+		}
+		out.RoutingRules = append(out.RoutingRules, rule)
+	}
+
+	return out
+}
+
+// WebsiteConfigurationFromOutput returns the WebsiteConfiguration of a
+// GetBucketWebsiteOutput, or nil if the bucket has no website configuration.
+func WebsiteConfigurationFromOutput(out *awss3.GetBucketWebsiteOutput) *types.WebsiteConfiguration {
+	if out == nil || (out.IndexDocument == nil && out.ErrorDocument == nil && out.RedirectAllRequestsTo == nil && len(out.RoutingRules) == 0) {
+		return nil
+	}
+
+	return &types.WebsiteConfiguration{
+		IndexDocument:         out.IndexDocument,
+		ErrorDocument:         out.ErrorDocument,
+		RedirectAllRequestsTo: out.RedirectAllRequestsTo,
+		RoutingRules:          out.RoutingRules,
+	}
+}
+
+// WebsiteNotFoundErrCode is the error code sent by Ceph when the bucket has no website configuration
+var WebsiteNotFoundErrCode = "NoSuchWebsiteConfiguration"
+
+// WebsiteConfigurationNotFound parses the error and validates if the
+// website configuration does not exist
+func WebsiteConfigurationNotFound(err error) bool {
+	var awsErr smithy.APIError
+
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == WebsiteNotFoundErrCode
+}
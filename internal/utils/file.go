@@ -0,0 +1,26 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+)
+
+// errRelativePath is returned for paths which are not absolute.
+var errRelativePath = errors.New("path is not absolute")
+
+// ReadFile reads the file at a path set by a ProviderConfig, such as the path
+// of a credentials file or of a projected ServiceAccount token. The path is
+// cleaned and must be absolute, so that the file read does not depend on the
+// working directory of the provider.
+func ReadFile(path string) ([]byte, error) {
+	cleanPath := filepath.Clean(path)
+	if !filepath.IsAbs(cleanPath) {
+		return nil, errors.Wrapf(errRelativePath, "cannot read %q", path)
+	}
+
+	// Only cluster scoped ProviderConfigs, which are managed by cluster
+	// administrators, may set paths of files read by the provider.
+	return os.ReadFile(cleanPath) //nolint:gosec // The path is set by a cluster administrator and is cleaned and absolute.
+}
@@ -0,0 +1,50 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "token"), []byte("value"), 0o600), "unexpected error")
+
+	cases := map[string]struct {
+		path    string
+		want    []byte
+		wantErr error
+	}{
+		"Absolute path is read": {
+			path: filepath.Join(dir, "token"),
+			want: []byte("value"),
+		},
+		"Absolute path is cleaned": {
+			path: dir + "/sub/../token",
+			want: []byte("value"),
+		},
+		"Relative path is rejected": {
+			path:    "token",
+			wantErr: errRelativePath,
+		},
+		"Relative path escaping the working directory is rejected": {
+			path:    "../token",
+			wantErr: errRelativePath,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ReadFile(tc.path)
+			require.ErrorIs(t, err, tc.wantErr, "unexpected error")
+			assert.Equal(t, tc.want, got, "unexpected file content")
+		})
+	}
+}
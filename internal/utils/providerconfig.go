@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"context"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apisnsv1alpha1 "github.com/linode/provider-ceph/apis/namespaced/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/backendstore"
+)
+
+// GetProviderConfig returns the ProviderConfig of the given backend. Backends of
+// namespaced ProviderConfigs are named "namespace/name". Otherwise the backend
+// belongs to a cluster scoped ProviderConfig or, if there is none with that name,
+// to a ClusterProviderConfig.
+// A ClusterProviderConfig with the name of a cluster scoped ProviderConfig
+// is shadowed by the ProviderConfig, see IsShadowed.
+func GetProviderConfig(ctx context.Context, kubeClient client.Reader, backendName string) (apisv1alpha1.ProviderConfigObject, error) {
+	if namespace, name := backendstore.SplitBackendName(backendName); namespace != "" {
+		pc := &apisnsv1alpha1.ProviderConfig{}
+		if err := kubeClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, pc); err != nil {
+			return nil, err
+		}
+
+		return pc, nil
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	err := kubeClient.Get(ctx, types.NamespacedName{Name: backendName}, pc)
+	if err == nil {
+		return pc, nil
+	}
+	if !kerrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	cpc := &apisnsv1alpha1.ClusterProviderConfig{}
+	if err := kubeClient.Get(ctx, types.NamespacedName{Name: backendName}, cpc); err != nil {
+		return nil, err
+	}
+
+	return cpc, nil
+}
+
+// IsShadowed returns true if the ProviderConfig is a ClusterProviderConfig
+// with the given name and a cluster scoped ProviderConfig has the same name.
+// The backends of both are named after them, so the backend belongs to the
+// ProviderConfig and the ClusterProviderConfig is not used.
+func IsShadowed(ctx context.Context, kubeClient client.Reader, pc apisv1alpha1.ProviderConfigObject, name string) (bool, error) {
+	if _, ok := pc.(*apisnsv1alpha1.ClusterProviderConfig); !ok {
+		return false, nil
+	}
+
+	err := kubeClient.Get(ctx, types.NamespacedName{Name: name}, &apisv1alpha1.ProviderConfig{})
+	if kerrors.IsNotFound(err) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
@@ -0,0 +1,126 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	apisnsv1alpha1 "github.com/linode/provider-ceph/apis/namespaced/v1alpha1"
+	apisv1alpha1 "github.com/linode/provider-ceph/apis/v1alpha1"
+	"github.com/linode/provider-ceph/internal/consts"
+)
+
+func TestGetProviderConfig(t *testing.T) {
+	t.Parallel()
+
+	s := runtime.NewScheme()
+	require.NoError(t, apisv1alpha1.SchemeBuilder.AddToScheme(s))
+	require.NoError(t, apisnsv1alpha1.SchemeBuilder.AddToScheme(s))
+
+	objs := []client.Object{
+		&apisv1alpha1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: consts.S3Backend1}},
+		&apisnsv1alpha1.ClusterProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: consts.S3Backend2}},
+		&apisnsv1alpha1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: consts.S3Backend1}},
+	}
+
+	cases := map[string]struct {
+		backendName string
+		want        client.Object
+		notFound    bool
+	}{
+		"Legacy provider config": {
+			backendName: consts.S3Backend1,
+			want:        objs[0],
+		},
+		"Cluster provider config": {
+			backendName: consts.S3Backend2,
+			want:        objs[1],
+		},
+		"Namespaced provider config": {
+			backendName: "ns/" + consts.S3Backend1,
+			want:        objs[2],
+		},
+		"Missing namespaced provider config": {
+			backendName: "ns/" + consts.S3Backend2,
+			notFound:    true,
+		},
+		"Missing provider config": {
+			backendName: consts.S3Backend3,
+			notFound:    true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			kubeClient := fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).Build()
+
+			pc, err := GetProviderConfig(context.Background(), kubeClient, tc.backendName)
+			if tc.notFound {
+				assert.True(t, kerrors.IsNotFound(err), "expected not found error, got %v", err)
+
+				return
+			}
+			require.NoError(t, err)
+			assert.IsType(t, tc.want, pc)
+			assert.Equal(t, tc.want.GetNamespace(), pc.GetNamespace())
+			assert.Equal(t, tc.want.GetName(), pc.GetName())
+		})
+	}
+}
+
+func TestIsShadowed(t *testing.T) {
+	t.Parallel()
+
+	s := runtime.NewScheme()
+	require.NoError(t, apisv1alpha1.SchemeBuilder.AddToScheme(s))
+	require.NoError(t, apisnsv1alpha1.SchemeBuilder.AddToScheme(s))
+
+	objs := []client.Object{
+		&apisv1alpha1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: consts.S3Backend1}},
+		&apisnsv1alpha1.ClusterProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: consts.S3Backend1}},
+		&apisnsv1alpha1.ClusterProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: consts.S3Backend2}},
+	}
+
+	cases := map[string]struct {
+		pc   apisv1alpha1.ProviderConfigObject
+		name string
+		want bool
+	}{
+		"Cluster provider config with the name of a legacy provider config": {
+			pc:   &apisnsv1alpha1.ClusterProviderConfig{},
+			name: consts.S3Backend1,
+			want: true,
+		},
+		"Cluster provider config with a unique name": {
+			pc:   &apisnsv1alpha1.ClusterProviderConfig{},
+			name: consts.S3Backend2,
+		},
+		"Legacy provider config is never shadowed": {
+			pc:   &apisv1alpha1.ProviderConfig{},
+			name: consts.S3Backend1,
+		},
+		"Namespaced provider config is never shadowed": {
+			pc:   &apisnsv1alpha1.ProviderConfig{},
+			name: consts.S3Backend1,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			kubeClient := fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).Build()
+
+			shadowed, err := IsShadowed(context.Background(), kubeClient, tc.pc, tc.name)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, shadowed)
+		})
+	}
+}